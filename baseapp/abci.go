@@ -185,6 +185,8 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
 	}
 
+	app.listenBeginBlock(req, res)
+
 	return res
 }
 
@@ -205,6 +207,8 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		res.ConsensusParamUpdates = cp
 	}
 
+	app.listenEndBlock(req, res)
+
 	return res
 }
 
@@ -221,7 +225,7 @@ func (app *BaseApp) CheckTxSync(req abci.RequestCheckTx) abci.ResponseCheckTx {
 		panic(fmt.Sprintf("unknown RequestCheckTx type: %s", req.Type))
 	}
 
-	tx, err := app.preCheckTx(req.Tx)
+	tx, err := app.preCheckTx(req.Tx, req.Type == abci.CheckTxType_Recheck)
 	if err != nil {
 		return sdkerrors.ResponseCheckTx(err, 0, 0, app.trace)
 	}
@@ -231,15 +235,12 @@ func (app *BaseApp) CheckTxSync(req abci.RequestCheckTx) abci.ResponseCheckTx {
 	app.checkAccountWGs.Wait(waits)
 	defer app.checkAccountWGs.Done(signals)
 
-	gInfo, err := app.checkTx(req.Tx, tx, req.Type == abci.CheckTxType_Recheck)
+	gInfo, priority, err := app.checkTx(req.Tx, tx, req.Type == abci.CheckTxType_Recheck, false)
 	if err != nil {
 		return sdkerrors.ResponseCheckTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace)
 	}
 
-	return abci.ResponseCheckTx{
-		GasWanted: int64(gInfo.GasWanted), // TODO: Should type accept unsigned ints?
-		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
-	}
+	return app.responseCheckTx(tx, gInfo, priority)
 }
 
 func (app *BaseApp) CheckTxAsync(req abci.RequestCheckTx, callback abci.CheckTxCallback) {
@@ -255,6 +256,10 @@ func (app *BaseApp) CheckTxAsync(req abci.RequestCheckTx, callback abci.CheckTxC
 	}
 	app.chCheckTx <- reqCheckTx
 
+	if app.sigVerifyTxsHandler != nil {
+		app.chPrepareCheckTx <- reqCheckTx
+		return
+	}
 	go app.prepareCheckTx(reqCheckTx)
 }
 
@@ -262,11 +267,17 @@ func (app *BaseApp) CheckTxAsync(req abci.RequestCheckTx, callback abci.CheckTxC
 func (app *BaseApp) BeginRecheckTx(req abci.RequestBeginRecheckTx) abci.ResponseBeginRecheckTx {
 	// NOTE: This is safe because Ostracon holds a lock on the mempool for Rechecking.
 	app.setCheckState(req.Header)
+	// the txs left in the mempool register themselves again while rechecked
+	app.pendingTxs.resetSenders()
+	app.checkTxCache.beginRecheck()
 	return abci.ResponseBeginRecheckTx{Code: abci.CodeTypeOK}
 }
 
 // EndRecheckTx implements the ABCI interface.
 func (app *BaseApp) EndRecheckTx(req abci.RequestEndRecheckTx) abci.ResponseEndRecheckTx {
+	// the replaced txs have been evicted by now, if they weren't committed
+	app.pendingTxs.resetReplaced()
+	app.checkTxCache.endRecheck()
 	return abci.ResponseEndRecheckTx{Code: abci.CodeTypeOK}
 }
 
@@ -275,8 +286,9 @@ func (app *BaseApp) EndRecheckTx(req abci.RequestEndRecheckTx) abci.ResponseEndR
 // Otherwise, the ResponseDeliverTx will contain releveant error information.
 // Regardless of tx execution outcome, the ResponseDeliverTx will contain relevant
 // gas execution context.
-func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliverTx) {
 	defer telemetry.MeasureSince(time.Now(), "abci", "deliver_tx")
+	defer func() { app.listenDeliverTx(req, res) }()
 
 	gInfo := sdk.GasInfo{}
 	resultStr := "successful"
@@ -296,6 +308,13 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 	gInfo, result, err := app.runTx(req.Tx, tx, false)
 	if err != nil {
 		resultStr = "failed"
+	}
+
+	return app.responseDeliverTx(gInfo, result, err)
+}
+
+func (app *BaseApp) responseDeliverTx(gInfo sdk.GasInfo, result *sdk.Result, err error) abci.ResponseDeliverTx {
+	if err != nil {
 		return sdkerrors.ResponseDeliverTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace)
 	}
 
@@ -317,7 +336,8 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	defer telemetry.MeasureSince(time.Now(), "abci", "commit")
 
-	header := app.deliverState.ctx.BlockHeader()
+	ctx := app.deliverState.ctx
+	header := ctx.BlockHeader()
 	retainHeight := app.GetBlockRetentionHeight(header.Height)
 
 	// Write the DeliverTx state into branched storage and commit the MultiStore.
@@ -355,10 +375,13 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 		go app.snapshot(header.Height)
 	}
 
-	return abci.ResponseCommit{
+	res = abci.ResponseCommit{
 		Data:         commitID.Hash,
 		RetainHeight: retainHeight,
 	}
+	app.listenCommit(ctx, res)
+
+	return res
 }
 
 // halt attempts to gracefully shutdown the node via SIGINT and SIGTERM falling
@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"reflect"
@@ -62,6 +63,9 @@ type BaseApp struct { // nolint: maligned
 	snapshotInterval   uint64 // block interval between state sync snapshots
 	snapshotKeepRecent uint32 // recent state sync snapshots to keep
 
+	// streaming services notified of the state changes of the delivered blocks
+	streamingServices []StreamingService
+
 	// volatile states:
 	//
 	// checkState is set on InitChain and reset on Commit
@@ -74,6 +78,28 @@ type BaseApp struct { // nolint: maligned
 	checkAccountWGs *AccountWGs
 	chCheckTx       chan *RequestCheckTxAsync
 
+	// verifies the signatures of the txs prepared for CheckTxAsync in batches
+	sigVerifyTxsHandler sdk.SigVerifyTxsHandler
+	chPrepareCheckTx    chan *RequestCheckTxAsync
+
+	// if true, DeliverTxs executes non-conflicting txs of a block concurrently
+	parallelDeliverTx bool
+	// if true, DeliverTxs executes all txs of a block concurrently and re-executes
+	// the conflicting ones
+	optimisticDeliverTx bool
+
+	// if true, CheckTx accepts a tx replacing the pending tx of its sender with a
+	// priority higher by txReplacementPriorityBump percent
+	txReplacement             bool
+	txReplacementPriorityBump uint64
+	pendingTxs                *pendingTxs
+
+	// evicts a replaced tx from the mempool, if set by the node
+	removeMempoolTx func(hash [sha256.Size]byte)
+
+	// txs accepted by CheckTx, reused on recheck
+	checkTxCache *checkTxCache
+
 	// an inter-block write-through cache provided to the context during deliverState
 	interBlockCache sdk.MultiStorePersistentCache
 
@@ -147,7 +173,10 @@ func NewBaseApp(
 		txDecoder:        txDecoder,
 		fauxMerkleMode:   false,
 		checkAccountWGs:  NewAccountWGs(),
+		pendingTxs:       newPendingTxs(),
+		checkTxCache:     newCheckTxCache(),
 		chCheckTx:        make(chan *RequestCheckTxAsync, 10000), // TODO config channel buffer size. It might be good to set it tendermint mempool.size
+		chPrepareCheckTx: make(chan *RequestCheckTxAsync, 10000),
 	}
 
 	for _, option := range options {
@@ -183,8 +212,14 @@ func (app *BaseApp) Logger() log.Logger {
 // MsgServiceRouter returns the MsgServiceRouter of a BaseApp.
 func (app *BaseApp) MsgServiceRouter() *MsgServiceRouter { return app.msgServiceRouter }
 
+// CommitMultiStore returns the root multi-store of the app.
+func (app *BaseApp) CommitMultiStore() sdk.CommitMultiStore { return app.cms }
+
+// SnapshotManager returns the snapshot manager of the app, or nil if no snapshot store is set.
+func (app *BaseApp) SnapshotManager() *snapshots.Manager { return app.snapshotManager }
+
 // MountStores mounts all IAVL or DB stores to the provided keys in the BaseApp
-// multistore.
+// multistore. Transient store keys are mounted as transient stores.
 func (app *BaseApp) MountStores(keys ...sdk.StoreKey) {
 	for _, key := range keys {
 		switch key.(type) {
@@ -197,6 +232,9 @@ func (app *BaseApp) MountStores(keys ...sdk.StoreKey) {
 				app.MountStore(key, sdk.StoreTypeDB)
 			}
 
+		case *sdk.TransientStoreKey:
+			app.MountStore(key, sdk.StoreTypeTransient)
+
 		default:
 			panic("Unrecognized store key type " + reflect.TypeOf(key).Name())
 		}
@@ -217,6 +255,14 @@ func (app *BaseApp) MountKVStores(keys map[string]*sdk.KVStoreKey) {
 	}
 }
 
+// MountTransientStores mounts all transient stores to the provided keys in
+// the BaseApp multistore. They are cleared on every Commit.
+func (app *BaseApp) MountTransientStores(keys map[string]*sdk.TransientStoreKey) {
+	for _, key := range keys {
+		app.MountStore(key, sdk.StoreTypeTransient)
+	}
+}
+
 // MountMemoryStores mounts all in-memory KVStores with the BaseApp's internal
 // commit multi-store.
 func (app *BaseApp) MountMemoryStores(keys map[string]*sdk.MemoryStoreKey) {
@@ -318,6 +364,19 @@ func (app *BaseApp) setTrace(trace bool) {
 	app.trace = trace
 }
 
+func (app *BaseApp) setParallelDeliverTx(enabled bool) {
+	app.parallelDeliverTx = enabled
+}
+
+func (app *BaseApp) setOptimisticDeliverTx(enabled bool) {
+	app.optimisticDeliverTx = enabled
+}
+
+func (app *BaseApp) setTxReplacement(priorityBump uint64) {
+	app.txReplacement = true
+	app.txReplacementPriorityBump = priorityBump
+}
+
 func (app *BaseApp) setIndexEvents(ie []string) {
 	app.indexEvents = make(map[string]struct{})
 
@@ -371,6 +430,7 @@ func (app *BaseApp) setCheckState(header ocproto.Header) {
 // Commit.
 func (app *BaseApp) setDeliverState(header ocproto.Header) {
 	ms := app.cms.CacheMultiStore()
+	app.addStreamingListeners(ms)
 	app.deliverState = &state{
 		ms:  ms,
 		ctx: sdk.NewContext(ms, header, false, app.logger),
@@ -498,10 +558,14 @@ func validateBasicTxMsgs(msgs []sdk.Msg) error {
 	return nil
 }
 
+// getCheckContextForTx returns a context on the check state with a gas meter of
+// its own, as the txs of different senders are checked concurrently.
 func (app *BaseApp) getCheckContextForTx(txBytes []byte, recheck bool) sdk.Context {
 	app.checkStateMtx.RLock()
 	defer app.checkStateMtx.RUnlock()
-	return app.getContextForTx(app.checkState, txBytes).WithIsReCheckTx(recheck)
+	return app.getContextForTx(app.checkState, txBytes).
+		WithIsReCheckTx(recheck).
+		WithGasMeter(sdk.NewInfiniteGasMeter())
 }
 
 // retrieve the context for the tx w/ txBytes and other memoized values.
@@ -541,8 +605,14 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 	return ctx.WithMultiStore(msCache), msCache
 }
 
-// stateless checkTx
-func (app *BaseApp) preCheckTx(txBytes []byte) (tx sdk.Tx, err error) {
+// stateless checkTx, skipped on recheck for the txs in the checkTxCache
+func (app *BaseApp) preCheckTx(txBytes []byte, recheck bool) (tx sdk.Tx, err error) {
+	if recheck {
+		if tx, ok := app.checkTxCache.get(sha256.Sum256(txBytes)); ok {
+			return tx, nil
+		}
+	}
+
 	defer func() {
 		if r := recover(); r != nil {
 			recoveryMW := newDefaultRecoveryMiddleware()
@@ -561,8 +631,8 @@ func (app *BaseApp) preCheckTx(txBytes []byte) (tx sdk.Tx, err error) {
 	return tx, err
 }
 
-func (app *BaseApp) checkTx(txBytes []byte, tx sdk.Tx, recheck bool) (gInfo sdk.GasInfo, err error) {
-	ctx := app.getCheckContextForTx(txBytes, recheck)
+func (app *BaseApp) checkTx(txBytes []byte, tx sdk.Tx, recheck, sigVerified bool) (gInfo sdk.GasInfo, priority int64, err error) {
+	ctx := app.getCheckContextForTx(txBytes, recheck).WithIsSigVerified(sigVerified)
 	gasCtx := &ctx
 
 	defer func() {
@@ -571,15 +641,39 @@ func (app *BaseApp) checkTx(txBytes []byte, tx sdk.Tx, recheck bool) (gInfo sdk.
 			err = processRecovery(r, recoveryMW)
 		}
 		gInfo = sdk.GasInfo{GasWanted: gasCtx.GasMeter().Limit(), GasUsed: gasCtx.GasMeter().GasConsumed()}
+		priority = gasCtx.Priority()
 	}()
 
+	txHash := sha256.Sum256(txBytes)
+	if app.txReplacement && recheck && app.pendingTxs.popReplaced(txHash) {
+		return gInfo, priority, sdkerrors.ErrTxReplaced
+	}
+
 	var anteCtx sdk.Context
 	anteCtx, err = app.anteTx(ctx, txBytes, tx, false)
+	if app.txReplacement && !recheck && sdkerrors.ErrWrongSequence.Is(err) {
+		if replaceCtx, replaced, replaceErr := app.checkReplaceableTx(ctx, txBytes, tx); replaced {
+			anteCtx, err = replaceCtx, replaceErr
+		}
+	}
 	if !anteCtx.IsZero() {
 		gasCtx = &anteCtx
 	}
 
-	return gInfo, err
+	if app.txReplacement && err == nil && !isUnorderedTx(tx) {
+		app.pendingTxs.set(txSender(tx), pendingTx{
+			tx:             tx,
+			hash:           txHash,
+			sigBlockHeight: tx.GetSigBlockHeight(),
+			priority:       anteCtx.Priority(),
+		})
+	}
+
+	if err == nil {
+		app.checkTxCache.add(txHash, tx)
+	}
+
+	return gInfo, priority, err
 }
 
 func (app *BaseApp) anteTx(ctx sdk.Context, txBytes []byte, tx sdk.Tx, simulate bool) (sdk.Context, error) {
@@ -614,18 +708,31 @@ func (app *BaseApp) anteTx(ctx sdk.Context, txBytes []byte, tx sdk.Tx, simulate
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(txBytes []byte, tx sdk.Tx, simulate bool) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
-	ctx := app.getRunContextForTx(txBytes, simulate)
+	return app.runTxWithContext(app.getRunContextForTx(txBytes, simulate), txBytes, tx, simulate)
+}
+
+// runTxWithContext is runTx against the given context. The context's multi-store
+// receives the state transitions of the tx and its block gas meter is charged
+// with the gas consumed by the tx.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, txBytes []byte, tx sdk.Tx, simulate bool) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
 	ms := ctx.MultiStore()
 
+	// the block gas accounting of runTx is not a read of the block gas meter by
+	// a tx run on a branch of the deliver state
+	blockGasMeter := ctx.BlockGasMeter()
+	if meter, ok := blockGasMeter.(*taskBlockGasMeter); ok {
+		blockGasMeter = meter.GasMeter
+	}
+
 	// only run the tx if there is block gas remaining
-	if !simulate && ctx.BlockGasMeter().IsOutOfGas() {
-		gInfo = sdk.GasInfo{GasUsed: ctx.BlockGasMeter().GasConsumed()}
+	if !simulate && blockGasMeter.IsOutOfGas() {
+		gInfo = sdk.GasInfo{GasUsed: blockGasMeter.GasConsumed()}
 		return gInfo, nil, sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
 	}
 
 	var startingGas uint64
 	if !simulate {
-		startingGas = ctx.BlockGasMeter().GasConsumed()
+		startingGas = blockGasMeter.GasConsumed()
 	}
 
 	defer func() {
@@ -644,11 +751,11 @@ func (app *BaseApp) runTx(txBytes []byte, tx sdk.Tx, simulate bool) (gInfo sdk.G
 	// to recover from this one.
 	defer func() {
 		if !simulate {
-			ctx.BlockGasMeter().ConsumeGas(
+			blockGasMeter.ConsumeGas(
 				ctx.GasMeter().GasConsumedToLimit(), "block gas meter",
 			)
 
-			if ctx.BlockGasMeter().GasConsumed() < startingGas {
+			if blockGasMeter.GasConsumed() < startingGas {
 				panic(sdk.ErrorGasOverflow{Descriptor: "tx gas summation"})
 			}
 		}
@@ -97,6 +97,9 @@ func registerTestCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&msgCounter2{}, "lbm-sdk/baseapp/msgCounter2", nil)
 	cdc.RegisterConcrete(&msgKeyValue{}, "lbm-sdk/baseapp/msgKeyValue", nil)
 	cdc.RegisterConcrete(&msgNoRoute{}, "lbm-sdk/baseapp/msgNoRoute", nil)
+	cdc.RegisterConcrete(&msgStoreKeyValue{}, "lbm-sdk/baseapp/msgStoreKeyValue", nil)
+	cdc.RegisterConcrete(&msgBlockGasAppend{}, "lbm-sdk/baseapp/msgBlockGasAppend", nil)
+	cdc.RegisterConcrete(&msgSequence{}, "lbm-sdk/baseapp/msgSequence", nil)
 }
 
 // aminoTxEncoder creates a amino TxEncoder for testing purposes.
@@ -203,6 +206,24 @@ func TestMountStores(t *testing.T) {
 	require.NotNil(t, store2)
 }
 
+func TestMountTransientStores(t *testing.T) {
+	tkey := sdk.NewTransientStoreKey("transient")
+	app := setupBaseApp(t, func(bapp *BaseApp) { bapp.MountStores(tkey) })
+	app.InitChain(abci.RequestInitChain{})
+
+	header := ocproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.deliverState.ctx.KVStore(tkey).Set([]byte("key"), []byte("value"))
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	// the transient store is cleared and not part of the commit info
+	require.Equal(t, sdk.StoreTypeTransient, app.cms.GetCommitKVStore(tkey).GetStoreType())
+	require.Nil(t, app.cms.GetKVStore(tkey).Get([]byte("key")))
+	app.BeginBlock(abci.RequestBeginBlock{Header: ocproto.Header{Height: 2}})
+	require.Nil(t, app.deliverState.ctx.KVStore(tkey).Get([]byte("key")))
+}
+
 // Test that we can make commits and then reload old versions.
 // Test that LoadLatestVersion actually does.
 func TestLoadVersion(t *testing.T) {
@@ -940,7 +961,9 @@ func TestCheckTx(t *testing.T) {
 		txBytes, err := codec.MarshalBinaryBare(tx)
 		require.NoError(t, err)
 		r := app.CheckTxSync(abci.RequestCheckTx{Tx: txBytes})
-		require.Empty(t, r.GetEvents())
+		require.Equal(t, sdk.Events{
+			sdk.NewEvent(EventTypeMempool, sdk.NewAttribute(AttributeKeyPriority, "0"), sdk.NewAttribute(AttributeKeySender, "")),
+		}.ToABCIEvents(), r.GetEvents())
 		require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
 	}
 
@@ -1799,8 +1822,8 @@ func TestListSnapshots(t *testing.T) {
 		s.Metadata = nil
 	}
 	assert.Equal(t, abci.ResponseListSnapshots{Snapshots: []*abci.Snapshot{
-		{Height: 4, Format: 1, Chunks: 2},
-		{Height: 2, Format: 1, Chunks: 1},
+		{Height: 4, Format: snapshottypes.CurrentFormat, Chunks: 3},
+		{Height: 2, Format: snapshottypes.CurrentFormat, Chunks: 2},
 	}}, resp)
 }
 
@@ -1814,13 +1837,13 @@ func TestLoadSnapshotChunk(t *testing.T) {
 		chunk       uint32
 		expectEmpty bool
 	}{
-		"Existing snapshot": {2, 1, 1, false},
-		"Missing height":    {100, 1, 1, true},
-		"Missing format":    {2, 2, 1, true},
-		"Missing chunk":     {2, 1, 9, true},
-		"Zero height":       {0, 1, 1, true},
+		"Existing snapshot": {2, snapshottypes.CurrentFormat, 1, false},
+		"Missing height":    {100, snapshottypes.CurrentFormat, 1, true},
+		"Missing format":    {2, snapshottypes.FormatV1, 1, true},
+		"Missing chunk":     {2, snapshottypes.CurrentFormat, 9, true},
+		"Zero height":       {0, snapshottypes.CurrentFormat, 1, true},
 		"Zero format":       {2, 0, 1, true},
-		"Zero chunk":        {2, 1, 0, false},
+		"Zero chunk":        {2, snapshottypes.CurrentFormat, 0, false},
 	}
 	for name, tc := range testcases {
 		tc := tc
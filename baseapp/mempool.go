@@ -0,0 +1,197 @@
+package baseapp
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"strconv"
+	"sync"
+
+	abci "github.com/line/ostracon/abci/types"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// The CheckTx responses carry the priority and the sender of the tx in an event,
+// for the mempool to order and evict txs by.
+const (
+	EventTypeMempool = "mempool"
+
+	AttributeKeyPriority = "priority"
+	AttributeKeySender   = "sender"
+)
+
+// pendingTx is the last tx of a sender accepted by CheckTx.
+type pendingTx struct {
+	tx             sdk.Tx
+	hash           [sha256.Size]byte
+	sigBlockHeight uint64
+	priority       int64
+}
+
+// pendingTxs tracks the last tx of each sender accepted to the mempool, and the
+// txs replaced since the last recheck.
+//
+// The index is rebuilt on every recheck: BeginRecheckTx clears it, the txs left
+// in the mempool add themselves back in mempool order, and the replaced txs are
+// rejected so that the mempool evicts them, unless they have been evicted when
+// replaced, see BaseApp.SetMempoolTxRemover.
+type pendingTxs struct {
+	mtx      sync.Mutex
+	senders  map[string]pendingTx
+	replaced map[[sha256.Size]byte]bool
+}
+
+func newPendingTxs() *pendingTxs {
+	return &pendingTxs{
+		senders:  map[string]pendingTx{},
+		replaced: map[[sha256.Size]byte]bool{},
+	}
+}
+
+func (p *pendingTxs) get(sender string) (pendingTx, bool) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	tx, ok := p.senders[sender]
+	return tx, ok
+}
+
+func (p *pendingTxs) set(sender string, tx pendingTx) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.senders[sender] = tx
+}
+
+func (p *pendingTxs) replace(hash [sha256.Size]byte) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.replaced[hash] = true
+}
+
+// popReplaced returns true if the tx has been replaced, and forgets it.
+func (p *pendingTxs) popReplaced(hash [sha256.Size]byte) bool {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if !p.replaced[hash] {
+		return false
+	}
+	delete(p.replaced, hash)
+	return true
+}
+
+func (p *pendingTxs) resetSenders() {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.senders = map[string]pendingTx{}
+}
+
+func (p *pendingTxs) resetReplaced() {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.replaced = map[[sha256.Size]byte]bool{}
+}
+
+// txSender returns the key of the sender of a tx, its first signer.
+func txSender(tx sdk.Tx) string {
+	signers := getUniqSigners(tx)
+	if len(signers) == 0 {
+		return ""
+	}
+	return signers[0]
+}
+
+// isUnorderedTx returns true if the tx is unordered. An unordered tx doesn't
+// take the sequence of its sender, thus it neither replaces nor is replaced by a
+// pending tx.
+func isUnorderedTx(tx sdk.Tx) bool {
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	return ok && unorderedTx.GetUnordered()
+}
+
+// checkReplaceableTx runs the ante handler on a tx that failed CheckTx because
+// of its sequence, as a replacement of the pending tx of its sender. It returns
+// false if the tx doesn't replace a pending tx: the tx has more than one signer,
+// or its sender has no pending tx at the same sig block height.
+//
+// Only the last pending tx of a sender can be replaced, the one taking the
+// sequence before the current sequence of the sender in the check state. A tx
+// reusing the sequence of an earlier pending tx of the sender fails with a
+// wrong sequence error, as the ante handler checks the sequence of a replacing
+// tx against the current sequence minus one. Replacing an earlier tx would
+// also invalidate the later pending txs of the sender, which have been checked
+// against the state the earlier tx left.
+func (app *BaseApp) checkReplaceableTx(ctx sdk.Context, txBytes []byte, tx sdk.Tx) (newCtx sdk.Context, replaced bool, err error) {
+	signers := getUniqSigners(tx)
+	if len(signers) != 1 {
+		return ctx, false, nil
+	}
+
+	pending, ok := app.pendingTxs.get(signers[0])
+	hash := sha256.Sum256(txBytes)
+	if !ok || pending.sigBlockHeight != tx.GetSigBlockHeight() || pending.hash == hash {
+		return ctx, false, nil
+	}
+
+	newCtx, err = app.anteReplaceTx(ctx, txBytes, tx, pending)
+	if err != nil {
+		return newCtx, true, err
+	}
+
+	app.pendingTxs.replace(pending.hash)
+	if app.removeMempoolTx != nil {
+		app.removeMempoolTx(pending.hash)
+	}
+	return newCtx, true, nil
+}
+
+// anteReplaceTx runs the ante handler on a tx replacing a pending tx, and only
+// writes its state transitions if the tx bumps the priority of the pending tx
+// enough. The ante handler gets the pending tx from the context, to undo its
+// fee deduction.
+func (app *BaseApp) anteReplaceTx(ctx sdk.Context, txBytes []byte, tx sdk.Tx, pending pendingTx) (sdk.Context, error) {
+	anteCtx, msCache := app.cacheTxContext(ctx.WithReplacedTx(pending.tx), txBytes)
+	anteCtx = anteCtx.WithEventManager(sdk.NewEventManager())
+	newCtx, err := app.anteHandler(anteCtx, tx, false)
+	if err != nil {
+		return newCtx, err
+	}
+
+	if !isPriorityBumped(pending.priority, newCtx.Priority(), app.txReplacementPriorityBump) {
+		return newCtx, sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientPriority,
+			"replacing a pending tx of priority %d needs a bump of %d%%, got priority %d",
+			pending.priority, app.txReplacementPriorityBump, newCtx.Priority(),
+		)
+	}
+
+	msCache.Write()
+	return newCtx, nil
+}
+
+// isPriorityBumped returns true if priority is higher than the one of a pending
+// tx by bumpPercent percent at least, and by one at least.
+func isPriorityBumped(pendingPriority, priority int64, bumpPercent uint64) bool {
+	if priority <= pendingPriority {
+		return false
+	}
+
+	required := new(big.Int).Mul(big.NewInt(pendingPriority), new(big.Int).SetUint64(100+bumpPercent))
+	return new(big.Int).Mul(big.NewInt(priority), big.NewInt(100)).Cmp(required) >= 0
+}
+
+// responseCheckTx returns the response of a tx accepted by CheckTx.
+func (app *BaseApp) responseCheckTx(tx sdk.Tx, gInfo sdk.GasInfo, priority int64) abci.ResponseCheckTx {
+	events := sdk.Events{
+		sdk.NewEvent(
+			EventTypeMempool,
+			sdk.NewAttribute(AttributeKeyPriority, strconv.FormatInt(priority, 10)),
+			sdk.NewAttribute(AttributeKeySender, txSender(tx)),
+		),
+	}
+
+	return abci.ResponseCheckTx{
+		GasWanted: int64(gInfo.GasWanted), // TODO: Should type accept unsigned ints?
+		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
+		Events:    events.ToABCIEvents(),
+	}
+}
@@ -0,0 +1,176 @@
+package baseapp
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	abci "github.com/line/ostracon/abci/types"
+	ocproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/codec"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// A msg of a signer using its sequence and paying for a priority.
+type msgSequence struct {
+	Signer   string
+	Sequence int64
+	Priority int64
+}
+
+func (msg msgSequence) Reset()         {}
+func (msg msgSequence) String() string { return "TODO" }
+func (msg msgSequence) ProtoMessage()  {}
+func (msg msgSequence) Route() string  { return routeMsgCounter }
+func (msg msgSequence) Type() string   { return "sequence" }
+func (msg msgSequence) GetSignBytes() []byte {
+	return nil
+}
+func (msg msgSequence) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Signer)}
+}
+func (msg msgSequence) ValidateBasic() error { return nil }
+
+// anteHandlerSequence checks and increments the sequences of the signers the
+// way the auth ante handler does.
+func anteHandlerSequence(capKey sdk.StoreKey) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		store := ctx.KVStore(capKey)
+		for _, m := range tx.GetMsgs() {
+			msg := m.(*msgSequence)
+			key := []byte(msg.Signer)
+
+			sequence := getIntFromStore(store, key)
+			if ctx.IsReplaceTx() {
+				sequence--
+			}
+			if msg.Sequence != sequence {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrWrongSequence, "expected %d, got %d", sequence, msg.Sequence)
+			}
+			if !ctx.IsReplaceTx() {
+				setIntOnStore(store, key, sequence+1)
+			}
+
+			ctx = ctx.WithPriority(msg.Priority)
+		}
+		return ctx, nil
+	}
+}
+
+func newTxSequence(msgs ...sdk.Msg) *txTest {
+	return &txTest{Msgs: msgs}
+}
+
+func TestCheckTxPriority(t *testing.T) {
+	app := setupBaseApp(t, func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerSequence(capKey1)) })
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	txBytes, err := cdc.MarshalBinaryBare(newTxSequence(&msgSequence{Signer: "alice", Sequence: 0, Priority: 7}))
+	require.NoError(t, err)
+
+	res := app.CheckTxSync(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.Events{
+		sdk.NewEvent(EventTypeMempool, sdk.NewAttribute(AttributeKeyPriority, "7"), sdk.NewAttribute(AttributeKeySender, "alice")),
+	}.ToABCIEvents(), res.Events)
+}
+
+func TestCheckTxReplacement(t *testing.T) {
+	app := setupBaseApp(t,
+		func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerSequence(capKey1)) },
+		SetTxReplacement(10),
+	)
+	app.InitChain(abci.RequestInitChain{})
+
+	var removed [][sha256.Size]byte
+	app.SetMempoolTxRemover(func(hash [sha256.Size]byte) { removed = append(removed, hash) })
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	checkTx := func(recheck bool, msgs ...sdk.Msg) (abci.ResponseCheckTx, []byte) {
+		txBytes, err := cdc.MarshalBinaryBare(newTxSequence(msgs...))
+		require.NoError(t, err)
+
+		typ := abci.CheckTxType_New
+		if recheck {
+			typ = abci.CheckTxType_Recheck
+		}
+		return app.CheckTxSync(abci.RequestCheckTx{Tx: txBytes, Type: typ}), txBytes
+	}
+
+	res, pending := checkTx(false, &msgSequence{Signer: "alice", Sequence: 0, Priority: 100})
+	require.True(t, res.IsOK(), res.Log)
+	res, _ = checkTx(false, &msgSequence{Signer: "bob", Sequence: 0, Priority: 100})
+	require.True(t, res.IsOK(), res.Log)
+
+	// a bump lower than 10% is rejected
+	res, _ = checkTx(false, &msgSequence{Signer: "alice", Sequence: 0, Priority: 109})
+	require.Equal(t, sdkerrors.ErrInsufficientPriority.ABCICode(), res.Code, res.Log)
+
+	// a tx using a wrong sequence is not a replacement
+	res, _ = checkTx(false, &msgSequence{Signer: "alice", Sequence: 5, Priority: 1000})
+	require.Equal(t, sdkerrors.ErrWrongSequence.ABCICode(), res.Code, res.Log)
+
+	// a tx of more than one signer is not a replacement
+	res, _ = checkTx(false, &msgSequence{Signer: "alice", Sequence: 0, Priority: 1000}, &msgSequence{Signer: "carol", Sequence: 0, Priority: 1000})
+	require.Equal(t, sdkerrors.ErrWrongSequence.ABCICode(), res.Code, res.Log)
+
+	res, replacing := checkTx(false, &msgSequence{Signer: "alice", Sequence: 0, Priority: 110})
+	require.True(t, res.IsOK(), res.Log)
+
+	// the replaced tx is evicted from the mempool
+	require.Equal(t, [][sha256.Size]byte{sha256.Sum256(pending)}, removed)
+
+	// the replacement is pending, so it's replaced with a bump on its own priority
+	res, _ = checkTx(false, &msgSequence{Signer: "alice", Sequence: 0, Priority: 120})
+	require.Equal(t, sdkerrors.ErrInsufficientPriority.ABCICode(), res.Code, res.Log)
+
+	// the next tx of alice still follows the replaced one
+	res, _ = checkTx(false, &msgSequence{Signer: "alice", Sequence: 1, Priority: 1})
+	require.True(t, res.IsOK(), res.Log)
+
+	// the replaced tx is rejected on recheck, and the replacing one stays
+	header := ocproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	app.BeginRecheckTx(abci.RequestBeginRecheckTx{Header: header})
+	res = app.CheckTxSync(abci.RequestCheckTx{Tx: pending, Type: abci.CheckTxType_Recheck})
+	require.Equal(t, sdkerrors.ErrTxReplaced.ABCICode(), res.Code, res.Log)
+	res = app.CheckTxSync(abci.RequestCheckTx{Tx: replacing, Type: abci.CheckTxType_Recheck})
+	require.True(t, res.IsOK(), res.Log)
+	app.EndRecheckTx(abci.RequestEndRecheckTx{})
+
+	require.Empty(t, app.pendingTxs.replaced)
+	p, ok := app.pendingTxs.get("alice")
+	require.True(t, ok)
+	require.Equal(t, int64(110), p.priority)
+}
+
+func TestIsPriorityBumped(t *testing.T) {
+	testCases := []struct {
+		pending  int64
+		priority int64
+		bump     uint64
+		expected bool
+	}{
+		{100, 100, 0, false},
+		{100, 101, 0, true},
+		{100, 109, 10, false},
+		{100, 110, 10, true},
+		{0, 1, 10, true},
+		{9223372036854775807, 9223372036854775807, 10, false},
+		{9223372036854775806, 9223372036854775807, 0, true},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, isPriorityBumped(tc.pending, tc.priority, tc.bump), "%+v", tc)
+	}
+}
@@ -17,6 +17,7 @@ import (
 type MsgServiceRouter struct {
 	interfaceRegistry codectypes.InterfaceRegistry
 	routes            map[string]MsgServiceHandler
+	methodNames       map[string]string
 }
 
 var _ gogogrpc.Server = &MsgServiceRouter{}
@@ -24,7 +25,8 @@ var _ gogogrpc.Server = &MsgServiceRouter{}
 // NewMsgServiceRouter creates a new MsgServiceRouter.
 func NewMsgServiceRouter() *MsgServiceRouter {
 	return &MsgServiceRouter{
-		routes: map[string]MsgServiceHandler{},
+		routes:      map[string]MsgServiceHandler{},
+		methodNames: map[string]string{},
 	}
 }
 
@@ -37,6 +39,12 @@ func (msr *MsgServiceRouter) Handler(methodName string) MsgServiceHandler {
 	return msr.routes[methodName]
 }
 
+// MethodName returns the fully-qualified Msg service method taking the
+// requests of a given type URL, or "" if not found.
+func (msr *MsgServiceRouter) MethodName(typeURL string) string {
+	return msr.methodNames[typeURL]
+}
+
 // RegisterService implements the gRPC Server.RegisterService method. sd is a gRPC
 // service description, handler is an object which implements that gRPC service.
 //
@@ -83,6 +91,7 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 			)
 		}
 
+		msr.methodNames["/"+proto.MessageName(serviceMsg)] = fqMethod
 		msr.routes[fqMethod] = func(ctx sdk.Context, req sdk.MsgRequest) (*sdk.Result, error) {
 			ctx = ctx.WithEventManager(sdk.NewEventManager())
 			interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
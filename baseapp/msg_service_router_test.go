@@ -40,6 +40,8 @@ func TestRegisterMsgService(t *testing.T) {
 			testdata.MsgServerImpl{},
 		)
 	})
+	require.Equal(t, "/testdata.Msg/CreateDog", app.MsgServiceRouter().MethodName("/testdata.MsgCreateDog"))
+	require.Empty(t, app.MsgServiceRouter().MethodName("/testdata.Dog"))
 }
 
 func TestRegisterMsgServiceTwice(t *testing.T) {
@@ -0,0 +1,68 @@
+package baseapp
+
+import (
+	"sync"
+
+	"github.com/line/lbm-sdk/store/cachekv"
+	"github.com/line/lbm-sdk/store/cachemulti"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+// deliverTxsOptimistic executes a batch of txs of a block speculatively. All
+// txs run concurrently on tracking branches of the deliver state as of the
+// start of the batch. The branches are then written back in block order, and a
+// tx that read a key written by a preceding tx of the batch, or the block gas
+// consumed before it, is run again on the up to date deliver state before its
+// branch is written.
+func (app *BaseApp) deliverTxsOptimistic(ms cachemulti.Store, tasks []*deliverTxTask) {
+	// txs after the one exhausting the block gas don't run at all
+	if !app.deliverState.ctx.BlockGasMeter().IsOutOfGas() {
+		wg := sync.WaitGroup{}
+		for _, task := range tasks {
+			if task.err != nil {
+				continue
+			}
+
+			wg.Add(1)
+			go func(task *deliverTxTask) {
+				defer wg.Done()
+				app.runDeliverTxTask(task, ms.CacheMultiStoreWithTracking())
+			}(task)
+		}
+		wg.Wait()
+	}
+
+	written := make(map[sdk.StoreKey]*cachekv.AccessSet)
+	for _, task := range tasks {
+		if task.msCache == nil || app.deliverState.ctx.BlockGasMeter().IsOutOfGas() {
+			task.response = app.commitDeliverTxTask(task)
+			continue
+		}
+
+		if readsWritten(task.msCache.(cachemulti.Store).ReadSets(), written) ||
+			task.blockGasMeter.moved(app.deliverState.ctx.BlockGasMeter()) {
+			app.runDeliverTxTask(task, ms.CacheMultiStoreWithTracking())
+		}
+
+		writeSets := task.msCache.(cachemulti.Store).WriteSets()
+		task.response = app.commitDeliverTxTask(task)
+
+		for key, writeSet := range writeSets {
+			if written[key] == nil {
+				written[key] = cachekv.NewAccessSet()
+			}
+			written[key].Merge(writeSet)
+		}
+	}
+}
+
+// readsWritten returns true if any of the read sets overlaps with the write set
+// of the same store.
+func readsWritten(readSets, writeSets map[sdk.StoreKey]*cachekv.AccessSet) bool {
+	for key, readSet := range readSets {
+		if readSet.Overlaps(writeSets[key]) {
+			return true
+		}
+	}
+	return false
+}
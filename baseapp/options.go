@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"io"
 
@@ -59,6 +60,28 @@ func SetIndexEvents(ie []string) func(*BaseApp) {
 	return func(app *BaseApp) { app.setIndexEvents(ie) }
 }
 
+// SetParallelDeliverTx provides a BaseApp option function that enables the
+// concurrent execution of non-conflicting txs in DeliverTxs.
+func SetParallelDeliverTx(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.setParallelDeliverTx(enabled) }
+}
+
+// SetOptimisticDeliverTx provides a BaseApp option function that enables the
+// optimistic concurrent execution of txs in DeliverTxs. It takes precedence
+// over SetParallelDeliverTx.
+func SetOptimisticDeliverTx(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.setOptimisticDeliverTx(enabled) }
+}
+
+// SetTxReplacement provides a BaseApp option function that lets CheckTx accept a
+// tx replacing the pending tx of its sender at the same sig block height, if it
+// reuses its sequence and its priority is higher by priorityBump percent at
+// least. The replaced tx is evicted from the mempool by the function set with
+// SetMempoolTxRemover, and rejected on the next recheck.
+func SetTxReplacement(priorityBump uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.setTxReplacement(priorityBump) }
+}
+
 // SetInterBlockCache provides a BaseApp option function that sets the
 // inter-block cache.
 func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
@@ -165,6 +188,23 @@ func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	app.anteHandler = ah
 }
 
+// SetSigVerifyTxsHandler sets the handler verifying the signatures of the txs of
+// CheckTxAsync in batches, ahead of their CheckTx.
+func (app *BaseApp) SetSigVerifyTxsHandler(handler sdk.SigVerifyTxsHandler) {
+	if app.sealed {
+		panic("SetSigVerifyTxsHandler() on sealed BaseApp")
+	}
+
+	app.sigVerifyTxsHandler = handler
+}
+
+// SetMempoolTxRemover sets the function evicting a tx from the mempool of the
+// node by its hash, called on the txs replaced in CheckTx. It is set by the node
+// once the mempool exists, after the BaseApp is sealed.
+func (app *BaseApp) SetMempoolTxRemover(remove func(hash [sha256.Size]byte)) {
+	app.removeMempoolTx = remove
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
@@ -0,0 +1,321 @@
+package baseapp
+
+import (
+	"time"
+
+	abci "github.com/line/ostracon/abci/types"
+
+	"github.com/line/lbm-sdk/store/cachemulti"
+	"github.com/line/lbm-sdk/telemetry"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// StoreAccessMsg is an optional interface for messages that declare the names
+// of the stores they write to, beyond the accounts of their signers. A tx whose
+// messages all declare their accesses may be executed concurrently with the
+// other txs of a block that neither share a signer nor a declared store or
+// account with it.
+//
+// The declaration only drives scheduling: a tx that turns out to read state
+// written by a preceding tx of its batch is run again, so an incomplete
+// declaration costs performance but not determinism.
+type StoreAccessMsg interface {
+	sdk.Msg
+
+	AccessedStores() []string
+}
+
+// AccountAccessMsg is an optional interface for messages that declare the
+// accounts they write to beyond the accounts of their signers, e.g. the
+// recipients of a transfer. See StoreAccessMsg.
+type AccountAccessMsg interface {
+	sdk.Msg
+
+	AccessedAccounts() []sdk.AccAddress
+}
+
+// deliverTxTask holds a tx of a block being executed by DeliverTxs.
+type deliverTxTask struct {
+	txBytes []byte
+	tx      sdk.Tx
+	err     error
+
+	msCache       sdk.CacheMultiStore
+	blockGasMeter *taskBlockGasMeter
+	gInfo         sdk.GasInfo
+	result        *sdk.Result
+
+	response abci.ResponseDeliverTx
+}
+
+// DeliverTxs executes the txs of a block in DeliverTx mode and returns their
+// responses in block order. It is called by the ABCI client of the node in
+// place of DeliverTx for each tx if parallel or optimistic execution is
+// enabled, see server.NewBatchLocalClientCreator.
+//
+// If parallel execution is enabled, the txs are split into consecutive batches
+// of txs that do not conflict with each other, see txConflictKeys. If
+// optimistic execution is enabled, all txs of the block form a single batch.
+// The txs of a batch run concurrently, and a tx that read state written by a
+// preceding tx of the batch is run again, see deliverTxsOptimistic. Either way
+// the resulting state, responses and block gas consumption are the same as with
+// sequential execution.
+func (app *BaseApp) DeliverTxs(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx {
+	responses := make([]abci.ResponseDeliverTx, len(reqs))
+
+	ms, ok := app.deliverState.ms.(cachemulti.Store)
+	if !ok || (!app.parallelDeliverTx && !app.optimisticDeliverTx) {
+		for i, req := range reqs {
+			responses[i] = app.DeliverTx(req)
+		}
+		return responses
+	}
+
+	defer telemetry.MeasureSince(time.Now(), "abci", "deliver_txs")
+
+	tasks := make([]*deliverTxTask, len(reqs))
+	for i, req := range reqs {
+		tx, err := app.txDecoder(req.Tx)
+		tasks[i] = &deliverTxTask{txBytes: req.Tx, tx: tx, err: err}
+	}
+
+	if app.optimisticDeliverTx {
+		app.deliverTxsOptimistic(ms, tasks)
+	} else {
+		for _, batch := range scheduleDeliverTxTasks(tasks) {
+			app.deliverTxsOptimistic(ms, batch)
+		}
+	}
+
+	for i, task := range tasks {
+		responses[i] = task.response
+	}
+
+	return responses
+}
+
+// taskBlockGasMeter is the block gas meter of a tx run on a branch of the
+// deliver state. It starts at the block gas consumed by the txs committed when
+// the tx is run, which is the position of the tx in the block unless a preceding
+// tx of its batch is committed after it has run. It records whether the tx read
+// it, so that the tx is run again if its position changed.
+type taskBlockGasMeter struct {
+	sdk.GasMeter
+
+	start sdk.Gas
+	read  bool
+}
+
+func newTaskBlockGasMeter(blockGasMeter sdk.GasMeter) *taskBlockGasMeter {
+	meter := sdk.NewInfiniteGasMeter()
+	if limit := blockGasMeter.Limit(); limit > 0 {
+		meter = sdk.NewGasMeter(limit)
+	}
+	start := blockGasMeter.GasConsumed()
+	meter.ConsumeGas(start, "block gas meter")
+	return &taskBlockGasMeter{GasMeter: meter, start: start}
+}
+
+func (m *taskBlockGasMeter) GasConsumed() sdk.Gas {
+	m.read = true
+	return m.GasMeter.GasConsumed()
+}
+
+func (m *taskBlockGasMeter) GasConsumedToLimit() sdk.Gas {
+	m.read = true
+	return m.GasMeter.GasConsumedToLimit()
+}
+
+func (m *taskBlockGasMeter) ConsumeGas(amount sdk.Gas, descriptor string) {
+	m.read = true
+	m.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+func (m *taskBlockGasMeter) IsPastLimit() bool {
+	m.read = true
+	return m.GasMeter.IsPastLimit()
+}
+
+func (m *taskBlockGasMeter) IsOutOfGas() bool {
+	m.read = true
+	return m.GasMeter.IsOutOfGas()
+}
+
+func (m *taskBlockGasMeter) String() string {
+	m.read = true
+	return m.GasMeter.String()
+}
+
+// consumed returns the block gas consumed by the tx.
+func (m *taskBlockGasMeter) consumed() sdk.Gas {
+	return m.GasMeter.GasConsumed() - m.start
+}
+
+// moved returns true if the tx read the block gas meter at a position other than
+// the current block gas consumption.
+func (m *taskBlockGasMeter) moved(blockGasMeter sdk.GasMeter) bool {
+	return m.read && m.start != blockGasMeter.GasConsumed()
+}
+
+// runDeliverTxTask runs a tx on the given branch of the deliver state.
+func (app *BaseApp) runDeliverTxTask(task *deliverTxTask, msCache sdk.CacheMultiStore) {
+	task.msCache = msCache
+	task.blockGasMeter = newTaskBlockGasMeter(app.deliverState.ctx.BlockGasMeter())
+
+	ctx := app.getContextForTx(app.deliverState, task.txBytes).
+		WithMultiStore(task.msCache).
+		WithGasMeter(sdk.NewInfiniteGasMeter()).
+		WithBlockGasMeter(task.blockGasMeter).
+		WithEventManager(sdk.NewEventManager())
+
+	task.gInfo, task.result, task.err = app.runTxWithContext(ctx, task.txBytes, task.tx, false)
+}
+
+// commitDeliverTxTask writes the branch of an executed tx to the deliver state,
+// charges the block gas meter and returns the response of the tx.
+func (app *BaseApp) commitDeliverTxTask(task *deliverTxTask) (res abci.ResponseDeliverTx) {
+	defer func() { app.listenDeliverTx(abci.RequestDeliverTx{Tx: task.txBytes}, res) }()
+
+	gInfo := sdk.GasInfo{}
+	resultStr := "successful"
+
+	defer func() {
+		telemetry.IncrCounter(1, "tx", "count")
+		telemetry.IncrCounter(1, "tx", resultStr)
+		telemetry.SetGauge(float32(gInfo.GasUsed), "tx", "gas", "used")
+		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
+	}()
+
+	if task.tx == nil {
+		return sdkerrors.ResponseDeliverTx(task.err, 0, 0, app.trace)
+	}
+
+	blockGasMeter := app.deliverState.ctx.BlockGasMeter()
+	if task.msCache == nil || blockGasMeter.IsOutOfGas() {
+		resultStr = "failed"
+		gInfo = sdk.GasInfo{GasUsed: blockGasMeter.GasConsumed()}
+		err := sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
+		return sdkerrors.ResponseDeliverTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace)
+	}
+
+	task.msCache.Write()
+
+	gInfo = task.gInfo
+	result, err := task.result, task.err
+	if gasErr := app.consumeBlockGas(blockGasMeter, gInfo, task.blockGasMeter.consumed()); gasErr != nil {
+		result, err = nil, gasErr
+	}
+
+	if err != nil {
+		resultStr = "failed"
+	}
+
+	return app.responseDeliverTx(gInfo, result, err)
+}
+
+// consumeBlockGas charges the block gas meter with the gas consumed by a tx, as
+// runTx does when running on the deliver state directly.
+func (app *BaseApp) consumeBlockGas(blockGasMeter sdk.GasMeter, gInfo sdk.GasInfo, amount sdk.Gas) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			gasMeter := sdk.NewInfiniteGasMeter()
+			gasMeter.ConsumeGas(gInfo.GasUsed, "tx gas")
+			ctx := sdk.Context{}.WithGasMeter(gasMeter)
+			err = processRecovery(r, newOutOfGasRecoveryMiddleware(gInfo.GasWanted, ctx, app.runTxRecoveryMiddleware))
+		}
+	}()
+
+	startingGas := blockGasMeter.GasConsumed()
+	blockGasMeter.ConsumeGas(amount, "block gas meter")
+
+	if blockGasMeter.GasConsumed() < startingGas {
+		panic(sdk.ErrorGasOverflow{Descriptor: "tx gas summation"})
+	}
+
+	return nil
+}
+
+// scheduleDeliverTxTasks splits the txs of a block into consecutive batches in
+// which no two txs conflict. A tx that cannot be decoded never conflicts, and a
+// tx with undeclared accesses runs in a batch of its own.
+func scheduleDeliverTxTasks(tasks []*deliverTxTask) (batches [][]*deliverTxTask) {
+	var batch []*deliverTxTask
+	used := map[string]bool{}
+
+	flush := func() {
+		if len(batch) > 0 {
+			batches = append(batches, batch)
+		}
+		batch = nil
+		used = map[string]bool{}
+	}
+
+	for _, task := range tasks {
+		if task.tx == nil {
+			batch = append(batch, task)
+			continue
+		}
+
+		keys, exclusive := txConflictKeys(task.tx)
+		if exclusive {
+			flush()
+			batch = append(batch, task)
+			flush()
+			continue
+		}
+
+		for _, key := range keys {
+			if used[key] {
+				flush()
+				break
+			}
+		}
+
+		for _, key := range keys {
+			used[key] = true
+		}
+		batch = append(batch, task)
+	}
+	flush()
+
+	return batches
+}
+
+// txConflictKeys returns the keys of the state a tx may write to: the accounts
+// of its signers and fee granter, and the stores and accounts declared by its
+// messages. Fees are not a conflict key: a chain crediting fees to the fee
+// collector at the end of the block, see the DeferredFees param of x/bank, has
+// no conflicts on them, otherwise the txs paying fees of a batch are run again.
+// exclusive is true if a message of the tx doesn't declare its accesses.
+func txConflictKeys(tx sdk.Tx) (keys []string, exclusive bool) {
+	for _, signer := range getUniqSigners(tx) {
+		keys = append(keys, "account/"+signer)
+	}
+
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		if granter := feeTx.FeeGranter(); !granter.Empty() {
+			keys = append(keys, "account/"+granter.String())
+		}
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		storeMsg, isStoreMsg := msg.(StoreAccessMsg)
+		accountMsg, isAccountMsg := msg.(AccountAccessMsg)
+		if !isStoreMsg && !isAccountMsg {
+			return nil, true
+		}
+		if isStoreMsg {
+			for _, store := range storeMsg.AccessedStores() {
+				keys = append(keys, "store/"+store)
+			}
+		}
+		if isAccountMsg {
+			for _, account := range accountMsg.AccessedAccounts() {
+				keys = append(keys, "account/"+account.String())
+			}
+		}
+	}
+
+	return keys, false
+}
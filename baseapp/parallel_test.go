@@ -0,0 +1,239 @@
+package baseapp
+
+import (
+	"fmt"
+	"testing"
+
+	abci "github.com/line/ostracon/abci/types"
+	ocproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/codec"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+const (
+	routeMsgStoreKeyValue  = "msgStoreKeyValue"
+	routeMsgBlockGasAppend = "msgBlockGasAppend"
+)
+
+// A msg that sets a key/value pair on the store it declares.
+type msgStoreKeyValue struct {
+	Key   []byte
+	Value []byte
+	Store string
+	Fail  bool
+}
+
+var _ StoreAccessMsg = msgStoreKeyValue{}
+
+func (msg msgStoreKeyValue) Reset()                       {}
+func (msg msgStoreKeyValue) String() string               { return "TODO" }
+func (msg msgStoreKeyValue) ProtoMessage()                {}
+func (msg msgStoreKeyValue) Route() string                { return routeMsgStoreKeyValue }
+func (msg msgStoreKeyValue) Type() string                 { return "storeKeyValue" }
+func (msg msgStoreKeyValue) GetSignBytes() []byte         { return nil }
+func (msg msgStoreKeyValue) GetSigners() []sdk.AccAddress { return nil }
+func (msg msgStoreKeyValue) ValidateBasic() error         { return nil }
+func (msg msgStoreKeyValue) AccessedStores() []string     { return []string{msg.Store} }
+
+// A msg that only declares the accounts it writes to.
+type msgAccountAccess struct {
+	Accounts []sdk.AccAddress
+}
+
+var _ AccountAccessMsg = msgAccountAccess{}
+
+func (msg msgAccountAccess) Reset()                             {}
+func (msg msgAccountAccess) String() string                     { return "TODO" }
+func (msg msgAccountAccess) ProtoMessage()                      {}
+func (msg msgAccountAccess) Route() string                      { return routeMsgStoreKeyValue }
+func (msg msgAccountAccess) Type() string                       { return "accountAccess" }
+func (msg msgAccountAccess) GetSignBytes() []byte               { return nil }
+func (msg msgAccountAccess) GetSigners() []sdk.AccAddress       { return nil }
+func (msg msgAccountAccess) ValidateBasic() error               { return nil }
+func (msg msgAccountAccess) AccessedAccounts() []sdk.AccAddress { return msg.Accounts }
+
+// A msg that appends the block gas consumed before it to a key of the store it
+// declares, as wasm does for the positions of contracts.
+type msgBlockGasAppend struct {
+	Key   []byte
+	Store string
+}
+
+var _ StoreAccessMsg = msgBlockGasAppend{}
+
+func (msg msgBlockGasAppend) Reset()                       {}
+func (msg msgBlockGasAppend) String() string               { return "TODO" }
+func (msg msgBlockGasAppend) ProtoMessage()                {}
+func (msg msgBlockGasAppend) Route() string                { return routeMsgBlockGasAppend }
+func (msg msgBlockGasAppend) Type() string                 { return "blockGasAppend" }
+func (msg msgBlockGasAppend) GetSignBytes() []byte         { return nil }
+func (msg msgBlockGasAppend) GetSigners() []sdk.AccAddress { return nil }
+func (msg msgBlockGasAppend) ValidateBasic() error         { return nil }
+func (msg msgBlockGasAppend) AccessedStores() []string     { return []string{msg.Store} }
+
+func setupParallelTestApp(t *testing.T, maxGas int64, options ...func(*BaseApp)) *BaseApp {
+	gasPerTx := uint64(100000)
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			ctx = ctx.WithGasMeter(sdk.NewGasMeter(gasPerTx))
+			ctx.GasMeter().ConsumeGas(1, "ante")
+			return ctx, nil
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		keys := map[string]sdk.StoreKey{capKey1.Name(): capKey1, capKey2.Name(): capKey2}
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgStoreKeyValue, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			kv := msg.(*msgStoreKeyValue)
+			store := ctx.KVStore(keys[kv.Store])
+			store.Set(kv.Key, append(store.Get(kv.Key), kv.Value...))
+			if kv.Fail {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "message handler failure")
+			}
+			ctx.EventManager().EmitEvent(sdk.NewEvent("set", sdk.NewAttribute("key", string(kv.Key))))
+			return &sdk.Result{Data: kv.Value, Events: ctx.EventManager().ABCIEvents()}, nil
+		}))
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgBlockGasAppend, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			bg := msg.(*msgBlockGasAppend)
+			store := ctx.KVStore(keys[bg.Store])
+			store.Set(bg.Key, append(store.Get(bg.Key), sdk.Uint64ToBigEndian(ctx.BlockGasMeter().GasConsumed())...))
+			return &sdk.Result{}, nil
+		}))
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgKeyValue, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			kv := msg.(*msgKeyValue)
+			ctx.KVStore(capKey1).Set(kv.Key, kv.Value)
+			ctx.KVStore(capKey2).Set(kv.Key, kv.Value)
+			return &sdk.Result{}, nil
+		}))
+	}
+
+	app := setupBaseApp(t, append(options, anteOpt, routerOpt)...)
+	app.InitChain(abci.RequestInitChain{
+		ConsensusParams: &abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: maxGas}},
+	})
+	return app
+}
+
+func newParallelTestBlock(t *testing.T, height int) []abci.RequestDeliverTx {
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	var reqs []abci.RequestDeliverTx
+	for i := 0; i < 20; i++ {
+		var msgs []sdk.Msg
+		key := []byte(fmt.Sprintf("key-%d", i%3))
+		value := []byte(fmt.Sprintf("%d-%d", height, i))
+		switch {
+		case i%7 == 6:
+			// undeclared accesses
+			msgs = append(msgs, msgKeyValue{Key: key, Value: value})
+		case i%4 == 1:
+			// reads the block gas consumed before it
+			msgs = append(msgs, msgBlockGasAppend{[]byte(fmt.Sprintf("position-%d", i)), capKey1.Name()})
+		case i%5 == 4:
+			// fails after writing
+			msgs = append(msgs, msgStoreKeyValue{key, value, capKey2.Name(), true})
+		default:
+			store := capKey1.Name()
+			if i%2 == 0 {
+				store = capKey2.Name()
+			}
+			msgs = append(msgs, msgStoreKeyValue{key, value, store, false})
+		}
+
+		txBytes, err := cdc.MarshalBinaryBare(txTest{Msgs: msgs})
+		require.NoError(t, err)
+		reqs = append(reqs, abci.RequestDeliverTx{Tx: txBytes})
+	}
+
+	// undecodable tx
+	reqs = append(reqs, abci.RequestDeliverTx{Tx: []byte("invalid")})
+
+	return reqs
+}
+
+func TestDeliverTxsParallel(t *testing.T) {
+	testCases := map[string]int64{
+		"infinite block gas": -1,
+		"out of block gas":   40000,
+	}
+
+	modes := map[string]func(*BaseApp){
+		"scheduled":  SetParallelDeliverTx(true),
+		"optimistic": SetOptimisticDeliverTx(true),
+	}
+
+	for name, maxGas := range testCases {
+		for mode, option := range modes {
+			t.Run(fmt.Sprintf("%s %s", mode, name), func(t *testing.T) {
+				testDeliverTxsParallel(t, maxGas, option)
+			})
+		}
+	}
+}
+
+func testDeliverTxsParallel(t *testing.T, maxGas int64, option func(*BaseApp)) {
+	sequentialStream := &recordingStreamingService{keys: []sdk.StoreKey{capKey1, capKey2}}
+	parallelStream := &recordingStreamingService{keys: []sdk.StoreKey{capKey1, capKey2}}
+	sequential := setupParallelTestApp(t, maxGas, func(bapp *BaseApp) { bapp.SetStreamingService(sequentialStream) })
+	parallel := setupParallelTestApp(t, maxGas, option, func(bapp *BaseApp) { bapp.SetStreamingService(parallelStream) })
+
+	for height := 1; height <= 3; height++ {
+		reqs := newParallelTestBlock(t, height)
+		header := ocproto.Header{Height: int64(height)}
+
+		sequential.BeginBlock(abci.RequestBeginBlock{Header: header})
+		parallel.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+		expected := sequential.DeliverTxs(reqs)
+		actual := parallel.DeliverTxs(reqs)
+		require.Equal(t, expected, actual)
+
+		sequential.EndBlock(abci.RequestEndBlock{Height: int64(height)})
+		parallel.EndBlock(abci.RequestEndBlock{Height: int64(height)})
+
+		require.Equal(t, sequential.Commit(), parallel.Commit())
+	}
+
+	// the state changes are streamed in the same order
+	require.Len(t, sequentialStream.blocks, 3)
+	require.Equal(t, sequentialStream.blocks, parallelStream.blocks)
+}
+
+func TestScheduleDeliverTxTasks(t *testing.T) {
+	declared := func(store string) *deliverTxTask {
+		return &deliverTxTask{tx: txTest{Msgs: []sdk.Msg{msgStoreKeyValue{Store: store}}}}
+	}
+	undeclared := &deliverTxTask{tx: txTest{Msgs: []sdk.Msg{msgKeyValue{}}}}
+	invalid := &deliverTxTask{}
+
+	tasks := []*deliverTxTask{
+		declared("a"), declared("b"), invalid, declared("a"), undeclared, declared("b"), declared("c"),
+	}
+	batches := scheduleDeliverTxTasks(tasks)
+
+	require.Equal(t, [][]*deliverTxTask{
+		{tasks[0], tasks[1], tasks[2]},
+		{tasks[3]},
+		{tasks[4]},
+		{tasks[5], tasks[6]},
+	}, batches)
+
+	account := func(addrs ...sdk.AccAddress) *deliverTxTask {
+		return &deliverTxTask{tx: txTest{Msgs: []sdk.Msg{msgAccountAccess{Accounts: addrs}}}}
+	}
+	addr1, addr2, addr3 := sdk.AccAddress("addr1"), sdk.AccAddress("addr2"), sdk.AccAddress("addr3")
+
+	tasks = []*deliverTxTask{
+		account(addr1), account(addr2), declared("a"), account(addr2, addr3), account(addr1), account(addr3),
+	}
+	batches = scheduleDeliverTxTasks(tasks)
+
+	require.Equal(t, [][]*deliverTxTask{
+		{tasks[0], tasks[1], tasks[2]},
+		{tasks[3], tasks[4]},
+		{tasks[5]},
+	}, batches)
+}
@@ -9,17 +9,23 @@ import (
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 )
 
+// maxPrepareCheckTxBatch bounds the number of txs whose signatures are verified
+// in a batch ahead of their CheckTx.
+const maxPrepareCheckTxBatch = 256
+
 func (app *BaseApp) startReactors() {
 	go app.checkTxAsyncReactor()
+	go app.prepareCheckTxReactor()
 }
 
 type RequestCheckTxAsync struct {
-	txBytes  []byte
-	recheck  bool
-	callback abci.CheckTxCallback
-	prepare  *sync.WaitGroup
-	tx       sdk.Tx
-	err      error
+	txBytes     []byte
+	recheck     bool
+	callback    abci.CheckTxCallback
+	prepare     *sync.WaitGroup
+	tx          sdk.Tx
+	sigVerified bool
+	err         error
 }
 
 func (app *BaseApp) checkTxAsyncReactor() {
@@ -38,22 +44,88 @@ func (app *BaseApp) checkTxAsyncReactor() {
 
 func (app *BaseApp) prepareCheckTx(req *RequestCheckTxAsync) {
 	defer req.prepare.Done()
-	req.tx, req.err = app.preCheckTx(req.txBytes)
+	req.tx, req.err = app.preCheckTx(req.txBytes, req.recheck)
+}
+
+// prepareCheckTxReactor prepares the requests pending together, for their
+// signatures to be verified in a batch by the sigVerifyTxsHandler.
+func (app *BaseApp) prepareCheckTxReactor() {
+	for req := range app.chPrepareCheckTx {
+		reqs := []*RequestCheckTxAsync{req}
+	pending:
+		for len(reqs) < maxPrepareCheckTxBatch {
+			select {
+			case req := <-app.chPrepareCheckTx:
+				reqs = append(reqs, req)
+			default:
+				break pending
+			}
+		}
+
+		go app.prepareCheckTxs(reqs)
+	}
+}
+
+func (app *BaseApp) prepareCheckTxs(reqs []*RequestCheckTxAsync) {
+	defer func() {
+		for _, req := range reqs {
+			req.prepare.Done()
+		}
+	}()
+
+	wg := sync.WaitGroup{}
+	for _, req := range reqs {
+		wg.Add(1)
+		go func(req *RequestCheckTxAsync) {
+			defer wg.Done()
+			req.tx, req.err = app.preCheckTx(req.txBytes, req.recheck)
+		}(req)
+	}
+	wg.Wait()
+
+	app.verifyCheckTxSigs(reqs)
+}
+
+// verifyCheckTxSigs verifies the signatures of the new txs of the requests, the
+// cache of the AnteHandler serving the rechecked ones.
+func (app *BaseApp) verifyCheckTxSigs(reqs []*RequestCheckTxAsync) {
+	defer func() {
+		// leave the signatures to the AnteHandler
+		if r := recover(); r != nil {
+			for _, req := range reqs {
+				req.sigVerified = false
+			}
+		}
+	}()
+
+	verifying := make([]*RequestCheckTxAsync, 0, len(reqs))
+	txs := make([]sdk.Tx, 0, len(reqs))
+	for _, req := range reqs {
+		if req.err == nil && !req.recheck {
+			verifying = append(verifying, req)
+			txs = append(txs, req.tx)
+		}
+	}
+	if len(txs) == 0 {
+		return
+	}
+
+	verified := app.sigVerifyTxsHandler(app.getCheckContextForTx(nil, false), txs)
+	for i, req := range verifying {
+		req.sigVerified = i < len(verified) && verified[i]
+	}
 }
 
 func (app *BaseApp) checkTxAsync(req *RequestCheckTxAsync, waits []*sync.WaitGroup, signals []*AccountWG) {
 	app.checkAccountWGs.Wait(waits)
 	defer app.checkAccountWGs.Done(signals)
 
-	gInfo, err := app.checkTx(req.txBytes, req.tx, req.recheck)
+	gInfo, priority, err := app.checkTx(req.txBytes, req.tx, req.recheck, req.sigVerified)
 
 	if err != nil {
 		req.callback(sdkerrors.ResponseCheckTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace))
 		return
 	}
 
-	req.callback(abci.ResponseCheckTx{
-		GasWanted: int64(gInfo.GasWanted), // TODO: Should type accept unsigned ints?
-		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
-	})
+	req.callback(app.responseCheckTx(req.tx, gInfo, priority))
 }
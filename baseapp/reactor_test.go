@@ -0,0 +1,75 @@
+package baseapp
+
+import (
+	"sync"
+	"testing"
+
+	abci "github.com/line/ostracon/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/codec"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+func TestCheckTxAsyncSigVerifyTxs(t *testing.T) {
+	mtx := sync.Mutex{}
+	verifying := 0
+	sigVerified := map[string]bool{}
+
+	anteOpt := func(bapp *BaseApp) {
+		anteHandler := anteHandlerSequence(capKey1)
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			mtx.Lock()
+			sigVerified[tx.GetMsgs()[0].(*msgSequence).Signer] = ctx.IsSigVerified()
+			mtx.Unlock()
+			return anteHandler(ctx, tx, simulate)
+		})
+	}
+	// the signatures of bob are left to the ante handler
+	sigVerifyOpt := func(bapp *BaseApp) {
+		bapp.SetSigVerifyTxsHandler(func(ctx sdk.Context, txs []sdk.Tx) []bool {
+			mtx.Lock()
+			defer mtx.Unlock()
+			verifying += len(txs)
+
+			verified := make([]bool, len(txs))
+			for i, tx := range txs {
+				verified[i] = tx.GetMsgs()[0].(*msgSequence).Signer != "bob"
+			}
+			return verified
+		})
+	}
+	app := setupBaseApp(t, anteOpt, sigVerifyOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	signers := []string{"alice", "bob", "carol", "dave"}
+	responses := make(chan abci.ResponseCheckTx, len(signers)+1)
+	callback := func(res abci.ResponseCheckTx) { responses <- res }
+
+	for _, signer := range signers {
+		txBytes, err := cdc.MarshalBinaryBare(newTxSequence(&msgSequence{Signer: signer}))
+		require.NoError(t, err)
+		app.CheckTxAsync(abci.RequestCheckTx{Tx: txBytes}, callback)
+	}
+	// a tx failing to decode is not verified
+	app.CheckTxAsync(abci.RequestCheckTx{Tx: []byte("invalid")}, callback)
+
+	failed := 0
+	for range signers {
+		if res := <-responses; !res.IsOK() {
+			failed++
+		}
+	}
+	if res := <-responses; !res.IsOK() {
+		failed++
+	}
+	require.Equal(t, 1, failed)
+
+	mtx.Lock()
+	defer mtx.Unlock()
+	require.Equal(t, len(signers), verifying)
+	require.Equal(t, map[string]bool{"alice": true, "bob": false, "carol": true, "dave": true}, sigVerified)
+}
@@ -0,0 +1,86 @@
+package baseapp
+
+import (
+	abci "github.com/line/ostracon/abci/types"
+
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+// ABCIListener is the interface used to hook into the ABCI message processing
+// of the BaseApp. The listeners are called once the BaseApp has processed the
+// message in DeliverTx mode.
+type ABCIListener interface {
+	// ListenBeginBlock updates the listener with the BeginBlock request and response
+	ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error
+	// ListenDeliverTx updates the listener with the DeliverTx request and response
+	ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+	// ListenEndBlock updates the listener with the EndBlock request and response
+	ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
+	// ListenCommit updates the listener with the Commit response
+	ListenCommit(ctx sdk.Context, res abci.ResponseCommit) error
+}
+
+// StreamingService streams the state changes of the delivered blocks. Its
+// WriteListeners are added to the deliver state, so they are notified of the
+// writes of BeginBlock, every DeliverTx and EndBlock in the order they are
+// applied, with the ABCIListener calls marking the end of each step. The state
+// changes of CheckTx and queries are never streamed.
+type StreamingService interface {
+	ABCIListener
+
+	// Listeners returns the WriteListeners of the KVStores to stream
+	Listeners() map[sdk.StoreKey][]sdk.WriteListener
+}
+
+// SetStreamingService adds a StreamingService to the BaseApp.
+func (app *BaseApp) SetStreamingService(s StreamingService) {
+	if app.sealed {
+		panic("SetStreamingService() on sealed BaseApp")
+	}
+	app.streamingServices = append(app.streamingServices, s)
+}
+
+// addStreamingListeners adds the WriteListeners of the streaming services to
+// the given deliver state multi-store.
+func (app *BaseApp) addStreamingListeners(ms sdk.CacheMultiStore) {
+	for _, s := range app.streamingServices {
+		for key, listeners := range s.Listeners() {
+			ms.AddListeners(key, listeners)
+		}
+	}
+}
+
+// listenBeginBlock, listenDeliverTx, listenEndBlock and listenCommit update the
+// streaming services. A failing service is logged and does not affect the
+// processing of the block.
+func (app *BaseApp) listenBeginBlock(req abci.RequestBeginBlock, res abci.ResponseBeginBlock) {
+	for _, s := range app.streamingServices {
+		if err := s.ListenBeginBlock(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("BeginBlock listening hook failed", "height", req.Header.Height, "err", err)
+		}
+	}
+}
+
+func (app *BaseApp) listenDeliverTx(req abci.RequestDeliverTx, res abci.ResponseDeliverTx) {
+	for _, s := range app.streamingServices {
+		if err := s.ListenDeliverTx(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("DeliverTx listening hook failed", "err", err)
+		}
+	}
+}
+
+func (app *BaseApp) listenEndBlock(req abci.RequestEndBlock, res abci.ResponseEndBlock) {
+	for _, s := range app.streamingServices {
+		if err := s.ListenEndBlock(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("EndBlock listening hook failed", "height", req.Height, "err", err)
+		}
+	}
+}
+
+func (app *BaseApp) listenCommit(ctx sdk.Context, res abci.ResponseCommit) {
+	for _, s := range app.streamingServices {
+		if err := s.ListenCommit(ctx, res); err != nil {
+			app.logger.Error("Commit listening hook failed", "height", ctx.BlockHeight(), "err", err)
+		}
+	}
+}
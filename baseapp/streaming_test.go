@@ -0,0 +1,123 @@
+package baseapp
+
+import (
+	"testing"
+
+	abci "github.com/line/ostracon/abci/types"
+	ocproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/codec"
+	storetypes "github.com/line/lbm-sdk/store/types"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+// recordedBlock are the state changes of a block as recorded by a recordingStreamingService
+type recordedBlock struct {
+	height     int64
+	beginBlock []storetypes.StoreKVPair
+	txs        [][]storetypes.StoreKVPair
+	endBlock   []storetypes.StoreKVPair
+	committed  bool
+}
+
+type recordingStreamingService struct {
+	keys    []sdk.StoreKey
+	pending []storetypes.StoreKVPair
+	blocks  []*recordedBlock
+}
+
+var _ StreamingService = (*recordingStreamingService)(nil)
+
+func (s *recordingStreamingService) Listeners() map[sdk.StoreKey][]sdk.WriteListener {
+	listeners := make(map[sdk.StoreKey][]sdk.WriteListener)
+	for _, key := range s.keys {
+		listeners[key] = []sdk.WriteListener{s}
+	}
+	return listeners
+}
+
+func (s *recordingStreamingService) OnWrite(storeKey sdk.StoreKey, key []byte, value []byte, delete bool) error {
+	s.pending = append(s.pending, storetypes.StoreKVPair{StoreKey: storeKey.Name(), Key: key, Value: value, Delete: delete})
+	return nil
+}
+
+func (s *recordingStreamingService) flush() []storetypes.StoreKVPair {
+	pairs := s.pending
+	s.pending = nil
+	return pairs
+}
+
+func (s *recordingStreamingService) current() *recordedBlock {
+	return s.blocks[len(s.blocks)-1]
+}
+
+func (s *recordingStreamingService) ListenBeginBlock(_ sdk.Context, req abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	s.blocks = append(s.blocks, &recordedBlock{height: req.Header.Height, beginBlock: s.flush()})
+	return nil
+}
+
+func (s *recordingStreamingService) ListenDeliverTx(_ sdk.Context, _ abci.RequestDeliverTx, _ abci.ResponseDeliverTx) error {
+	s.current().txs = append(s.current().txs, s.flush())
+	return nil
+}
+
+func (s *recordingStreamingService) ListenEndBlock(_ sdk.Context, _ abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	s.current().endBlock = s.flush()
+	return nil
+}
+
+func (s *recordingStreamingService) ListenCommit(_ sdk.Context, _ abci.ResponseCommit) error {
+	s.current().committed = true
+	return nil
+}
+
+func TestStreamingService(t *testing.T) {
+	service := &recordingStreamingService{keys: []sdk.StoreKey{capKey2}}
+	app := setupParallelTestApp(t, -1, func(bapp *BaseApp) {
+		bapp.SetStreamingService(service)
+		bapp.SetBeginBlocker(func(ctx sdk.Context, _ abci.RequestBeginBlock) abci.ResponseBeginBlock {
+			ctx.KVStore(capKey2).Set([]byte("begin"), []byte("1"))
+			return abci.ResponseBeginBlock{}
+		})
+		bapp.SetEndBlocker(func(ctx sdk.Context, _ abci.RequestEndBlock) abci.ResponseEndBlock {
+			ctx.KVStore(capKey2).Delete([]byte("begin"))
+			return abci.ResponseEndBlock{}
+		})
+	})
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+	newTx := func(msgs ...sdk.Msg) []byte {
+		txBytes, err := cdc.MarshalBinaryBare(txTest{Msgs: msgs})
+		require.NoError(t, err)
+		return txBytes
+	}
+	txs := [][]byte{
+		newTx(msgStoreKeyValue{[]byte("b"), []byte("1"), capKey2.Name(), false}, msgStoreKeyValue{[]byte("a"), []byte("2"), capKey2.Name(), false}),
+		newTx(msgStoreKeyValue{[]byte("c"), []byte("3"), capKey1.Name(), false}),
+		newTx(msgStoreKeyValue{[]byte("d"), []byte("4"), capKey2.Name(), true}),
+	}
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: ocproto.Header{Height: 1}})
+	for _, tx := range txs {
+		app.DeliverTx(abci.RequestDeliverTx{Tx: tx})
+	}
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	pair := func(key, value string) storetypes.StoreKVPair {
+		return storetypes.StoreKVPair{StoreKey: capKey2.Name(), Key: []byte(key), Value: []byte(value)}
+	}
+	require.Equal(t, []*recordedBlock{{
+		height:     1,
+		beginBlock: []storetypes.StoreKVPair{pair("begin", "1")},
+		txs: [][]storetypes.StoreKVPair{
+			{pair("a", "2"), pair("b", "1")},
+			nil,
+			nil,
+		},
+		endBlock:  []storetypes.StoreKVPair{{StoreKey: capKey2.Name(), Key: []byte("begin"), Delete: true}},
+		committed: true,
+	}}, service.blocks)
+}
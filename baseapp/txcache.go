@@ -0,0 +1,64 @@
+package baseapp
+
+import (
+	"crypto/sha256"
+	"sync"
+
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+// checkTxCacheSize bounds the number of txs in the checkTxCache, should the
+// mempool not be rechecked.
+const checkTxCacheSize = 10000
+
+// checkTxCache holds the txs accepted by CheckTx, decoded and validated, keyed
+// by tx hash. The ReCheck of the txs left in the mempool reuses them, skipping
+// their stateless checks.
+//
+// The txs not rechecked between BeginRecheckTx and EndRecheckTx have left the
+// mempool, and are removed from the cache in EndRecheckTx.
+type checkTxCache struct {
+	mtx       sync.Mutex
+	txs       map[[sha256.Size]byte]sdk.Tx
+	rechecked map[[sha256.Size]byte]sdk.Tx // nil if not rechecking
+}
+
+func newCheckTxCache() *checkTxCache {
+	return &checkTxCache{txs: map[[sha256.Size]byte]sdk.Tx{}}
+}
+
+func (c *checkTxCache) get(hash [sha256.Size]byte) (sdk.Tx, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	tx, ok := c.txs[hash]
+	return tx, ok
+}
+
+// add keeps a tx accepted by CheckTx or ReCheck.
+func (c *checkTxCache) add(hash [sha256.Size]byte, tx sdk.Tx) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if len(c.txs) < checkTxCacheSize {
+		c.txs[hash] = tx
+	}
+	if c.rechecked != nil {
+		c.rechecked[hash] = tx
+	}
+}
+
+func (c *checkTxCache) beginRecheck() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.rechecked = map[[sha256.Size]byte]sdk.Tx{}
+}
+
+func (c *checkTxCache) endRecheck() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.rechecked == nil {
+		return
+	}
+	c.txs = c.rechecked
+	c.rechecked = nil
+}
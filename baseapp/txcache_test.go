@@ -0,0 +1,69 @@
+package baseapp
+
+import (
+	"testing"
+
+	abci "github.com/line/ostracon/abci/types"
+	ocproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/codec"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+func TestRecheckTxCache(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	decoded := 0
+	decoderOpt := func(bapp *BaseApp) {
+		decoder := bapp.txDecoder
+		bapp.txDecoder = func(txBytes []byte) (sdk.Tx, error) {
+			decoded++
+			return decoder(txBytes)
+		}
+	}
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerSequence(capKey1)) }
+	app := setupBaseApp(t, decoderOpt, anteOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	txs := make([][]byte, 3)
+	for i := range txs {
+		txBytes, err := cdc.MarshalBinaryBare(newTxSequence(&msgSequence{Signer: "alice", Sequence: int64(i)}))
+		require.NoError(t, err)
+		txs[i] = txBytes
+
+		res := app.CheckTxSync(abci.RequestCheckTx{Tx: txBytes})
+		require.True(t, res.IsOK(), res.Log)
+	}
+	require.Equal(t, 3, decoded)
+
+	recheck := func(txs ...[]byte) {
+		header := ocproto.Header{Height: app.LastBlockHeight() + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+
+		app.BeginRecheckTx(abci.RequestBeginRecheckTx{Header: header})
+		for _, txBytes := range txs {
+			res := app.CheckTxSync(abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_Recheck})
+			require.True(t, res.IsOK(), res.Log)
+		}
+		app.EndRecheckTx(abci.RequestEndRecheckTx{})
+	}
+
+	// the rechecked txs are not decoded again
+	recheck(txs...)
+	require.Equal(t, 3, decoded)
+	require.Len(t, app.checkTxCache.txs, 3)
+
+	// the txs not rechecked leave the cache
+	recheck(txs[0])
+	require.Equal(t, 3, decoded)
+	require.Len(t, app.checkTxCache.txs, 1)
+
+	// the txs out of the cache are decoded on recheck
+	recheck(txs[0], txs[1])
+	require.Equal(t, 4, decoded)
+	require.Len(t, app.checkTxCache.txs, 2)
+}
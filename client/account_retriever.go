@@ -18,6 +18,7 @@ type Account interface {
 type AccountRetriever interface {
 	GetAccount(clientCtx Context, addr sdk.AccAddress) (Account, error)
 	GetLatestHeight(clientCtx Context) (uint64, error)
+	GetSigBlockHeightWindow(clientCtx Context) (min, max uint64, err error)
 	GetAccountWithHeight(clientCtx Context, addr sdk.AccAddress) (Account, int64, error)
 	EnsureExists(clientCtx Context, addr sdk.AccAddress) error
 	GetAccountSequence(clientCtx Context, addr sdk.AccAddress) (accSeq uint64, err error)
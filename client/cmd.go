@@ -243,9 +243,11 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 		clientCtx = clientCtx.WithFrom(from).WithFromAddress(fromAddr).WithFromName(fromName)
 
 		// If the `from` signer account is a ledger key, we need to use
-		// SIGN_MODE_AMINO_JSON, because ledger doesn't support proto yet.
+		// SIGN_MODE_AMINO_JSON, because ledger doesn't support proto yet, unless
+		// SIGN_MODE_TEXTUAL is chosen, whose sign docs the ledger displays.
 		// ref: https://github.com/cosmos/cosmos-sdk/issues/8109
-		if keyType == keyring.TypeLedger && clientCtx.SignModeStr != flags.SignModeLegacyAminoJSON {
+		if keyType == keyring.TypeLedger && clientCtx.SignModeStr != flags.SignModeLegacyAminoJSON &&
+			clientCtx.SignModeStr != flags.SignModeTextual {
 			fmt.Println("Default sign-mode 'direct' not supported by Ledger, using sign-mode 'amino-json'.")
 			clientCtx = clientCtx.WithSignModeStr(flags.SignModeLegacyAminoJSON)
 		}
@@ -35,7 +35,9 @@ func (t TestAccount) GetSequence() uint64 {
 
 // TestAccountRetriever is an AccountRetriever that can be used in unit tests
 type TestAccountRetriever struct {
-	Accounts map[string]TestAccount
+	Accounts          map[string]TestAccount
+	MinSigBlockHeight uint64
+	MaxSigBlockHeight uint64
 }
 
 // GetAccount implements AccountRetriever.GetAccount
@@ -52,6 +54,11 @@ func (t TestAccountRetriever) GetLatestHeight(_ Context) (uint64, error) {
 	return 0, nil
 }
 
+// GetSigBlockHeightWindow implements AccountRetriever.GetSigBlockHeightWindow
+func (t TestAccountRetriever) GetSigBlockHeightWindow(_ Context) (min, max uint64, err error) {
+	return t.MinSigBlockHeight, t.MaxSigBlockHeight, nil
+}
+
 // GetAccountWithHeight implements AccountRetriever.GetAccountWithHeight
 func (t TestAccountRetriever) GetAccountWithHeight(clientCtx Context, addr sdk.AccAddress) (Account, int64, error) {
 	acc, err := t.GetAccount(clientCtx, addr)
@@ -1,13 +1,20 @@
 package tx
 
 import (
+	"context"
+
 	"github.com/spf13/pflag"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/line/lbm-sdk/client"
 	"github.com/line/lbm-sdk/client/flags"
 	"github.com/line/lbm-sdk/crypto/keyring"
 	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/types/tx/signing"
+	authsigning "github.com/line/lbm-sdk/x/auth/signing"
+	authtx "github.com/line/lbm-sdk/x/auth/tx"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
 )
 
 // Factory defines a client transaction factory that facilitates generating and
@@ -17,9 +24,11 @@ type Factory struct {
 	txConfig           client.TxConfig
 	accountRetriever   client.AccountRetriever
 	sigBlockHeight     uint64
+	autoSigBlockHeight bool
 	sequence           uint64
 	gas                uint64
 	timeoutHeight      uint64
+	unordered          bool
 	gasAdjustment      float64
 	chainID            string
 	memo               string
@@ -27,6 +36,7 @@ type Factory struct {
 	gasPrices          sdk.DecCoins
 	signMode           signing.SignMode
 	simulateAndExecute bool
+	coinMetadata       authtx.CoinMetadataQueryFn
 }
 
 // NewFactoryCLI creates a new Factory.
@@ -39,6 +49,8 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 		signMode = signing.SignMode_SIGN_MODE_DIRECT
 	case flags.SignModeLegacyAminoJSON:
 		signMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	case flags.SignModeTextual:
+		signMode = signing.SignMode_SIGN_MODE_TEXTUAL
 	}
 
 	sigBlockHeight, _ := flagSet.GetUint64(flags.FlagSigBlockHeight)
@@ -46,6 +58,7 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 	gasAdj, _ := flagSet.GetFloat64(flags.FlagGasAdjustment)
 	memo, _ := flagSet.GetString(flags.FlagMemo)
 	timeoutHeight, _ := flagSet.GetUint64(flags.FlagTimeoutHeight)
+	unordered, _ := flagSet.GetBool(flags.FlagUnordered)
 
 	gasStr, _ := flagSet.GetString(flags.FlagGas)
 	gasSetting, _ := flags.ParseGasSetting(gasStr)
@@ -60,11 +73,16 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 		sigBlockHeight:     sigBlockHeight,
 		sequence:           accSeq,
 		timeoutHeight:      timeoutHeight,
+		unordered:          unordered,
 		gasAdjustment:      gasAdj,
 		memo:               memo,
 		signMode:           signMode,
 	}
 
+	if signMode == signing.SignMode_SIGN_MODE_TEXTUAL && !clientCtx.Offline {
+		f.coinMetadata = QueryCoinMetadataFn(clientCtx)
+	}
+
 	feesStr, _ := flagSet.GetString(flags.FlagFees)
 	f = f.WithFees(feesStr)
 
@@ -85,6 +103,7 @@ func (f Factory) Fees() sdk.Coins                           { return f.fees }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
+func (f Factory) Unordered() bool                           { return f.unordered }
 
 // SimulateAndExecute returns the option to simulate and then execute the transaction
 // using the gas from the simulation results
@@ -136,6 +155,13 @@ func (f Factory) WithGasPrices(gasPrices string) Factory {
 	return f
 }
 
+// WithCoinMetadataQueryFn returns a copy of the Factory rendering the coins of
+// its SIGN_MODE_TEXTUAL sign docs with the given coin metadata.
+func (f Factory) WithCoinMetadataQueryFn(coinMetadata authtx.CoinMetadataQueryFn) Factory {
+	f.coinMetadata = coinMetadata
+	return f
+}
+
 // WithKeybase returns a copy of the Factory with updated Keybase.
 func (f Factory) WithKeybase(keybase keyring.Keyring) Factory {
 	f.keybase = keybase
@@ -155,8 +181,10 @@ func (f Factory) WithMemo(memo string) Factory {
 }
 
 // WithSigBlockHeight returns a copy of the Factory with an updated sig block height.
+// A zero sig block height lets PrepareFactory pick one.
 func (f Factory) WithSigBlockHeight(sigBlockHeight uint64) Factory {
 	f.sigBlockHeight = sigBlockHeight
+	f.autoSigBlockHeight = false
 	return f
 }
 
@@ -189,3 +217,36 @@ func (f Factory) WithTimeoutHeight(height uint64) Factory {
 	f.timeoutHeight = height
 	return f
 }
+
+// WithUnordered returns a copy of the Factory building unordered txs or not.
+// The sequence of an unordered tx is a nonce, random unless set.
+func (f Factory) WithUnordered(unordered bool) Factory {
+	f.unordered = unordered
+	return f
+}
+
+// signModeHandler returns the SignModeHandler of the tx config, unless the
+// coins of the SIGN_MODE_TEXTUAL sign docs are rendered with the coin metadata
+// of the Factory.
+func (f Factory) signModeHandler(signMode signing.SignMode) authsigning.SignModeHandler {
+	if signMode == signing.SignMode_SIGN_MODE_TEXTUAL && f.coinMetadata != nil {
+		return authtx.NewSignModeTextualHandler(f.coinMetadata)
+	}
+	return f.txConfig.SignModeHandler()
+}
+
+// QueryCoinMetadataFn returns a CoinMetadataQueryFn querying the bank metadata
+// of the denoms from the chain.
+func QueryCoinMetadataFn(clientCtx client.Context) authtx.CoinMetadataQueryFn {
+	queryClient := banktypes.NewQueryClient(clientCtx)
+	return func(denom string) (*banktypes.Metadata, error) {
+		res, err := queryClient.DenomMetadata(context.Background(), &banktypes.QueryDenomMetadataRequest{Denom: denom})
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &res.Metadata, nil
+	}
+}
@@ -2,6 +2,8 @@ package tx
 
 import (
 	"bufio"
+	"crypto/rand"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"net/http"
@@ -12,6 +14,7 @@ import (
 	"github.com/line/lbm-sdk/client"
 	"github.com/line/lbm-sdk/client/flags"
 	"github.com/line/lbm-sdk/client/input"
+	"github.com/line/lbm-sdk/crypto/keyring"
 	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/line/lbm-sdk/crypto/types"
 	sdk "github.com/line/lbm-sdk/types"
@@ -213,6 +216,10 @@ func BuildUnsignedTx(txf Factory, msgs ...sdk.Msg) (client.TxBuilder, error) {
 		return nil, fmt.Errorf("chain ID required but not specified")
 	}
 
+	if txf.unordered && txf.timeoutHeight == 0 {
+		return nil, errors.New("unordered tx requires a timeout height")
+	}
+
 	fees := txf.fees
 
 	if !txf.gasPrices.IsZero() {
@@ -243,6 +250,7 @@ func BuildUnsignedTx(txf Factory, msgs ...sdk.Msg) (client.TxBuilder, error) {
 	tx.SetGasLimit(txf.gas)
 	tx.SetSigBlockHeight(txf.sigBlockHeight)
 	tx.SetTimeoutHeight(txf.TimeoutHeight())
+	tx.SetUnordered(txf.unordered)
 
 	return tx, nil
 }
@@ -307,24 +315,38 @@ func CalculateGas(
 // PrepareFactory set sig block height and account sequence to the tx factory.
 // It doesn't require that the account should exist.
 // If the account does not exist, then it use the zero sequence number.
+// A sig block height picked by PrepareFactory is picked again on every call,
+// so a factory reused over a long time keeps producing acceptable txs.
 func PrepareFactory(clientCtx client.Context, txf Factory) (Factory, error) {
 	from := clientCtx.GetFromAddress()
-	sigBlockHeight := txf.sigBlockHeight
 
-	if !clientCtx.Offline {
-		if sigBlockHeight == 0 {
-			height, err := txf.accountRetriever.GetLatestHeight(clientCtx)
+	if !clientCtx.Offline && (txf.sigBlockHeight == 0 || txf.autoSigBlockHeight) {
+		min, max, err := txf.accountRetriever.GetSigBlockHeightWindow(clientCtx)
+		if err != nil {
+			return txf, err
+		}
+		// `ctx.Height` of checkTx may be later by 1 block than consensus block height.
+		// Some cli integrated test fails because of this(sigBlockHeight = height).
+		sigBlockHeight := max
+		if sigBlockHeight > min {
+			sigBlockHeight--
+		}
+		txf = txf.WithSigBlockHeight(sigBlockHeight)
+		txf.autoSigBlockHeight = true
+	}
+
+	// the sequence of an unordered tx is a nonce, picked at random
+	if txf.unordered {
+		if txf.sequence == 0 {
+			nonce, err := randomNonce()
 			if err != nil {
 				return txf, err
 			}
-			// `ctx.Height` of checkTx may be later by 1 block than consensus block height.
-			// Some cli integrated test fails because of this(sigBlockHeight = height).
-			sigBlockHeight = height - 1
+			txf = txf.WithSequence(nonce)
 		}
+		return txf, nil
 	}
 
-	txf = txf.WithSigBlockHeight(sigBlockHeight)
-
 	initSeq := txf.sequence
 	if initSeq == 0 && !clientCtx.Offline {
 		seq, err := txf.accountRetriever.GetAccountSequence(clientCtx, from)
@@ -342,6 +364,15 @@ func PrepareFactory(clientCtx client.Context, txf Factory) (Factory, error) {
 	return txf, nil
 }
 
+// randomNonce returns a random nonce for an unordered tx.
+func randomNonce() (uint64, error) {
+	var bz [8]byte
+	if _, err := rand.Read(bz[:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(bz[:]), nil
+}
+
 // SignWithPrivKey signs a given tx with the given private key, and returns the
 // corresponding SignatureV2 if the signing is successful.
 func SignWithPrivKey(
@@ -383,6 +414,11 @@ func checkMultipleSigners(mode signing.SignMode, tx authsigning.Tx) error {
 		len(tx.GetSigners()) > 1 {
 		return sdkerrors.Wrap(sdkerrors.ErrNotSupported, "Signing in DIRECT mode is only supported for transactions with one signer only")
 	}
+	// the textual sign docs hash the SIGN_MODE_DIRECT ones
+	if mode == signing.SignMode_SIGN_MODE_TEXTUAL &&
+		len(tx.GetSigners()) > 1 {
+		return sdkerrors.Wrap(sdkerrors.ErrNotSupported, "Signing in TEXTUAL mode is only supported for transactions with one signer only")
+	}
 	return nil
 }
 
@@ -445,13 +481,18 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 	}
 
 	// Generate the bytes to be signed.
-	bytesToSign, err := txf.txConfig.SignModeHandler().GetSignBytes(signMode, signerData, txBuilder.GetTx())
+	bytesToSign, err := txf.signModeHandler(signMode).GetSignBytes(signMode, signerData, txBuilder.GetTx())
 	if err != nil {
 		return err
 	}
 
-	// Sign those bytes
-	sigBytes, _, err := txf.keybase.Sign(name, bytesToSign)
+	// Sign those bytes, a Ledger device displaying the textual sign docs as is
+	var sigBytes []byte
+	if signMode == signing.SignMode_SIGN_MODE_TEXTUAL && key.GetType() == keyring.TypeLedger {
+		sigBytes, _, err = keyring.SignTextualWithLedger(key, bytesToSign)
+	} else {
+		sigBytes, _, err = txf.keybase.Sign(name, bytesToSign)
+	}
 	if err != nil {
 		return err
 	}
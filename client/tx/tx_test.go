@@ -85,6 +85,38 @@ func TestCalculateGas(t *testing.T) {
 	}
 }
 
+func TestPrepareFactorySigBlockHeight(t *testing.T) {
+	from := sdk.BytesToAccAddress([]byte("from"))
+	retriever := client.TestAccountRetriever{
+		Accounts:          map[string]client.TestAccount{from.String(): {Address: from, Seq: 1}},
+		MinSigBlockHeight: 0,
+		MaxSigBlockHeight: 100,
+	}
+	clientCtx := client.Context{}.WithFromAddress(from)
+
+	// picked from the window
+	txf, err := tx.PrepareFactory(clientCtx, tx.Factory{}.WithAccountRetriever(retriever))
+	require.NoError(t, err)
+	require.Equal(t, uint64(99), txf.SigBlockHeight())
+	require.Equal(t, uint64(1), txf.Sequence())
+
+	// picked again for the current window
+	retriever.MinSigBlockHeight, retriever.MaxSigBlockHeight = 4000, 7600
+	txf, err = tx.PrepareFactory(clientCtx, txf.WithAccountRetriever(retriever))
+	require.NoError(t, err)
+	require.Equal(t, uint64(7599), txf.SigBlockHeight())
+
+	// kept when given explicitly
+	txf, err = tx.PrepareFactory(clientCtx, txf.WithSigBlockHeight(50))
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), txf.SigBlockHeight())
+
+	// kept in offline mode
+	txf, err = tx.PrepareFactory(clientCtx.WithOffline(true), txf.WithSigBlockHeight(0))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), txf.SigBlockHeight())
+}
+
 func TestBuildSimTx(t *testing.T) {
 	txCfg := NewTestTxConfig()
 
@@ -122,6 +154,30 @@ func TestBuildUnsignedTx(t *testing.T) {
 	require.Empty(t, sigs)
 }
 
+func TestBuildUnsignedUnorderedTx(t *testing.T) {
+	txf := tx.Factory{}.
+		WithTxConfig(NewTestTxConfig()).
+		WithSigBlockHeight(1).
+		WithFees("50stake").
+		WithChainID("test-chain").
+		WithUnordered(true)
+
+	msg := banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), nil)
+	_, err := tx.BuildUnsignedTx(txf, msg)
+	require.Error(t, err)
+
+	txb, err := tx.BuildUnsignedTx(txf.WithTimeoutHeight(10), msg)
+	require.NoError(t, err)
+	require.True(t, txb.GetTx().(sdk.TxWithUnordered).GetUnordered())
+
+	// the nonce is picked at random
+	from := sdk.BytesToAccAddress([]byte("from"))
+	clientCtx := client.Context{}.WithFromAddress(from)
+	prepared, err := tx.PrepareFactory(clientCtx, txf.WithAccountRetriever(client.TestAccountRetriever{}))
+	require.NoError(t, err)
+	require.NotZero(t, prepared.Sequence())
+}
+
 func TestSign(t *testing.T) {
 	requireT := require.New(t)
 	path := hd.CreateHDPath(118, 0, 0).String()
@@ -158,6 +214,8 @@ func TestSign(t *testing.T) {
 		WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT)
 	txfAmino := txfDirect.
 		WithSignMode(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	txfTextual := txfDirect.
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_TEXTUAL)
 	msg1 := banktypes.NewMsgSend(info1.GetAddress(), sdk.AccAddress("to"), nil)
 	msg2 := banktypes.NewMsgSend(info2.GetAddress(), sdk.AccAddress("to"), nil)
 	txb, err := tx.BuildUnsignedTx(txfNoKeybase, msg1, msg2)
@@ -166,6 +224,8 @@ func TestSign(t *testing.T) {
 	requireT.NoError(err)
 	txbSimple, err := tx.BuildUnsignedTx(txfNoKeybase, msg2)
 	requireT.NoError(err)
+	txbTextual, err := tx.BuildUnsignedTx(txfNoKeybase, msg1)
+	requireT.NoError(err)
 
 	testCases := []struct {
 		name         string
@@ -201,6 +261,12 @@ func TestSign(t *testing.T) {
 			txfDirect, txb2, from1, false, []cryptotypes.PubKey{}, nil},
 		{"direct: should fail to overwrite multi-signers tx",
 			txfDirect, txb2, from1, true, []cryptotypes.PubKey{}, nil},
+
+		/**** test textual mode, like the direct mode limited to single-signer txs ****/
+		{"textual: should succeed with keyring",
+			txfTextual, txbTextual, from1, true, []cryptotypes.PubKey{pubKey1}, nil},
+		{"textual: should fail to sign multi-signers tx",
+			txfTextual, txb2, from1, true, []cryptotypes.PubKey{}, nil},
 	}
 	var prevSigs []signingtypes.SignatureV2
 	for _, tc := range testCases {
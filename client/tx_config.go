@@ -43,6 +43,7 @@ type (
 		SetFeeAmount(amount sdk.Coins)
 		SetGasLimit(limit uint64)
 		SetTimeoutHeight(height uint64)
+		SetUnordered(unordered bool)
 		SetFeeGranter(feeGranter sdk.AccAddress)
 	}
 )
@@ -0,0 +1,102 @@
+package batch
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/line/lbm-sdk/crypto/keys/ed25519"
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+)
+
+var _ cryptotypes.BatchVerifier = &Verifier{}
+
+// entry is a signature added to a Verifier.
+type entry struct {
+	key       cryptotypes.PubKey
+	message   []byte
+	signature []byte
+}
+
+// Verifier is a BatchVerifier of ed25519 and secp256k1 signatures. It verifies
+// the signatures of a batch concurrently on a pool of workers.
+//
+// NOTE: ed25519 signatures go through the pool too. The ed25519 batch
+// verification equation is cofactored, and accepts some signatures that
+// ed25519.Verify rejects, e.g. the ones of a key with a small order component.
+// Whether a signature is valid must not depend on how it is verified.
+type Verifier struct {
+	workers int
+	entries []entry
+}
+
+// NewVerifier returns a Verifier using as many workers as the number of CPUs
+// usable by the process.
+func NewVerifier() *Verifier {
+	return NewVerifierWithWorkers(runtime.GOMAXPROCS(0))
+}
+
+// NewVerifierWithWorkers returns a Verifier using the given number of workers.
+func NewVerifierWithWorkers(workers int) *Verifier {
+	if workers < 1 {
+		workers = 1
+	}
+	return &Verifier{workers: workers}
+}
+
+// SupportsBatchVerifier returns true if the signatures of the key can be added
+// to a Verifier.
+func SupportsBatchVerifier(key cryptotypes.PubKey) bool {
+	switch key.(type) {
+	case *ed25519.PubKey, *secp256k1.PubKey:
+		return true
+	default:
+		return false
+	}
+}
+
+// Add implements cryptotypes.BatchVerifier.
+func (v *Verifier) Add(key cryptotypes.PubKey, message, signature []byte) error {
+	if !SupportsBatchVerifier(key) {
+		return fmt.Errorf("batch verification of %T signatures is not supported", key)
+	}
+
+	v.entries = append(v.entries, entry{key: key, message: message, signature: signature})
+	return nil
+}
+
+// Len returns the number of signatures in the batch.
+func (v *Verifier) Len() int {
+	return len(v.entries)
+}
+
+// Verify implements cryptotypes.BatchVerifier.
+func (v *Verifier) Verify() (bool, []bool) {
+	results := make([]bool, len(v.entries))
+
+	workers := v.workers
+	if workers > len(v.entries) {
+		workers = len(v.entries)
+	}
+
+	wg := sync.WaitGroup{}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < len(v.entries); i += workers {
+				e := v.entries[i]
+				results[i] = e.key.VerifySignature(e.message, e.signature)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	for _, ok := range results {
+		if !ok {
+			return false, results
+		}
+	}
+	return true, results
+}
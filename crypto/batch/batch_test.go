@@ -0,0 +1,161 @@
+package batch_test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/crypto/batch"
+	"github.com/line/lbm-sdk/crypto/keys/ed25519"
+	kmultisig "github.com/line/lbm-sdk/crypto/keys/multisig"
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+)
+
+func TestVerifier(t *testing.T) {
+	privs := []cryptotypes.PrivKey{
+		ed25519.GenPrivKey(), secp256k1.GenPrivKey(), ed25519.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey(),
+	}
+
+	for _, workers := range []int{0, 1, 2, 8} {
+		bv := batch.NewVerifierWithWorkers(workers)
+		ok, results := bv.Verify()
+		require.True(t, ok)
+		require.Empty(t, results)
+
+		expected := make([]bool, len(privs))
+		for i, priv := range privs {
+			msg := []byte{byte(i)}
+			sig, err := priv.Sign(msg)
+			require.NoError(t, err)
+
+			// every other signature signs another message
+			expected[i] = i%2 == 0
+			if !expected[i] {
+				msg = []byte("other")
+			}
+			require.NoError(t, bv.Add(priv.PubKey(), msg, sig))
+		}
+		require.Equal(t, len(privs), bv.Len())
+
+		ok, results = bv.Verify()
+		require.False(t, ok)
+		require.Equal(t, expected, results)
+	}
+}
+
+func TestVerifierUnsupportedKey(t *testing.T) {
+	pk := kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{secp256k1.GenPrivKey().PubKey()})
+	require.False(t, batch.SupportsBatchVerifier(pk))
+	require.Error(t, batch.NewVerifier().Add(pk, []byte("msg"), []byte("sig")))
+}
+
+func TestVerifierEd25519(t *testing.T) {
+	bv := batch.NewVerifier()
+	var msgs, sigs [][]byte
+	var keys []cryptotypes.PubKey
+	for i := 0; i < 16; i++ {
+		priv := ed25519.GenPrivKey()
+		msg := []byte{byte(i)}
+		sig, err := priv.Sign(msg)
+		require.NoError(t, err)
+		require.NoError(t, bv.Add(priv.PubKey(), msg, sig))
+		keys, msgs, sigs = append(keys, priv.PubKey()), append(msgs, msg), append(sigs, sig)
+	}
+
+	// a batch of valid signatures only
+	ok, results := bv.Verify()
+	require.True(t, ok)
+	for i, valid := range results {
+		require.True(t, valid, i)
+	}
+
+	// the invalid signatures of a batch are reported one by one, matching the verification of
+	// each signature on its own
+	bv = batch.NewVerifier()
+	expected := make([]bool, len(keys))
+	for i, key := range keys {
+		sig := append([]byte{}, sigs[i]...)
+		if i%5 == 0 {
+			sig[0] ^= 1
+		}
+		expected[i] = key.VerifySignature(msgs[i], sig)
+		require.NoError(t, bv.Add(key, msgs[i], sig))
+	}
+	ok, results = bv.Verify()
+	require.False(t, ok)
+	require.Equal(t, expected, results)
+	require.False(t, results[0])
+	require.True(t, results[1])
+}
+
+func TestVerifierEd25519SmallOrderKey(t *testing.T) {
+	// a key of order 8 and a signature of the identity with s = 0, valid under the
+	// cofactored verification equation but rejected by ed25519.Verify
+	key := &ed25519.PubKey{Key: mustDecodeHex(t, "c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac037a")}
+	sig := make([]byte, ed25519.SignatureSize)
+	sig[0] = 1
+	msg := []byte{0}
+	require.False(t, key.VerifySignature(msg, sig))
+
+	bv := batch.NewVerifier()
+	for i := 0; i < 4; i++ {
+		priv := ed25519.GenPrivKey()
+		valid, err := priv.Sign(msg)
+		require.NoError(t, err)
+		require.NoError(t, bv.Add(priv.PubKey(), msg, valid))
+	}
+	require.NoError(t, bv.Add(key, msg, sig))
+
+	ok, results := bv.Verify()
+	require.False(t, ok)
+	require.Equal(t, []bool{true, true, true, true, false}, results)
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	bz, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return bz
+}
+
+// BenchmarkVerifyEd25519 compares the verification of ed25519 signatures on the pool of
+// workers with their verification one by one.
+func BenchmarkVerifyEd25519(b *testing.B) {
+	for _, size := range []int{1, 8, 64, 512} {
+		keys := make([]cryptotypes.PubKey, size)
+		msgs := make([][]byte, size)
+		sigs := make([][]byte, size)
+		for i := range keys {
+			priv := ed25519.GenPrivKey()
+			msgs[i] = []byte{byte(i), byte(i >> 8)}
+			sig, err := priv.Sign(msgs[i])
+			require.NoError(b, err)
+			keys[i], sigs[i] = priv.PubKey(), sig
+		}
+
+		b.Run(fmt.Sprintf("serial-%d", size), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				for i, key := range keys {
+					if !key.VerifySignature(msgs[i], sigs[i]) {
+						b.Fatal("invalid signature")
+					}
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("batch-%d", size), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				bv := batch.NewVerifier()
+				for i, key := range keys {
+					if err := bv.Add(key, msgs[i], sigs[i]); err != nil {
+						b.Fatal(err)
+					}
+				}
+				if ok, _ := bv.Verify(); !ok {
+					b.Fatal("invalid batch")
+				}
+			}
+		})
+	}
+}
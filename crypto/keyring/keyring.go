@@ -569,23 +569,29 @@ func (ks keystore) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
 // and returns the signed bytes and the public key. It returns an error if the device could
 // not be queried or it returned an error.
 func SignWithLedger(info Info, msg []byte) (sig []byte, pub types.PubKey, err error) {
-	switch info.(type) {
-	case *ledgerInfo, ledgerInfo:
-	default:
-		return nil, nil, errors.New("not a ledger object")
+	priv, err := ledgerPrivKey(info)
+	if err != nil {
+		return
 	}
 
-	path, err := info.GetPath()
+	sig, err = priv.Sign(msg)
 	if err != nil {
-		return
+		return nil, nil, err
 	}
 
-	priv, err := ledger.NewPrivKeySecp256k1Unsafe(*path)
+	return sig, priv.PubKey(), nil
+}
+
+// SignTextualWithLedger signs a SIGN_MODE_TEXTUAL sign doc with the ledger device referenced
+// by an Info object like SignWithLedger, the device displaying the lines of the document.
+// It returns an error if the Ledger app does not support textual sign docs.
+func SignTextualWithLedger(info Info, doc []byte) (sig []byte, pub types.PubKey, err error) {
+	priv, err := ledgerPrivKey(info)
 	if err != nil {
 		return
 	}
 
-	sig, err = priv.Sign(msg)
+	sig, err = priv.SignTextual(doc)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -593,6 +599,27 @@ func SignWithLedger(info Info, msg []byte) (sig []byte, pub types.PubKey, err er
 	return sig, priv.PubKey(), nil
 }
 
+// ledgerPrivKey returns the private key of the ledger device referenced by an Info object.
+func ledgerPrivKey(info Info) (ledger.PrivKeyLedgerSecp256k1, error) {
+	switch info.(type) {
+	case *ledgerInfo, ledgerInfo:
+	default:
+		return ledger.PrivKeyLedgerSecp256k1{}, errors.New("not a ledger object")
+	}
+
+	path, err := info.GetPath()
+	if err != nil {
+		return ledger.PrivKeyLedgerSecp256k1{}, err
+	}
+
+	priv, err := ledger.NewPrivKeySecp256k1Unsafe(*path)
+	if err != nil {
+		return ledger.PrivKeyLedgerSecp256k1{}, err
+	}
+
+	return priv.(ledger.PrivKeyLedgerSecp256k1), nil
+}
+
 func newOSBackendKeyringConfig(appName, dir string, buf io.Reader) keyring.Config {
 	return keyring.Config{
 		ServiceName:      appName,
@@ -80,11 +80,20 @@ func TestSignVerifyKeyRingWithLedger(t *testing.T) {
 	require.True(t, i1.GetPubKey().VerifySignature(d1, s1))
 	require.True(t, bytes.Equal(s1, s2))
 
+	// the textual sign docs are signed by the device too
+	d2 := []byte("Chain id: test-chain\nSequence: 0")
+	s3, pub3, err := SignTextualWithLedger(i1, d2)
+	require.NoError(t, err)
+	require.True(t, pub3.Equals(pub1))
+	require.True(t, pub3.VerifySignature(d2, s3))
+
 	localInfo, _, err := kb.NewMnemonic("test", English, types.FullFundraiserPath, hd.Secp256k1)
 	require.NoError(t, err)
 	_, _, err = SignWithLedger(localInfo, d1)
 	require.Error(t, err)
 	require.Equal(t, "not a ledger object", err.Error())
+	_, _, err = SignTextualWithLedger(localInfo, d2)
+	require.EqualError(t, err, "not a ledger object")
 }
 
 func TestAltKeyring_SaveLedgerKey(t *testing.T) {
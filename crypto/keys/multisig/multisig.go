@@ -12,6 +12,7 @@ import (
 )
 
 var _ multisigtypes.PubKey = &LegacyAminoPubKey{}
+var _ multisigtypes.BatchVerifiablePubKey = &LegacyAminoPubKey{}
 var _ types.UnpackInterfacesMessage = &LegacyAminoPubKey{}
 
 // NewLegacyAminoPubKey returns a new LegacyAminoPubKey.
@@ -42,6 +43,38 @@ func (m *LegacyAminoPubKey) Bytes() []byte {
 
 // VerifyMultisignature implements the multisigtypes.PubKey VerifyMultisignature method
 func (m *LegacyAminoPubKey) VerifyMultisignature(getSignBytes multisigtypes.GetSignBytesFunc, sig *signing.MultiSignatureData) error {
+	return m.checkMultisignature(getSignBytes, sig, func(i int, pubKey cryptotypes.PubKey, msg, sig []byte) error {
+		if !pubKey.VerifySignature(msg, sig) {
+			return fmt.Errorf("unable to verify signature at index %d", i)
+		}
+		return nil
+	}, func(nestedMultisigPk multisigtypes.PubKey, sig *signing.MultiSignatureData) error {
+		return nestedMultisigPk.VerifyMultisignature(getSignBytes, sig)
+	})
+}
+
+// AddMultisignatureToBatch implements the multisigtypes.BatchVerifiablePubKey
+// AddMultisignatureToBatch method
+func (m *LegacyAminoPubKey) AddMultisignatureToBatch(bv cryptotypes.BatchVerifier, getSignBytes multisigtypes.GetSignBytesFunc, sig *signing.MultiSignatureData) error {
+	return m.checkMultisignature(getSignBytes, sig, func(_ int, pubKey cryptotypes.PubKey, msg, sig []byte) error {
+		return bv.Add(pubKey, msg, sig)
+	}, func(nestedMultisigPk multisigtypes.PubKey, sig *signing.MultiSignatureData) error {
+		nestedBatchPk, ok := nestedMultisigPk.(multisigtypes.BatchVerifiablePubKey)
+		if !ok {
+			return fmt.Errorf("unable to batch verify the multisignature of %T", nestedMultisigPk)
+		}
+		return nestedBatchPk.AddMultisignatureToBatch(bv, getSignBytes, sig)
+	})
+}
+
+// checkMultisignature checks the structure of a multi-signature, and passes its
+// single signatures to verifySig and its nested multi-signatures to verifyMultisig.
+func (m *LegacyAminoPubKey) checkMultisignature(
+	getSignBytes multisigtypes.GetSignBytesFunc,
+	sig *signing.MultiSignatureData,
+	verifySig func(i int, pubKey cryptotypes.PubKey, msg, sig []byte) error,
+	verifyMultisig func(nestedMultisigPk multisigtypes.PubKey, sig *signing.MultiSignatureData) error,
+) error {
 	bitarray := sig.BitArray
 	sigs := sig.Signatures
 	size := bitarray.Count()
@@ -69,15 +102,15 @@ func (m *LegacyAminoPubKey) VerifyMultisignature(getSignBytes multisigtypes.GetS
 				if err != nil {
 					return err
 				}
-				if !pubKeys[i].VerifySignature(msg, si.Signature) {
-					return fmt.Errorf("unable to verify signature at index %d", i)
+				if err := verifySig(i, pubKeys[i], msg, si.Signature); err != nil {
+					return err
 				}
 			case *signing.MultiSignatureData:
 				nestedMultisigPk, ok := pubKeys[i].(multisigtypes.PubKey)
 				if !ok {
 					return fmt.Errorf("unable to parse pubkey of index %d", i)
 				}
-				if err := nestedMultisigPk.VerifyMultisignature(getSignBytes, si); err != nil {
+				if err := verifyMultisig(nestedMultisigPk, si); err != nil {
 					return err
 				}
 			default:
@@ -7,6 +7,7 @@ import (
 
 	"github.com/line/lbm-sdk/codec"
 	"github.com/line/lbm-sdk/codec/types"
+	"github.com/line/lbm-sdk/crypto/batch"
 	kmultisig "github.com/line/lbm-sdk/crypto/keys/multisig"
 	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/line/lbm-sdk/crypto/types"
@@ -201,6 +202,38 @@ func TestVerifyMultisignature(t *testing.T) {
 	}
 }
 
+func TestAddMultisignatureToBatch(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	signBytesFn := func(mode signing.SignMode) ([]byte, error) { return msg, nil }
+
+	// nested multisignature
+	genPk, sig := generateNestedMultiSignature(3, msg)
+	pk := genPk.(multisig.BatchVerifiablePubKey)
+	bv := batch.NewVerifier()
+	require.NoError(t, pk.AddMultisignatureToBatch(bv, signBytesFn, sig))
+	require.Equal(t, 15, bv.Len())
+	ok, _ := bv.Verify()
+	require.True(t, ok)
+
+	// wrong size for sig bit array
+	pubKeys, _ := generatePubKeysAndSignatures(3, msg)
+	pk = kmultisig.NewLegacyAminoPubKey(3, pubKeys)
+	require.Error(t, pk.AddMultisignatureToBatch(batch.NewVerifier(), signBytesFn, multisig.NewMultisig(1)))
+
+	// wrong signatures
+	pubKeys, _ = generatePubKeysAndSignatures(2, msg)
+	_, sigs := generatePubKeysAndSignatures(2, msg)
+	pk = kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	sig = multisig.NewMultisig(2)
+	require.NoError(t, multisig.AddSignatureFromPubKey(sig, sigs[0], pubKeys[0], pubKeys))
+	require.NoError(t, multisig.AddSignatureFromPubKey(sig, sigs[1], pubKeys[1], pubKeys))
+	bv = batch.NewVerifier()
+	require.NoError(t, pk.AddMultisignatureToBatch(bv, signBytesFn, sig))
+	ok, results := bv.Verify()
+	require.False(t, ok)
+	require.Equal(t, []bool{false, false}, results)
+}
+
 func TestAddSignatureFromPubKeyNilCheck(t *testing.T) {
 	pkSet, sigs := generatePubKeysAndSignatures(5, []byte{1, 2, 3, 4})
 	multisignature := multisig.NewMultisig(5)
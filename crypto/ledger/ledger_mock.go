@@ -111,6 +111,12 @@ func (mock LedgerSECP256K1Mock) SignSECP256K1(derivationPath []uint32, message [
 	return sig2.Serialize(), nil
 }
 
+// SignSECP256K1Textual signs a textual sign doc as SignSECP256K1 does, the mock
+// not displaying anything.
+func (mock LedgerSECP256K1Mock) SignSECP256K1Textual(derivationPath []uint32, doc []byte) ([]byte, error) {
+	return mock.SignSECP256K1(derivationPath, doc)
+}
+
 // ShowAddressSECP256K1 shows the address for the corresponding bip32 derivation path
 func (mock LedgerSECP256K1Mock) ShowAddressSECP256K1(bip32Path []uint32, hrp string) error {
 	fmt.Printf("Request to show address for %v at %v", hrp, bip32Path)
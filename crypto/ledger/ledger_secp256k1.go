@@ -38,6 +38,14 @@ type (
 		SignSECP256K1([]uint32, []byte) ([]byte, error)
 	}
 
+	// SECP256K1Textual reflects the interface a Ledger API must implement to sign
+	// SIGN_MODE_TEXTUAL sign docs, which the device displays line by line instead
+	// of parsing them as amino JSON
+	SECP256K1Textual interface {
+		// Signs a textual sign doc (requires user confirmation)
+		SignSECP256K1Textual([]uint32, []byte) ([]byte, error)
+	}
+
 	// PrivKeyLedgerSecp256k1 implements PrivKey, calling the ledger nano we
 	// cache the PubKey from the first call to use it later.
 	PrivKeyLedgerSecp256k1 struct {
@@ -106,6 +114,18 @@ func (pkl PrivKeyLedgerSecp256k1) Sign(message []byte) ([]byte, error) {
 	return sign(device, pkl, message)
 }
 
+// SignTextual returns a secp256k1 signature of a SIGN_MODE_TEXTUAL sign doc. It
+// fails if the Ledger app cannot display textual sign docs.
+func (pkl PrivKeyLedgerSecp256k1) SignTextual(doc []byte) ([]byte, error) {
+	device, err := getDevice()
+	if err != nil {
+		return nil, err
+	}
+	defer warnIfErrors(device.Close)
+
+	return signTextual(device, pkl, doc)
+}
+
 // ShowAddress triggers a ledger device to show the corresponding address.
 func ShowAddress(path hd.BIP44Params, expectedPubKey types.PubKey,
 	accountAddressPrefix string) error {
@@ -231,6 +251,27 @@ func sign(device SECP256K1, pkl PrivKeyLedgerSecp256k1, msg []byte) ([]byte, err
 	return convertDERtoBER(sig)
 }
 
+// signTextual calls the ledger to sign a textual sign doc, like sign does for
+// amino JSON ones.
+func signTextual(device SECP256K1, pkl PrivKeyLedgerSecp256k1, doc []byte) ([]byte, error) {
+	textualDevice, ok := device.(SECP256K1Textual)
+	if !ok {
+		return nil, errors.New("the Ledger app does not support SIGN_MODE_TEXTUAL")
+	}
+
+	err := validateKey(device, pkl)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := textualDevice.SignSECP256K1Textual(pkl.Path.DerivationPath(), doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertDERtoBER(sig)
+}
+
 // getPubKeyUnsafe reads the pubkey from a ledger device
 //
 // This function is marked as unsafe as it will retrieve a pubkey without user verification
@@ -21,6 +21,17 @@ type PubKey interface {
 	GetThreshold() uint
 }
 
+// BatchVerifiablePubKey defines a PubKey whose multi-signatures can be verified
+// in a batch with other signatures.
+type BatchVerifiablePubKey interface {
+	PubKey
+
+	// AddMultisignatureToBatch checks the multi-signature represented by
+	// MultiSignatureData as VerifyMultisignature does, but adds its signatures to
+	// the BatchVerifier instead of verifying them.
+	AddMultisignatureToBatch(bv types.BatchVerifier, getSignBytes GetSignBytesFunc, sig *signing.MultiSignatureData) error
+}
+
 // GetSignBytesFunc defines a function type which returns sign bytes for a given SignMode or an error.
 // It will generally be implemented as a closure which wraps whatever signable object signatures are
 // being verified against.
@@ -16,6 +16,15 @@ type PubKey interface {
 	Type() string
 }
 
+// BatchVerifier verifies the signatures of a batch of messages at once.
+type BatchVerifier interface {
+	// Add adds the signature of a message to the batch.
+	Add(key PubKey, message, signature []byte) error
+	// Verify verifies the signatures of the batch. It returns true if all of
+	// them are valid, and the validity of each of them in the order of Add.
+	Verify() (bool, []bool)
+}
+
 // LedgerPrivKey defines a private key that is not a proto message. For now,
 // LedgerSecp256k1 keys are not converted to proto.Message yet, this is why
 // they use LedgerPrivKey instead of PrivKey. All other keys must use PrivKey
@@ -0,0 +1,131 @@
+package server
+
+import (
+	abcicli "github.com/line/ostracon/abci/client"
+	abci "github.com/line/ostracon/abci/types"
+	tmsync "github.com/line/ostracon/libs/sync"
+	"github.com/line/ostracon/proxy"
+
+	"github.com/line/lbm-sdk/server/types"
+)
+
+// batchLocalClientCreator creates local ABCI clients handing the txs of a block to the app at
+// once.
+type batchLocalClientCreator struct {
+	mtx *tmsync.Mutex
+	app types.BatchDeliverTxApplication
+}
+
+// NewBatchLocalClientCreator returns a ClientCreator for an app running in the same process,
+// like proxy.NewLocalClientCreator. The txs the node delivers through DeliverTxAsync are not
+// executed one by one, but are buffered and handed to the DeliverTxs method of the app at
+// once before the next request, usually EndBlock, so that the app may execute them in
+// parallel.
+func NewBatchLocalClientCreator(app types.BatchDeliverTxApplication) proxy.ClientCreator {
+	return &batchLocalClientCreator{
+		mtx: new(tmsync.Mutex),
+		app: app,
+	}
+}
+
+func (c *batchLocalClientCreator) NewABCIClient() (abcicli.Client, error) {
+	return &batchLocalClient{
+		Client: abcicli.NewLocalClient(c.mtx, c.app),
+		mtx:    c.mtx,
+		app:    c.app,
+	}, nil
+}
+
+// batchLocalClient is a local ABCI client buffering DeliverTxAsync requests. The buffered
+// requests are delivered before any other request of the block execution, which ostracon
+// sends after the txs of a block.
+type batchLocalClient struct {
+	abcicli.Client
+
+	mtx *tmsync.Mutex
+	app types.BatchDeliverTxApplication
+
+	pending []*abcicli.ReqRes
+}
+
+var _ abcicli.Client = (*batchLocalClient)(nil)
+
+func (cli *batchLocalClient) DeliverTxAsync(req abci.RequestDeliverTx, cb abcicli.ResponseCallback) *abcicli.ReqRes {
+	reqRes := abcicli.NewReqRes(abci.ToRequestDeliverTx(req), cb)
+	cli.pending = append(cli.pending, reqRes)
+	return reqRes
+}
+
+func (cli *batchLocalClient) DeliverTxSync(req abci.RequestDeliverTx) (*abci.ResponseDeliverTx, error) {
+	cli.deliverPending()
+	return cli.Client.DeliverTxSync(req)
+}
+
+func (cli *batchLocalClient) FlushAsync(cb abcicli.ResponseCallback) *abcicli.ReqRes {
+	cli.deliverPending()
+	return cli.Client.FlushAsync(cb)
+}
+
+func (cli *batchLocalClient) FlushSync() (*abci.ResponseFlush, error) {
+	cli.deliverPending()
+	return cli.Client.FlushSync()
+}
+
+func (cli *batchLocalClient) BeginBlockAsync(req abci.RequestBeginBlock, cb abcicli.ResponseCallback) *abcicli.ReqRes {
+	cli.deliverPending()
+	return cli.Client.BeginBlockAsync(req, cb)
+}
+
+func (cli *batchLocalClient) BeginBlockSync(req abci.RequestBeginBlock) (*abci.ResponseBeginBlock, error) {
+	cli.deliverPending()
+	return cli.Client.BeginBlockSync(req)
+}
+
+func (cli *batchLocalClient) EndBlockAsync(req abci.RequestEndBlock, cb abcicli.ResponseCallback) *abcicli.ReqRes {
+	cli.deliverPending()
+	return cli.Client.EndBlockAsync(req, cb)
+}
+
+func (cli *batchLocalClient) EndBlockSync(req abci.RequestEndBlock) (*abci.ResponseEndBlock, error) {
+	cli.deliverPending()
+	return cli.Client.EndBlockSync(req)
+}
+
+func (cli *batchLocalClient) CommitAsync(cb abcicli.ResponseCallback) *abcicli.ReqRes {
+	cli.deliverPending()
+	return cli.Client.CommitAsync(cb)
+}
+
+func (cli *batchLocalClient) CommitSync() (*abci.ResponseCommit, error) {
+	cli.deliverPending()
+	return cli.Client.CommitSync()
+}
+
+// deliverPending delivers the buffered txs to the app and completes their requests in order,
+// invoking the global callback for each of them as the local client does.
+func (cli *batchLocalClient) deliverPending() {
+	if len(cli.pending) == 0 {
+		return
+	}
+	pending := cli.pending
+	cli.pending = nil
+
+	reqs := make([]abci.RequestDeliverTx, len(pending))
+	for i, reqRes := range pending {
+		reqs[i] = *reqRes.Request.GetDeliverTx()
+	}
+
+	responses := func() []abci.ResponseDeliverTx {
+		cli.mtx.Lock()
+		defer cli.mtx.Unlock()
+		return cli.app.DeliverTxs(reqs)
+	}()
+
+	globalCb := cli.GetGlobalCallback()
+	for i, reqRes := range pending {
+		res := abci.ToResponseDeliverTx(responses[i])
+		if reqRes.SetDone(res) && globalCb != nil {
+			globalCb(reqRes.Request, res)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"testing"
+
+	abci "github.com/line/ostracon/abci/types"
+	"github.com/stretchr/testify/require"
+)
+
+// batchDeliverTxApp records the txs delivered to it through DeliverTxs.
+type batchDeliverTxApp struct {
+	abci.BaseApplication
+
+	batches [][]string
+}
+
+func (app *batchDeliverTxApp) DeliverTxs(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx {
+	var batch []string
+	responses := make([]abci.ResponseDeliverTx, len(reqs))
+	for i, req := range reqs {
+		batch = append(batch, string(req.Tx))
+		responses[i] = abci.ResponseDeliverTx{Data: req.Tx}
+	}
+	app.batches = append(app.batches, batch)
+	return responses
+}
+
+func TestBatchLocalClient(t *testing.T) {
+	app := &batchDeliverTxApp{}
+	client, err := NewBatchLocalClientCreator(app).NewABCIClient()
+	require.NoError(t, err)
+
+	var delivered []string
+	client.SetGlobalCallback(func(req *abci.Request, res *abci.Response) {
+		require.Equal(t, req.GetDeliverTx().Tx, res.GetDeliverTx().Data)
+		delivered = append(delivered, string(res.GetDeliverTx().Data))
+	})
+
+	for height := 1; height <= 2; height++ {
+		_, err = client.BeginBlockSync(abci.RequestBeginBlock{})
+		require.NoError(t, err)
+
+		reqRes := client.DeliverTxAsync(abci.RequestDeliverTx{Tx: []byte("a")}, nil)
+		client.DeliverTxAsync(abci.RequestDeliverTx{Tx: []byte("b")}, nil)
+		require.Nil(t, reqRes.Response)
+
+		_, err = client.EndBlockSync(abci.RequestEndBlock{})
+		require.NoError(t, err)
+		reqRes.Wait()
+		require.Equal(t, []byte("a"), reqRes.Response.GetDeliverTx().Data)
+
+		_, err = client.CommitSync()
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, [][]string{{"a", "b"}, {"a", "b"}}, app.batches)
+	require.Equal(t, []string{"a", "b", "a", "b"}, delivered)
+}
@@ -18,6 +18,13 @@ const (
 
 	// DefaultGRPCAddress is the default address the gRPC server binds to.
 	DefaultGRPCAddress = "0.0.0.0:9090"
+
+	// DefaultStreamingGRPCAddress is the default address the state streaming gRPC server binds to.
+	DefaultStreamingGRPCAddress = "127.0.0.1:9095"
+
+	// DefaultStreamingFileDir is the default directory, relative to the home directory, the state
+	// streaming files are written to.
+	DefaultStreamingFileDir = "data/streaming"
 )
 
 // BaseConfig defines the server's basic configuration
@@ -77,6 +84,14 @@ type BaseConfig struct {
 	// Bech32CacheSize is the maximum bytes size of bech32 cache (Default : 1GB)
 	Bech32CacheSize int `mapstructure:"bech32-cache-size"`
 
+	// ParallelDeliverTx enables the parallel execution of the non-conflicting
+	// txs of a block.
+	ParallelDeliverTx bool `mapstructure:"parallel-deliver-tx"`
+
+	// OptimisticDeliverTx enables the optimistic parallel execution of all the
+	// txs of a block.
+	OptimisticDeliverTx bool `mapstructure:"optimistic-deliver-tx"`
+
 	// When true, Prometheus metrics are served under /metrics on prometheus_listen_addr in config.toml.
 	// It works when tendermint's prometheus option (config.toml) is set to true.
 	Prometheus bool `mapstructure:"prometheus"`
@@ -136,6 +151,46 @@ type StateSyncConfig struct {
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
 }
 
+// WasmConfig defines the node local configuration of the wasm VM cache.
+type WasmConfig struct {
+	// CacheWarmupSize sets the LRU budget in MiB of the contract codes pinned in the cache in addition
+	// to the codes pinned by governance. The most frequently executed codes are pinned on start and
+	// executed codes are pinned on use, unpinning the least recently executed ones to stay within the
+	// budget. 0 disables the warm-up.
+	CacheWarmupSize uint32 `mapstructure:"cache-warmup-size"`
+}
+
+// StreamingConfig defines the streaming of the state changes of every committed block.
+type StreamingConfig struct {
+	// Keys are the names of the stores to stream, "*" streams all stores.
+	Keys []string `mapstructure:"keys"`
+
+	// File defines the file sink writing a file per block.
+	File StreamingFileConfig `mapstructure:"file"`
+
+	// GRPC defines the gRPC sink streaming the blocks to local subscribers.
+	GRPC StreamingGRPCConfig `mapstructure:"grpc"`
+}
+
+// StreamingFileConfig defines the file sink of the state streaming.
+type StreamingFileConfig struct {
+	// Enable defines if the file sink should be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// Dir defines the directory the files are written to, relative paths are
+	// resolved against the home directory.
+	Dir string `mapstructure:"dir"`
+}
+
+// StreamingGRPCConfig defines the gRPC sink of the state streaming.
+type StreamingGRPCConfig struct {
+	// Enable defines if the gRPC sink should be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// Address defines the address the gRPC sink listens on
+	Address string `mapstructure:"address"`
+}
+
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
@@ -145,6 +200,8 @@ type Config struct {
 	API       APIConfig        `mapstructure:"api"`
 	GRPC      GRPCConfig       `mapstructure:"grpc"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	Wasm      WasmConfig       `mapstructure:"wasm"`
+	Streaming StreamingConfig  `mapstructure:"streaming"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -212,6 +269,20 @@ func DefaultConfig() *Config {
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
 		},
+		Wasm: WasmConfig{
+			CacheWarmupSize: 0,
+		},
+		Streaming: StreamingConfig{
+			Keys: []string{"*"},
+			File: StreamingFileConfig{
+				Enable: false,
+				Dir:    DefaultStreamingFileDir,
+			},
+			GRPC: StreamingGRPCConfig{
+				Enable:  false,
+				Address: DefaultStreamingGRPCAddress,
+			},
+		},
 	}
 }
 
@@ -228,16 +299,18 @@ func GetConfig(v *viper.Viper) Config {
 
 	return Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:      v.GetString("minimum-gas-prices"),
-			InterBlockCache:   v.GetBool("inter-block-cache"),
-			Pruning:           v.GetString("pruning"),
-			PruningKeepRecent: v.GetString("pruning-keep-recent"),
-			PruningKeepEvery:  v.GetString("pruning-keep-every"),
-			PruningInterval:   v.GetString("pruning-interval"),
-			HaltHeight:        v.GetUint64("halt-height"),
-			HaltTime:          v.GetUint64("halt-time"),
-			IndexEvents:       v.GetStringSlice("index-events"),
-			MinRetainBlocks:   v.GetUint64("min-retain-blocks"),
+			MinGasPrices:        v.GetString("minimum-gas-prices"),
+			InterBlockCache:     v.GetBool("inter-block-cache"),
+			Pruning:             v.GetString("pruning"),
+			PruningKeepRecent:   v.GetString("pruning-keep-recent"),
+			PruningKeepEvery:    v.GetString("pruning-keep-every"),
+			PruningInterval:     v.GetString("pruning-interval"),
+			HaltHeight:          v.GetUint64("halt-height"),
+			HaltTime:            v.GetUint64("halt-time"),
+			IndexEvents:         v.GetStringSlice("index-events"),
+			MinRetainBlocks:     v.GetUint64("min-retain-blocks"),
+			ParallelDeliverTx:   v.GetBool("parallel-deliver-tx"),
+			OptimisticDeliverTx: v.GetBool("optimistic-deliver-tx"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
@@ -267,5 +340,19 @@ func GetConfig(v *viper.Viper) Config {
 			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
 			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
 		},
+		Wasm: WasmConfig{
+			CacheWarmupSize: v.GetUint32("wasm.cache-warmup-size"),
+		},
+		Streaming: StreamingConfig{
+			Keys: v.GetStringSlice("streaming.keys"),
+			File: StreamingFileConfig{
+				Enable: v.GetBool("streaming.file.enable"),
+				Dir:    v.GetString("streaming.file.dir"),
+			},
+			GRPC: StreamingGRPCConfig{
+				Enable:  v.GetBool("streaming.grpc.enable"),
+				Address: v.GetString("streaming.grpc.address"),
+			},
+		},
 	}
 }
@@ -1,8 +1,10 @@
 package config
 
 import (
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/line/lbm-sdk/types"
@@ -18,3 +20,18 @@ func TestSetMinimumFees(t *testing.T) {
 	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("foo", 5)})
 	require.Equal(t, "5.000000000000000000foo", cfg.MinGasPrices)
 }
+
+func TestStreamingConfigFile(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Streaming.Keys = []string{"bank", "wasm"}
+	cfg.Streaming.GRPC.Enable = true
+
+	path := filepath.Join(t.TempDir(), "app.toml")
+	WriteConfigFile(path, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	require.NoError(t, v.ReadInConfig())
+	got := GetConfig(v)
+	require.Equal(t, cfg.Streaming, got.Streaming)
+}
@@ -72,6 +72,16 @@ iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 # Bech32CacheSize is the maximum bytes size of bech32 cache (Default : 1GB)
 bech32-cache-size = {{ .BaseConfig.Bech32CacheSize }}
 
+# ParallelDeliverTx enables the parallel execution of the txs of a block that
+# don't conflict with each other. The results are the same as with sequential
+# execution.
+parallel-deliver-tx = {{ .BaseConfig.ParallelDeliverTx }}
+
+# OptimisticDeliverTx enables the optimistic parallel execution of all the txs
+# of a block, running again the txs that read state written by a preceding tx.
+# It takes precedence over parallel-deliver-tx.
+optimistic-deliver-tx = {{ .BaseConfig.OptimisticDeliverTx }}
+
 # IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 # which informs Tendermint what to index. If empty, all events will be indexed.
 #
@@ -177,6 +187,46 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+###############################################################################
+###                           Wasm Configuration                            ###
+###############################################################################
+
+[wasm]
+
+# cache-warmup-size specifies the LRU budget in MiB of the contract codes pinned in the wasm VM cache,
+# in addition to the codes pinned by governance (0 to disable). The most frequently executed codes are
+# pinned on start, and executed codes are pinned on use, unpinning the least recently executed ones.
+# The execution counts are node local and kept in a db next to the wasm VM cache.
+cache-warmup-size = {{ .Wasm.CacheWarmupSize }}
+
+###############################################################################
+###                      State Streaming Configuration                      ###
+###############################################################################
+
+[streaming]
+
+# keys are the names of the stores whose state changes are streamed, "*" streams all stores.
+# The state changes of BeginBlock, every DeliverTx and EndBlock of a block are passed to the
+# enabled sinks when the block is committed.
+keys = [{{ range $i, $k := .Streaming.Keys }}{{ if $i }}, {{ end }}{{ printf "%q" $k }}{{ end }}]
+
+[streaming.file]
+
+# enable writes the state changes of every block to a file named block-{height} in dir.
+enable = {{ .Streaming.File.Enable }}
+
+# dir is the directory the files are written to, relative to the home directory.
+dir = "{{ .Streaming.File.Dir }}"
+
+[streaming.grpc]
+
+# enable serves the state changes of every block to the subscribers of the
+# lbm.base.streaming.v1.StateStreaming gRPC service.
+enable = {{ .Streaming.GRPC.Enable }}
+
+# address defines the address the service listens on, it should not be exposed publicly.
+address = "{{ .Streaming.GRPC.Address }}"
 `
 
 var configTemplate *template.Template
@@ -43,6 +43,14 @@ func (ms multiStore) SetTracer(w io.Writer) sdk.MultiStore {
 	panic("not implemented")
 }
 
+func (ms multiStore) ListeningEnabled(key sdk.StoreKey) bool {
+	panic("not implemented")
+}
+
+func (ms multiStore) AddListeners(key sdk.StoreKey, listeners []store.WriteListener) {
+	panic("not implemented")
+}
+
 func (ms multiStore) Commit() sdk.CommitID {
 	panic("not implemented")
 }
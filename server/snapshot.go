@@ -0,0 +1,615 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	abci "github.com/line/ostracon/abci/types"
+	ostcfg "github.com/line/ostracon/config"
+	"github.com/line/ostracon/crypto/vrf"
+	ostlog "github.com/line/ostracon/libs/log"
+	"github.com/line/ostracon/libs/protoio"
+	"github.com/line/ostracon/light"
+	"github.com/line/ostracon/node"
+	ostsm "github.com/line/ostracon/proto/ostracon/state"
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	sm "github.com/line/ostracon/state"
+	"github.com/line/ostracon/statesync"
+	"github.com/line/ostracon/store"
+	octypes "github.com/line/ostracon/types"
+
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/server/types"
+	"github.com/line/lbm-sdk/snapshots"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+const flagOutput = "output"
+
+// stateProviderTimeout bounds the time to fetch the trusted ostracon state of a restored snapshot
+const stateProviderTimeout = 20 * time.Second
+
+// GetSnapshotStore opens the snapshot store in the data directory of the given node home
+// directory, which holds the snapshots served through state sync.
+func GetSnapshotStore(homeDir string) (*snapshots.Store, error) {
+	dir := snapshotDir(homeDir)
+	snapshotDB, err := sdk.NewLevelDB("metadata", dir)
+	if err != nil {
+		return nil, err
+	}
+	return snapshots.NewStore(snapshotDB, dir)
+}
+
+// SnapshotCmd returns the snapshots command group, managing the local snapshots of a stopped node.
+func SnapshotCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage the local state sync snapshots of a stopped node",
+	}
+	cmd.AddCommand(
+		listSnapshotsCmd(defaultNodeHome),
+		createSnapshotCmd(appCreator, defaultNodeHome),
+		exportSnapshotCmd(defaultNodeHome),
+		importSnapshotCmd(defaultNodeHome),
+		restoreSnapshotCmd(appCreator, defaultNodeHome),
+	)
+	return cmd
+}
+
+func listSnapshotsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the local snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withSnapshotStore(cmd, func(store *snapshots.Store) error {
+				list, err := store.List()
+				if err != nil {
+					return err
+				}
+				for _, snapshot := range list {
+					cmd.Printf("height: %d format: %d chunks: %d hash: %X\n",
+						snapshot.Height, snapshot.Format, snapshot.Chunks, snapshot.Hash)
+				}
+				return nil
+			})
+		},
+	}
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+func createSnapshotCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a snapshot of the app state at the latest height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withSnapshotApp(cmd, appCreator, func(app types.Application, manager *snapshots.Manager) error {
+				height := app.CommitMultiStore().LastCommitID().Version
+				if height == 0 {
+					return fmt.Errorf("no app state to snapshot")
+				}
+				snapshot, err := manager.Create(uint64(height))
+				if err != nil {
+					return err
+				}
+				cmd.Printf("Created snapshot at height %d format %d with %d chunks\n",
+					snapshot.Height, snapshot.Format, snapshot.Chunks)
+				return nil
+			})
+		},
+	}
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+func exportSnapshotCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "export <height> <format>",
+		Aliases: []string{"dump"},
+		Short:   "Export a local snapshot to an archive file",
+		Long: `Export a local snapshot to a single archive file, which can be imported by another node.
+The archive is written to <height>-<format>.tar in the current directory, unless --output is given.
+
+When the block store of the node holds the two blocks above the snapshot height, the ostracon state
+at the snapshot height is exported along with the snapshot, so that a node restoring it needs no
+peer. Otherwise the archive is exported without it.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+			output, _ := cmd.Flags().GetString(flagOutput)
+			if output == "" {
+				output = fmt.Sprintf("%d-%d.tar", height, format)
+			}
+
+			trustedState, err := exportTrustedState(cmd.Context(), GetServerContextFromCmd(cmd).Config, height)
+			if err != nil {
+				cmd.Printf("Exporting the snapshot without the ostracon state: %v\n", err)
+			}
+
+			return withSnapshotStore(cmd, func(store *snapshots.Store) error {
+				if trustedState != nil {
+					if err := store.SaveState(height, format, trustedState); err != nil {
+						return err
+					}
+				}
+				file, err := os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+				if err != nil {
+					return err
+				}
+				err = store.Export(height, format, file)
+				if cerr := file.Close(); err == nil {
+					err = cerr
+				}
+				if err != nil {
+					_ = os.Remove(output)
+					return err
+				}
+				cmd.Printf("Exported snapshot at height %d format %d to %s\n", height, format, output)
+				return nil
+			})
+		},
+	}
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagOutput, "", "The archive file to write the snapshot to")
+	return cmd
+}
+
+func importSnapshotCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "import <archive>",
+		Aliases: []string{"load"},
+		Short:   "Import a snapshot from an archive file into the local snapshots",
+		Long: `Import a snapshot from an archive file written by export into the local snapshots. The chunks
+of the archive are verified against the snapshot metadata it contains.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			return withSnapshotStore(cmd, func(store *snapshots.Store) error {
+				snapshot, err := store.Import(file)
+				if err != nil {
+					return err
+				}
+				cmd.Printf("Imported snapshot at height %d format %d with %d chunks\n",
+					snapshot.Height, snapshot.Format, snapshot.Chunks)
+				return nil
+			})
+		},
+	}
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+func restoreSnapshotCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore <height> <format>",
+		Short: "Restore the app state from a local snapshot and bootstrap the node at its height",
+		Long: `Restore the app state from a local snapshot, without fetching it from a peer through state sync.
+The app state, the ostracon state and the block store of the node must be empty.
+
+As state sync does, the ostracon state of the node is bootstrapped at the snapshot height, so that
+the node starts from there. The state is taken from the snapshot when it was exported with it.
+Otherwise it is fetched by a light client from the rpc_servers of the [statesync] section of
+config.toml, which must be configured as for state sync with the trust_height and trust_hash.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			var trustedState []byte
+			err = withSnapshotStore(cmd, func(store *snapshots.Store) error {
+				trustedState, err = store.LoadState(height, format)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+			return withSnapshotApp(cmd, appCreator, func(app types.Application, manager *snapshots.Manager) error {
+				if latest := app.CommitMultiStore().LastCommitID().Version; latest != 0 {
+					return fmt.Errorf("the app state must be empty to restore a snapshot, found state at height %d", latest)
+				}
+				return withNodeStores(config, func(stateStore sm.Store, blockStore *store.BlockStore) error {
+					genesis, err := stateStore.LoadFromDBOrGenesisFile(config.GenesisFile())
+					if err != nil {
+						return err
+					}
+					if genesis.LastBlockHeight != 0 || blockStore.Height() != 0 {
+						return fmt.Errorf("the ostracon state must be empty to restore a snapshot, found state at height %d",
+							genesis.LastBlockHeight)
+					}
+
+					// the trusted state is loaded before restoring, so that its failures are found before
+					// the app state is written
+					var (
+						state, previousState sm.State
+						commit               *octypes.Commit
+					)
+					if trustedState != nil {
+						state, previousState, commit, err = decodeTrustedState(trustedState)
+					} else {
+						state, previousState, commit, err = fetchLightClientState(
+							cmd.Context(), config, genesis, serverCtx.Logger.With("module", "light"), height)
+					}
+					if err != nil {
+						return err
+					}
+					if state.ChainID != genesis.ChainID || uint64(state.LastBlockHeight) != height {
+						return fmt.Errorf("the trusted state of chain %s at height %d does not match the snapshot",
+							state.ChainID, state.LastBlockHeight)
+					}
+
+					if err := manager.RestoreLocalSnapshot(height, format); err != nil {
+						return err
+					}
+					info := app.Info(abci.RequestInfo{})
+					if uint64(info.LastBlockHeight) != height || !bytes.Equal(info.LastBlockAppHash, state.AppHash) {
+						return fmt.Errorf("restored app state at height %d with app hash %X does not match the trusted app hash %X",
+							info.LastBlockHeight, info.LastBlockAppHash, state.AppHash)
+					}
+					state.Version.Consensus.App = info.AppVersion
+					previousState.Version.Consensus.App = info.AppVersion
+
+					if previousState.LastBlockHeight > 0 {
+						if err := stateStore.Bootstrap(previousState); err != nil {
+							return fmt.Errorf("failed to bootstrap node with previous state: %w", err)
+						}
+					}
+					if err := stateStore.Bootstrap(state); err != nil {
+						return fmt.Errorf("failed to bootstrap node with new state: %w", err)
+					}
+					if err := blockStore.SaveSeenCommit(state.LastBlockHeight, commit); err != nil {
+						return fmt.Errorf("failed to store last seen commit: %w", err)
+					}
+					cmd.Printf("Restored app state at height %d from snapshot format %d\n", height, format)
+					return nil
+				})
+			})
+		},
+	}
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+// fetchLightClientState fetches the trusted ostracon state a node restored from a local snapshot is
+// bootstrapped with from the light client configured for state sync.
+func fetchLightClientState(
+	ctx context.Context, config *ostcfg.Config, genesis sm.State, logger ostlog.Logger, height uint64,
+) (state, previousState sm.State, commit *octypes.Commit, err error) {
+	ctx, cancel := context.WithTimeout(ctx, stateProviderTimeout)
+	defer cancel()
+	provider, err := newStateProvider(ctx, config, genesis, logger)
+	if err != nil {
+		return sm.State{}, sm.State{}, nil, fmt.Errorf("failed to set up light client state provider: %w", err)
+	}
+	return fetchTrustedState(ctx, provider, height)
+}
+
+// newStateProvider returns the light client configured for state sync, providing the trusted ostracon
+// state of a snapshot exported without it.
+var newStateProvider = func(
+	ctx context.Context, config *ostcfg.Config, genesis sm.State, logger ostlog.Logger,
+) (statesync.StateProvider, error) {
+	ss := config.StateSync
+	if len(ss.RPCServers) < 2 || ss.TrustHeight <= 0 || ss.TrustHash == "" {
+		return nil, fmt.Errorf("[statesync] rpc_servers (at least 2), trust_height and trust_hash are required")
+	}
+	trustHash, err := hex.DecodeString(ss.TrustHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trusted hash: %w", err)
+	}
+	return statesync.NewLightClientStateProvider(
+		ctx,
+		genesis.ChainID, genesis.Version, genesis.InitialHeight,
+		ss.RPCServers, light.TrustOptions{
+			Period: ss.TrustPeriod,
+			Height: ss.TrustHeight,
+			Hash:   trustHash,
+		}, logger)
+}
+
+// fetchTrustedState returns the ostracon state at a snapshot height, the state before it and the
+// commit of the block at the height, as state sync does to bootstrap a node.
+func fetchTrustedState(ctx context.Context, provider statesync.StateProvider, height uint64) (
+	state, previousState sm.State, commit *octypes.Commit, err error,
+) {
+	state, err = provider.State(ctx, height)
+	if err != nil {
+		return sm.State{}, sm.State{}, nil, fmt.Errorf("failed to build new state: %w", err)
+	}
+	if height > 1 {
+		previousState, err = provider.State(ctx, height-1)
+		if err != nil {
+			return sm.State{}, sm.State{}, nil, fmt.Errorf("failed to build previous state: %w", err)
+		}
+	}
+	commit, err = provider.Commit(ctx, height)
+	if err != nil {
+		return sm.State{}, sm.State{}, nil, fmt.Errorf("failed to fetch commit: %w", err)
+	}
+	return state, previousState, commit, nil
+}
+
+// exportTrustedState returns the encoded ostracon state at a snapshot height, built from the state
+// and block stores of the node.
+func exportTrustedState(ctx context.Context, config *ostcfg.Config, height uint64) (trustedState []byte, err error) {
+	err = withNodeStores(config, func(stateStore sm.Store, blockStore *store.BlockStore) error {
+		provider, err := newLocalStateProvider(stateStore, blockStore)
+		if err != nil {
+			return err
+		}
+		state, previousState, commit, err := fetchTrustedState(ctx, provider, height)
+		if err != nil {
+			return err
+		}
+		trustedState, err = encodeTrustedState(state, previousState, commit)
+		return err
+	})
+	return trustedState, err
+}
+
+// newLocalStateProvider returns the provider of the ostracon state exported with a snapshot, reading
+// the state and block stores of the node.
+var newLocalStateProvider = func(stateStore sm.Store, blockStore *store.BlockStore) (statesync.StateProvider, error) {
+	state, err := stateStore.Load()
+	if err != nil {
+		return nil, err
+	}
+	if state.IsEmpty() {
+		return nil, fmt.Errorf("the ostracon state of the node is empty")
+	}
+	return &localStateProvider{state: state, stateStore: stateStore, blockStore: blockStore}, nil
+}
+
+// localStateProvider provides the ostracon state at a height from the state and block stores of a
+// node, the way the light client of state sync builds it from the blocks above the height.
+type localStateProvider struct {
+	state      sm.State
+	stateStore sm.Store
+	blockStore *store.BlockStore
+}
+
+var _ statesync.StateProvider = (*localStateProvider)(nil)
+
+func (p *localStateProvider) AppHash(_ context.Context, height uint64) ([]byte, error) {
+	meta, err := p.loadBlockMeta(int64(height) + 1)
+	if err != nil {
+		return nil, err
+	}
+	return meta.Header.AppHash, nil
+}
+
+func (p *localStateProvider) Commit(_ context.Context, height uint64) (*octypes.Commit, error) {
+	commit := p.blockStore.LoadBlockCommit(int64(height))
+	if commit == nil {
+		return nil, fmt.Errorf("no commit of block %d in the block store", height)
+	}
+	return commit, nil
+}
+
+func (p *localStateProvider) State(_ context.Context, height uint64) (sm.State, error) {
+	lastHeight := int64(height)
+	last, err := p.loadBlockMeta(lastHeight)
+	if err != nil {
+		return sm.State{}, err
+	}
+	current, err := p.loadBlockMeta(lastHeight + 1)
+	if err != nil {
+		return sm.State{}, err
+	}
+	if _, err := p.loadBlockMeta(lastHeight + 2); err != nil {
+		return sm.State{}, err
+	}
+
+	proofHash, err := vrf.ProofToHash(vrf.Proof(last.Header.Proof))
+	if err != nil {
+		return sm.State{}, err
+	}
+	lastVoters, err := p.stateStore.LoadVoters(lastHeight, p.state.VoterParams)
+	if err != nil {
+		return sm.State{}, err
+	}
+	validators, err := p.stateStore.LoadValidators(lastHeight + 1)
+	if err != nil {
+		return sm.State{}, err
+	}
+	voters, err := p.stateStore.LoadVoters(lastHeight+1, p.state.VoterParams)
+	if err != nil {
+		return sm.State{}, err
+	}
+	nextValidators, err := p.stateStore.LoadValidators(lastHeight + 2)
+	if err != nil {
+		return sm.State{}, err
+	}
+	consensusParams, err := p.stateStore.LoadConsensusParams(lastHeight + 1)
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	return sm.State{
+		Version:                          p.state.Version,
+		ChainID:                          p.state.ChainID,
+		InitialHeight:                    p.state.InitialHeight,
+		VoterParams:                      p.state.VoterParams,
+		LastBlockHeight:                  lastHeight,
+		LastBlockID:                      last.BlockID,
+		LastBlockTime:                    last.Header.Time,
+		LastProofHash:                    proofHash,
+		NextValidators:                   nextValidators,
+		Validators:                       validators,
+		Voters:                           voters,
+		LastVoters:                       lastVoters,
+		LastHeightValidatorsChanged:      lastHeight + 2,
+		ConsensusParams:                  consensusParams,
+		LastHeightConsensusParamsChanged: lastHeight + 1,
+		LastResultsHash:                  current.Header.LastResultsHash,
+		AppHash:                          current.Header.AppHash,
+	}, nil
+}
+
+// loadBlockMeta loads the meta of a block from the block store.
+func (p *localStateProvider) loadBlockMeta(height int64) (*octypes.BlockMeta, error) {
+	meta := p.blockStore.LoadBlockMeta(height)
+	if meta == nil {
+		return nil, fmt.Errorf("no block %d in the block store", height)
+	}
+	return meta, nil
+}
+
+// encodeTrustedState encodes the ostracon state at a snapshot height, the commit of the block at the
+// height and the state before it, if any, as delimited Protobuf messages.
+func encodeTrustedState(state, previousState sm.State, commit *octypes.Commit) ([]byte, error) {
+	states := []sm.State{state}
+	if previousState.LastBlockHeight > 0 {
+		states = append(states, previousState)
+	}
+	buf := &bytes.Buffer{}
+	writer := protoio.NewDelimitedWriter(buf)
+	if _, err := writer.WriteMsg(commit.ToProto()); err != nil {
+		return nil, err
+	}
+	for _, state := range states {
+		pb, err := state.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := writer.WriteMsg(pb); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeTrustedState decodes the ostracon state encoded by encodeTrustedState.
+func decodeTrustedState(bz []byte) (state, previousState sm.State, commit *octypes.Commit, err error) {
+	reader := protoio.NewDelimitedReader(bytes.NewReader(bz), len(bz))
+	var commitPb ostproto.Commit
+	if _, err := reader.ReadMsg(&commitPb); err != nil {
+		return sm.State{}, sm.State{}, nil, fmt.Errorf("failed to decode trusted commit: %w", err)
+	}
+	commit, err = octypes.CommitFromProto(&commitPb)
+	if err != nil {
+		return sm.State{}, sm.State{}, nil, fmt.Errorf("failed to decode trusted commit: %w", err)
+	}
+
+	var states []sm.State
+	for {
+		var pb ostsm.State
+		if _, err := reader.ReadMsg(&pb); err == io.EOF {
+			break
+		} else if err != nil {
+			return sm.State{}, sm.State{}, nil, fmt.Errorf("failed to decode trusted state: %w", err)
+		}
+		decoded, err := sm.StateFromProto(&pb)
+		if err != nil {
+			return sm.State{}, sm.State{}, nil, fmt.Errorf("failed to decode trusted state: %w", err)
+		}
+		states = append(states, *decoded)
+	}
+	switch len(states) {
+	case 1:
+		return states[0], sm.State{}, commit, nil
+	case 2:
+		return states[0], states[1], commit, nil
+	default:
+		return sm.State{}, sm.State{}, nil, fmt.Errorf("expected 1 or 2 trusted states, got %d", len(states))
+	}
+}
+
+// withNodeStores calls fn with the ostracon state store and block store of the node, closing their
+// databases afterwards.
+func withNodeStores(config *ostcfg.Config, fn func(stateStore sm.Store, blockStore *store.BlockStore) error) error {
+	stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: config})
+	if err != nil {
+		return err
+	}
+	defer stateDB.Close()
+
+	blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return err
+	}
+	defer blockStoreDB.Close()
+
+	return fn(sm.NewStore(stateDB), store.NewBlockStore(blockStoreDB))
+}
+
+// withSnapshotStore calls fn with the snapshot store of the node home directory given by the
+// command flags, closing the store afterwards.
+func withSnapshotStore(cmd *cobra.Command, fn func(store *snapshots.Store) error) error {
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	dir := snapshotDir(homeDir)
+	snapshotDB, err := sdk.NewLevelDB("metadata", dir)
+	if err != nil {
+		return err
+	}
+	defer snapshotDB.Close()
+
+	store, err := snapshots.NewStore(snapshotDB, dir)
+	if err != nil {
+		return err
+	}
+	return fn(store)
+}
+
+// withSnapshotApp calls fn with the app of the node home directory given by the command flags and
+// its snapshot manager, closing the app database afterwards.
+func withSnapshotApp(
+	cmd *cobra.Command, appCreator types.AppCreator, fn func(app types.Application, manager *snapshots.Manager) error,
+) error {
+	serverCtx := GetServerContextFromCmd(cmd)
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+
+	db, err := openDB(homeDir)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+	manager := app.SnapshotManager()
+	if manager == nil {
+		return fmt.Errorf("the app has no snapshot store configured")
+	}
+	return fn(app, manager)
+}
+
+// snapshotDir returns the snapshot directory of a node home directory.
+func snapshotDir(homeDir string) string {
+	return filepath.Join(homeDir, "data", "snapshots")
+}
+
+// parseSnapshotArgs parses the height and format arguments of a snapshot.
+func parseSnapshotArgs(args []string) (uint64, uint32, error) {
+	height, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid snapshot height %q: %w", args[0], err)
+	}
+	format, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid snapshot format %q: %w", args[1], err)
+	}
+	return height, uint32(format), nil
+}
@@ -0,0 +1,320 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/cast"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/ostracon/abci/example/kvstore"
+	abci "github.com/line/ostracon/abci/types"
+	ostcfg "github.com/line/ostracon/config"
+	"github.com/line/ostracon/consensus"
+	"github.com/line/ostracon/crypto/ed25519"
+	"github.com/line/ostracon/crypto/tmhash"
+	ostlog "github.com/line/ostracon/libs/log"
+	mempoolmock "github.com/line/ostracon/mempool/mock"
+	ostproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/line/ostracon/proxy"
+	sm "github.com/line/ostracon/state"
+	"github.com/line/ostracon/statesync"
+	"github.com/line/ostracon/store"
+	octypes "github.com/line/ostracon/types"
+	tmdb "github.com/line/tm-db/v2"
+
+	"github.com/line/lbm-sdk/baseapp"
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/server/types"
+	"github.com/line/lbm-sdk/simapp"
+	"github.com/line/lbm-sdk/snapshots"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+func TestSnapshotCmd(t *testing.T) {
+	source, target, offline := t.TempDir(), t.TempDir(), t.TempDir()
+	encCfg := simapp.MakeTestEncodingConfig()
+
+	// both nodes share the genesis of a chain with a single validator
+	appState, err := json.Marshal(simapp.NewDefaultGenesisState(encCfg.Marshaler))
+	require.NoError(t, err)
+	genDoc := &octypes.GenesisDoc{
+		ChainID:    "theChainId",
+		Validators: []octypes.GenesisValidator{{PubKey: ed25519.GenPrivKey().PubKey(), Power: 10}},
+		AppState:   appState,
+	}
+	for _, home := range []string{source, target, offline} {
+		require.NoError(t, os.Mkdir(filepath.Join(home, "config"), 0700))
+		require.NoError(t, genDoc.SaveAs(filepath.Join(home, "config", "genesis.json")))
+	}
+
+	// commit the genesis state of the source node
+	db, err := sdk.NewLevelDB("application", filepath.Join(source, "data"))
+	require.NoError(t, err)
+	app := simapp.NewSimApp(ostlog.NewNopLogger(), db, nil, true, map[int64]bool{}, source, 0, encCfg, simapp.EmptyAppOptions{})
+	app.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   appState,
+	})
+	app.Commit()
+	commitID := app.LastCommitID()
+	require.NoError(t, db.Close())
+
+	// the app creator opens the snapshot store, which is closed after every command so the node
+	// can be opened again
+	var snapshotDB tmdb.DB
+	appCreator := func(logger ostlog.Logger, db tmdb.DB, _ io.Writer, appOpts types.AppOptions) types.Application {
+		home := cast.ToString(appOpts.Get(flags.FlagHome))
+		dir := filepath.Join(home, "data", "snapshots")
+		var err error
+		snapshotDB, err = sdk.NewLevelDB("metadata", dir)
+		require.NoError(t, err)
+		snapshotStore, err := snapshots.NewStore(snapshotDB, dir)
+		require.NoError(t, err)
+		return simapp.NewSimApp(logger, db, nil, true, map[int64]bool{}, home, 0, encCfg, appOpts,
+			baseapp.SetSnapshotStore(snapshotStore))
+	}
+	run := func(home string, args ...string) (string, error) {
+		serverCtx := NewDefaultContext()
+		serverCtx.Viper.Set(flags.FlagHome, home)
+		serverCtx.Config.SetRoot(home)
+		ctx := context.WithValue(context.Background(), ServerContextKey, serverCtx)
+		output := &bytes.Buffer{}
+		cmd := SnapshotCmd(appCreator, home)
+		cmd.SetOut(output)
+		cmd.SetErr(ioutil.Discard)
+		cmd.SetArgs(append(args, fmt.Sprintf("--%s=%s", flags.FlagHome, home)))
+		err := cmd.ExecuteContext(ctx)
+		if snapshotDB != nil {
+			require.NoError(t, snapshotDB.Close())
+			snapshotDB = nil
+		}
+		return output.String(), err
+	}
+
+	output, err := run(source, "list")
+	require.NoError(t, err)
+	require.Empty(t, output)
+
+	output, err = run(source, "create")
+	require.NoError(t, err)
+	require.Contains(t, output, "Created snapshot at height 1 format 2")
+	output, err = run(source, "list")
+	require.NoError(t, err)
+	require.Contains(t, output, "height: 1 format: 2")
+
+	// the snapshot is moved to the target node through an archive, which is not overwritten. The
+	// source node has no blocks to export the ostracon state from.
+	archive := filepath.Join(t.TempDir(), "snapshot.tar")
+	output, err = run(source, "export", "1", "2", fmt.Sprintf("--output=%s", archive))
+	require.NoError(t, err)
+	require.Contains(t, output, "Exporting the snapshot without the ostracon state: the ostracon state of the node is empty")
+	_, err = run(source, "export", "1", "2", fmt.Sprintf("--output=%s", archive))
+	require.Error(t, err)
+	_, err = run(source, "export", "2", "2", fmt.Sprintf("--output=%s.missing", archive))
+	require.Error(t, err)
+
+	_, err = run(target, "import", archive)
+	require.NoError(t, err)
+	_, err = run(target, "import", archive)
+	require.Error(t, err)
+
+	// the trusted state is required to restore the snapshot exported without it, without the light
+	// client configured the app state is left untouched
+	_, err = run(target, "restore", "1", "2")
+	require.EqualError(t, err, "failed to set up light client state provider: "+
+		"[statesync] rpc_servers (at least 2), trust_height and trust_hash are required")
+
+	// the app state of the target node is restored from the snapshot and the node bootstrapped
+	// with the trusted state of the chain, once
+	provider := &trustedStateProvider{genDoc: genDoc, appHash: commitID.Hash}
+	defer func(original func(context.Context, *ostcfg.Config, sm.State, ostlog.Logger) (statesync.StateProvider, error)) {
+		newStateProvider = original
+	}(newStateProvider)
+	newStateProvider = func(context.Context, *ostcfg.Config, sm.State, ostlog.Logger) (statesync.StateProvider, error) {
+		return provider, nil
+	}
+	_, err = run(target, "restore", "1", "2")
+	require.NoError(t, err)
+	_, err = run(target, "restore", "1", "2")
+	require.Error(t, err)
+	requireRestored(t, target, genDoc, commitID)
+
+	// the ostracon state exported with the snapshot bootstraps the offline node without a light client
+	defer func(original func(sm.Store, *store.BlockStore) (statesync.StateProvider, error)) {
+		newLocalStateProvider = original
+	}(newLocalStateProvider)
+	newLocalStateProvider = func(sm.Store, *store.BlockStore) (statesync.StateProvider, error) {
+		return provider, nil
+	}
+	newStateProvider = func(context.Context, *ostcfg.Config, sm.State, ostlog.Logger) (statesync.StateProvider, error) {
+		return nil, fmt.Errorf("no light client")
+	}
+	archive = filepath.Join(t.TempDir(), "snapshot.tar")
+	output, err = run(source, "export", "1", "2", fmt.Sprintf("--output=%s", archive))
+	require.NoError(t, err)
+	require.NotContains(t, output, "without the ostracon state")
+	_, err = run(offline, "import", archive)
+	require.NoError(t, err)
+	_, err = run(offline, "restore", "1", "2")
+	require.NoError(t, err)
+	requireRestored(t, offline, genDoc, commitID)
+}
+
+// requireRestored checks that a node restored from a snapshot starts from the restored height, passing
+// the handshake with its app.
+func requireRestored(t *testing.T, home string, genDoc *octypes.GenesisDoc, commitID sdk.CommitID) {
+	db, err := sdk.NewLevelDB("application", filepath.Join(home, "data"))
+	require.NoError(t, err)
+	defer db.Close()
+	encCfg := simapp.MakeTestEncodingConfig()
+	app := simapp.NewSimApp(ostlog.NewNopLogger(), db, nil, true, map[int64]bool{}, home, 0, encCfg, simapp.EmptyAppOptions{})
+	require.Equal(t, commitID, app.LastCommitID())
+
+	config := ostcfg.DefaultConfig()
+	config.SetRoot(home)
+	require.NoError(t, withNodeStores(config, func(stateStore sm.Store, blockStore *store.BlockStore) error {
+		state, err := stateStore.LoadFromDBOrGenesisDoc(genDoc)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), state.LastBlockHeight)
+		require.Equal(t, int64(1), blockStore.LoadSeenCommit(1).Height)
+
+		proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(app))
+		require.NoError(t, proxyApp.Start())
+		defer proxyApp.Stop() //nolint:errcheck
+		return consensus.NewHandshaker(stateStore, state, blockStore, genDoc).Handshake(proxyApp)
+	}))
+}
+
+// trustedStateProvider provides the ostracon state of a chain at its genesis validators, as a light
+// client would verify it, with the app hash of the committed app state.
+type trustedStateProvider struct {
+	genDoc  *octypes.GenesisDoc
+	appHash []byte
+}
+
+var _ statesync.StateProvider = (*trustedStateProvider)(nil)
+
+func (p *trustedStateProvider) AppHash(_ context.Context, _ uint64) ([]byte, error) {
+	return p.appHash, nil
+}
+
+func (p *trustedStateProvider) Commit(_ context.Context, height uint64) (*octypes.Commit, error) {
+	return &octypes.Commit{
+		Height:     int64(height),
+		BlockID:    p.blockID(height),
+		Signatures: []octypes.CommitSig{octypes.NewCommitSigAbsent()},
+	}, nil
+}
+
+func (p *trustedStateProvider) State(_ context.Context, height uint64) (sm.State, error) {
+	state, err := sm.MakeGenesisState(p.genDoc)
+	if err != nil {
+		return sm.State{}, err
+	}
+	state.LastBlockHeight = int64(height)
+	state.LastBlockID = p.blockID(height)
+	state.LastVoters = state.Voters
+	state.AppHash = p.appHash
+	return state, nil
+}
+
+func (p *trustedStateProvider) blockID(height uint64) octypes.BlockID {
+	hash := tmhash.Sum(sdk.Uint64ToBigEndian(height))
+	return octypes.BlockID{Hash: hash, PartSetHeader: octypes.PartSetHeader{Total: 1, Hash: hash}}
+}
+
+func TestLocalStateProvider(t *testing.T) {
+	privVal := octypes.NewMockPV(octypes.PrivKeyEd25519)
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	genDoc := &octypes.GenesisDoc{
+		ChainID:     "theChainId",
+		GenesisTime: time.Now(),
+		Validators:  []octypes.GenesisValidator{{PubKey: pubKey, Power: 10}},
+	}
+	require.NoError(t, genDoc.ValidateAndComplete())
+
+	config := ostcfg.DefaultConfig()
+	config.SetRoot(t.TempDir())
+	require.NoError(t, withNodeStores(config, func(stateStore sm.Store, blockStore *store.BlockStore) error {
+		// the provider fails without a committed block
+		_, err := newLocalStateProvider(stateStore, blockStore)
+		require.Error(t, err)
+
+		state, err := sm.MakeGenesisState(genDoc)
+		require.NoError(t, err)
+		require.NoError(t, stateStore.Save(state))
+		proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(kvstore.NewApplication()))
+		require.NoError(t, proxyApp.Start())
+		defer proxyApp.Stop() //nolint:errcheck
+		blockExec := sm.NewBlockExecutor(stateStore, ostlog.NewNopLogger(), proxyApp.Consensus(),
+			mempoolmock.Mempool{}, sm.EmptyEvidencePool{})
+
+		// commit three blocks, keeping the state after each of them
+		states := make(map[uint64]sm.State)
+		lastCommit := &octypes.Commit{}
+		for height := int64(1); height <= 3; height++ {
+			proof, err := privVal.GenerateVRFProof(state.MakeHashMessage(0))
+			require.NoError(t, err)
+			block, parts := state.MakeBlock(height, []octypes.Tx{[]byte("key=value")}, lastCommit, nil, pubKey.Address(), 0, proof)
+			blockID := octypes.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+			voteSet := octypes.NewVoteSet(genDoc.ChainID, height, 0, ostproto.PrecommitType, state.Voters)
+			lastCommit, err = octypes.MakeCommit(blockID, height, 0, voteSet, []octypes.PrivValidator{privVal}, time.Now())
+			require.NoError(t, err)
+			blockStore.SaveBlock(block, parts, lastCommit)
+			state, _, err = blockExec.ApplyBlock(state, blockID, block, nil)
+			require.NoError(t, err)
+			states[uint64(height)] = state
+		}
+
+		// the state at a height is built from the two blocks above it as the light client does
+		provider, err := newLocalStateProvider(stateStore, blockStore)
+		require.NoError(t, err)
+		got, previous, commit, err := fetchTrustedState(context.Background(), provider, 1)
+		require.NoError(t, err)
+		expected := states[1]
+		require.Equal(t, sm.State{}, previous)
+		require.Equal(t, expected.ChainID, got.ChainID)
+		require.Equal(t, expected.LastBlockHeight, got.LastBlockHeight)
+		require.Equal(t, expected.LastBlockID, got.LastBlockID)
+		require.True(t, expected.LastBlockTime.Equal(got.LastBlockTime))
+		require.Equal(t, expected.LastProofHash, got.LastProofHash)
+		require.Equal(t, expected.AppHash, got.AppHash)
+		require.Equal(t, expected.LastResultsHash, got.LastResultsHash)
+		require.Equal(t, expected.Validators.Hash(), got.Validators.Hash())
+		require.Equal(t, expected.Voters.Hash(), got.Voters.Hash())
+		require.Equal(t, expected.LastVoters.Hash(), got.LastVoters.Hash())
+		require.Equal(t, expected.NextValidators.Hash(), got.NextValidators.Hash())
+		require.Equal(t, expected.ConsensusParams, got.ConsensusParams)
+		require.Equal(t, expected.LastBlockID, commit.BlockID)
+		appHash, err := provider.AppHash(context.Background(), 1)
+		require.NoError(t, err)
+		require.Equal(t, []byte(expected.AppHash), appHash)
+
+		// the state survives its encoding
+		bz, err := encodeTrustedState(got, previous, commit)
+		require.NoError(t, err)
+		decoded, decodedPrevious, decodedCommit, err := decodeTrustedState(bz)
+		require.NoError(t, err)
+		require.Equal(t, got.AppHash, decoded.AppHash)
+		require.Equal(t, got.LastBlockID, decoded.LastBlockID)
+		require.Equal(t, got.Voters.Hash(), decoded.Voters.Hash())
+		require.Equal(t, sm.State{}, decodedPrevious)
+		require.Equal(t, commit.Hash(), decodedCommit.Hash())
+
+		// the blocks above the last one are missing
+		_, err = provider.State(context.Background(), 2)
+		require.EqualError(t, err, "no block 4 in the block store")
+		return nil
+	}))
+}
@@ -3,7 +3,9 @@ package server
 // DONTCOVER
 
 import (
+	"crypto/sha256"
 	"fmt"
+	"io"
 	"os"
 	"runtime/pprof"
 	"time"
@@ -13,6 +15,7 @@ import (
 	"github.com/line/ostracon/abci/server"
 	ostcmd "github.com/line/ostracon/cmd/ostracon/commands"
 	ostos "github.com/line/ostracon/libs/os"
+	mempl "github.com/line/ostracon/mempool"
 	"github.com/line/ostracon/node"
 	"github.com/line/ostracon/p2p"
 	pvm "github.com/line/ostracon/privval"
@@ -44,6 +47,8 @@ const (
 	FlagInterBlockCacheSize = "inter-block-cache-size"
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagBech32CacheSize     = "bech32-cache-size"
+	FlagParallelDeliverTx   = "parallel-deliver-tx"
+	FlagOptimisticDeliverTx = "optimistic-deliver-tx"
 	FlagUnsafeSkipUpgrades  = "unsafe-skip-upgrades"
 	FlagTrace               = "trace"
 	FlagInvCheckPeriod      = "inv-check-period"
@@ -146,6 +151,8 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
 	cmd.Flags().Int(FlagInterBlockCacheSize, cache.DefaultCommitKVStoreCacheSize, "The maximum bytes size of the inter-block cache")
 	cmd.Flags().Int(FlagIAVLCacheSize, iavl.DefaultIAVLCacheSize, "The maximum bytes size of the iavl node cache")
+	cmd.Flags().Bool(FlagParallelDeliverTx, false, "Execute the non-conflicting txs of a block in parallel")
+	cmd.Flags().Bool(FlagOptimisticDeliverTx, false, "Execute all the txs of a block optimistically in parallel")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 	cmd.Flags().Bool(FlagTrace, false, "Provide full stack traces for errors in ABCI Log")
 	cmd.Flags().String(FlagPruning, storetypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
@@ -202,6 +209,7 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 		if err = svr.Stop(); err != nil {
 			ostos.Exit(err.Error())
 		}
+		closeApp(ctx, app)
 	}()
 
 	// Wait for SIGINT or SIGTERM signal
@@ -255,11 +263,15 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	if err2 != nil {
 		return err2
 	}
+	clientCreator, err := newClientCreator(ctx, app)
+	if err != nil {
+		return err
+	}
 	ocNode, err := node.NewNode(
 		cfg,
 		pv,
 		nodeKey,
-		proxy.NewLocalClientCreator(app),
+		clientCreator,
 		genDocProvider,
 		node.DefaultDBProvider,
 		node.DefaultMetricsProvider(cfg.Instrumentation),
@@ -270,6 +282,7 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	}
 
 	ctx.Logger.Debug("initialization: ocNode created")
+	setMempoolTxRemover(app, ocNode.Mempool())
 	if err := ocNode.Start(); err != nil {
 		return err
 	}
@@ -329,6 +342,8 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			_ = ocNode.Stop()
 		}
 
+		closeApp(ctx, app)
+
 		if cpuProfileCleanup != nil {
 			cpuProfileCleanup()
 		}
@@ -347,3 +362,42 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	// Wait for SIGINT or SIGTERM signal
 	return WaitForQuitSignals()
 }
+
+// closeApp lets an app implementing io.Closer persist its node local data after the node stopped.
+func closeApp(ctx *Context, app types.Application) {
+	if closer, ok := app.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			ctx.Logger.Error("failed to close the app", "err", err)
+		}
+	}
+}
+
+// setMempoolTxRemover lets an app replacing pending txs evict the replaced txs from the mempool.
+// They stay in the cache of the mempool, so that they aren't checked again when received again.
+func setMempoolTxRemover(app types.Application, mempool mempl.Mempool) {
+	replacingApp, ok := app.(types.TxReplacingApplication)
+	if !ok {
+		return
+	}
+
+	if clistMempool, ok := mempool.(*mempl.CListMempool); ok {
+		replacingApp.SetMempoolTxRemover(func(hash [sha256.Size]byte) {
+			clistMempool.RemoveTxByKey(hash, false)
+		})
+	}
+}
+
+// newClientCreator returns the creator of the ABCI clients the node uses to talk to the app.
+// If parallel or optimistic execution of txs is enabled, the txs of a block are handed to the
+// app at once.
+func newClientCreator(ctx *Context, app types.Application) (proxy.ClientCreator, error) {
+	if !ctx.Viper.GetBool(FlagParallelDeliverTx) && !ctx.Viper.GetBool(FlagOptimisticDeliverTx) {
+		return proxy.NewLocalClientCreator(app), nil
+	}
+
+	batchApp, ok := app.(types.BatchDeliverTxApplication)
+	if !ok {
+		return nil, fmt.Errorf("the app does not support the parallel execution of txs")
+	}
+	return NewBatchLocalClientCreator(batchApp), nil
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"io"
 
@@ -14,6 +15,8 @@ import (
 	"github.com/line/lbm-sdk/client"
 	"github.com/line/lbm-sdk/server/api"
 	"github.com/line/lbm-sdk/server/config"
+	"github.com/line/lbm-sdk/snapshots"
+	sdk "github.com/line/lbm-sdk/types"
 )
 
 type (
@@ -46,6 +49,31 @@ type (
 
 		// RegisterTendermintService registers the gRPC Query service for ostracon queries.
 		RegisterTendermintService(clientCtx client.Context)
+
+		// CommitMultiStore returns the multistore of the app.
+		CommitMultiStore() sdk.CommitMultiStore
+
+		// SnapshotManager returns the snapshot manager of the app, or nil if snapshots are not
+		// configured.
+		SnapshotManager() *snapshots.Manager
+	}
+
+	// BatchDeliverTxApplication defines an application that executes the txs
+	// of a block at once, in place of a DeliverTx call for each tx.
+	BatchDeliverTxApplication interface {
+		abci.Application
+
+		// DeliverTxs executes the txs of a block and returns their responses
+		// in block order.
+		DeliverTxs([]abci.RequestDeliverTx) []abci.ResponseDeliverTx
+	}
+
+	// TxReplacingApplication defines an application that replaces pending txs
+	// in CheckTx, and evicts the replaced txs from the mempool of the node.
+	TxReplacingApplication interface {
+		// SetMempoolTxRemover sets the function removing a tx from the
+		// mempool by its hash.
+		SetMempoolTxRemover(func(hash [sha256.Size]byte))
 	}
 
 	// AppCreator is a function that allows us to lazily initialize an
@@ -275,6 +275,7 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		flags.LineBreak,
 		ostraconCmd,
 		ExportCmd(appExport, defaultNodeHome),
+		SnapshotCmd(appCreator, defaultNodeHome),
 		flags.LineBreak,
 		version.NewVersionCommand(),
 	)
@@ -0,0 +1,133 @@
+package simapp
+
+import (
+	"testing"
+
+	abci "github.com/line/ostracon/abci/types"
+	ocproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/baseapp"
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	"github.com/line/lbm-sdk/simapp/helpers"
+	sdk "github.com/line/lbm-sdk/types"
+	signingtypes "github.com/line/lbm-sdk/types/tx/signing"
+	"github.com/line/lbm-sdk/x/auth/signing"
+	authtx "github.com/line/lbm-sdk/x/auth/tx"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+)
+
+// TestCheckTxReplacementFees checks that the sender of a tx replacing a pending tx
+// only pays the fees of the replacing tx in the check state.
+func TestCheckTxReplacementFees(t *testing.T) {
+	for _, deferredFees := range []bool{false, true} {
+		privs := []*secp256k1.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+		addr := sdk.BytesToAccAddress(privs[0].PubKey().Address())
+		app := setupDeliverTxsTestApp(t, privs, baseapp.SetTxReplacement(10))
+
+		header := ocproto.Header{Height: app.LastBlockHeight() + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		params := banktypes.DefaultParams()
+		params.DeferredFees = deferredFees
+		app.BankKeeper.SetParams(app.NewContext(false, header), params)
+		app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+		app.Commit()
+		app.BeginRecheckTx(abci.RequestBeginRecheckTx{Header: header})
+		app.EndRecheckTx(abci.RequestEndRecheckTx{})
+
+		txCfg := MakeTestEncodingConfig().TxConfig
+		checkTx := func(fee int64) abci.ResponseCheckTx {
+			to := sdk.BytesToAccAddress(privs[1].PubKey().Address())
+			msg := banktypes.NewMsgSend(addr, to, sdk.NewCoins(sdk.NewInt64Coin(deliverTxsTestDenom, 10)))
+			fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, fee))
+			tx, err := helpers.GenTx(txCfg, []sdk.Msg{msg}, fees, helpers.DefaultGenTxGas, "", []uint64{1}, []uint64{0}, privs[0])
+			require.NoError(t, err)
+
+			txBytes, err := txCfg.TxEncoder()(tx)
+			require.NoError(t, err)
+			return app.CheckTxSync(abci.RequestCheckTx{Tx: txBytes})
+		}
+
+		res := checkTx(100)
+		require.True(t, res.IsOK(), res.Log)
+		res = checkTx(200)
+		require.True(t, res.IsOK(), res.Log)
+
+		ctx := app.NewContext(true, header)
+		require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000000-200), app.BankKeeper.GetBalance(ctx, addr, sdk.DefaultBondDenom), "deferred fees %t", deferredFees)
+
+		feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		collected := int64(200)
+		if deferredFees {
+			collected = 0
+		}
+		require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, collected), app.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom))
+	}
+}
+
+// TestDeliverTxTextualGasUsed checks that a SIGN_MODE_TEXTUAL tx uses the same gas
+// in DeliverTx whether or not its signature was cached by CheckTx.
+func TestDeliverTxTextualGasUsed(t *testing.T) {
+	privs := []*secp256k1.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	metadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: sdk.DefaultBondDenom, Exponent: 0}, {Denom: "bond", Exponent: 6}},
+		Base:       sdk.DefaultBondDenom,
+		Display:    "bond",
+	}
+	setupApp := func() *SimApp {
+		app := setupDeliverTxsTestApp(t, privs)
+		header := ocproto.Header{Height: app.LastBlockHeight() + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		app.BankKeeper.SetDenomMetaData(app.NewContext(false, header), metadata)
+		app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+		app.Commit()
+		app.BeginRecheckTx(abci.RequestBeginRecheckTx{Header: header})
+		app.EndRecheckTx(abci.RequestEndRecheckTx{})
+		return app
+	}
+
+	txCfg := MakeTestEncodingConfig().TxConfig
+	from := sdk.BytesToAccAddress(privs[0].PubKey().Address())
+	to := sdk.BytesToAccAddress(privs[1].PubKey().Address())
+	txBuilder := txCfg.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(deliverTxsTestDenom, 10)))))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	txBuilder.SetGasLimit(helpers.DefaultGenTxGas)
+	txBuilder.SetSigBlockHeight(1)
+	sig := signingtypes.SignatureV2{
+		PubKey:   privs[0].PubKey(),
+		Data:     &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_TEXTUAL},
+		Sequence: 0,
+	}
+	require.NoError(t, txBuilder.SetSignatures(sig))
+	textual := authtx.NewSignModeTextualHandler(func(denom string) (*banktypes.Metadata, error) {
+		if denom != metadata.Base {
+			return nil, nil
+		}
+		return &metadata, nil
+	})
+	signBytes, err := textual.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signing.SignerData{Sequence: 0}, txBuilder.GetTx())
+	require.NoError(t, err)
+	sig.Data.(*signingtypes.SingleSignatureData).Signature, err = privs[0].Sign(signBytes)
+	require.NoError(t, err)
+	require.NoError(t, txBuilder.SetSignatures(sig))
+	txBytes, err := txCfg.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	deliverTx := func(app *SimApp, check bool) abci.ResponseDeliverTx {
+		if check {
+			res := app.CheckTxSync(abci.RequestCheckTx{Tx: txBytes})
+			require.True(t, res.IsOK(), res.Log)
+		}
+		header := ocproto.Header{Height: app.LastBlockHeight() + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.True(t, res.IsOK(), res.Log)
+		return res
+	}
+
+	cached := deliverTx(setupApp(), true)
+	uncached := deliverTx(setupApp(), false)
+	require.Equal(t, uncached.GasUsed, cached.GasUsed)
+}
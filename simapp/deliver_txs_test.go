@@ -0,0 +1,199 @@
+package simapp
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	abcicli "github.com/line/ostracon/abci/client"
+	abci "github.com/line/ostracon/abci/types"
+	"github.com/line/ostracon/libs/log"
+	ocproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/line/ostracon/proxy"
+	"github.com/line/tm-db/v2/memdb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/baseapp"
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
+	"github.com/line/lbm-sdk/server"
+	"github.com/line/lbm-sdk/simapp/helpers"
+	sdk "github.com/line/lbm-sdk/types"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+)
+
+const deliverTxsTestDenom = "atom"
+
+func setupDeliverTxsTestApp(t *testing.T, privs []*secp256k1.PrivKey, options ...func(*baseapp.BaseApp)) *SimApp {
+	encCfg := MakeTestEncodingConfig()
+	app := NewSimApp(log.NewNopLogger(), memdb.NewDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encCfg, EmptyAppOptions{}, options...)
+
+	genAccs := make([]authtypes.GenesisAccount, len(privs))
+	balances := make([]banktypes.Balance, len(privs))
+	totalSupply := sdk.NewCoins()
+	for i, priv := range privs {
+		addr := sdk.BytesToAccAddress(priv.PubKey().Address())
+		genAccs[i] = authtypes.NewBaseAccount(addr, priv.PubKey(), 0)
+		coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000000), sdk.NewInt64Coin(deliverTxsTestDenom, 1000))
+		balances[i] = banktypes.Balance{Address: addr.String(), Coins: coins}
+		totalSupply = totalSupply.Add(coins...)
+	}
+
+	genesisState := NewDefaultGenesisState(encCfg.Marshaler)
+	genesisState[authtypes.ModuleName] = app.AppCodec().MustMarshalJSON(authtypes.NewGenesisState(authtypes.DefaultParams(), genAccs))
+	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultGenesisState().Params, balances, totalSupply, []banktypes.Metadata{})
+	genesisState[banktypes.ModuleName] = app.AppCodec().MustMarshalJSON(bankGenesis)
+
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	app.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	app.Commit()
+
+	return app
+}
+
+// randomDeliverTxsBlock returns a block of bank sends between a few accounts, so
+// that many txs conflict on their recipients, and some of them fail.
+func randomDeliverTxsBlock(t *testing.T, r *rand.Rand, height int64, privs []*secp256k1.PrivKey, seqs []uint64) []abci.RequestDeliverTx {
+	txCfg := MakeTestEncodingConfig().TxConfig
+
+	numTxs := r.Intn(50)
+	reqs := make([]abci.RequestDeliverTx, 0, numTxs)
+	for i := 0; i < numTxs; i++ {
+		from := r.Intn(len(privs))
+		fromAddr := sdk.BytesToAccAddress(privs[from].PubKey().Address())
+
+		var msgs []sdk.Msg
+		for j := 0; j <= r.Intn(3); j++ {
+			toAddr := sdk.BytesToAccAddress(privs[r.Intn(len(privs))].PubKey().Address())
+			if r.Intn(10) == 0 {
+				// a new account
+				toAddr = sdk.BytesToAccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			}
+			amount := sdk.NewCoins(sdk.NewInt64Coin(deliverTxsTestDenom, r.Int63n(300)+1))
+			msgs = append(msgs, banktypes.NewMsgSend(fromAddr, toAddr, amount))
+		}
+
+		fees := sdk.NewCoins()
+		if r.Intn(2) == 0 {
+			fees = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, r.Int63n(100)+1))
+		}
+
+		tx, err := helpers.GenTx(txCfg, msgs, fees, helpers.DefaultGenTxGas, "", []uint64{uint64(height)}, []uint64{seqs[from]}, privs[from])
+		require.NoError(t, err)
+		seqs[from]++
+
+		txBytes, err := txCfg.TxEncoder()(tx)
+		require.NoError(t, err)
+		reqs = append(reqs, abci.RequestDeliverTx{Tx: txBytes})
+	}
+
+	return reqs
+}
+
+// TestDeliverTxsModes compares the results of the parallel DeliverTxs modes with
+// the ones of sequential execution on random blocks.
+func TestDeliverTxsModes(t *testing.T) {
+	modes := map[string]func(*baseapp.BaseApp){
+		"scheduled":  baseapp.SetParallelDeliverTx(true),
+		"optimistic": baseapp.SetOptimisticDeliverTx(true),
+	}
+
+	for name, option := range modes {
+		t.Run(name, func(t *testing.T) {
+			for seed := int64(0); seed < 3; seed++ {
+				r := rand.New(rand.NewSource(seed))
+
+				privs := make([]*secp256k1.PrivKey, 5)
+				for i := range privs {
+					privs[i] = secp256k1.GenPrivKey()
+				}
+				seqs := make([]uint64, len(privs))
+
+				sequential := setupDeliverTxsTestApp(t, privs)
+				parallel := setupDeliverTxsTestApp(t, privs, option)
+
+				for height := int64(2); height < 7; height++ {
+					reqs := randomDeliverTxsBlock(t, r, height, privs, seqs)
+					header := ocproto.Header{Height: height}
+
+					sequential.BeginBlock(abci.RequestBeginBlock{Header: header})
+					parallel.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+					require.Equal(t, sequential.DeliverTxs(reqs), parallel.DeliverTxs(reqs), "seed %d height %d", seed, height)
+
+					require.Equal(t, sequential.EndBlock(abci.RequestEndBlock{Height: height}), parallel.EndBlock(abci.RequestEndBlock{Height: height}))
+					require.Equal(t, sequential.Commit(), parallel.Commit(), "seed %d height %d", seed, height)
+				}
+			}
+		})
+	}
+}
+
+// TestDeliverTxsABCIClient runs random blocks through the ABCI clients the node uses, comparing
+// the batching local client driving the parallel DeliverTxs modes with the local client driving
+// sequential execution.
+func TestDeliverTxsABCIClient(t *testing.T) {
+	modes := map[string]func(*baseapp.BaseApp){
+		"scheduled":  baseapp.SetParallelDeliverTx(true),
+		"optimistic": baseapp.SetOptimisticDeliverTx(true),
+	}
+
+	newClient := func(creator proxy.ClientCreator) (abcicli.Client, *[]*abci.ResponseDeliverTx) {
+		client, err := creator.NewABCIClient()
+		require.NoError(t, err)
+		responses := &[]*abci.ResponseDeliverTx{}
+		client.SetGlobalCallback(func(_ *abci.Request, res *abci.Response) {
+			if res := res.GetDeliverTx(); res != nil {
+				*responses = append(*responses, res)
+			}
+		})
+		return client, responses
+	}
+
+	for name, option := range modes {
+		t.Run(name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(0))
+
+			privs := make([]*secp256k1.PrivKey, 5)
+			for i := range privs {
+				privs[i] = secp256k1.GenPrivKey()
+			}
+			seqs := make([]uint64, len(privs))
+
+			sequential, sequentialResponses := newClient(proxy.NewLocalClientCreator(setupDeliverTxsTestApp(t, privs)))
+			parallel, parallelResponses := newClient(server.NewBatchLocalClientCreator(setupDeliverTxsTestApp(t, privs, option)))
+
+			for height := int64(2); height < 7; height++ {
+				reqs := randomDeliverTxsBlock(t, r, height, privs, seqs)
+
+				for _, client := range []abcicli.Client{sequential, parallel} {
+					_, err := client.BeginBlockSync(abci.RequestBeginBlock{Header: ocproto.Header{Height: height}})
+					require.NoError(t, err)
+					for _, req := range reqs {
+						client.DeliverTxAsync(req, nil)
+					}
+				}
+
+				expected, err := sequential.EndBlockSync(abci.RequestEndBlock{Height: height})
+				require.NoError(t, err)
+				actual, err := parallel.EndBlockSync(abci.RequestEndBlock{Height: height})
+				require.NoError(t, err)
+				require.Equal(t, expected, actual)
+				require.Len(t, *parallelResponses, len(*sequentialResponses))
+				require.Equal(t, *sequentialResponses, *parallelResponses, "height %d", height)
+
+				expectedCommit, err := sequential.CommitSync()
+				require.NoError(t, err)
+				actualCommit, err := parallel.CommitSync()
+				require.NoError(t, err)
+				require.Equal(t, expectedCommit, actualCommit, "height %d", height)
+			}
+		})
+	}
+}
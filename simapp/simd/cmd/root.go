@@ -4,7 +4,6 @@ import (
 	"errors"
 	"io"
 	"os"
-	"path/filepath"
 
 	ostcli "github.com/line/ostracon/libs/cli"
 	"github.com/line/ostracon/libs/log"
@@ -23,7 +22,6 @@ import (
 	servertypes "github.com/line/lbm-sdk/server/types"
 	"github.com/line/lbm-sdk/simapp"
 	"github.com/line/lbm-sdk/simapp/params"
-	"github.com/line/lbm-sdk/snapshots"
 	"github.com/line/lbm-sdk/store"
 	sdk "github.com/line/lbm-sdk/types"
 	authclient "github.com/line/lbm-sdk/x/auth/client"
@@ -180,12 +178,7 @@ func (a appCreator) newApp(logger log.Logger, db tmdb.DB, traceStore io.Writer,
 		panic(err)
 	}
 
-	snapshotDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots")
-	snapshotDB, err := sdk.NewLevelDB("metadata", snapshotDir)
-	if err != nil {
-		panic(err)
-	}
-	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+	snapshotStore, err := server.GetSnapshotStore(cast.ToString(appOpts.Get(flags.FlagHome)))
 	if err != nil {
 		panic(err)
 	}
@@ -205,6 +198,8 @@ func (a appCreator) newApp(logger log.Logger, db tmdb.DB, traceStore io.Writer,
 		baseapp.SetIAVLCacheManager(cast.ToInt(appOpts.Get(server.FlagIAVLCacheSize)), iavlCacheMetricsProvider),
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(server.FlagTrace))),
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents))),
+		baseapp.SetParallelDeliverTx(cast.ToBool(appOpts.Get(server.FlagParallelDeliverTx))),
+		baseapp.SetOptimisticDeliverTx(cast.ToBool(appOpts.Get(server.FlagOptimisticDeliverTx))),
 		baseapp.SetSnapshotStore(snapshotStore),
 		baseapp.SetSnapshotInterval(cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval))),
 		baseapp.SetSnapshotKeepRecent(cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent))),
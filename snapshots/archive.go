@@ -0,0 +1,227 @@
+package snapshots
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/line/lbm-sdk/snapshots/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// A snapshot archive is a tar archive holding the encoded snapshot metadata as its first entry,
+// followed by the chunks of the snapshot in order, named by their index, and optionally by the
+// trusted state saved with the snapshot. It allows moving a snapshot between nodes without state
+// sync.
+
+const (
+	// archiveMetadataName is the name of the archive entry holding the snapshot metadata
+	archiveMetadataName = "metadata"
+	// archiveMaxMetadataSize is the maximum size of the metadata entry of an archive
+	archiveMaxMetadataSize = 16e6
+	// archiveStateName is the name of the archive entry holding the trusted state of a snapshot
+	archiveStateName = "state"
+	// archiveMaxStateSize is the maximum size of the state entry of an archive
+	archiveMaxStateSize = 16e6
+)
+
+// SaveState saves the trusted state of the consensus engine at the height of a snapshot, which is
+// exported along with the snapshot so that a node restoring it can be bootstrapped without a peer.
+// The state is opaque to the store.
+func (s *Store) SaveState(height uint64, format uint32, state []byte) error {
+	snapshot, err := s.Get(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot for height %v format %v", height, format)
+	}
+	err = ioutil.WriteFile(s.pathState(height, format), state, 0644)
+	return sdkerrors.Wrapf(err, "failed to save state of snapshot for height %v format %v", height, format)
+}
+
+// LoadState loads the trusted state saved with a snapshot, or nil if there is none.
+func (s *Store) LoadState(height uint64, format uint32) ([]byte, error) {
+	state, err := ioutil.ReadFile(s.pathState(height, format))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return state, sdkerrors.Wrapf(err, "failed to load state of snapshot for height %v format %v", height, format)
+}
+
+// Export writes a snapshot with its chunks to w as a snapshot archive.
+func (s *Store) Export(height uint64, format uint32, w io.Writer) error {
+	snapshot, err := s.Get(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot for height %v format %v", height, format)
+	}
+	metadata, err := proto.Marshal(snapshot)
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to encode snapshot metadata")
+	}
+
+	tw := tar.NewWriter(w)
+	if err := writeArchiveEntry(tw, archiveMetadataName, int64(len(metadata)), bytes.NewReader(metadata)); err != nil {
+		return sdkerrors.Wrap(err, "failed to write snapshot metadata")
+	}
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		if err := s.exportChunk(tw, height, format, i); err != nil {
+			return sdkerrors.Wrapf(err, "failed to write snapshot chunk %v", i)
+		}
+	}
+	state, err := s.LoadState(height, format)
+	if err != nil {
+		return err
+	}
+	if state != nil {
+		if err := writeArchiveEntry(tw, archiveStateName, int64(len(state)), bytes.NewReader(state)); err != nil {
+			return sdkerrors.Wrap(err, "failed to write snapshot state")
+		}
+	}
+	return tw.Close()
+}
+
+// exportChunk writes a chunk file to a snapshot archive.
+func (s *Store) exportChunk(tw *tar.Writer, height uint64, format uint32, chunk uint32) error {
+	file, err := os.Open(s.pathChunk(height, format, chunk))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	return writeArchiveEntry(tw, strconv.FormatUint(uint64(chunk), 10), info.Size(), file)
+}
+
+// writeArchiveEntry writes an entry of the given size to a snapshot archive.
+func writeArchiveEntry(tw *tar.Writer, name string, size int64, r io.Reader) error {
+	err := tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0644,
+		Size:     size,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, r)
+	return err
+}
+
+// Import saves the snapshot of a snapshot archive read from r along with its trusted state, returning
+// it. The chunks of the archive are verified against the snapshot metadata, and the snapshot is not
+// kept if they don't match.
+func (s *Store) Import(r io.Reader) (*types.Snapshot, error) {
+	tr := tar.NewReader(r)
+	header, err := tr.Next()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to read snapshot archive")
+	}
+	if header.Name != archiveMetadataName {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMetadata, "expected archive entry %q, got %q",
+			archiveMetadataName, header.Name)
+	}
+	if header.Size > archiveMaxMetadataSize {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMetadata, "metadata of %v bytes is too large", header.Size)
+	}
+	metadata, err := ioutil.ReadAll(tr)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to read snapshot metadata")
+	}
+	expected := &types.Snapshot{}
+	if err := proto.Unmarshal(metadata, expected); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to decode snapshot metadata")
+	}
+	if uint32(len(expected.Metadata.ChunkHashes)) != expected.Chunks {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunk hashes, but %v chunks",
+			len(expected.Metadata.ChunkHashes), expected.Chunks)
+	}
+
+	chunks := make(chan io.ReadCloser)
+	go func() {
+		defer close(chunks)
+		for i := uint32(0); i < expected.Chunks; i++ {
+			pr, pw := io.Pipe()
+			chunks <- pr
+			header, err := tr.Next()
+			if err != nil {
+				pw.CloseWithError(sdkerrors.Wrapf(err, "failed to read snapshot chunk %v", i))
+				return
+			}
+			if header.Name != strconv.FormatUint(uint64(i), 10) {
+				pw.CloseWithError(sdkerrors.Wrapf(sdkerrors.ErrLogic, "expected snapshot chunk %v, got archive entry %q",
+					i, header.Name))
+				return
+			}
+			if _, err := io.Copy(pw, tr); err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+			pw.Close()
+		}
+	}()
+
+	snapshot, err := s.save(expected.Height, expected.Format, chunks, expected.Metadata.Extensions)
+	if err != nil {
+		return nil, err
+	}
+	// the chunks are all read once the snapshot is saved, so the archive is read on from there
+	err = verifyImport(expected, snapshot)
+	if err == nil {
+		err = s.importState(tr, snapshot.Height, snapshot.Format)
+	}
+	if err != nil {
+		if derr := s.Delete(snapshot.Height, snapshot.Format); derr != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to delete snapshot: %v", derr)
+		}
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// importState saves the trusted state following the chunks of a snapshot archive, if any.
+func (s *Store) importState(tr *tar.Reader, height uint64, format uint32) error {
+	header, err := tr.Next()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to read snapshot archive")
+	}
+	if header.Name != archiveStateName {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "expected archive entry %q, got %q", archiveStateName, header.Name)
+	}
+	if header.Size > archiveMaxStateSize {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "state of %v bytes is too large", header.Size)
+	}
+	state, err := ioutil.ReadAll(tr)
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to read snapshot state")
+	}
+	return s.SaveState(height, format, state)
+}
+
+// verifyImport checks that the chunks of an imported snapshot match the metadata of the archive.
+func verifyImport(expected *types.Snapshot, snapshot *types.Snapshot) error {
+	for i, hash := range snapshot.Metadata.ChunkHashes {
+		if !bytes.Equal(hash, expected.Metadata.ChunkHashes[i]) {
+			return sdkerrors.Wrapf(types.ErrChunkHashMismatch, "chunk %v: expected %x, got %x",
+				i, expected.Metadata.ChunkHashes[i], hash)
+		}
+	}
+	if !bytes.Equal(snapshot.Hash, expected.Hash) {
+		return sdkerrors.Wrapf(types.ErrChunkHashMismatch, "snapshot hash: expected %x, got %x",
+			expected.Hash, snapshot.Hash)
+	}
+	return nil
+}
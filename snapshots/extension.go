@@ -0,0 +1,173 @@
+package snapshots
+
+import (
+	"bufio"
+	"compress/zlib"
+	"io"
+
+	protoio "github.com/gogo/protobuf/io"
+
+	"github.com/line/lbm-sdk/snapshots/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+const (
+	// Do not change chunk size without new snapshot format (must be uniform across nodes)
+	extensionChunkSize      = uint64(10e6)
+	extensionBufferSize     = int(extensionChunkSize)
+	extensionMaxPayloadSize = int(64e6)
+)
+
+// ExtensionPayloadWriter writes a payload of an extension snapshotter to the snapshot.
+type ExtensionPayloadWriter func(payload []byte) error
+
+// ExtensionPayloadReader reads the next payload of an extension snapshotter from the snapshot. It
+// returns io.EOF once all payloads have been read.
+type ExtensionPayloadReader func() ([]byte, error)
+
+// ExtensionSnapshotter is a snapshotter of state that is not part of the multistore, e.g. files
+// kept by a module. Its payloads are appended to the snapshot as a named section of their own.
+// The multistore is restored before the extensions, so they may read the restored state.
+type ExtensionSnapshotter interface {
+	// SnapshotName returns the name of the extension, which must be unique in the manager.
+	SnapshotName() string
+
+	// SnapshotFormat returns the format the payloads are written in by Snapshot.
+	SnapshotFormat() uint32
+
+	// SupportedFormats returns the formats of the payloads Restore can read.
+	SupportedFormats() []uint32
+
+	// Snapshot writes the payloads of the extension at the given height. The payloads must be
+	// identical across nodes for a given height and format.
+	Snapshot(height uint64, payloadWriter ExtensionPayloadWriter) error
+
+	// Restore restores the extension at the given height from the payloads of the given format.
+	Restore(height uint64, format uint32, payloadReader ExtensionPayloadReader) error
+}
+
+// isSupportedExtensionFormat returns true if the extension can restore payloads of the format.
+func isSupportedExtensionFormat(extension ExtensionSnapshotter, format uint32) bool {
+	for _, f := range extension.SupportedFormats() {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// snapshotExtension generates the chunks of the payload section of an extension.
+func snapshotExtension(extension ExtensionSnapshotter, height uint64) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser)
+	go func() {
+		// Set up a stream pipeline to serialize the payloads:
+		// payload -> delimited Protobuf -> zlib -> buffer -> chunkWriter -> chan io.ReadCloser
+		chunkWriter := NewChunkWriter(ch, extensionChunkSize)
+		defer chunkWriter.Close()
+		bufWriter := bufio.NewWriterSize(chunkWriter, extensionBufferSize)
+		defer func() {
+			if err := bufWriter.Flush(); err != nil {
+				chunkWriter.CloseWithError(err)
+			}
+		}()
+		zWriter, err := zlib.NewWriterLevel(bufWriter, 7)
+		if err != nil {
+			chunkWriter.CloseWithError(sdkerrors.Wrap(err, "zlib failure"))
+			return
+		}
+		defer func() {
+			if err := zWriter.Close(); err != nil {
+				chunkWriter.CloseWithError(err)
+			}
+		}()
+		protoWriter := protoio.NewDelimitedWriter(zWriter)
+
+		err = extension.Snapshot(height, func(payload []byte) error {
+			return protoWriter.WriteMsg(&types.ExtensionPayload{Payload: payload})
+		})
+		if err != nil {
+			chunkWriter.CloseWithError(sdkerrors.Wrapf(err, "failed to snapshot extension %q", extension.SnapshotName()))
+		}
+	}()
+	return ch
+}
+
+// restoreExtension restores an extension from the chunks of its payload section.
+func restoreExtension(extension ExtensionSnapshotter, height uint64, format uint32, chunks <-chan io.ReadCloser) error {
+	// Set up a restore stream pipeline
+	// chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> payload
+	chunkReader := NewChunkReader(chunks)
+	defer chunkReader.Close()
+	zReader, err := zlib.NewReader(chunkReader)
+	if err != nil {
+		return sdkerrors.Wrap(err, "zlib failure")
+	}
+	defer zReader.Close()
+	protoReader := protoio.NewDelimitedReader(zReader, extensionMaxPayloadSize)
+	defer protoReader.Close()
+
+	err = extension.Restore(height, format, func() ([]byte, error) {
+		item := &types.ExtensionPayload{}
+		err := protoReader.ReadMsg(item)
+		if err == io.EOF {
+			return nil, io.EOF
+		} else if err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+		return item.Payload, nil
+	})
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to restore extension %q", extension.SnapshotName())
+	}
+	return nil
+}
+
+// restoreSection passes the next count chunks to a restore running concurrently, and returns the
+// result of the restore. The restore may end early on an error.
+func restoreSection(chunks <-chan io.ReadCloser, count uint32, restore func(<-chan io.ReadCloser) error) error {
+	ch := make(chan io.ReadCloser, chunkBufferSize)
+	chDone := make(chan error, 1)
+	go func() {
+		chDone <- restore(ch)
+	}()
+
+feed:
+	for i := uint32(0); i < count; i++ {
+		select {
+		case chunk, ok := <-chunks:
+			if !ok {
+				break feed
+			}
+			select {
+			case ch <- chunk:
+			case err := <-chDone:
+				_ = chunk.Close()
+				close(ch)
+				return sectionEnded(err)
+			}
+		case err := <-chDone:
+			close(ch)
+			return sectionEnded(err)
+		}
+	}
+	close(ch)
+	return <-chDone
+}
+
+// sectionEnded returns the error of a restore that ended before all chunks of its section were
+// passed.
+func sectionEnded(err error) error {
+	if err == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "restore ended prematurely")
+	}
+	return err
+}
+
+// extensionChunks returns the number of chunks of the extension sections of a snapshot.
+func extensionChunks(metadata types.Metadata) uint64 {
+	var chunks uint64
+	for _, extension := range metadata.Extensions {
+		chunks += uint64(extension.Chunks)
+	}
+	return chunks
+}
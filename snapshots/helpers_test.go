@@ -148,3 +148,43 @@ func (m *hungSnapshotter) Restore(
 ) error {
 	panic("not implemented")
 }
+
+// mockExtension is an extension snapshotter writing and restoring a list of payloads.
+type mockExtension struct {
+	payloads [][]byte
+	restored [][]byte
+}
+
+func (m *mockExtension) SnapshotName() string {
+	return "mock"
+}
+
+func (m *mockExtension) SnapshotFormat() uint32 {
+	return 1
+}
+
+func (m *mockExtension) SupportedFormats() []uint32 {
+	return []uint32{1}
+}
+
+func (m *mockExtension) Snapshot(height uint64, payloadWriter snapshots.ExtensionPayloadWriter) error {
+	for _, payload := range m.payloads {
+		if err := payloadWriter(payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockExtension) Restore(height uint64, format uint32, payloadReader snapshots.ExtensionPayloadReader) error {
+	m.restored = [][]byte{}
+	for {
+		payload, err := payloadReader()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		m.restored = append(m.restored, payload)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"io"
 	"io/ioutil"
+	"sort"
 	"sync"
 
 	"github.com/line/lbm-sdk/snapshots/types"
@@ -42,9 +43,13 @@ type restoreDone struct {
 //
 // 2) io.ReadCloser streams automatically propagate IO errors, and can pass arbitrary
 //    errors via io.Pipe.CloseWithError().
+//
+// The chunks of the multistore are followed by the payload sections of the registered extension
+// snapshotters, in the order of their names, which are described by the snapshot metadata.
 type Manager struct {
-	store  *Store
-	target types.Snapshotter
+	store      *Store
+	target     types.Snapshotter
+	extensions map[string]ExtensionSnapshotter
 
 	mtx                sync.Mutex
 	operation          operation
@@ -57,9 +62,48 @@ type Manager struct {
 // NewManager creates a new manager.
 func NewManager(store *Store, target types.Snapshotter) *Manager {
 	return &Manager{
-		store:  store,
-		target: target,
+		store:      store,
+		target:     target,
+		extensions: make(map[string]ExtensionSnapshotter),
+	}
+}
+
+// RegisterExtensions registers extension snapshotters, whose payloads are included in the
+// snapshots created from now on.
+func (m *Manager) RegisterExtensions(extensions ...ExtensionSnapshotter) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	for _, extension := range extensions {
+		name := extension.SnapshotName()
+		if _, ok := m.extensions[name]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrConflict, "duplicate snapshot extension %q", name)
+		}
+		m.extensions[name] = extension
+	}
+	return nil
+}
+
+// sortedExtensions returns the registered extensions in the order of their names.
+func (m *Manager) sortedExtensions() []ExtensionSnapshotter {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	names := make([]string, 0, len(m.extensions))
+	for name := range m.extensions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	extensions := make([]ExtensionSnapshotter, len(names))
+	for i, name := range names {
+		extensions[i] = m.extensions[name]
 	}
+	return extensions
+}
+
+// getExtension returns the registered extension of the given name, or nil.
+func (m *Manager) getExtension(name string) ExtensionSnapshotter {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return m.extensions[name]
 }
 
 // begin starts an operation, or errors if one is in progress. It manages the mutex itself.
@@ -120,11 +164,34 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
-	chunks, err := m.target.Snapshot(height, types.CurrentFormat)
+	storeChunks, err := m.target.Snapshot(height, types.CurrentFormat)
 	if err != nil {
 		return nil, err
 	}
-	return m.store.Save(height, types.CurrentFormat, chunks)
+
+	// Append the payload sections of the extensions to the chunks of the multistore, counting
+	// the chunks of every section.
+	extensions := m.sortedExtensions()
+	extensionMetadata := make([]types.ExtensionMetadata, len(extensions))
+	chunks := make(chan io.ReadCloser)
+	go func() {
+		defer close(chunks)
+		for chunk := range storeChunks {
+			chunks <- chunk
+		}
+		for i, extension := range extensions {
+			extensionMetadata[i] = types.ExtensionMetadata{
+				Name:   extension.SnapshotName(),
+				Format: extension.SnapshotFormat(),
+			}
+			for chunk := range snapshotExtension(extension, height) {
+				chunks <- chunk
+				extensionMetadata[i].Chunks++
+			}
+		}
+	}()
+
+	return m.store.save(height, types.CurrentFormat, chunks, extensionMetadata)
 }
 
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
@@ -168,6 +235,26 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 			uint32(len(snapshot.Metadata.ChunkHashes)),
 			snapshot.Chunks)
 	}
+	if extensionChunks(snapshot.Metadata) >= uint64(snapshot.Chunks) {
+		return sdkerrors.Wrap(types.ErrInvalidMetadata, "snapshot has no chunks left for the multistore")
+	}
+	names := make(map[string]bool, len(snapshot.Metadata.Extensions))
+	extensions := make([]ExtensionSnapshotter, len(snapshot.Metadata.Extensions))
+	for i, metadata := range snapshot.Metadata.Extensions {
+		if names[metadata.Name] {
+			return sdkerrors.Wrapf(types.ErrInvalidMetadata, "duplicate snapshot extension %q", metadata.Name)
+		}
+		names[metadata.Name] = true
+		extension := m.getExtension(metadata.Name)
+		if extension == nil {
+			return sdkerrors.Wrapf(types.ErrUnknownFormat, "unknown snapshot extension %q", metadata.Name)
+		}
+		if !isSupportedExtensionFormat(extension, metadata.Format) {
+			return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot extension %q format %v",
+				metadata.Name, metadata.Format)
+		}
+		extensions[i] = extension
+	}
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 	err := m.beginLocked(opRestore)
@@ -180,7 +267,7 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	chReady := make(chan struct{}, 1)
 	chDone := make(chan restoreDone, 1)
 	go func() {
-		err := m.target.Restore(snapshot.Height, snapshot.Format, chChunks, chReady)
+		err := m.restore(snapshot, extensions, chChunks, chReady)
 		chDone <- restoreDone{
 			complete: err == nil,
 			err:      err,
@@ -206,6 +293,30 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	return nil
 }
 
+// restore restores the multistore and then the extensions from the chunks of a snapshot.
+func (m *Manager) restore(
+	snapshot types.Snapshot, extensions []ExtensionSnapshotter, chChunks <-chan io.ReadCloser, chReady chan<- struct{},
+) error {
+	storeChunks := snapshot.Chunks - uint32(extensionChunks(snapshot.Metadata))
+	err := restoreSection(chChunks, storeChunks, func(chunks <-chan io.ReadCloser) error {
+		return m.target.Restore(snapshot.Height, snapshot.Format, chunks, chReady)
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, metadata := range snapshot.Metadata.Extensions {
+		extension, format := extensions[i], metadata.Format
+		err := restoreSection(chChunks, metadata.Chunks, func(chunks <-chan io.ReadCloser) error {
+			return restoreExtension(extension, snapshot.Height, format, chunks)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // RestoreChunk adds a chunk to an active snapshot restoration, mirroring ABCI ApplySnapshotChunk.
 // Chunks must be given until the restore is complete, returning true, or a chunk errors.
 func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
@@ -238,8 +349,17 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 			"expected %x, got %x", hash, expected)
 	}
 
-	// Pass the chunk to the restore, and wait for completion if it was the final one.
-	m.chRestore <- ioutil.NopCloser(bytes.NewReader(chunk))
+	// Pass the chunk to the restore, and wait for completion if it was the final one. The restore
+	// may end on an error before reading the chunk.
+	select {
+	case m.chRestore <- ioutil.NopCloser(bytes.NewReader(chunk)):
+	case done := <-m.chRestoreDone:
+		m.endLocked()
+		if done.err != nil {
+			return false, done.err
+		}
+		return false, sdkerrors.Wrap(sdkerrors.ErrLogic, "restore ended unexpectedly")
+	}
 	m.restoreChunkIndex++
 
 	if int(m.restoreChunkIndex) >= len(m.restoreChunkHashes) {
@@ -257,3 +377,41 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	}
 	return false, nil
 }
+
+// RestoreLocalSnapshot restores the app state from a snapshot in the snapshot store, without a
+// peer providing its chunks. It returns once the restore is complete.
+func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
+	if m == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "no snapshot store configured")
+	}
+	snapshot, chunks, err := m.store.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot for height %v format %v", height, format)
+	}
+	defer DrainChunks(chunks)
+
+	if err := m.Restore(*snapshot); err != nil {
+		return err
+	}
+	for chunk := range chunks {
+		bz, err := ioutil.ReadAll(chunk)
+		chunk.Close()
+		if err != nil {
+			m.end()
+			return sdkerrors.Wrap(err, "failed to load snapshot chunk")
+		}
+		done, err := m.RestoreChunk(bz)
+		if err != nil {
+			m.end()
+			return err
+		}
+		if done {
+			return nil
+		}
+	}
+	m.end()
+	return sdkerrors.Wrap(sdkerrors.ErrLogic, "restore ended prematurely")
+}
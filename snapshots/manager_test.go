@@ -207,3 +207,70 @@ func TestManager_Restore(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestManager_Extensions(t *testing.T) {
+	store := setupStore(t)
+	snapshotter := &mockSnapshotter{chunks: [][]byte{{1, 2, 3}, {4, 5, 6}}}
+	extension := &mockExtension{payloads: [][]byte{{7}, {8, 9}}}
+	manager := snapshots.NewManager(store, snapshotter)
+	require.NoError(t, manager.RegisterExtensions(extension))
+	require.Error(t, manager.RegisterExtensions(&mockExtension{}))
+
+	// the payloads of the extension follow the chunks of the multistore
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, snapshot.Chunks)
+	assert.Equal(t, []types.ExtensionMetadata{{Name: "mock", Format: 1, Chunks: 1}}, snapshot.Metadata.Extensions)
+
+	storeSnapshot, chunks, err := store.Load(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, storeSnapshot)
+	bodies := readChunks(chunks)
+
+	// a manager without the extension can't restore the snapshot
+	manager = snapshots.NewManager(store, &mockSnapshotter{})
+	err = manager.Restore(*snapshot)
+	require.True(t, errors.Is(err, types.ErrUnknownFormat))
+
+	// nor can a manager not supporting the format of the extension
+	unsupported := *snapshot
+	unsupported.Metadata.Extensions = []types.ExtensionMetadata{{Name: "mock", Format: 2, Chunks: 1}}
+	require.NoError(t, manager.RegisterExtensions(&mockExtension{}))
+	err = manager.Restore(unsupported)
+	require.True(t, errors.Is(err, types.ErrUnknownFormat))
+
+	// the extension is restored after the multistore
+	target := &mockSnapshotter{}
+	restored := &mockExtension{}
+	manager = snapshots.NewManager(store, target)
+	require.NoError(t, manager.RegisterExtensions(restored))
+	require.NoError(t, manager.Restore(*snapshot))
+	for i, body := range bodies {
+		done, err := manager.RestoreChunk(body)
+		require.NoError(t, err)
+		assert.Equal(t, i == len(bodies)-1, done)
+	}
+	assert.Equal(t, [][]byte{{1, 2, 3}, {4, 5, 6}}, target.chunks)
+	assert.Equal(t, [][]byte{{7}, {8, 9}}, restored.restored)
+}
+
+func TestManager_RestoreLocalSnapshot(t *testing.T) {
+	store := setupStore(t)
+	target := &mockSnapshotter{}
+	manager := snapshots.NewManager(store, target)
+
+	// Restoring a missing snapshot should error
+	err := manager.RestoreLocalSnapshot(9, 1)
+	require.Error(t, err)
+
+	// Restoring a snapshot of the store should pass all its chunks to the target
+	err = manager.RestoreLocalSnapshot(3, 2)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{{3, 2, 0}, {3, 2, 1}, {3, 2, 2}}, target.chunks)
+
+	// A failed restore should end the restore operation
+	err = manager.RestoreLocalSnapshot(2, 1)
+	require.Error(t, err)
+	_, err = manager.Prune(1)
+	require.NoError(t, err)
+}
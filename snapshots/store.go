@@ -222,6 +222,16 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 // Save saves a snapshot to disk, returning it.
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	return s.save(height, format, chunks, nil)
+}
+
+// save saves a snapshot to disk along with the metadata of the payload sections of its
+// extensions, which is stored together with the rest of the snapshot metadata. The extension
+// metadata is read only once the chunk channel is closed, so it may be filled in while the chunks
+// are generated.
+func (s *Store) save(
+	height uint64, format uint32, chunks <-chan io.ReadCloser, extensions []types.ExtensionMetadata,
 ) (*types.Snapshot, error) {
 	defer DrainChunks(chunks)
 	if height == 0 {
@@ -290,6 +300,9 @@ func (s *Store) Save(
 	}
 	snapshot.Chunks = index
 	snapshot.Hash = snapshotHasher.Sum(nil)
+	if len(extensions) > 0 {
+		snapshot.Metadata.Extensions = extensions
+	}
 	return snapshot, s.saveSnapshot(snapshot)
 }
 
@@ -318,6 +331,11 @@ func (s *Store) pathChunk(height uint64, format uint32, chunk uint32) string {
 	return filepath.Join(s.pathSnapshot(height, format), strconv.FormatUint(uint64(chunk), 10))
 }
 
+// pathState generates the path of the trusted state saved with a snapshot.
+func (s *Store) pathState(height uint64, format uint32) string {
+	return filepath.Join(s.pathSnapshot(height, format), "state")
+}
+
 // decodeKey decodes a snapshot key.
 func decodeKey(k []byte) (uint64, uint32, error) {
 	if len(k) != 13 {
@@ -1,15 +1,18 @@
 package snapshots_test
 
 import (
+	"archive/tar"
 	"bytes"
 	"errors"
 	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -331,3 +334,96 @@ func TestStore_Save(t *testing.T) {
 	require.NoError(t, err)
 	close(ch)
 }
+
+func makeArchive(t *testing.T, snapshot *types.Snapshot, chunks [][]byte) []byte {
+	metadata, err := proto.Marshal(snapshot)
+	require.NoError(t, err)
+	entries := append([][]byte{metadata}, chunks...)
+
+	buf := new(bytes.Buffer)
+	tw := tar.NewWriter(buf)
+	for i, entry := range entries {
+		name := "metadata"
+		if i > 0 {
+			name = strconv.Itoa(i - 1)
+		}
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(entry))}))
+		_, err := tw.Write(entry)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	return buf.Bytes()
+}
+
+func TestStore_ExportImport(t *testing.T) {
+	store := setupStore(t)
+	target := setupStore(t)
+
+	// Exporting a missing snapshot should error
+	err := store.Export(9, 1, new(bytes.Buffer))
+	require.Error(t, err)
+
+	// Saving the state of a missing snapshot should error
+	require.Error(t, store.SaveState(9, 1, []byte("state")))
+
+	// An exported snapshot should be imported as it was, with its state
+	require.NoError(t, store.SaveState(2, 2, []byte("state")))
+	buf := new(bytes.Buffer)
+	require.NoError(t, store.Export(2, 2, buf))
+	archive := buf.Bytes()
+	require.NoError(t, target.Delete(2, 2))
+	snapshot, err := target.Import(bytes.NewReader(archive))
+	require.NoError(t, err)
+	expected, err := store.Get(2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, expected, snapshot)
+	loaded, chunks, err := target.Load(2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, expected, loaded)
+	assert.Equal(t, [][]byte{{2, 2, 0}, {2, 2, 1}, {2, 2, 2}}, readChunks(chunks))
+	state, err := target.LoadState(2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("state"), state)
+
+	// Importing an existing snapshot should error
+	_, err = target.Import(bytes.NewReader(archive))
+	require.Error(t, err)
+
+	// Importing a truncated archive should error, without keeping the snapshot
+	require.NoError(t, target.Delete(2, 2))
+	_, err = target.Import(bytes.NewReader(archive[:len(archive)/2]))
+	require.Error(t, err)
+	snapshot, err = target.Get(2, 2)
+	require.NoError(t, err)
+	assert.Nil(t, snapshot)
+
+	// Importing chunks not matching the metadata should error, without keeping the snapshot
+	chunkBodies := [][]byte{{7, 1, 0}, {7, 1, 1}}
+	metadata := &types.Snapshot{
+		Height: 7,
+		Format: 1,
+		Chunks: 2,
+		Hash:   hash(chunkBodies),
+		Metadata: types.Metadata{
+			ChunkHashes: checksums(chunkBodies),
+			Extensions:  []types.ExtensionMetadata{{Name: "mock", Format: 1, Chunks: 1}},
+		},
+	}
+	_, err = target.Import(bytes.NewReader(makeArchive(t, metadata, [][]byte{{7, 1, 0}, {7, 1, 9}})))
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrChunkHashMismatch))
+	snapshot, err = target.Get(7, 1)
+	require.NoError(t, err)
+	assert.Nil(t, snapshot)
+
+	// The extension metadata of an archive should be kept
+	snapshot, err = target.Import(bytes.NewReader(makeArchive(t, metadata, chunkBodies)))
+	require.NoError(t, err)
+	assert.Equal(t, metadata, snapshot)
+	loaded, err = target.Get(7, 1)
+	require.NoError(t, err)
+	assert.Equal(t, metadata, loaded)
+	state, err = target.LoadState(7, 1)
+	require.NoError(t, err)
+	assert.Nil(t, state)
+}
@@ -1,6 +1,22 @@
 package types
 
+const (
+	// FormatV1 serializes all stores into a single compressed stream of snapshot items, which is
+	// split into chunks of a fixed size.
+	FormatV1 uint32 = 1
+
+	// FormatV2 compresses every store into its own stream, which are generated and restored in
+	// parallel. Every chunk is prefixed with a header naming the store it belongs to. A store is
+	// not split, so the largest store bounds the time to generate or restore a snapshot.
+	FormatV2 uint32 = 2
+)
+
 // CurrentFormat is the currently used format for snapshots. Snapshots using the same format
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
-const CurrentFormat uint32 = 1
+const CurrentFormat = FormatV2
+
+// IsSupportedFormat returns true if snapshots of the given format can be restored.
+func IsSupportedFormat(format uint32) bool {
+	return format == FormatV1 || format == FormatV2
+}
@@ -0,0 +1,153 @@
+package cachekv
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/line/lbm-sdk/store/types"
+)
+
+// keyRange is the domain [start, end) of an iterator. A nil start or end
+// leaves the domain unbounded on that side.
+type keyRange struct {
+	start, end []byte
+}
+
+func (r keyRange) contains(key []byte) bool {
+	return IsKeyInDomain(key, r.start, r.end)
+}
+
+// AccessSet is a set of keys and key ranges accessed on a store.
+type AccessSet struct {
+	mtx    sync.Mutex
+	keys   map[string]struct{}
+	ranges []keyRange
+}
+
+// NewAccessSet returns an empty AccessSet.
+func NewAccessSet() *AccessSet {
+	return &AccessSet{keys: make(map[string]struct{})}
+}
+
+// AddKey adds a single key to the set.
+func (s *AccessSet) AddKey(key []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.keys[string(key)] = struct{}{}
+}
+
+// AddRange adds the domain of an iterator to the set.
+func (s *AccessSet) AddRange(start, end []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.ranges = append(s.ranges, keyRange{start: cp(start), end: cp(end)})
+}
+
+// Merge adds all the keys and ranges of other to the set.
+func (s *AccessSet) Merge(other *AccessSet) {
+	if other == nil || other == s {
+		return
+	}
+
+	other.mtx.Lock()
+	defer other.mtx.Unlock()
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for key := range other.keys {
+		s.keys[key] = struct{}{}
+	}
+	s.ranges = append(s.ranges, other.ranges...)
+}
+
+// Keys returns the keys of the set in ascending order.
+func (s *AccessSet) Keys() [][]byte {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	keys := make([][]byte, 0, len(s.keys))
+	for key := range s.keys {
+		keys = append(keys, []byte(key))
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+
+	return keys
+}
+
+// Overlaps returns true if any key of written is a key of the set or falls in
+// one of its ranges. Ranges of written are ignored.
+func (s *AccessSet) Overlaps(written *AccessSet) bool {
+	if written == nil || s == nil {
+		return false
+	}
+
+	keys := written.Keys()
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for _, key := range keys {
+		if _, ok := s.keys[string(key)]; ok {
+			return true
+		}
+	}
+
+	for _, r := range s.ranges {
+		// keys are sorted, so only the first key not below start needs a check
+		i := sort.Search(len(keys), func(i int) bool {
+			return r.start == nil || bytes.Compare(keys[i], r.start) >= 0
+		})
+		if i < len(keys) && r.contains(keys[i]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewTrackingStore creates a new Store that records the keys and ranges it
+// reads from its parent and the keys it writes to it.
+func NewTrackingStore(parent types.KVStore) *Store {
+	store := NewStore(parent)
+	store.readSet = NewAccessSet()
+	store.writeSet = NewAccessSet()
+	return store
+}
+
+// ReadSet returns the keys and ranges read from the parent store so far, or nil
+// if the store doesn't track its accesses.
+func (store *Store) ReadSet() *AccessSet {
+	return store.readSet
+}
+
+// WriteSet returns the keys written to the parent store so far, together with
+// the keys pending to be written by the next Write, or nil if the store doesn't
+// track its accesses.
+func (store *Store) WriteSet() *AccessSet {
+	if store.writeSet == nil {
+		return nil
+	}
+
+	writeSet := NewAccessSet()
+	writeSet.Merge(store.writeSet)
+	store.cache.Range(func(key, value interface{}) bool {
+		if value.(*cValue).dirty {
+			writeSet.AddKey([]byte(key.(string)))
+		}
+		return true
+	})
+
+	return writeSet
+}
+
+func cp(bz []byte) []byte {
+	if bz == nil {
+		return nil
+	}
+	ret := make([]byte, len(bz))
+	copy(ret, bz)
+	return ret
+}
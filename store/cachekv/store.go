@@ -32,6 +32,10 @@ type Store struct {
 	unsortedCache sync.Map
 	sortedCache   *list.List // always ascending sorted
 	parent        types.KVStore
+
+	// accesses to the parent, only recorded by stores created by NewTrackingStore
+	readSet  *AccessSet
+	writeSet *AccessSet
 }
 
 var _ types.CacheKVStore = (*Store)(nil)
@@ -65,6 +69,9 @@ func (store *Store) Get(key []byte) []byte {
 
 	value := store.parent.Get(key)
 	store.setCacheValue(key, value, false, false)
+	if store.readSet != nil {
+		store.readSet.AddKey(key)
+	}
 	return value
 }
 
@@ -119,6 +126,9 @@ func (store *Store) Write() {
 	for _, key := range keys {
 		v, _ := store.cache.Load(key)
 		cacheValue := v.(*cValue)
+		if store.writeSet != nil {
+			store.writeSet.AddKey([]byte(key))
+		}
 
 		switch {
 		case cacheValue.deleted:
@@ -165,6 +175,10 @@ func (store *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 
 	var parent, cache types.Iterator
 
+	if store.readSet != nil {
+		store.readSet.AddRange(start, end)
+	}
+
 	if ascending {
 		parent = store.parent.Iterator(start, end)
 	} else {
@@ -572,3 +572,44 @@ func BenchmarkCacheKVStoreGetKeyFound(b *testing.B) {
 		st.Get([]byte{byte((i & 0xFF0000) >> 16), byte((i & 0xFF00) >> 8), byte(i & 0xFF)})
 	}
 }
+
+func TestTrackingStore(t *testing.T) {
+	mem := dbadapter.Store{DB: memdb.NewDB()}
+	mem.Set(keyFmt(1), valFmt(1))
+	mem.Set(keyFmt(5), valFmt(5))
+
+	st := cachekv.NewTrackingStore(mem)
+	require.Equal(t, valFmt(1), st.Get(keyFmt(1)))
+	st.Set(keyFmt(2), valFmt(2))
+	st.Delete(keyFmt(3))
+	require.Equal(t, valFmt(2), st.Get(keyFmt(2)))
+
+	it := st.Iterator(keyFmt(4), keyFmt(6))
+	it.Close()
+
+	readSet := st.ReadSet()
+	require.Equal(t, [][]byte{keyFmt(1)}, readSet.Keys())
+
+	writeSet := st.WriteSet()
+	require.Equal(t, [][]byte{keyFmt(2), keyFmt(3)}, writeSet.Keys())
+
+	// pending writes are kept after being written to the parent
+	st.Write()
+	require.Equal(t, [][]byte{keyFmt(2), keyFmt(3)}, st.WriteSet().Keys())
+
+	overlaps := func(keys ...int) bool {
+		written := cachekv.NewAccessSet()
+		for _, k := range keys {
+			written.AddKey(keyFmt(k))
+		}
+		return readSet.Overlaps(written)
+	}
+	require.True(t, overlaps(1))
+	require.False(t, overlaps(2, 3))
+	require.True(t, overlaps(2, 5))
+	require.False(t, overlaps(6))
+
+	// a store created by NewStore doesn't track anything
+	require.Nil(t, cachekv.NewStore(mem).ReadSet())
+	require.Nil(t, cachekv.NewStore(mem).WriteSet())
+}
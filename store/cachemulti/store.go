@@ -3,11 +3,14 @@ package cachemulti
 import (
 	"fmt"
 	"io"
+	"sort"
 
 	tmdb "github.com/line/tm-db/v2"
 
 	"github.com/line/lbm-sdk/store/cachekv"
 	"github.com/line/lbm-sdk/store/dbadapter"
+	"github.com/line/lbm-sdk/store/listenkv"
+	"github.com/line/lbm-sdk/store/tracekv"
 	"github.com/line/lbm-sdk/store/types"
 )
 
@@ -25,6 +28,8 @@ type Store struct {
 
 	traceWriter  io.Writer
 	traceContext types.TraceContext
+
+	listeners map[types.StoreKey][]types.WriteListener
 }
 
 var _ types.CacheMultiStore = Store{}
@@ -42,6 +47,7 @@ func NewFromKVStore(
 		keys:         keys,
 		traceWriter:  traceWriter,
 		traceContext: traceContext,
+		listeners:    make(map[types.StoreKey][]types.WriteListener),
 	}
 
 	for key, store := range stores {
@@ -69,6 +75,9 @@ func newCacheMultiStoreFromCMS(cms Store) Store {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range cms.stores {
 		stores[k] = v
+		if cms.ListeningEnabled(k) {
+			stores[k] = listenkv.NewStore(v.(types.KVStore), k, cms.listeners[k])
+		}
 	}
 
 	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext)
@@ -102,16 +111,35 @@ func (cms Store) TracingEnabled() bool {
 	return cms.traceWriter != nil
 }
 
+// ListeningEnabled returns if listening is enabled for a specific KVStore.
+func (cms Store) ListeningEnabled(key types.StoreKey) bool {
+	return len(cms.listeners[key]) > 0
+}
+
+// AddListeners adds listeners for a specific KVStore. The listeners are not
+// passed on to the branches of the MultiStore, instead the writes of a branch
+// are passed to them when the branch is written.
+func (cms Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	cms.listeners[key] = append(cms.listeners[key], listeners...)
+}
+
 // GetStoreType returns the type of the store.
 func (cms Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
 }
 
-// Write calls Write on each underlying store.
+// Write calls Write on each underlying store. The stores are written in the
+// order of their key names so that listeners observe the writes in a
+// deterministic order.
 func (cms Store) Write() {
 	cms.db.Write()
-	for _, store := range cms.stores {
-		store.Write()
+	keys := make([]types.StoreKey, 0, len(cms.stores))
+	for key := range cms.stores {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name() < keys[j].Name() })
+	for _, key := range keys {
+		cms.stores[key].Write()
 	}
 }
 
@@ -150,5 +178,58 @@ func (cms Store) GetKVStore(key types.StoreKey) types.KVStore {
 	if store == nil {
 		panic(fmt.Sprintf("kv store with key %v has not been registered in stores", key))
 	}
+	if cms.ListeningEnabled(key) {
+		return listenkv.NewStore(store.(types.KVStore), key, cms.listeners[key])
+	}
 	return store.(types.KVStore)
 }
+
+// CacheMultiStoreWithTracking branches the MultiStore like CacheMultiStore, but
+// the branched stores record the keys they read from and write to their parents.
+// The accesses are reported by ReadSets and WriteSets.
+func (cms Store) CacheMultiStoreWithTracking() Store {
+	tracked := Store{
+		db:           cachekv.NewStore(cms.db),
+		stores:       make(map[types.StoreKey]types.CacheWrap, len(cms.stores)),
+		traceWriter:  cms.traceWriter,
+		traceContext: cms.traceContext,
+		listeners:    make(map[types.StoreKey][]types.WriteListener),
+	}
+
+	for key, store := range cms.stores {
+		var parent types.KVStore = store.(types.KVStore)
+		if cms.ListeningEnabled(key) {
+			parent = listenkv.NewStore(parent, key, cms.listeners[key])
+		}
+		if cms.TracingEnabled() {
+			parent = tracekv.NewStore(parent, cms.traceWriter, cms.traceContext)
+		}
+		tracked.stores[key] = cachekv.NewTrackingStore(parent)
+	}
+
+	return tracked
+}
+
+// ReadSets returns the keys and ranges read by each tracking store, see
+// CacheMultiStoreWithTracking.
+func (cms Store) ReadSets() map[types.StoreKey]*cachekv.AccessSet {
+	sets := make(map[types.StoreKey]*cachekv.AccessSet)
+	for key, store := range cms.stores {
+		if tracking, ok := store.(*cachekv.Store); ok && tracking.ReadSet() != nil {
+			sets[key] = tracking.ReadSet()
+		}
+	}
+	return sets
+}
+
+// WriteSets returns the keys written or pending to be written by each tracking
+// store, see CacheMultiStoreWithTracking.
+func (cms Store) WriteSets() map[types.StoreKey]*cachekv.AccessSet {
+	sets := make(map[types.StoreKey]*cachekv.AccessSet)
+	for key, store := range cms.stores {
+		if tracking, ok := store.(*cachekv.Store); ok && tracking.WriteSet() != nil {
+			sets[key] = tracking.WriteSet()
+		}
+	}
+	return sets
+}
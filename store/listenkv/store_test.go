@@ -0,0 +1,91 @@
+package listenkv_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/tm-db/v2/memdb"
+
+	"github.com/line/lbm-sdk/store/dbadapter"
+	"github.com/line/lbm-sdk/store/listenkv"
+	"github.com/line/lbm-sdk/store/types"
+)
+
+var testStoreKey = types.NewKVStoreKey("listen_test")
+
+type recordingListener struct {
+	pairs []types.StoreKVPair
+	err   error
+}
+
+func (l *recordingListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	l.pairs = append(l.pairs, types.StoreKVPair{StoreKey: storeKey.Name(), Key: key, Value: value, Delete: delete})
+	return l.err
+}
+
+func newListenKVStore(listener types.WriteListener) (*listenkv.Store, types.KVStore) {
+	parent := dbadapter.Store{DB: memdb.NewDB()}
+	return listenkv.NewStore(parent, testStoreKey, []types.WriteListener{listener}), parent
+}
+
+func TestListenKVStoreSetDelete(t *testing.T) {
+	listener := &recordingListener{}
+	store, parent := newListenKVStore(listener)
+
+	store.Set([]byte("key1"), []byte("value1"))
+	store.Set([]byte("key2"), []byte("value2"))
+	store.Delete([]byte("key1"))
+
+	require.Nil(t, parent.Get([]byte("key1")))
+	require.Equal(t, []byte("value2"), parent.Get([]byte("key2")))
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "listen_test", Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: "listen_test", Key: []byte("key2"), Value: []byte("value2")},
+		{StoreKey: "listen_test", Key: []byte("key1"), Delete: true},
+	}, listener.pairs)
+}
+
+func TestListenKVStoreReads(t *testing.T) {
+	listener := &recordingListener{}
+	store, parent := newListenKVStore(listener)
+	parent.Set([]byte("key1"), []byte("value1"))
+
+	require.Equal(t, []byte("value1"), store.Get([]byte("key1")))
+	require.True(t, store.Has([]byte("key1")))
+	iter := store.Iterator(nil, nil)
+	require.True(t, iter.Valid())
+	require.NoError(t, iter.Close())
+	iter = store.ReverseIterator(nil, nil)
+	require.True(t, iter.Valid())
+	require.NoError(t, iter.Close())
+	require.Empty(t, listener.pairs)
+}
+
+func TestListenKVStoreCacheWrap(t *testing.T) {
+	listener := &recordingListener{}
+	store, parent := newListenKVStore(listener)
+
+	cache := store.CacheWrap().(types.CacheKVStore)
+	cache.Set([]byte("key2"), []byte("value2"))
+	cache.Set([]byte("key1"), []byte("value1"))
+	require.Empty(t, listener.pairs)
+
+	cache.Write()
+	require.Equal(t, []byte("value1"), parent.Get([]byte("key1")))
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "listen_test", Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: "listen_test", Key: []byte("key2"), Value: []byte("value2")},
+	}, listener.pairs)
+}
+
+func TestListenKVStoreListenerError(t *testing.T) {
+	store, _ := newListenKVStore(&recordingListener{err: errors.New("closed")})
+	require.Panics(t, func() { store.Set([]byte("key1"), []byte("value1")) })
+}
+
+func TestListenKVStoreGetStoreType(t *testing.T) {
+	store, parent := newListenKVStore(&recordingListener{})
+	require.Equal(t, parent.GetStoreType(), store.GetStoreType())
+}
@@ -0,0 +1,339 @@
+package rootmulti
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"sort"
+	"sync"
+
+	protoio "github.com/gogo/protobuf/io"
+	iavltree "github.com/line/iavl/v2"
+
+	"github.com/line/lbm-sdk/snapshots"
+	"github.com/line/lbm-sdk/store/iavl"
+	"github.com/line/lbm-sdk/store/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// Format 2 snapshots serialize every store into its own stream of delimited SnapshotItem
+// Protobuf messages containing SnapshotIAVLItems, compressed with zlib. The compressed stream of
+// a store is split into chunks of snapshotChunkSize, each prefixed by a delimited
+// SnapshotChunkHeader naming the store and the position of the chunk in the stream of the store.
+//
+// The stores are exported in parallel, and their chunks are interleaved round-robin in the order
+// of the store names, such that the output is deterministic while every store makes progress.
+// On restore, the chunks of every store are passed to an importer of their own, so stores are
+// imported concurrently as well.
+//
+// The parallelism is per store: a store is never split by key range, since IAVL exports and
+// imports a tree as a single stream of nodes in post-order. The largest store therefore bounds
+// the time to generate or restore a snapshot, and once the smaller stores are done, the chunks
+// of the largest one are generated and imported one after another as with format 1.
+
+// snapshotV2ChunkBuffer is the number of chunks read ahead per store while generating or
+// restoring a format 2 snapshot. It bounds the memory held by a snapshot in progress to
+// snapshotV2ChunkBuffer chunks of snapshotChunkSize per store, but also the progress a store
+// can make ahead of the others: as the chunks are interleaved round-robin, a store whose
+// buffer is full waits until the chunks of the other stores are consumed.
+const snapshotV2ChunkBuffer = 4
+
+// errSnapshotAborted is returned to store exporters when the snapshot is no longer consumed.
+var errSnapshotAborted = sdkerrors.Wrap(sdkerrors.ErrLogic, "snapshot aborted")
+
+// storeChunk is a chunk of the compressed stream of a store, or an error while generating it.
+type storeChunk struct {
+	data []byte
+	err  error
+}
+
+// storeChunkWriter splits the compressed stream of a store into chunks of snapshotChunkSize and
+// passes them through a channel.
+type storeChunkWriter struct {
+	ch    chan<- storeChunk
+	done  <-chan struct{}
+	chunk []byte
+}
+
+// Write implements io.Writer.
+func (w *storeChunkWriter) Write(data []byte) (int, error) {
+	nTotal := 0
+	for len(data) > 0 {
+		size := int(snapshotChunkSize) - len(w.chunk)
+		if size > len(data) {
+			size = len(data)
+		}
+		w.chunk = append(w.chunk, data[:size]...)
+		nTotal += size
+		data = data[size:]
+		if len(w.chunk) >= int(snapshotChunkSize) {
+			if err := w.Flush(); err != nil {
+				return nTotal, err
+			}
+		}
+	}
+	return nTotal, nil
+}
+
+// Flush passes the pending data as a chunk, if any.
+func (w *storeChunkWriter) Flush() error {
+	if len(w.chunk) == 0 {
+		return nil
+	}
+	select {
+	case w.ch <- storeChunk{data: w.chunk}:
+	case <-w.done:
+		return errSnapshotAborted
+	}
+	w.chunk = nil
+	return nil
+}
+
+// snapshotV2 generates the chunks of a format 2 snapshot.
+func snapshotV2(height uint64, stores []namedStore) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser)
+	done := make(chan struct{})
+	storeChs := make([]chan storeChunk, len(stores))
+	for i, store := range stores {
+		storeChs[i] = make(chan storeChunk, snapshotV2ChunkBuffer)
+		go exportStoreV2(height, store, storeChs[i], done)
+	}
+
+	go func() {
+		defer close(ch)
+		defer close(done)
+
+		indexes := make([]uint32, len(stores))
+		for active := len(stores); active > 0; {
+			for i, store := range stores {
+				if storeChs[i] == nil {
+					continue
+				}
+				chunk, ok := <-storeChs[i]
+				if !ok {
+					storeChs[i] = nil
+					active--
+					continue
+				}
+				if chunk.err != nil {
+					pr, pw := io.Pipe()
+					pw.CloseWithError(chunk.err)
+					ch <- pr
+					return
+				}
+				header, err := encodeChunkHeader(store.name, indexes[i])
+				if err != nil {
+					pr, pw := io.Pipe()
+					pw.CloseWithError(err)
+					ch <- pr
+					return
+				}
+				ch <- ioutil.NopCloser(io.MultiReader(bytes.NewReader(header), bytes.NewReader(chunk.data)))
+				indexes[i]++
+			}
+		}
+	}()
+
+	return ch
+}
+
+// exportStoreV2 serializes a store and passes its compressed stream in chunks through a channel,
+// which is closed once the store is exported. An error is passed as the last chunk.
+func exportStoreV2(height uint64, store namedStore, ch chan<- storeChunk, done <-chan struct{}) {
+	defer close(ch)
+
+	err := func() error {
+		// Set up a stream pipeline to serialize snapshot nodes:
+		// ExportNode -> delimited Protobuf -> zlib -> chunkWriter -> chan storeChunk
+		chunkWriter := &storeChunkWriter{ch: ch, done: done}
+		zWriter, err := zlib.NewWriterLevel(chunkWriter, 7)
+		if err != nil {
+			return sdkerrors.Wrap(err, "zlib failure")
+		}
+		protoWriter := protoio.NewDelimitedWriter(zWriter)
+
+		exporter, err := store.Export(int64(height))
+		if err != nil {
+			return err
+		}
+		defer exporter.Close()
+		for {
+			node, err := exporter.Next()
+			if err == iavltree.ExportDone {
+				break
+			} else if err != nil {
+				return err
+			}
+			err = protoWriter.WriteMsg(&types.SnapshotItem{
+				Item: &types.SnapshotItem_IAVL{
+					IAVL: &types.SnapshotIAVLItem{
+						Key:     node.Key,
+						Value:   node.Value,
+						Height:  int32(node.Height),
+						Version: node.Version,
+					},
+				},
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := zWriter.Close(); err != nil {
+			return err
+		}
+		return chunkWriter.Flush()
+	}()
+
+	if err != nil && err != errSnapshotAborted {
+		select {
+		case ch <- storeChunk{err: err}:
+		case <-done:
+		}
+	}
+}
+
+// encodeChunkHeader returns the delimited SnapshotChunkHeader of a format 2 chunk.
+func encodeChunkHeader(store string, index uint32) ([]byte, error) {
+	var buf bytes.Buffer
+	err := protoio.NewDelimitedWriter(&buf).WriteMsg(&types.SnapshotChunkHeader{
+		Store: store,
+		Index: index,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeChunkV2 reads a format 2 chunk, returning its header and the compressed data following it.
+func decodeChunkV2(chunk io.ReadCloser) (*types.SnapshotChunkHeader, []byte, error) {
+	defer chunk.Close()
+	bz, err := ioutil.ReadAll(chunk)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	size, n := binary.Uvarint(bz)
+	if n <= 0 || size > uint64(len(bz)-n) {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "invalid snapshot chunk header")
+	}
+	header := &types.SnapshotChunkHeader{}
+	if err := header.Unmarshal(bz[n : n+int(size)]); err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "invalid snapshot chunk header")
+	}
+	return header, bz[n+int(size):], nil
+}
+
+// storeImport is the import of a store from a format 2 snapshot in progress.
+type storeImport struct {
+	chunks    chan io.ReadCloser
+	nextIndex uint32
+	err       error
+}
+
+// restoreV2 imports the stores of a format 2 snapshot concurrently, dispatching every chunk to the
+// importer of the store named by its header.
+func (rs *Store) restoreV2(height uint64, chunks <-chan io.ReadCloser) (err error) {
+	defer snapshots.DrainChunks(chunks)
+
+	imports := make(map[string]*storeImport)
+	var wg sync.WaitGroup
+	defer func() {
+		for _, imp := range imports {
+			close(imp.chunks)
+		}
+		wg.Wait()
+
+		// Report the error of the first store by name for a deterministic result
+		names := make([]string, 0, len(imports))
+		for name := range imports {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if imports[name].err != nil && err == nil {
+				err = sdkerrors.Wrapf(imports[name].err, "failed to import store %q", name)
+			}
+		}
+	}()
+
+	for chunk := range chunks {
+		header, data, err := decodeChunkV2(chunk)
+		if err != nil {
+			return err
+		}
+
+		imp, ok := imports[header.Store]
+		if !ok {
+			store, ok := rs.getStoreByName(header.Store).(*iavl.Store)
+			if !ok || store == nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-IAVL store %q", header.Store)
+			}
+			imp = &storeImport{chunks: make(chan io.ReadCloser, snapshotV2ChunkBuffer)}
+			imports[header.Store] = imp
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				imp.err = importStoreV2(height, store, imp.chunks)
+			}()
+		}
+		if header.Index != imp.nextIndex {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "expected chunk %v of store %q, got %v",
+				imp.nextIndex, header.Store, header.Index)
+		}
+		imp.chunks <- ioutil.NopCloser(bytes.NewReader(data))
+		imp.nextIndex++
+	}
+	return nil
+}
+
+// importStoreV2 imports the compressed stream of a store passed in chunks through a channel. The
+// channel is drained even if the import fails.
+func importStoreV2(height uint64, store *iavl.Store, chunks <-chan io.ReadCloser) error {
+	// Set up a restore stream pipeline
+	// chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> ExportNode
+	chunkReader := snapshots.NewChunkReader(chunks)
+	defer chunkReader.Close()
+	zReader, err := zlib.NewReader(chunkReader)
+	if err != nil {
+		return sdkerrors.Wrap(err, "zlib failure")
+	}
+	defer zReader.Close()
+	protoReader := protoio.NewDelimitedReader(zReader, snapshotMaxItemSize)
+	defer protoReader.Close()
+
+	importer, err := store.Import(int64(height))
+	if err != nil {
+		return sdkerrors.Wrap(err, "import failed")
+	}
+	defer importer.Close()
+
+	for {
+		item := &types.SnapshotItem{}
+		err := protoReader.ReadMsg(item)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+
+		switch item := item.Item.(type) {
+		case *types.SnapshotItem_IAVL:
+			if err := importNode(importer, item.IAVL); err != nil {
+				return err
+			}
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown snapshot item %T", item)
+		}
+	}
+
+	err = importer.Commit()
+	if err != nil {
+		return sdkerrors.Wrap(err, "IAVL commit failed")
+	}
+	return nil
+}
@@ -24,8 +24,10 @@ import (
 	"github.com/line/lbm-sdk/store/cachemulti"
 	"github.com/line/lbm-sdk/store/dbadapter"
 	"github.com/line/lbm-sdk/store/iavl"
+	"github.com/line/lbm-sdk/store/listenkv"
 	"github.com/line/lbm-sdk/store/mem"
 	"github.com/line/lbm-sdk/store/tracekv"
+	"github.com/line/lbm-sdk/store/transient"
 	"github.com/line/lbm-sdk/store/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 )
@@ -60,6 +62,8 @@ type Store struct {
 
 	interBlockCache  types.MultiStorePersistentCache
 	iavlCacheManager types.CacheManager
+
+	listeners map[types.StoreKey][]types.WriteListener
 }
 
 var (
@@ -79,6 +83,7 @@ func NewStore(db tmdb.DB) *Store {
 		stores:       make(map[types.StoreKey]types.CommitKVStore),
 		keysByName:   make(map[string]types.StoreKey),
 		pruneHeights: make([]int64, 0),
+		listeners:    make(map[types.StoreKey][]types.WriteListener),
 	}
 }
 
@@ -333,6 +338,18 @@ func (rs *Store) TracingEnabled() bool {
 	return rs.traceWriter != nil
 }
 
+// ListeningEnabled returns if listening is enabled for a specific KVStore.
+func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
+	return len(rs.listeners[key]) > 0
+}
+
+// AddListeners adds listeners for a specific KVStore. The writes of the
+// branches created with CacheMultiStore are passed to the listeners when the
+// branches are written.
+func (rs *Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	rs.listeners[key] = append(rs.listeners[key], listeners...)
+}
+
 // LastCommitID implements Committer/CommitStore.
 func (rs *Store) LastCommitID() types.CommitID {
 	if rs.lastCommitInfo == nil {
@@ -431,6 +448,9 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range rs.stores {
 		stores[k] = v
+		if rs.ListeningEnabled(k) {
+			stores[k] = listenkv.NewStore(v, k, rs.listeners[k])
+		}
 	}
 
 	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext)
@@ -493,6 +513,9 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	if rs.TracingEnabled() {
 		store = tracekv.NewStore(store, rs.traceWriter, rs.traceContext)
 	}
+	if rs.ListeningEnabled(key) {
+		store = listenkv.NewStore(store, key, rs.listeners[key])
+	}
 
 	return store
 }
@@ -602,12 +625,18 @@ func parsePath(path string) (storeName string, subpath string, err error) {
 
 //---------------------- Snapshotting ------------------
 
+// namedStore is an IAVL store to be snapshotted along with its name.
+type namedStore struct {
+	*iavl.Store
+	name string
+}
+
 // Snapshot implements snapshottypes.Snapshotter. The snapshot output for a given format must be
 // identical across nodes such that chunks from different sources fit together. If the output for a
 // given format changes (at the byte level), the snapshot format must be bumped - see
 // TestMultistoreSnapshot_Checksum test.
 func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
-	if format != snapshottypes.CurrentFormat {
+	if !snapshottypes.IsSupportedFormat(format) {
 		return nil, sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
 	}
 	if height == 0 {
@@ -618,16 +647,12 @@ func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, e
 	}
 
 	// Collect stores to snapshot (only IAVL stores are supported)
-	type namedStore struct {
-		*iavl.Store
-		name string
-	}
 	stores := []namedStore{}
 	for key := range rs.stores {
 		switch store := rs.GetCommitKVStore(key).(type) {
 		case *iavl.Store:
 			stores = append(stores, namedStore{name: key.Name(), Store: store})
-		case *mem.Store:
+		case *mem.Store, *transient.Store:
 			// Non-persisted stores shouldn't be snapshotted
 			continue
 		default:
@@ -639,6 +664,15 @@ func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, e
 		return strings.Compare(stores[i].name, stores[j].name) == -1
 	})
 
+	if format == snapshottypes.FormatV1 {
+		return snapshotV1(height, stores), nil
+	}
+	return snapshotV2(height, stores), nil
+}
+
+// snapshotV1 generates the chunks of a format 1 snapshot. All stores are serialized into a single
+// compressed stream, which is split into chunks of snapshotChunkSize.
+func snapshotV1(height uint64, stores []namedStore) <-chan io.ReadCloser {
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
 	go func() {
@@ -719,14 +753,14 @@ func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, e
 		}
 	}()
 
-	return ch, nil
+	return ch
 }
 
 // Restore implements snapshottypes.Snapshotter.
 func (rs *Store) Restore(
 	height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{},
 ) error {
-	if format != snapshottypes.CurrentFormat {
+	if !snapshottypes.IsSupportedFormat(format) {
 		return sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
 	}
 	if height == 0 {
@@ -743,6 +777,22 @@ func (rs *Store) Restore(
 		close(ready)
 	}
 
+	var err error
+	if format == snapshottypes.FormatV1 {
+		err = rs.restoreV1(height, chunks)
+	} else {
+		err = rs.restoreV2(height, chunks)
+	}
+	if err != nil {
+		return err
+	}
+
+	flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)), []int64{})
+	return rs.LoadLatestVersion()
+}
+
+// restoreV1 imports the stores of a format 1 snapshot one after another.
+func (rs *Store) restoreV1(height uint64, chunks <-chan io.ReadCloser) error {
 	// Set up a restore stream pipeline
 	// chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> ExportNode
 	chunkReader := snapshots.NewChunkReader(chunks)
@@ -791,27 +841,8 @@ func (rs *Store) Restore(
 			if importer == nil {
 				return sdkerrors.Wrap(sdkerrors.ErrLogic, "received IAVL node item before store item")
 			}
-			if item.IAVL.Height > math.MaxInt8 {
-				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
-			}
-			node := &iavltree.ExportNode{
-				Key:     item.IAVL.Key,
-				Value:   item.IAVL.Value,
-				Height:  int8(item.IAVL.Height),
-				Version: item.IAVL.Version,
-			}
-			// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
-			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
-			if node.Key == nil {
-				node.Key = []byte{}
-			}
-			if node.Height == 0 && node.Value == nil {
-				node.Value = []byte{}
-			}
-			err := importer.Add(node)
-			if err != nil {
-				return sdkerrors.Wrap(err, "IAVL node import failed")
+			if err := importNode(importer, item.IAVL); err != nil {
+				return err
 			}
 
 		default:
@@ -826,9 +857,34 @@ func (rs *Store) Restore(
 		}
 		importer.Close()
 	}
+	return nil
+}
 
-	flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)), []int64{})
-	return rs.LoadLatestVersion()
+// importNode adds an exported IAVL node of a snapshot to an importer.
+func importNode(importer *iavltree.Importer, item *types.SnapshotIAVLItem) error {
+	if item.Height > math.MaxInt8 {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
+			item.Height, math.MaxInt8)
+	}
+	node := &iavltree.ExportNode{
+		Key:     item.Key,
+		Value:   item.Value,
+		Height:  int8(item.Height),
+		Version: item.Version,
+	}
+	// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
+	// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+	if node.Key == nil {
+		node.Key = []byte{}
+	}
+	if node.Height == 0 && node.Value == nil {
+		node.Value = []byte{}
+	}
+	err := importer.Add(node)
+	if err != nil {
+		return sdkerrors.Wrap(err, "IAVL node import failed")
+	}
+	return nil
 }
 
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
@@ -879,6 +935,13 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 
 		return mem.NewStore(), nil
 
+	case types.StoreTypeTransient:
+		if _, ok := key.(*types.TransientStoreKey); !ok {
+			return nil, fmt.Errorf("unexpected key type for a TransientStoreKey; got: %s", key.String())
+		}
+
+		return transient.NewStore(), nil
+
 	default:
 		panic(fmt.Sprintf("unrecognized store type %v", params.typ))
 	}
@@ -887,6 +950,9 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 func (rs *Store) buildCommitInfo(version int64) *types.CommitInfo {
 	storeInfos := []types.StoreInfo{}
 	for key, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeTransient {
+			continue
+		}
 		storeInfos = append(storeInfos, types.StoreInfo{
 			Name:     key.Name(),
 			CommitId: store.LastCommitID(),
@@ -929,6 +995,12 @@ func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore
 	var wg sync.WaitGroup
 	ix := 0
 	for key, store := range storeMap {
+		if store.GetStoreType() == types.StoreTypeTransient {
+			// transient stores are cleared on commit and not part of the app hash
+			store.Commit()
+			continue
+		}
+
 		wg.Add(1)
 		go func(i int, k types.StoreKey, s types.CommitKVStore) {
 			commitID := s.Commit()
@@ -945,7 +1017,7 @@ func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore
 
 	return &types.CommitInfo{
 		Version:    version,
-		StoreInfos: storeInfos,
+		StoreInfos: storeInfos[:ix],
 	}
 }
 
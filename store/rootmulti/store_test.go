@@ -1,6 +1,7 @@
 package rootmulti
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
@@ -19,6 +20,7 @@ import (
 	"github.com/line/tm-db/v2/memdb"
 
 	snapshottypes "github.com/line/lbm-sdk/snapshots/types"
+	"github.com/line/lbm-sdk/store/cachemulti"
 	"github.com/line/lbm-sdk/store/iavl"
 	sdkmaps "github.com/line/lbm-sdk/store/internal/maps"
 	"github.com/line/lbm-sdk/store/types"
@@ -561,6 +563,18 @@ func TestMultistoreSnapshot_Checksum(t *testing.T) {
 			"a4a864e6c02c9fca5837ec80dc84f650b25276ed7e4820cf7516ced9f9901b86",
 			"ca2879ac6e7205d257440131ba7e72bef784cd61642e32b847729e543c1928b9",
 		}},
+		{2, []string{
+			"6289c407399d98d9d5c9f55bbe915530adab6d58ce0038fb478e0cd9fdf4ca1a",
+			"f0f9d406e404774e5daecb19ab48c5cef89df815099f0e51d07c0fd51bbcdde1",
+			"7b4045dfde567b41dc502754187a4e570a5578488d4a88f67780dbac0cc0e63e",
+			"c7cda95253955283862cc29491a18aec9f80254d18ac6059ba127b467c453c88",
+			"9459415e67644c278ea20eb2d7b82ae29e5dac4a5e19477f1233585c3494ecc4",
+			"1b70fe822fa5ac2b0ace44428a7182dc79f7b10ad20d302131c856e382132e2f",
+			"198a593def7476ae91553ca57f3e1e2266ceaaba26bcac33b6e140b345a75ed4",
+			"16d2ed47d4b39c1b1b42307b0a99307f87c8cc4580a6e7661eb54cc9248c59ec",
+			"377827fba5b8cef2b30931f9bcac282a08953fb7355a3ed8bbd91ae47f5425b3",
+			"0430d9f9ac8f48481a768b00b9a87958e11b8be52275c0e74d01a41bd84a33b1",
+		}},
 	}
 	for _, tc := range testcases {
 		tc := tc
@@ -631,23 +645,83 @@ func TestMultistoreRestore_Errors(t *testing.T) {
 }
 
 func TestMultistoreSnapshotRestore(t *testing.T) {
-	source := newMultiStoreWithMixedMountsAndBasicData(memdb.NewDB())
-	target := newMultiStoreWithMixedMounts(memdb.NewDB())
+	for _, format := range []uint32{snapshottypes.FormatV1, snapshottypes.FormatV2} {
+		format := format
+		t.Run(fmt.Sprintf("Format %v", format), func(t *testing.T) {
+			source := newMultiStoreWithMixedMountsAndBasicData(memdb.NewDB())
+			target := newMultiStoreWithMixedMounts(memdb.NewDB())
+			version := uint64(source.LastCommitID().Version)
+			require.EqualValues(t, 3, version)
+
+			chunks, err := source.Snapshot(version, format)
+			require.NoError(t, err)
+			ready := make(chan struct{})
+			err = target.Restore(version, format, chunks, ready)
+			require.NoError(t, err)
+			assert.EqualValues(t, struct{}{}, <-ready)
+
+			assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+			for key, sourceStore := range source.stores {
+				targetStore := target.getStoreByName(key.Name()).(types.CommitKVStore)
+				assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+			}
+		})
+	}
+}
+
+func TestMultistoreSnapshotV2_Chunks(t *testing.T) {
+	source := newMultiStoreWithGeneratedData(memdb.NewDB(), 2, 11000)
 	version := uint64(source.LastCommitID().Version)
-	require.EqualValues(t, 3, version)
 
-	chunks, err := source.Snapshot(version, snapshottypes.CurrentFormat)
-	require.NoError(t, err)
-	ready := make(chan struct{})
-	err = target.Restore(version, snapshottypes.CurrentFormat, chunks, ready)
+	chunks, err := source.Snapshot(version, snapshottypes.FormatV2)
 	require.NoError(t, err)
-	assert.EqualValues(t, struct{}{}, <-ready)
+	collected := [][]byte{}
+	headers := []types.SnapshotChunkHeader{}
+	for chunk := range chunks {
+		bz, err := ioutil.ReadAll(chunk)
+		require.NoError(t, err)
+		collected = append(collected, bz)
+		header, _, err := decodeChunkV2(ioutil.NopCloser(bytes.NewReader(bz)))
+		require.NoError(t, err)
+		headers = append(headers, *header)
+	}
 
-	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
-	for key, sourceStore := range source.stores {
-		targetStore := target.getStoreByName(key.Name()).(types.CommitKVStore)
-		assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+	// every store is compressed into its own stream, and the chunks are interleaved
+	assert.Equal(t, []types.SnapshotChunkHeader{
+		{Store: "store0", Index: 0}, {Store: "store1", Index: 0},
+		{Store: "store0", Index: 1}, {Store: "store1", Index: 1},
+	}, headers)
+
+	newChunks := func(order []int) <-chan io.ReadCloser {
+		ch := make(chan io.ReadCloser, len(order))
+		for _, i := range order {
+			ch <- ioutil.NopCloser(bytes.NewReader(collected[i]))
+		}
+		close(ch)
+		return ch
 	}
+	newTarget := func() *Store {
+		target := NewStore(memdb.NewDB())
+		for key := range source.stores {
+			target.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+		}
+		require.NoError(t, target.LoadLatestVersion())
+		return target
+	}
+
+	// chunks are self-describing, so the stores may be ordered arbitrarily
+	target := newTarget()
+	err = target.Restore(version, snapshottypes.FormatV2, newChunks([]int{1, 3, 0, 2}), nil)
+	require.NoError(t, err)
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+
+	// but the chunks of a store must be in order
+	err = newTarget().Restore(version, snapshottypes.FormatV2, newChunks([]int{2, 0, 1, 3}), nil)
+	require.Error(t, err)
+
+	// and complete
+	err = newTarget().Restore(version, snapshottypes.FormatV2, newChunks([]int{0, 1, 2}), nil)
+	require.Error(t, err)
 }
 
 func TestSetInitialVersion(t *testing.T) {
@@ -668,6 +742,101 @@ func TestSetInitialVersion(t *testing.T) {
 	require.True(t, iavlStore.VersionExists(5))
 }
 
+type kvPairListener struct {
+	pairs []types.StoreKVPair
+}
+
+func (l *kvPairListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	l.pairs = append(l.pairs, types.StoreKVPair{StoreKey: storeKey.Name(), Key: key, Value: value, Delete: delete})
+	return nil
+}
+
+func TestAddListeners(t *testing.T) {
+	multi := newMultiStoreWithMounts(memdb.NewDB(), types.PruneNothing)
+	require.NoError(t, multi.LoadLatestVersion())
+	key1, key2 := multi.keysByName["store1"], multi.keysByName["store2"]
+
+	listener := &kvPairListener{}
+	multi.AddListeners(key1, []types.WriteListener{listener})
+	require.True(t, multi.ListeningEnabled(key1))
+	require.False(t, multi.ListeningEnabled(key2))
+
+	multi.GetKVStore(key1).Set([]byte("a"), []byte("1"))
+	multi.GetKVStore(key2).Set([]byte("b"), []byte("2"))
+	require.Equal(t, []types.StoreKVPair{{StoreKey: "store1", Key: []byte("a"), Value: []byte("1")}}, listener.pairs)
+
+	// writes of branches are passed once they reach the listening store
+	listener.pairs = nil
+	cms := multi.CacheMultiStore()
+	branch := cms.CacheMultiStore()
+	branch.GetKVStore(key1).Set([]byte("c"), []byte("3"))
+	branch.GetKVStore(key1).Delete([]byte("a"))
+	require.Empty(t, listener.pairs)
+	branch.Write()
+	require.Empty(t, listener.pairs)
+	cms.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("a"), Delete: true},
+		{StoreKey: "store1", Key: []byte("c"), Value: []byte("3")},
+	}, listener.pairs)
+
+	// a listening branch passes the writes of its own branches
+	listener.pairs = nil
+	cms = multi.CacheMultiStore()
+	require.False(t, cms.ListeningEnabled(key1))
+	cms.AddListeners(key1, []types.WriteListener{listener})
+	tracked := cms.(cachemulti.Store).CacheMultiStoreWithTracking()
+	tracked.GetKVStore(key1).Set([]byte("d"), []byte("4"))
+	tracked.Write()
+	branch = cms.CacheMultiStore()
+	require.False(t, branch.ListeningEnabled(key1))
+	branch.GetKVStore(key1).Set([]byte("e"), []byte("5"))
+	branch.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("d"), Value: []byte("4")},
+		{StoreKey: "store1", Key: []byte("e"), Value: []byte("5")},
+	}, listener.pairs)
+}
+
+func TestTransientStore(t *testing.T) {
+	db := memdb.NewDB()
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	tkey := types.NewTransientStoreKey("transient")
+	multi.MountStoreWithDB(tkey, types.StoreTypeTransient, nil)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	withoutTransient := newMultiStoreWithMounts(memdb.NewDB(), types.PruneNothing)
+	require.NoError(t, withoutTransient.LoadLatestVersion())
+
+	for i := 0; i < 3; i++ {
+		multi.GetKVStore(tkey).Set([]byte("key"), []byte("value"))
+		multi.GetKVStore(multi.keysByName["store1"]).Set([]byte("key"), []byte{byte(i)})
+		withoutTransient.GetKVStore(withoutTransient.keysByName["store1"]).Set([]byte("key"), []byte{byte(i)})
+
+		// transient stores are cleared and ignored by the app hash
+		require.Equal(t, withoutTransient.Commit(), multi.Commit())
+		require.Nil(t, multi.GetKVStore(tkey).Get([]byte("key")))
+	}
+
+	// transient stores are not snapshotted
+	chunks, err := multi.Snapshot(3, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	for reader := range chunks {
+		require.NoError(t, reader.Close())
+	}
+
+	// a reloaded store starts with an empty transient store
+	multi = newMultiStoreWithMounts(db, types.PruneNothing)
+	multi.MountStoreWithDB(tkey, types.StoreTypeTransient, nil)
+	require.NoError(t, multi.LoadLatestVersion())
+	require.Equal(t, withoutTransient.LastCommitID(), multi.LastCommitID())
+
+	// transient stores need a transient store key
+	multi = newMultiStoreWithMounts(db, types.PruneNothing)
+	multi.MountStoreWithDB(types.NewKVStoreKey("transient"), types.StoreTypeTransient, nil)
+	require.Error(t, multi.LoadLatestVersion())
+}
+
 func BenchmarkMultistoreSnapshot100K(b *testing.B) {
 	benchmarkMultistoreSnapshot(b, 10, 10000)
 }
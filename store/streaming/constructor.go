@@ -0,0 +1,79 @@
+package streaming
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cast"
+
+	"github.com/line/lbm-sdk/baseapp"
+	"github.com/line/lbm-sdk/client/flags"
+	servertypes "github.com/line/lbm-sdk/server/types"
+	"github.com/line/lbm-sdk/store/types"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+// App options of the streaming section of the app config, see server/config.
+const (
+	OptStreamingKeys        = "streaming.keys"
+	OptStreamingFileEnable  = "streaming.file.enable"
+	OptStreamingFileDir     = "streaming.file.dir"
+	OptStreamingGRPCEnable  = "streaming.grpc.enable"
+	OptStreamingGRPCAddress = "streaming.grpc.address"
+)
+
+// LoadStreamingService creates the Service configured by the app options and
+// sets it on the BaseApp. No service is created if no sink is enabled.
+func LoadStreamingService(bApp *baseapp.BaseApp, appOpts servertypes.AppOptions, keys map[string]*sdk.KVStoreKey) (*Service, error) {
+	var sinks []Sink
+	if cast.ToBool(appOpts.Get(OptStreamingFileEnable)) {
+		dir := cast.ToString(appOpts.Get(OptStreamingFileDir))
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), dir)
+		}
+		sink, err := NewFileSink(dir)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if cast.ToBool(appOpts.Get(OptStreamingGRPCEnable)) {
+		sink, err := NewGRPCSink(cast.ToString(appOpts.Get(OptStreamingGRPCAddress)))
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if len(sinks) == 0 {
+		return nil, nil
+	}
+
+	storeKeys, err := selectStoreKeys(cast.ToStringSlice(appOpts.Get(OptStreamingKeys)), keys)
+	if err != nil {
+		return nil, err
+	}
+	service := NewService(storeKeys, sinks...)
+	bApp.SetStreamingService(service)
+	return service, nil
+}
+
+// selectStoreKeys returns the store keys of the given names, "*" selects all
+// stores.
+func selectStoreKeys(names []string, keys map[string]*sdk.KVStoreKey) ([]types.StoreKey, error) {
+	var storeKeys []types.StoreKey
+	for _, name := range names {
+		if name == "*" {
+			storeKeys = storeKeys[:0]
+			for _, key := range keys {
+				storeKeys = append(storeKeys, key)
+			}
+			return storeKeys, nil
+		}
+		key, ok := keys[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown store %q to stream", name)
+		}
+		storeKeys = append(storeKeys, key)
+	}
+	return storeKeys, nil
+}
@@ -0,0 +1,51 @@
+package streaming
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+var _ Sink = (*FileSink)(nil)
+
+// FileSink writes the state changes of every block to a file named
+// block-{height} in a directory. The files contain the protobuf encoded
+// BlockStateChanges.
+type FileSink struct {
+	dir string
+}
+
+// NewFileSink returns a FileSink writing to the given directory, which is
+// created if it does not exist.
+func NewFileSink(dir string) (*FileSink, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &FileSink{dir: dir}, nil
+}
+
+// BlockFileName returns the name of the file the changes of the given block
+// height are written to.
+func BlockFileName(height int64) string {
+	return fmt.Sprintf("block-%d", height)
+}
+
+// WriteBlock implements Sink. The file is written under a temporary name first
+// so readers never observe a partially written block.
+func (s *FileSink) WriteBlock(block *BlockStateChanges) error {
+	bz, err := block.Marshal()
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(s.dir, BlockFileName(block.Height))
+	if err := ioutil.WriteFile(path+".tmp", bz, 0644); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+// Close implements Sink.
+func (s *FileSink) Close() error {
+	return nil
+}
@@ -0,0 +1,105 @@
+package streaming
+
+import (
+	"net"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// subscriberBufferSize is the number of blocks buffered for a subscriber. A
+// subscriber falling further behind is disconnected so that it never holds up
+// the commit of a block.
+const subscriberBufferSize = 64
+
+var (
+	_ Sink                 = (*GRPCSink)(nil)
+	_ StateStreamingServer = (*GRPCSink)(nil)
+)
+
+// GRPCSink serves the state changes of every block to the subscribers of the
+// StateStreaming service. Subscribers receive the blocks committed after they
+// subscribed.
+type GRPCSink struct {
+	server   *grpc.Server
+	listener net.Listener
+
+	mtx         sync.Mutex
+	subscribers map[chan *BlockStateChanges]struct{}
+}
+
+// NewGRPCSink starts a StateStreaming gRPC server listening on the given
+// address.
+func NewGRPCSink(address string) (*GRPCSink, error) {
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &GRPCSink{
+		server:      grpc.NewServer(),
+		listener:    lis,
+		subscribers: make(map[chan *BlockStateChanges]struct{}),
+	}
+	RegisterStateStreamingServer(s.server, s)
+	go s.server.Serve(lis) // nolint: errcheck
+
+	return s, nil
+}
+
+// Addr returns the address the server listens on.
+func (s *GRPCSink) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+// WriteBlock implements Sink.
+func (s *GRPCSink) WriteBlock(block *BlockStateChanges) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for ch := range s.subscribers {
+		select {
+		case ch <- block:
+		default:
+			delete(s.subscribers, ch)
+			close(ch)
+		}
+	}
+	return nil
+}
+
+// Subscribe implements StateStreamingServer.
+func (s *GRPCSink) Subscribe(_ *SubscribeRequest, stream StateStreaming_SubscribeServer) error {
+	ch := make(chan *BlockStateChanges, subscriberBufferSize)
+	s.mtx.Lock()
+	s.subscribers[ch] = struct{}{}
+	s.mtx.Unlock()
+
+	defer func() {
+		s.mtx.Lock()
+		delete(s.subscribers, ch)
+		s.mtx.Unlock()
+	}()
+
+	for {
+		select {
+		case block, ok := <-ch:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "subscriber fell behind")
+			}
+			if err := stream.Send(block); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// Close implements Sink. It stops the server and disconnects all subscribers.
+func (s *GRPCSink) Close() error {
+	s.server.Stop()
+	return nil
+}
@@ -0,0 +1,129 @@
+package streaming
+
+import (
+	"fmt"
+
+	abci "github.com/line/ostracon/abci/types"
+	"github.com/line/ostracon/crypto/tmhash"
+
+	"github.com/line/lbm-sdk/baseapp"
+	"github.com/line/lbm-sdk/store/types"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+// Sink receives the state changes of every committed block.
+type Sink interface {
+	WriteBlock(block *BlockStateChanges) error
+	Close() error
+}
+
+var (
+	_ baseapp.StreamingService = (*Service)(nil)
+	_ types.WriteListener      = (*Service)(nil)
+)
+
+// Service is a baseapp.StreamingService collecting the state changes of the
+// given stores while a block is delivered and passing them to the sinks when
+// the block is committed.
+type Service struct {
+	storeKeys []types.StoreKey
+	sinks     []Sink
+
+	// pending are the changes written since the last ABCI message
+	pending []*types.StoreKVPair
+	// block are the changes of the block being delivered
+	block *BlockStateChanges
+}
+
+// NewService returns a Service streaming the state changes of the given
+// stores to the sinks.
+func NewService(storeKeys []types.StoreKey, sinks ...Sink) *Service {
+	return &Service{storeKeys: storeKeys, sinks: sinks}
+}
+
+// Listeners implements baseapp.StreamingService.
+func (s *Service) Listeners() map[sdk.StoreKey][]sdk.WriteListener {
+	listeners := make(map[sdk.StoreKey][]sdk.WriteListener, len(s.storeKeys))
+	for _, key := range s.storeKeys {
+		listeners[key] = []sdk.WriteListener{s}
+	}
+	return listeners
+}
+
+// OnWrite implements types.WriteListener.
+func (s *Service) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	s.pending = append(s.pending, &types.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+	return nil
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener. It starts a new block with
+// the changes written so far, including the changes of InitChain.
+func (s *Service) ListenBeginBlock(_ sdk.Context, req abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	s.block = &BlockStateChanges{
+		Height:     req.Header.Height,
+		BeginBlock: s.flush(),
+	}
+	return nil
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener.
+func (s *Service) ListenDeliverTx(_ sdk.Context, req abci.RequestDeliverTx, _ abci.ResponseDeliverTx) error {
+	if s.block == nil {
+		return fmt.Errorf("no block in progress")
+	}
+	s.block.DeliverTxs = append(s.block.DeliverTxs, TxStateChanges{
+		TxHash:  tmhash.Sum(req.Tx),
+		Changes: s.flush(),
+	})
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener.
+func (s *Service) ListenEndBlock(_ sdk.Context, _ abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	if s.block == nil {
+		return fmt.Errorf("no block in progress")
+	}
+	s.block.EndBlock = s.flush()
+	return nil
+}
+
+// ListenCommit implements baseapp.ABCIListener. It passes the changes of the
+// committed block to all sinks, a failing sink does not keep the others from
+// receiving the block.
+func (s *Service) ListenCommit(_ sdk.Context, _ abci.ResponseCommit) error {
+	block := s.block
+	s.block = nil
+	if block == nil {
+		return fmt.Errorf("no block in progress")
+	}
+
+	var firstErr error
+	for _, sink := range s.sinks {
+		if err := sink.WriteBlock(block); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// Close closes all sinks.
+func (s *Service) Close() error {
+	var firstErr error
+	for _, sink := range s.sinks {
+		if err := sink.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (s *Service) flush() []*types.StoreKVPair {
+	pairs := s.pending
+	s.pending = nil
+	return pairs
+}
@@ -0,0 +1,139 @@
+package streaming
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	abci "github.com/line/ostracon/abci/types"
+	"github.com/line/ostracon/crypto/tmhash"
+	ocproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/line/lbm-sdk/store/types"
+	sdk "github.com/line/lbm-sdk/types"
+)
+
+var (
+	storeKey1 = types.NewKVStoreKey("store1")
+	storeKey2 = types.NewKVStoreKey("store2")
+)
+
+// deliverTestBlock passes the state changes of a block to the service as the BaseApp does
+func deliverTestBlock(t *testing.T, s *Service, height int64) *BlockStateChanges {
+	ctx := sdk.Context{}
+	require.NoError(t, s.OnWrite(storeKey1, []byte("begin"), []byte("1"), false))
+	require.NoError(t, s.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: ocproto.Header{Height: height}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, s.OnWrite(storeKey2, []byte("tx"), []byte("2"), false))
+	require.NoError(t, s.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte("tx1")}, abci.ResponseDeliverTx{}))
+	require.NoError(t, s.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte("tx2")}, abci.ResponseDeliverTx{}))
+	require.NoError(t, s.OnWrite(storeKey1, []byte("begin"), nil, true))
+	require.NoError(t, s.ListenEndBlock(ctx, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}))
+	require.NoError(t, s.ListenCommit(ctx, abci.ResponseCommit{}))
+
+	return &BlockStateChanges{
+		Height:     height,
+		BeginBlock: []*types.StoreKVPair{{StoreKey: "store1", Key: []byte("begin"), Value: []byte("1")}},
+		DeliverTxs: []TxStateChanges{
+			{TxHash: tmhash.Sum([]byte("tx1")), Changes: []*types.StoreKVPair{{StoreKey: "store2", Key: []byte("tx"), Value: []byte("2")}}},
+			{TxHash: tmhash.Sum([]byte("tx2"))},
+		},
+		EndBlock: []*types.StoreKVPair{{StoreKey: "store1", Key: []byte("begin"), Delete: true}},
+	}
+}
+
+func TestServiceListeners(t *testing.T) {
+	s := NewService([]types.StoreKey{storeKey1, storeKey2})
+	listeners := s.Listeners()
+	require.Len(t, listeners, 2)
+	require.Equal(t, []sdk.WriteListener{s}, listeners[storeKey1])
+	require.Equal(t, []sdk.WriteListener{s}, listeners[storeKey2])
+}
+
+func TestServiceWithoutBlock(t *testing.T) {
+	s := NewService(nil)
+	require.Error(t, s.ListenDeliverTx(sdk.Context{}, abci.RequestDeliverTx{}, abci.ResponseDeliverTx{}))
+	require.Error(t, s.ListenEndBlock(sdk.Context{}, abci.RequestEndBlock{}, abci.ResponseEndBlock{}))
+	require.Error(t, s.ListenCommit(sdk.Context{}, abci.ResponseCommit{}))
+}
+
+func TestFileSink(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "streaming")
+	sink, err := NewFileSink(dir)
+	require.NoError(t, err)
+	s := NewService([]types.StoreKey{storeKey1, storeKey2}, sink)
+
+	for height := int64(1); height <= 2; height++ {
+		exp := deliverTestBlock(t, s, height)
+
+		bz, err := ioutil.ReadFile(filepath.Join(dir, BlockFileName(height)))
+		require.NoError(t, err)
+		var got BlockStateChanges
+		require.NoError(t, got.Unmarshal(bz))
+		require.Equal(t, exp, &got)
+	}
+	require.NoError(t, s.Close())
+}
+
+func TestGRPCSink(t *testing.T) {
+	sink, err := NewGRPCSink("127.0.0.1:0")
+	require.NoError(t, err)
+	defer sink.Close()
+	s := NewService([]types.StoreKey{storeKey1, storeKey2}, sink)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, sink.Addr().String(), grpc.WithInsecure(), grpc.WithBlock())
+	require.NoError(t, err)
+	defer conn.Close()
+	stream, err := NewStateStreamingClient(conn).Subscribe(ctx, &SubscribeRequest{})
+	require.NoError(t, err)
+
+	// wait until the subscription is registered
+	require.Eventually(t, func() bool {
+		sink.mtx.Lock()
+		defer sink.mtx.Unlock()
+		return len(sink.subscribers) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	for height := int64(1); height <= 2; height++ {
+		exp := deliverTestBlock(t, s, height)
+		got, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, exp, got)
+	}
+}
+
+func TestGRPCSinkSlowSubscriber(t *testing.T) {
+	sink := &GRPCSink{subscribers: make(map[chan *BlockStateChanges]struct{})}
+	ch := make(chan *BlockStateChanges, subscriberBufferSize)
+	sink.subscribers[ch] = struct{}{}
+
+	for i := 0; i <= subscriberBufferSize; i++ {
+		require.NoError(t, sink.WriteBlock(&BlockStateChanges{Height: int64(i)}))
+	}
+	require.Empty(t, sink.subscribers)
+	for i := 0; i < subscriberBufferSize; i++ {
+		<-ch
+	}
+	_, ok := <-ch
+	require.False(t, ok)
+}
+
+func TestSelectStoreKeys(t *testing.T) {
+	keys := map[string]*sdk.KVStoreKey{"store1": storeKey1, "store2": storeKey2}
+
+	got, err := selectStoreKeys([]string{"store2"}, keys)
+	require.NoError(t, err)
+	require.Equal(t, []types.StoreKey{storeKey2}, got)
+
+	got, err = selectStoreKeys([]string{"store2", "*"}, keys)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []types.StoreKey{storeKey1, storeKey2}, got)
+
+	_, err = selectStoreKeys([]string{"unknown"}, keys)
+	require.Error(t, err)
+}
@@ -0,0 +1,976 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lbm/base/streaming/v1/streaming.proto
+
+package streaming
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/line/lbm-sdk/store/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SubscribeRequest is the request type for the StateStreaming/Subscribe RPC method.
+type SubscribeRequest struct {
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e9a7ebaad0bfd70, []int{0}
+}
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+// BlockStateChanges contains the state changes of a block in the order they were written.
+type BlockStateChanges struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// begin_block are the changes written in BeginBlock. The changes of InitChain are reported with the
+	// BeginBlock of the first block.
+	BeginBlock []*types.StoreKVPair `protobuf:"bytes,2,rep,name=begin_block,json=beginBlock,proto3" json:"begin_block,omitempty"`
+	DeliverTxs []TxStateChanges     `protobuf:"bytes,3,rep,name=deliver_txs,json=deliverTxs,proto3" json:"deliver_txs"`
+	EndBlock   []*types.StoreKVPair `protobuf:"bytes,4,rep,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+}
+
+func (m *BlockStateChanges) Reset()         { *m = BlockStateChanges{} }
+func (m *BlockStateChanges) String() string { return proto.CompactTextString(m) }
+func (*BlockStateChanges) ProtoMessage()    {}
+func (*BlockStateChanges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e9a7ebaad0bfd70, []int{1}
+}
+func (m *BlockStateChanges) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockStateChanges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockStateChanges.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockStateChanges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockStateChanges.Merge(m, src)
+}
+func (m *BlockStateChanges) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockStateChanges) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockStateChanges.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockStateChanges proto.InternalMessageInfo
+
+func (m *BlockStateChanges) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockStateChanges) GetBeginBlock() []*types.StoreKVPair {
+	if m != nil {
+		return m.BeginBlock
+	}
+	return nil
+}
+
+func (m *BlockStateChanges) GetDeliverTxs() []TxStateChanges {
+	if m != nil {
+		return m.DeliverTxs
+	}
+	return nil
+}
+
+func (m *BlockStateChanges) GetEndBlock() []*types.StoreKVPair {
+	if m != nil {
+		return m.EndBlock
+	}
+	return nil
+}
+
+// TxStateChanges contains the state changes of a tx in the order they were written.
+type TxStateChanges struct {
+	// tx_hash is the sha256 hash of the tx bytes
+	TxHash  []byte               `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Changes []*types.StoreKVPair `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (m *TxStateChanges) Reset()         { *m = TxStateChanges{} }
+func (m *TxStateChanges) String() string { return proto.CompactTextString(m) }
+func (*TxStateChanges) ProtoMessage()    {}
+func (*TxStateChanges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e9a7ebaad0bfd70, []int{2}
+}
+func (m *TxStateChanges) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxStateChanges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxStateChanges.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxStateChanges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxStateChanges.Merge(m, src)
+}
+func (m *TxStateChanges) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxStateChanges) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxStateChanges.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxStateChanges proto.InternalMessageInfo
+
+func (m *TxStateChanges) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *TxStateChanges) GetChanges() []*types.StoreKVPair {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SubscribeRequest)(nil), "lbm.base.streaming.v1.SubscribeRequest")
+	proto.RegisterType((*BlockStateChanges)(nil), "lbm.base.streaming.v1.BlockStateChanges")
+	proto.RegisterType((*TxStateChanges)(nil), "lbm.base.streaming.v1.TxStateChanges")
+}
+
+func init() {
+	proto.RegisterFile("lbm/base/streaming/v1/streaming.proto", fileDescriptor_5e9a7ebaad0bfd70)
+}
+
+var fileDescriptor_5e9a7ebaad0bfd70 = []byte{
+	// 390 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x3d, 0x8f, 0xda, 0x40,
+	0x10, 0x86, 0xed, 0x80, 0x20, 0x2c, 0x11, 0x4a, 0x56, 0xf9, 0x40, 0x14, 0x0e, 0x41, 0x42, 0xd0,
+	0x64, 0x1d, 0x48, 0x13, 0x29, 0x45, 0x14, 0xd2, 0x44, 0x4a, 0x8a, 0xc8, 0x46, 0x29, 0xd2, 0x10,
+	0xaf, 0x3d, 0xb2, 0x57, 0xd8, 0xde, 0x64, 0x77, 0xb1, 0xfc, 0x33, 0xee, 0x67, 0x51, 0x52, 0x5e,
+	0x75, 0x3a, 0xc1, 0x8f, 0xb8, 0xf6, 0xe4, 0xc5, 0x7c, 0xdc, 0x07, 0x12, 0xdd, 0x8e, 0xf6, 0x99,
+	0x77, 0xde, 0x57, 0x33, 0xa8, 0x1f, 0xd3, 0xc4, 0xa6, 0x9e, 0x04, 0x5b, 0x2a, 0x01, 0x5e, 0xc2,
+	0xd2, 0xd0, 0xce, 0x46, 0x87, 0x82, 0xfc, 0x13, 0x5c, 0x71, 0xfc, 0x2a, 0xa6, 0x09, 0x29, 0x30,
+	0x72, 0xf8, 0xc9, 0x46, 0x9d, 0x97, 0x21, 0x0f, 0xb9, 0x26, 0xec, 0xe2, 0xb5, 0x85, 0x3b, 0xef,
+	0x8e, 0x34, 0xb9, 0x80, 0x42, 0x2f, 0x66, 0x52, 0x41, 0xba, 0xd7, 0xeb, 0x61, 0xf4, 0xdc, 0x5d,
+	0x50, 0xe9, 0x0b, 0x46, 0xc1, 0x81, 0xff, 0x0b, 0x90, 0xaa, 0x77, 0x63, 0xa2, 0x17, 0x93, 0x98,
+	0xfb, 0x73, 0x57, 0x79, 0x0a, 0xbe, 0x45, 0x5e, 0x1a, 0x82, 0xc4, 0xaf, 0x51, 0x2d, 0x02, 0x16,
+	0x46, 0xaa, 0x6d, 0x76, 0xcd, 0x61, 0xc5, 0x29, 0x2b, 0xfc, 0x05, 0x35, 0x29, 0x84, 0x2c, 0x9d,
+	0xd1, 0xa2, 0xa5, 0xfd, 0xa4, 0x5b, 0x19, 0x36, 0xc7, 0x16, 0x39, 0xf2, 0xc9, 0x05, 0x90, 0x6c,
+	0x44, 0xdc, 0xe2, 0xf1, 0xe3, 0xf7, 0x2f, 0x8f, 0x09, 0x07, 0xe9, 0x16, 0x3d, 0x04, 0xff, 0x44,
+	0xcd, 0x00, 0x62, 0x96, 0x81, 0x98, 0xa9, 0x5c, 0xb6, 0x2b, 0x5a, 0xa0, 0x4f, 0x1e, 0x0d, 0x4a,
+	0xa6, 0xf9, 0xb1, 0xa9, 0x49, 0x75, 0x79, 0xf5, 0xd6, 0x70, 0x50, 0xd9, 0x3f, 0xcd, 0x25, 0xfe,
+	0x8c, 0x1a, 0x90, 0x06, 0xa5, 0x99, 0xea, 0x59, 0x66, 0x9e, 0x42, 0x1a, 0x68, 0x2b, 0x3d, 0x1f,
+	0xb5, 0xee, 0x0e, 0xc0, 0x6f, 0x50, 0x5d, 0xe5, 0xb3, 0xc8, 0x93, 0x91, 0x8e, 0xfd, 0xcc, 0xa9,
+	0xa9, 0xfc, 0xbb, 0x27, 0x23, 0xfc, 0x09, 0xd5, 0xfd, 0x2d, 0x73, 0x66, 0xe4, 0x1d, 0x3e, 0x16,
+	0xa8, 0xa5, 0x47, 0xb8, 0xbb, 0x5c, 0xf8, 0x2f, 0x6a, 0xec, 0x97, 0x80, 0x07, 0x27, 0x92, 0xdf,
+	0x5f, 0x53, 0x67, 0x78, 0x02, 0x7c, 0xb0, 0xba, 0x0f, 0xe6, 0xe4, 0xeb, 0x72, 0x6d, 0x99, 0xab,
+	0xb5, 0x65, 0x5e, 0xaf, 0x2d, 0xf3, 0x62, 0x63, 0x19, 0xab, 0x8d, 0x65, 0x5c, 0x6e, 0x2c, 0xe3,
+	0xcf, 0x20, 0x64, 0x2a, 0x5a, 0x50, 0xe2, 0xf3, 0xc4, 0x8e, 0x59, 0x0a, 0x76, 0x4c, 0x93, 0xf7,
+	0x32, 0x98, 0x97, 0x27, 0xb3, 0x57, 0xa6, 0x35, 0x7d, 0x30, 0x1f, 0x6f, 0x07, 0x00, 0xca, 0xd3,
+	0xf4, 0x7a, 0xa9, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StateStreamingClient is the client API for StateStreaming service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StateStreamingClient interface {
+	// Subscribe streams the state changes of the blocks committed after the subscription.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (StateStreaming_SubscribeClient, error)
+}
+
+type stateStreamingClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewStateStreamingClient(cc grpc1.ClientConn) StateStreamingClient {
+	return &stateStreamingClient{cc}
+}
+
+func (c *stateStreamingClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (StateStreaming_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_StateStreaming_serviceDesc.Streams[0], "/lbm.base.streaming.v1.StateStreaming/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stateStreamingSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StateStreaming_SubscribeClient interface {
+	Recv() (*BlockStateChanges, error)
+	grpc.ClientStream
+}
+
+type stateStreamingSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *stateStreamingSubscribeClient) Recv() (*BlockStateChanges, error) {
+	m := new(BlockStateChanges)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StateStreamingServer is the server API for StateStreaming service.
+type StateStreamingServer interface {
+	// Subscribe streams the state changes of the blocks committed after the subscription.
+	Subscribe(*SubscribeRequest, StateStreaming_SubscribeServer) error
+}
+
+// UnimplementedStateStreamingServer can be embedded to have forward compatible implementations.
+type UnimplementedStateStreamingServer struct {
+}
+
+func (*UnimplementedStateStreamingServer) Subscribe(req *SubscribeRequest, srv StateStreaming_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+
+func RegisterStateStreamingServer(s grpc1.Server, srv StateStreamingServer) {
+	s.RegisterService(&_StateStreaming_serviceDesc, srv)
+}
+
+func _StateStreaming_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StateStreamingServer).Subscribe(m, &stateStreamingSubscribeServer{stream})
+}
+
+type StateStreaming_SubscribeServer interface {
+	Send(*BlockStateChanges) error
+	grpc.ServerStream
+}
+
+type stateStreamingSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *stateStreamingSubscribeServer) Send(m *BlockStateChanges) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _StateStreaming_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lbm.base.streaming.v1.StateStreaming",
+	HandlerType: (*StateStreamingServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _StateStreaming_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "lbm/base/streaming/v1/streaming.proto",
+}
+
+func (m *SubscribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockStateChanges) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockStateChanges) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockStateChanges) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EndBlock) > 0 {
+		for iNdEx := len(m.EndBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EndBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStreaming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DeliverTxs) > 0 {
+		for iNdEx := len(m.DeliverTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeliverTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStreaming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BeginBlock) > 0 {
+		for iNdEx := len(m.BeginBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BeginBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStreaming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintStreaming(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxStateChanges) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxStateChanges) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxStateChanges) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStreaming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintStreaming(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStreaming(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStreaming(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubscribeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BlockStateChanges) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStreaming(uint64(m.Height))
+	}
+	if len(m.BeginBlock) > 0 {
+		for _, e := range m.BeginBlock {
+			l = e.Size()
+			n += 1 + l + sovStreaming(uint64(l))
+		}
+	}
+	if len(m.DeliverTxs) > 0 {
+		for _, e := range m.DeliverTxs {
+			l = e.Size()
+			n += 1 + l + sovStreaming(uint64(l))
+		}
+	}
+	if len(m.EndBlock) > 0 {
+		for _, e := range m.EndBlock {
+			l = e.Size()
+			n += 1 + l + sovStreaming(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxStateChanges) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovStreaming(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStreaming(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStreaming(x uint64) (n int) {
+	return sovStreaming(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockStateChanges) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockStateChanges: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockStateChanges: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeginBlock = append(m.BeginBlock, &types.StoreKVPair{})
+			if err := m.BeginBlock[len(m.BeginBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliverTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeliverTxs = append(m.DeliverTxs, TxStateChanges{})
+			if err := m.DeliverTxs[len(m.DeliverTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndBlock = append(m.EndBlock, &types.StoreKVPair{})
+			if err := m.EndBlock[len(m.EndBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxStateChanges) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxStateChanges: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxStateChanges: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &types.StoreKVPair{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStreaming(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStreaming
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStreaming
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStreaming
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStreaming        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStreaming          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStreaming = fmt.Errorf("proto: unexpected end of group")
+)
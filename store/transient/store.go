@@ -0,0 +1,64 @@
+package transient
+
+import (
+	"io"
+
+	"github.com/line/tm-db/v2/memdb"
+
+	"github.com/line/lbm-sdk/store/cachekv"
+	"github.com/line/lbm-sdk/store/dbadapter"
+	"github.com/line/lbm-sdk/store/tracekv"
+	"github.com/line/lbm-sdk/store/types"
+)
+
+var (
+	_ types.KVStore   = (*Store)(nil)
+	_ types.Committer = (*Store)(nil)
+)
+
+// Store is an in-memory only KVStore that is cleared on every commit, so its
+// entries live for a single block. It is not part of the app state, so it is
+// neither hashed, snapshotted nor pruned.
+type Store struct {
+	dbadapter.Store
+}
+
+// NewStore constructs new MemDB adapter
+func NewStore() *Store {
+	return &Store{Store: dbadapter.Store{DB: memdb.NewDB()}}
+}
+
+// Commit cleans up Store.
+// Implements CommitStore
+func (ts *Store) Commit() (id types.CommitID) {
+	ts.Store = dbadapter.Store{DB: memdb.NewDB()}
+	return
+}
+
+// SetPruning is a no-op as the store keeps no versions.
+func (ts *Store) SetPruning(_ types.PruningOptions) {}
+
+// GetPruning is a no-op as pruning options cannot be directly set on this store.
+// They must be set on the root commit multi-store.
+func (ts *Store) GetPruning() types.PruningOptions { return types.PruningOptions{} }
+
+// LastCommitID implements CommitStore, the store is never committed.
+func (ts *Store) LastCommitID() (id types.CommitID) {
+	return
+}
+
+// GetStoreType implements Store.
+func (ts *Store) GetStoreType() types.StoreType {
+	return types.StoreTypeTransient
+}
+
+// CacheWrap branches the store. A branch outliving a commit reads from the
+// cleared store.
+func (ts *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(ts)
+}
+
+// CacheWrapWithTrace implements KVStore.
+func (ts *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(ts, w, tc))
+}
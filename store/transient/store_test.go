@@ -0,0 +1,42 @@
+package transient_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/store/transient"
+	"github.com/line/lbm-sdk/store/types"
+)
+
+var k, v = []byte("hello"), []byte("world")
+
+func TestTransientStore(t *testing.T) {
+	tstore := transient.NewStore()
+	require.Equal(t, types.StoreTypeTransient, tstore.GetStoreType())
+
+	require.Nil(t, tstore.Get(k))
+	tstore.Set(k, v)
+	require.Equal(t, v, tstore.Get(k))
+
+	id := tstore.Commit()
+	require.True(t, id.IsZero())
+	require.True(t, tstore.LastCommitID().IsZero())
+	require.Nil(t, tstore.Get(k))
+}
+
+func TestTransientStoreCacheWrap(t *testing.T) {
+	tstore := transient.NewStore()
+	tstore.Set(k, v)
+
+	cache := tstore.CacheWrap().(types.CacheKVStore)
+	require.Equal(t, v, cache.Get(k))
+	cache.Set([]byte("key2"), v)
+	cache.Write()
+	require.Equal(t, v, tstore.Get([]byte("key2")))
+
+	// branches outliving a commit read from the cleared store
+	cache = tstore.CacheWrap().(types.CacheKVStore)
+	tstore.Commit()
+	require.Nil(t, cache.Get(k))
+}
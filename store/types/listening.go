@@ -0,0 +1,8 @@
+package types
+
+// WriteListener is notified of the writes to a listening KVStore, see listenkv.Store.
+type WriteListener interface {
+	// OnWrite is called for every Set and Delete on the KVStore of the given store key.
+	// The value is nil for a delete.
+	OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error
+}
@@ -225,36 +225,93 @@ func (m *SnapshotIAVLItem) GetHeight() int32 {
 	return 0
 }
 
+// SnapshotChunkHeader prefixes every chunk of a format 2 snapshot. It names the store whose
+// compressed item stream the chunk continues, and the position of the chunk in that stream.
+type SnapshotChunkHeader struct {
+	Store string `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *SnapshotChunkHeader) Reset()         { *m = SnapshotChunkHeader{} }
+func (m *SnapshotChunkHeader) String() string { return proto.CompactTextString(m) }
+func (*SnapshotChunkHeader) ProtoMessage()    {}
+func (*SnapshotChunkHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_abb9d27e807f2725, []int{3}
+}
+func (m *SnapshotChunkHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotChunkHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotChunkHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotChunkHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotChunkHeader.Merge(m, src)
+}
+func (m *SnapshotChunkHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotChunkHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotChunkHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotChunkHeader proto.InternalMessageInfo
+
+func (m *SnapshotChunkHeader) GetStore() string {
+	if m != nil {
+		return m.Store
+	}
+	return ""
+}
+
+func (m *SnapshotChunkHeader) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*SnapshotItem)(nil), "lbm.base.store.v1.SnapshotItem")
 	proto.RegisterType((*SnapshotStoreItem)(nil), "lbm.base.store.v1.SnapshotStoreItem")
 	proto.RegisterType((*SnapshotIAVLItem)(nil), "lbm.base.store.v1.SnapshotIAVLItem")
+	proto.RegisterType((*SnapshotChunkHeader)(nil), "lbm.base.store.v1.SnapshotChunkHeader")
 }
 
 func init() { proto.RegisterFile("lbm/base/store/v1/snapshot.proto", fileDescriptor_abb9d27e807f2725) }
 
 var fileDescriptor_abb9d27e807f2725 = []byte{
-	// 320 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x4e, 0xeb, 0x30,
-	0x14, 0x86, 0xe3, 0xdb, 0xb4, 0x17, 0x0e, 0x1d, 0x5a, 0xab, 0x42, 0x11, 0x43, 0x88, 0x5a, 0x24,
-	0xba, 0x60, 0xab, 0xb0, 0xc2, 0x40, 0x59, 0x5a, 0x89, 0xc9, 0x95, 0x18, 0xd8, 0x12, 0xb0, 0x92,
-	0xa8, 0x76, 0x5c, 0xd5, 0x6e, 0xa4, 0xbe, 0x05, 0x2b, 0x6f, 0xc4, 0xd8, 0x91, 0x09, 0xa1, 0xf4,
-	0x45, 0x90, 0x9d, 0x76, 0xa1, 0x12, 0xdb, 0xff, 0x27, 0xdf, 0x77, 0x7c, 0x64, 0x43, 0x24, 0x12,
-	0x49, 0x93, 0x58, 0x73, 0xaa, 0x8d, 0x5a, 0x72, 0x5a, 0x8e, 0xa8, 0x2e, 0xe2, 0x85, 0xce, 0x94,
-	0x21, 0x8b, 0xa5, 0x32, 0x0a, 0x77, 0x45, 0x22, 0x89, 0x25, 0x88, 0x23, 0x48, 0x39, 0x3a, 0xeb,
-	0xa5, 0x2a, 0x55, 0xee, 0x2f, 0xb5, 0xa9, 0x06, 0xfb, 0xef, 0x08, 0xda, 0xb3, 0x9d, 0x3b, 0x35,
-	0x5c, 0xe2, 0x5b, 0x68, 0x3a, 0x25, 0x40, 0x11, 0x1a, 0x9e, 0x5c, 0x5f, 0x90, 0x83, 0x49, 0x64,
-	0xcf, 0xcf, 0xec, 0x07, 0x2b, 0x4d, 0x3c, 0x56, 0x4b, 0xf8, 0x01, 0xfc, 0x3c, 0x2e, 0x45, 0xf0,
-	0xcf, 0xc9, 0x83, 0x3f, 0xe4, 0xe9, 0xfd, 0xd3, 0xa3, 0x75, 0xc7, 0x47, 0xd5, 0xd7, 0xb9, 0x6f,
-	0xdb, 0xc4, 0x63, 0x4e, 0x1e, 0xb7, 0xc0, 0xcf, 0x0d, 0x97, 0xfd, 0x4b, 0xe8, 0x1e, 0x1c, 0x85,
-	0x31, 0xf8, 0x45, 0x2c, 0xeb, 0xf5, 0x8e, 0x99, 0xcb, 0x7d, 0x01, 0x9d, 0xdf, 0x63, 0x71, 0x07,
-	0x1a, 0x73, 0xbe, 0x76, 0x58, 0x9b, 0xd9, 0x88, 0x7b, 0xd0, 0x2c, 0x63, 0xb1, 0xe2, 0x6e, 0xb9,
-	0x36, 0xab, 0x0b, 0x0e, 0xe0, 0x7f, 0xc9, 0x97, 0x3a, 0x57, 0x45, 0xd0, 0x88, 0xd0, 0xb0, 0xc1,
-	0xf6, 0x15, 0x9f, 0x42, 0x2b, 0xe3, 0x79, 0x9a, 0x99, 0xc0, 0x8f, 0xd0, 0xb0, 0xc9, 0x76, 0x6d,
-	0x7c, 0xf7, 0x51, 0x85, 0x68, 0x53, 0x85, 0xe8, 0xbb, 0x0a, 0xd1, 0xdb, 0x36, 0xf4, 0x36, 0xdb,
-	0xd0, 0xfb, 0xdc, 0x86, 0xde, 0xf3, 0x20, 0xcd, 0x4d, 0xb6, 0x4a, 0xc8, 0x8b, 0x92, 0x54, 0xe4,
-	0x05, 0xa7, 0x22, 0x91, 0x57, 0xfa, 0x75, 0xbe, 0x7b, 0x26, 0xb3, 0x5e, 0x70, 0x9d, 0xb4, 0xdc,
-	0xc5, 0xdf, 0xfc, 0x04, 0x00, 0x00, 0xff, 0xff, 0x66, 0xda, 0xd5, 0x52, 0xc5, 0x01, 0x00, 0x00,
+	// 352 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0xfb, 0x40,
+	0x10, 0xc6, 0xb3, 0xff, 0x26, 0xfd, 0xdb, 0xb1, 0x42, 0xbb, 0x16, 0x09, 0x1e, 0x62, 0x48, 0x05,
+	0x7b, 0x31, 0xa1, 0x7a, 0xd5, 0x43, 0xdb, 0x4b, 0x0b, 0x9e, 0xb6, 0xe0, 0xc1, 0x5b, 0x62, 0x97,
+	0x24, 0x34, 0xc9, 0x96, 0xec, 0x36, 0xd8, 0xb7, 0xf0, 0xea, 0x1b, 0x79, 0xec, 0xd1, 0x93, 0x48,
+	0xfa, 0x22, 0xb2, 0x9b, 0x06, 0xc1, 0x82, 0xb7, 0xf9, 0x76, 0xbf, 0xdf, 0x7c, 0xc3, 0x0c, 0xd8,
+	0x49, 0x90, 0x7a, 0x81, 0xcf, 0xa9, 0xc7, 0x05, 0xcb, 0xa9, 0x57, 0x0c, 0x3d, 0x9e, 0xf9, 0x2b,
+	0x1e, 0x31, 0xe1, 0xae, 0x72, 0x26, 0x18, 0xee, 0x26, 0x41, 0xea, 0x4a, 0x87, 0xab, 0x1c, 0x6e,
+	0x31, 0x3c, 0xef, 0x85, 0x2c, 0x64, 0xea, 0xd7, 0x93, 0x55, 0x65, 0x74, 0xde, 0x10, 0xb4, 0xe7,
+	0x7b, 0x76, 0x26, 0x68, 0x8a, 0xef, 0xc0, 0x50, 0x88, 0x89, 0x6c, 0x34, 0x38, 0xbe, 0xb9, 0x74,
+	0x0f, 0x3a, 0xb9, 0xb5, 0x7f, 0x2e, 0x1f, 0x24, 0x34, 0xd5, 0x48, 0x05, 0xe1, 0x09, 0xe8, 0xb1,
+	0x5f, 0x24, 0xe6, 0x3f, 0x05, 0xf7, 0xff, 0x80, 0x67, 0xa3, 0xc7, 0x07, 0xc9, 0x8e, 0x8f, 0xca,
+	0xcf, 0x0b, 0x5d, 0xaa, 0xa9, 0x46, 0x14, 0x3c, 0x6e, 0x82, 0x1e, 0x0b, 0x9a, 0x3a, 0x57, 0xd0,
+	0x3d, 0x88, 0xc2, 0x18, 0xf4, 0xcc, 0x4f, 0xab, 0xf1, 0x5a, 0x44, 0xd5, 0x4e, 0x02, 0x9d, 0xdf,
+	0x6d, 0x71, 0x07, 0x1a, 0x4b, 0xba, 0x51, 0xb6, 0x36, 0x91, 0x25, 0xee, 0x81, 0x51, 0xf8, 0xc9,
+	0x9a, 0xaa, 0xe1, 0xda, 0xa4, 0x12, 0xd8, 0x84, 0xff, 0x05, 0xcd, 0x79, 0xcc, 0x32, 0xb3, 0x61,
+	0xa3, 0x41, 0x83, 0xd4, 0x12, 0x9f, 0x41, 0x33, 0xa2, 0x71, 0x18, 0x09, 0x53, 0xb7, 0xd1, 0xc0,
+	0x20, 0x7b, 0xe5, 0x8c, 0xe0, 0xb4, 0x4e, 0x9b, 0x44, 0xeb, 0x6c, 0x39, 0xa5, 0xfe, 0x82, 0xe6,
+	0xb2, 0xfd, 0xcf, 0xe2, 0x5a, 0xf5, 0x42, 0x7a, 0x60, 0xc4, 0xd9, 0x82, 0xbe, 0xa8, 0xd0, 0x13,
+	0x52, 0x89, 0xf1, 0xfd, 0x7b, 0x69, 0xa1, 0x6d, 0x69, 0xa1, 0xaf, 0xd2, 0x42, 0xaf, 0x3b, 0x4b,
+	0xdb, 0xee, 0x2c, 0xed, 0x63, 0x67, 0x69, 0x4f, 0xfd, 0x30, 0x16, 0xd1, 0x3a, 0x70, 0x9f, 0x59,
+	0xea, 0x25, 0x71, 0x46, 0xbd, 0x24, 0x48, 0xaf, 0xf9, 0x62, 0xb9, 0xbf, 0xb4, 0xd8, 0xac, 0x28,
+	0x0f, 0x9a, 0xea, 0x76, 0xb7, 0xdf, 0x03, 0x00, 0xd5, 0x62, 0x08, 0xe6, 0x08, 0x02, 0x00, 0x00,
 }
 
 func (m *SnapshotItem) Marshal() (dAtA []byte, err error) {
@@ -408,6 +465,41 @@ func (m *SnapshotIAVLItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SnapshotChunkHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotChunkHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotChunkHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Store) > 0 {
+		i -= len(m.Store)
+		copy(dAtA[i:], m.Store)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Store)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSnapshot(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSnapshot(v)
 	base := offset
@@ -491,6 +583,22 @@ func (m *SnapshotIAVLItem) Size() (n int) {
 	return n
 }
 
+func (m *SnapshotChunkHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Store)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovSnapshot(uint64(m.Index))
+	}
+	return n
+}
+
 func sovSnapshot(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -855,6 +963,107 @@ func (m *SnapshotIAVLItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SnapshotChunkHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotChunkHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotChunkHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Store = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSnapshot(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -135,6 +135,14 @@ type MultiStore interface {
 	// implied that the caller should update the context when necessary between
 	// tracing operations. The modified MultiStore is returned.
 	SetTracingContext(TraceContext) MultiStore
+
+	// ListeningEnabled returns if listening is enabled for the KVStore of the given key.
+	ListeningEnabled(key StoreKey) bool
+
+	// AddListeners adds WriteListeners for the KVStore of the given key. The
+	// listeners are notified of the writes to the KVStore in the order they are
+	// applied, including the writes flushed from the branches of the MultiStore.
+	AddListeners(key StoreKey, listeners []WriteListener)
 }
 
 // From MultiStore.CacheMultiStore()....
@@ -291,6 +299,7 @@ const (
 	StoreTypeDB
 	StoreTypeIAVL
 	StoreTypeMemory
+	StoreTypeTransient
 )
 
 func (st StoreType) String() string {
@@ -306,6 +315,9 @@ func (st StoreType) String() string {
 
 	case StoreTypeMemory:
 		return "StoreTypeMemory"
+
+	case StoreTypeTransient:
+		return "StoreTypeTransient"
 	}
 
 	return "unknown store type"
@@ -368,6 +380,29 @@ func (key *MemoryStoreKey) String() string {
 	return fmt.Sprintf("MemoryStoreKey{%p, %s}", key, key.name)
 }
 
+// TransientStoreKey is used for indexing transient stores in a MultiStore
+type TransientStoreKey struct {
+	name string
+}
+
+// NewTransientStoreKey constructs new TransientStoreKey
+// Must return a pointer according to the ocap principle
+func NewTransientStoreKey(name string) *TransientStoreKey {
+	return &TransientStoreKey{
+		name: name,
+	}
+}
+
+// Name implements StoreKey
+func (key *TransientStoreKey) Name() string {
+	return key.name
+}
+
+// String implements StoreKey
+func (key *TransientStoreKey) String() string {
+	return fmt.Sprintf("TransientStoreKey{%p, %s}", key, key.name)
+}
+
 //----------------------------------------
 
 // key-value result for iterator queries
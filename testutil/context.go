@@ -23,3 +23,19 @@ func DefaultContext(key sdk.StoreKey) sdk.Context {
 
 	return ctx
 }
+
+// DefaultContextWithTransient creates a sdk.Context with a fresh MemDB mounting a transient
+// store under tkey next to the IAVL store under key, that can be used in tests.
+func DefaultContextWithTransient(key sdk.StoreKey, tkey sdk.StoreKey) sdk.Context {
+	db := memdb.NewDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(tkey, sdk.StoreTypeTransient, db)
+	err := cms.LoadLatestVersion()
+	if err != nil {
+		panic(err)
+	}
+	ctx := sdk.NewContext(cms, ocproto.Header{}, false, log.NewNopLogger())
+
+	return ctx
+}
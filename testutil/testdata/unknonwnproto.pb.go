@@ -2569,7 +2569,8 @@ type TestUpdatedTxBody struct {
 	Messages                     []*types.Any `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	Memo                         string       `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
 	TimeoutHeight                int64        `protobuf:"varint,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
-	SomeNewField                 uint64       `protobuf:"varint,4,opt,name=some_new_field,json=someNewField,proto3" json:"some_new_field,omitempty"`
+	Unordered                    bool         `protobuf:"varint,4,opt,name=unordered,proto3" json:"unordered,omitempty"`
+	SomeNewField                 uint64       `protobuf:"varint,5,opt,name=some_new_field,json=someNewField,proto3" json:"some_new_field,omitempty"`
 	SomeNewFieldNonCriticalField string       `protobuf:"bytes,1050,opt,name=some_new_field_non_critical_field,json=someNewFieldNonCriticalField,proto3" json:"some_new_field_non_critical_field,omitempty"`
 	ExtensionOptions             []*types.Any `protobuf:"bytes,1023,rep,name=extension_options,json=extensionOptions,proto3" json:"extension_options,omitempty"`
 	NonCriticalExtensionOptions  []*types.Any `protobuf:"bytes,2047,rep,name=non_critical_extension_options,json=nonCriticalExtensionOptions,proto3" json:"non_critical_extension_options,omitempty"`
@@ -2629,6 +2630,13 @@ func (m *TestUpdatedTxBody) GetTimeoutHeight() int64 {
 	return 0
 }
 
+func (m *TestUpdatedTxBody) GetUnordered() bool {
+	if m != nil {
+		return m.Unordered
+	}
+	return false
+}
+
 func (m *TestUpdatedTxBody) GetSomeNewField() uint64 {
 	if m != nil {
 		return m.SomeNewField
@@ -2809,110 +2817,110 @@ func init() {
 func init() { proto.RegisterFile("unknonwnproto.proto", fileDescriptor_448ea787339d1228) }
 
 var fileDescriptor_448ea787339d1228 = []byte{
-	// 1639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	// 1647 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
 	0x15, 0xd7, 0x70, 0x49, 0x89, 0x7c, 0xa2, 0x29, 0x66, 0xac, 0xb6, 0x1b, 0x3a, 0x66, 0x98, 0x85,
 	0xea, 0x30, 0x41, 0x43, 0x9a, 0x4b, 0x06, 0x08, 0x72, 0x0a, 0xa9, 0x58, 0x95, 0x00, 0x55, 0x2e,
 	0xa6, 0x4e, 0x5a, 0xf8, 0x42, 0x2c, 0xb9, 0x43, 0x72, 0x21, 0x72, 0x46, 0xdd, 0x9d, 0x95, 0xc8,
-	0x5b, 0xd1, 0x1e, 0x7a, 0xcd, 0xa5, 0x28, 0xd0, 0xff, 0xa0, 0xa7, 0x22, 0xb7, 0x1e, 0x7b, 0xcc,
-	0xa5, 0x80, 0x2f, 0x05, 0x0a, 0x14, 0x08, 0x0a, 0xfb, 0xda, 0xff, 0xa0, 0x28, 0x52, 0xcc, 0xec,
-	0x07, 0x97, 0x92, 0xa8, 0x50, 0x72, 0x6b, 0x43, 0x40, 0x2e, 0xd2, 0xcc, 0xdb, 0xdf, 0xbc, 0x8f,
-	0xdf, 0xfb, 0xe0, 0xce, 0xc2, 0x5d, 0x9f, 0x1d, 0x33, 0xce, 0xce, 0xd8, 0x89, 0xcb, 0x05, 0xaf,
-	0xa9, 0xbf, 0x38, 0x2b, 0xa8, 0x27, 0x6c, 0x4b, 0x58, 0xa5, 0xed, 0x21, 0x1f, 0x72, 0x25, 0xac,
-	0xcb, 0x55, 0xf0, 0xbc, 0xf4, 0xe6, 0x90, 0xf3, 0xe1, 0x98, 0xd6, 0xd5, 0xae, 0xe7, 0x0f, 0xea,
-	0x16, 0x9b, 0x85, 0x8f, 0xf0, 0xb8, 0x37, 0xa9, 0x8b, 0x69, 0xfd, 0xb4, 0x51, 0x17, 0xd3, 0x40,
-	0x66, 0x08, 0xc8, 0xed, 0xfa, 0x9e, 0xe0, 0x13, 0xea, 0x36, 0x70, 0x01, 0x52, 0x8e, 0xad, 0xa3,
-	0x0a, 0xaa, 0x66, 0x48, 0xca, 0xb1, 0x31, 0x86, 0x34, 0xb3, 0x26, 0x54, 0x4f, 0x55, 0x50, 0x35,
-	0x47, 0xd4, 0x1a, 0xbf, 0x07, 0x45, 0xcf, 0xef, 0x79, 0x7d, 0xd7, 0x39, 0x11, 0x0e, 0x67, 0xdd,
-	0x01, 0xa5, 0xba, 0x56, 0x41, 0xd5, 0x14, 0xd9, 0x4a, 0xca, 0xf7, 0x28, 0xc5, 0x3a, 0x6c, 0x9c,
-	0x58, 0xb3, 0x09, 0x65, 0x42, 0xdf, 0x50, 0x1a, 0xa2, 0xad, 0xf1, 0x65, 0x6a, 0x6e, 0xd6, 0xbc,
-	0x60, 0xb6, 0x04, 0x59, 0x87, 0xd9, 0xbe, 0x27, 0xdc, 0x99, 0x32, 0x9d, 0x21, 0xf1, 0x3e, 0x76,
-	0x49, 0x4b, 0xb8, 0xb4, 0x0d, 0x99, 0x01, 0x3d, 0xa3, 0xae, 0x9e, 0x56, 0x7e, 0x04, 0x1b, 0x7c,
-	0x0f, 0xb2, 0x2e, 0xf5, 0xa8, 0x7b, 0x4a, 0x6d, 0xfd, 0xf7, 0xd9, 0x0a, 0xaa, 0x6a, 0x24, 0x16,
-	0xe0, 0x1f, 0x41, 0xba, 0xef, 0x88, 0x99, 0xbe, 0x5e, 0x41, 0xd5, 0x82, 0xa9, 0xd7, 0x22, 0x52,
-	0x6b, 0xb1, 0x57, 0xb5, 0x5d, 0x47, 0xcc, 0x88, 0x42, 0xe1, 0x8f, 0xe1, 0xce, 0xc4, 0xf1, 0xfa,
-	0x74, 0x3c, 0xb6, 0x18, 0xe5, 0xbe, 0xa7, 0x43, 0x05, 0x55, 0x37, 0xcd, 0xed, 0x5a, 0xc0, 0x75,
-	0x2d, 0xe2, 0xba, 0xd6, 0x66, 0x33, 0xb2, 0x08, 0x35, 0x7e, 0x0c, 0x69, 0xa9, 0x09, 0x67, 0x21,
-	0x7d, 0x68, 0x71, 0xaf, 0xb8, 0x86, 0x0b, 0x00, 0x87, 0xdc, 0x6b, 0xb3, 0x21, 0x1d, 0x53, 0xaf,
-	0x88, 0x70, 0x1e, 0xb2, 0x3f, 0xb5, 0xc6, 0xbc, 0x3d, 0x16, 0xbc, 0x98, 0xc2, 0x00, 0xeb, 0x3f,
-	0xe1, 0x5e, 0x9f, 0x9f, 0x15, 0x35, 0xbc, 0x09, 0x1b, 0x47, 0x96, 0xe3, 0xf2, 0x9e, 0x53, 0x4c,
-	0x1b, 0x35, 0xc8, 0x1e, 0x51, 0x4f, 0x50, 0xbb, 0xd5, 0x5e, 0x25, 0x51, 0xc6, 0xdf, 0x50, 0x74,
-	0xa0, 0xb9, 0xd2, 0x01, 0x6c, 0x40, 0xca, 0x6a, 0xe9, 0xe9, 0x8a, 0x56, 0xdd, 0x34, 0xf1, 0x9c,
-	0x91, 0xc8, 0x28, 0x49, 0x59, 0x2d, 0xdc, 0x84, 0x8c, 0xc3, 0x6c, 0x3a, 0xd5, 0x33, 0x0a, 0x76,
-	0xff, 0x3c, 0xac, 0xd9, 0xae, 0x1d, 0xc8, 0xe7, 0x8f, 0x98, 0x70, 0x67, 0x24, 0xc0, 0x96, 0x0e,
-	0x01, 0xe6, 0x42, 0x5c, 0x04, 0xed, 0x98, 0xce, 0x94, 0x2f, 0x1a, 0x91, 0x4b, 0x5c, 0x85, 0xcc,
-	0xa9, 0x35, 0xf6, 0x03, 0x6f, 0x2e, 0xb7, 0x1d, 0x00, 0x3e, 0x4e, 0x7d, 0x84, 0x8c, 0xa7, 0x51,
-	0x58, 0xe6, 0x6a, 0x61, 0xbd, 0x0f, 0xeb, 0x4c, 0xe1, 0x55, 0xcd, 0x5c, 0xa2, 0xbe, 0xd9, 0x26,
-	0x21, 0xc2, 0xd8, 0x8b, 0x74, 0x37, 0x2e, 0xea, 0x9e, 0xeb, 0x59, 0xe2, 0xa6, 0x39, 0xd7, 0xf3,
-	0x49, 0x9c, 0xab, 0xce, 0x05, 0x3d, 0x45, 0xd0, 0xac, 0x21, 0x0d, 0x0b, 0x5b, 0x2e, 0x2f, 0xab,
-	0x69, 0xc3, 0x8e, 0x93, 0x77, 0x43, 0x0d, 0x32, 0x9d, 0xbd, 0xe5, 0xe9, 0xec, 0x90, 0x54, 0xaf,
-	0x65, 0xb0, 0x98, 0xcb, 0x4b, 0xad, 0xc8, 0xde, 0x96, 0x56, 0x10, 0x91, 0xcb, 0x15, 0x98, 0xec,
-	0x44, 0x0c, 0xc8, 0x9e, 0x74, 0xb9, 0x2f, 0xa8, 0xea, 0xc9, 0x1c, 0x09, 0x36, 0xc6, 0x2f, 0x62,
-	0x7e, 0x3b, 0x37, 0xe0, 0x77, 0xae, 0x3d, 0x64, 0x40, 0x8b, 0x19, 0x30, 0x7e, 0x9d, 0x98, 0x28,
-	0xcd, 0x95, 0xea, 0xa2, 0x00, 0x29, 0x6f, 0x10, 0x8e, 0xae, 0x94, 0x37, 0xc0, 0x6f, 0x41, 0xce,
-	0xf3, 0xdd, 0xfe, 0xc8, 0x72, 0x87, 0x34, 0x9c, 0x24, 0x73, 0x01, 0xae, 0xc0, 0xa6, 0x4d, 0x3d,
-	0xe1, 0x30, 0x4b, 0x4e, 0x37, 0x3d, 0xa3, 0x14, 0x25, 0x45, 0xf8, 0x01, 0x14, 0xfa, 0x2e, 0xb5,
-	0x1d, 0xd1, 0xed, 0x5b, 0xae, 0xdd, 0x65, 0x3c, 0x18, 0x7a, 0xfb, 0x6b, 0x24, 0x1f, 0xc8, 0x77,
-	0x2d, 0xd7, 0x3e, 0xe2, 0xf8, 0x3e, 0xe4, 0xfa, 0x23, 0xfa, 0x4b, 0x9f, 0x4a, 0x48, 0x36, 0x84,
-	0x64, 0x03, 0xd1, 0x11, 0xc7, 0x75, 0xc8, 0x72, 0xd7, 0x19, 0x3a, 0xcc, 0x1a, 0xeb, 0x39, 0x45,
-	0xc4, 0xdd, 0x8b, 0xd3, 0xa9, 0x41, 0x62, 0x50, 0x27, 0x17, 0x4f, 0x59, 0xe3, 0x5f, 0x29, 0xc8,
-	0x3f, 0xa1, 0x9e, 0xf8, 0x9c, 0xba, 0x9e, 0xc3, 0x59, 0x03, 0xe7, 0x01, 0x4d, 0xc3, 0x4e, 0x43,
-	0x53, 0xbc, 0x03, 0xc8, 0x0a, 0xc9, 0xfd, 0xfe, 0x5c, 0x67, 0xf2, 0x00, 0x41, 0x96, 0x44, 0xf5,
-	0xc2, 0x04, 0x2f, 0x45, 0xf5, 0x24, 0xaa, 0x1f, 0x16, 0xd7, 0x52, 0x54, 0x1f, 0xbf, 0x0f, 0xc8,
-	0x0e, 0x47, 0xc5, 0x12, 0x54, 0x27, 0xfd, 0xd5, 0xd7, 0x6f, 0xaf, 0x11, 0x64, 0xe3, 0x02, 0x20,
-	0xaa, 0xe6, 0x71, 0x66, 0x7f, 0x8d, 0x20, 0x8a, 0x1f, 0x00, 0x1a, 0x28, 0x0a, 0x97, 0x9e, 0x95,
-	0xb8, 0x01, 0x36, 0x00, 0x0d, 0x15, 0x8f, 0xcb, 0x06, 0x32, 0x1a, 0x4a, 0x6f, 0x47, 0x7a, 0xee,
-	0x6a, 0x6f, 0x47, 0xf8, 0x5d, 0x40, 0xc7, 0x7a, 0x7e, 0x29, 0xe7, 0x9d, 0xf4, 0xb3, 0xaf, 0xdf,
-	0x46, 0x04, 0x1d, 0x77, 0x32, 0xa0, 0x79, 0xfe, 0xc4, 0xf8, 0x8d, 0xb6, 0x40, 0xb7, 0x79, 0x5d,
-	0xba, 0xcd, 0x95, 0xe8, 0x36, 0x57, 0xa2, 0xdb, 0x94, 0x74, 0xef, 0x7c, 0x1b, 0xdd, 0xe6, 0x8d,
-	0x88, 0x36, 0x5f, 0x17, 0xd1, 0xf8, 0x1e, 0xe4, 0x18, 0x3d, 0xeb, 0x0e, 0x1c, 0x3a, 0xb6, 0xf5,
-	0x37, 0x2b, 0xa8, 0x9a, 0x26, 0x59, 0x46, 0xcf, 0xf6, 0xe4, 0x3e, 0xca, 0xc2, 0xef, 0x16, 0xb3,
-	0xd0, 0xbc, 0x6e, 0x16, 0x9a, 0x2b, 0x65, 0xa1, 0xb9, 0x52, 0x16, 0x9a, 0x2b, 0x65, 0xa1, 0x79,
-	0xa3, 0x2c, 0x34, 0x5f, 0x5b, 0x16, 0x3e, 0x00, 0xcc, 0x38, 0xeb, 0xf6, 0x5d, 0x47, 0x38, 0x7d,
-	0x6b, 0x1c, 0xa6, 0xe3, 0xb7, 0x6a, 0x76, 0x91, 0x22, 0xe3, 0x6c, 0x37, 0x7c, 0xb2, 0x90, 0x97,
-	0x7f, 0xa7, 0xa0, 0x94, 0x74, 0xff, 0x90, 0x33, 0xfa, 0x98, 0xd1, 0xc7, 0x83, 0xcf, 0xe5, 0x4f,
-	0xf9, 0x2d, 0xcd, 0xd2, 0xad, 0x61, 0xff, 0x3f, 0xeb, 0xf0, 0x83, 0xf3, 0xec, 0x1f, 0xa9, 0x5f,
-	0xab, 0xe1, 0x2d, 0xa1, 0xbe, 0x31, 0x6f, 0x88, 0x77, 0x2e, 0x47, 0x25, 0x62, 0xba, 0x25, 0xbd,
-	0x81, 0x3f, 0x81, 0x75, 0x87, 0x31, 0xea, 0x36, 0xf4, 0x82, 0x52, 0x5e, 0xfd, 0xd6, 0xc8, 0x6a,
-	0x07, 0x0a, 0x4f, 0xc2, 0x73, 0xb1, 0x06, 0x53, 0xdf, 0xba, 0x96, 0x06, 0x33, 0xd4, 0x60, 0x96,
-	0xfe, 0x88, 0x60, 0x3d, 0x50, 0x9a, 0x78, 0x4f, 0xd2, 0x96, 0xbe, 0x27, 0x1d, 0xc8, 0x57, 0x7e,
-	0x46, 0xdd, 0x30, 0xfb, 0xcd, 0x55, 0x3d, 0x0e, 0xfe, 0xa9, 0x3f, 0x24, 0xd0, 0x50, 0x7a, 0x28,
-	0x2f, 0x02, 0x91, 0x30, 0x61, 0x3c, 0x17, 0x19, 0x57, 0x77, 0xb2, 0xd0, 0xb8, 0x5c, 0x97, 0xfe,
-	0x14, 0xf9, 0x6a, 0x5e, 0x80, 0xeb, 0xb0, 0xd1, 0xe7, 0x3e, 0x8b, 0x2e, 0x89, 0x39, 0x12, 0x6d,
-	0x6f, 0xea, 0xb1, 0xf9, 0xbf, 0xf0, 0x38, 0xea, 0xbf, 0x6f, 0x16, 0xfb, 0xaf, 0xf5, 0x5d, 0xff,
-	0xdd, 0xa2, 0xfe, 0x6b, 0xbd, 0x74, 0xff, 0xb5, 0x5e, 0x71, 0xff, 0xb5, 0x5e, 0xaa, 0xff, 0xb4,
-	0xa5, 0xfd, 0xf7, 0xe5, 0xff, 0xad, 0xff, 0x5a, 0x2b, 0xf5, 0x9f, 0x79, 0x65, 0xff, 0x6d, 0x27,
-	0x3f, 0x1c, 0x68, 0xe1, 0x47, 0x82, 0xa8, 0x03, 0xff, 0x8a, 0xa0, 0x90, 0xb0, 0xb7, 0xf7, 0xe9,
-	0xcd, 0xae, 0x43, 0xaf, 0xfd, 0x5a, 0x12, 0xc5, 0xf3, 0x0f, 0xb4, 0xf0, 0x3e, 0xb5, 0xf7, 0x69,
-	0xe3, 0xe7, 0x8e, 0x18, 0x3d, 0x9a, 0x0a, 0xd7, 0x6a, 0xb3, 0xd9, 0x2b, 0x8d, 0x6d, 0x67, 0x1e,
-	0x5b, 0x02, 0xd7, 0x66, 0xb3, 0xd8, 0xa3, 0x6b, 0x47, 0xf7, 0x04, 0xf2, 0xc9, 0xf3, 0xb8, 0x2a,
-	0x03, 0x40, 0xcb, 0xe9, 0x8b, 0x26, 0x80, 0x25, 0x03, 0x0f, 0x26, 0xa3, 0x26, 0x27, 0x60, 0x3e,
-	0x98, 0x80, 0x6a, 0xd7, 0x37, 0xfe, 0x82, 0xa0, 0x28, 0x0d, 0x7e, 0x76, 0x62, 0x5b, 0x82, 0xda,
-	0x4f, 0xa6, 0xc4, 0x3a, 0xc3, 0xf7, 0x01, 0x7a, 0xdc, 0x9e, 0x75, 0x7b, 0x33, 0x41, 0x3d, 0x65,
-	0x23, 0x4f, 0x72, 0x52, 0xd2, 0x91, 0x02, 0xfc, 0x00, 0xb6, 0x2c, 0x5f, 0x8c, 0xba, 0x0e, 0x1b,
-	0xf0, 0x10, 0x93, 0x52, 0x98, 0x3b, 0x52, 0x7c, 0xc0, 0x06, 0x3c, 0xc0, 0x95, 0x01, 0x3c, 0x67,
-	0xc8, 0x2c, 0xe1, 0xbb, 0xd4, 0xd3, 0xb5, 0x8a, 0x56, 0xcd, 0x93, 0x84, 0x04, 0x97, 0x61, 0x33,
-	0xbe, 0xbb, 0x74, 0x3f, 0x54, 0x5f, 0x0c, 0xf2, 0x24, 0x17, 0xdd, 0x5e, 0x3e, 0xc4, 0x3f, 0x84,
-	0xc2, 0xfc, 0x79, 0xe3, 0xa1, 0xd9, 0xd2, 0x7f, 0x95, 0x55, 0x98, 0x7c, 0x84, 0x91, 0x42, 0xe3,
-	0x0b, 0x0d, 0xde, 0x58, 0x08, 0xa1, 0xc3, 0xed, 0x19, 0x7e, 0x08, 0xd9, 0x09, 0xf5, 0x3c, 0x6b,
-	0xa8, 0x22, 0xd0, 0x96, 0x16, 0x59, 0x8c, 0x92, 0xdd, 0x3d, 0xa1, 0x13, 0x1e, 0x75, 0xb7, 0x5c,
-	0x4b, 0x17, 0x84, 0x33, 0xa1, 0xdc, 0x17, 0xdd, 0x11, 0x75, 0x86, 0x23, 0x11, 0xf2, 0x78, 0x27,
-	0x94, 0xee, 0x2b, 0x21, 0xde, 0x81, 0x82, 0xc7, 0x27, 0xb4, 0x3b, 0xbf, 0x8a, 0xa5, 0xd5, 0x55,
-	0x2c, 0x2f, 0xa5, 0x47, 0xa1, 0xb3, 0x78, 0x1f, 0xde, 0x59, 0x44, 0x75, 0x2f, 0x19, 0xcc, 0x7f,
-	0x08, 0x06, 0xf3, 0x5b, 0xc9, 0x93, 0x47, 0xe7, 0x87, 0x74, 0x07, 0xde, 0xa0, 0x53, 0x41, 0x99,
-	0xac, 0x91, 0x2e, 0x57, 0x9f, 0x93, 0x3d, 0xfd, 0x9b, 0x8d, 0x2b, 0xc2, 0x2c, 0xc6, 0xf8, 0xc7,
-	0x01, 0x1c, 0x3f, 0x85, 0xf2, 0x82, 0xf9, 0x4b, 0x14, 0x6e, 0x5d, 0xa1, 0xf0, 0x5e, 0xe2, 0x97,
-	0xe3, 0xd1, 0x39, 0xdd, 0xc6, 0x9f, 0x11, 0xdc, 0x4d, 0xa4, 0xa4, 0x1d, 0x96, 0x05, 0xfe, 0x08,
-	0xf2, 0x32, 0xff, 0xd4, 0x55, 0xb5, 0x13, 0x25, 0xe6, 0x7b, 0xb5, 0x71, 0x6f, 0x52, 0x13, 0xd3,
-	0xda, 0x69, 0xa3, 0xf6, 0x33, 0xf5, 0x58, 0x82, 0xc9, 0xa6, 0x17, 0xaf, 0x3d, 0x5c, 0x99, 0x7f,
-	0x6b, 0xdb, 0x34, 0x0b, 0x89, 0x03, 0x7b, 0x94, 0x06, 0xdf, 0xde, 0x16, 0xaa, 0xa9, 0xa9, 0xf2,
-	0x94, 0xa8, 0xa6, 0xe6, 0xaa, 0xd5, 0xf4, 0x6e, 0x50, 0x4c, 0x84, 0x9e, 0x50, 0xe9, 0xfa, 0x67,
-	0x0e, 0x13, 0xaa, 0x34, 0x98, 0x3f, 0x09, 0xfc, 0x4d, 0x13, 0xb5, 0xee, 0xec, 0x7e, 0xf5, 0xbc,
-	0x8c, 0x9e, 0x3d, 0x2f, 0xa3, 0x7f, 0x3e, 0x2f, 0xa3, 0x2f, 0x5e, 0x94, 0xd7, 0x9e, 0xbd, 0x28,
-	0xaf, 0xfd, 0xfd, 0x45, 0x79, 0xed, 0xe9, 0x7b, 0x43, 0x47, 0x8c, 0xfc, 0x5e, 0xad, 0xcf, 0x27,
-	0xf5, 0xb1, 0xc3, 0x68, 0x7d, 0xdc, 0x9b, 0x7c, 0xe0, 0xd9, 0xc7, 0x75, 0xd9, 0xe2, 0xbe, 0x70,
-	0xc6, 0xf5, 0xa8, 0xd7, 0x7b, 0xeb, 0x8a, 0xd3, 0xe6, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc7,
-	0x8b, 0xa3, 0xa7, 0xc9, 0x18, 0x00, 0x00,
+	0x5b, 0xd1, 0x1e, 0x7a, 0xed, 0xa5, 0x28, 0xd0, 0x6f, 0xd0, 0x4b, 0x8b, 0xdc, 0x7a, 0xec, 0x31,
+	0x97, 0x02, 0xbe, 0x14, 0x28, 0x50, 0x20, 0x28, 0xec, 0x6b, 0xbf, 0x41, 0x51, 0xa4, 0x98, 0xd9,
+	0x3f, 0x5c, 0x4a, 0xa2, 0x42, 0xc9, 0xad, 0x0d, 0x01, 0xb9, 0x48, 0x33, 0x6f, 0x7e, 0xf3, 0xde,
+	0x9b, 0xdf, 0xfb, 0xc3, 0x9d, 0x81, 0xbb, 0x3e, 0x3b, 0x66, 0x9c, 0x9d, 0xb1, 0x13, 0x97, 0x0b,
+	0x5e, 0x53, 0x7f, 0x71, 0x56, 0x50, 0x4f, 0xd8, 0x96, 0xb0, 0x4a, 0xdb, 0x43, 0x3e, 0xe4, 0x4a,
+	0x58, 0x97, 0xa3, 0x60, 0xbd, 0xf4, 0xe6, 0x90, 0xf3, 0xe1, 0x98, 0xd6, 0xd5, 0xac, 0xe7, 0x0f,
+	0xea, 0x16, 0x9b, 0x85, 0x4b, 0x78, 0xdc, 0x9b, 0xd4, 0xc5, 0xb4, 0x7e, 0xda, 0xa8, 0x8b, 0x69,
+	0x20, 0x33, 0x04, 0xe4, 0x76, 0x7d, 0x4f, 0xf0, 0x09, 0x75, 0x1b, 0xb8, 0x00, 0x29, 0xc7, 0xd6,
+	0x51, 0x05, 0x55, 0x33, 0x24, 0xe5, 0xd8, 0x18, 0x43, 0x9a, 0x59, 0x13, 0xaa, 0xa7, 0x2a, 0xa8,
+	0x9a, 0x23, 0x6a, 0x8c, 0xdf, 0x83, 0xa2, 0xe7, 0xf7, 0xbc, 0xbe, 0xeb, 0x9c, 0x08, 0x87, 0xb3,
+	0xee, 0x80, 0x52, 0x5d, 0xab, 0xa0, 0x6a, 0x8a, 0x6c, 0x25, 0xe5, 0x7b, 0x94, 0x62, 0x1d, 0x36,
+	0x4e, 0xac, 0xd9, 0x84, 0x32, 0xa1, 0x6f, 0x28, 0x0d, 0xd1, 0xd4, 0xf8, 0x22, 0x35, 0x37, 0x6b,
+	0x5e, 0x30, 0x5b, 0x82, 0xac, 0xc3, 0x6c, 0xdf, 0x13, 0xee, 0x4c, 0x99, 0xce, 0x90, 0x78, 0x1e,
+	0xbb, 0xa4, 0x25, 0x5c, 0xda, 0x86, 0xcc, 0x80, 0x9e, 0x51, 0x57, 0x4f, 0x2b, 0x3f, 0x82, 0x09,
+	0xbe, 0x07, 0x59, 0x97, 0x7a, 0xd4, 0x3d, 0xa5, 0xb6, 0xfe, 0xbb, 0x6c, 0x05, 0x55, 0x35, 0x12,
+	0x0b, 0xf0, 0x0f, 0x20, 0xdd, 0x77, 0xc4, 0x4c, 0x5f, 0xaf, 0xa0, 0x6a, 0xc1, 0xd4, 0x6b, 0x11,
+	0xa9, 0xb5, 0xd8, 0xab, 0xda, 0xae, 0x23, 0x66, 0x44, 0xa1, 0xf0, 0xc7, 0x70, 0x67, 0xe2, 0x78,
+	0x7d, 0x3a, 0x1e, 0x5b, 0x8c, 0x72, 0xdf, 0xd3, 0xa1, 0x82, 0xaa, 0x9b, 0xe6, 0x76, 0x2d, 0xe0,
+	0xba, 0x16, 0x71, 0x5d, 0x6b, 0xb3, 0x19, 0x59, 0x84, 0x1a, 0x3f, 0x84, 0xb4, 0xd4, 0x84, 0xb3,
+	0x90, 0x3e, 0xb4, 0xb8, 0x57, 0x5c, 0xc3, 0x05, 0x80, 0x43, 0xee, 0xb5, 0xd9, 0x90, 0x8e, 0xa9,
+	0x57, 0x44, 0x38, 0x0f, 0xd9, 0x1f, 0x5b, 0x63, 0xde, 0x1e, 0x0b, 0x5e, 0x4c, 0x61, 0x80, 0xf5,
+	0x1f, 0x71, 0xaf, 0xcf, 0xcf, 0x8a, 0x1a, 0xde, 0x84, 0x8d, 0x23, 0xcb, 0x71, 0x79, 0xcf, 0x29,
+	0xa6, 0x8d, 0x1a, 0x64, 0x8f, 0xa8, 0x27, 0xa8, 0xdd, 0x6a, 0xaf, 0x12, 0x28, 0xe3, 0x6f, 0x28,
+	0xda, 0xd0, 0x5c, 0x69, 0x03, 0x36, 0x20, 0x65, 0xb5, 0xf4, 0x74, 0x45, 0xab, 0x6e, 0x9a, 0x78,
+	0xce, 0x48, 0x64, 0x94, 0xa4, 0xac, 0x16, 0x6e, 0x42, 0xc6, 0x61, 0x36, 0x9d, 0xea, 0x19, 0x05,
+	0xbb, 0x7f, 0x1e, 0xd6, 0x6c, 0xd7, 0x0e, 0xe4, 0xfa, 0x23, 0x26, 0xdc, 0x19, 0x09, 0xb0, 0xa5,
+	0x43, 0x80, 0xb9, 0x10, 0x17, 0x41, 0x3b, 0xa6, 0x33, 0xe5, 0x8b, 0x46, 0xe4, 0x10, 0x57, 0x21,
+	0x73, 0x6a, 0x8d, 0xfd, 0xc0, 0x9b, 0xcb, 0x6d, 0x07, 0x80, 0x8f, 0x53, 0x1f, 0x21, 0xe3, 0x69,
+	0x74, 0x2c, 0x73, 0xb5, 0x63, 0xbd, 0x0f, 0xeb, 0x4c, 0xe1, 0x75, 0xed, 0x72, 0xf5, 0xcd, 0x36,
+	0x09, 0x11, 0xc6, 0x5e, 0xa4, 0xbb, 0x71, 0x51, 0xf7, 0x5c, 0xcf, 0x12, 0x37, 0xcd, 0xb9, 0x9e,
+	0x4f, 0xe2, 0x58, 0x75, 0x2e, 0xe8, 0x29, 0x82, 0x66, 0x0d, 0x69, 0x98, 0xd8, 0x72, 0x78, 0x59,
+	0x4e, 0x1b, 0x76, 0x1c, 0xbc, 0x1b, 0x6a, 0x90, 0xe1, 0xec, 0x2d, 0x0f, 0x67, 0x87, 0xa4, 0x7a,
+	0x2d, 0x83, 0xc5, 0x5c, 0x5e, 0x6a, 0x65, 0x40, 0x03, 0x2b, 0x88, 0xc8, 0xe1, 0x0a, 0x4c, 0x76,
+	0x22, 0x06, 0x64, 0x4d, 0xba, 0xdc, 0x17, 0x54, 0xd5, 0x64, 0x8e, 0x04, 0x13, 0xe3, 0x67, 0x31,
+	0xbf, 0x9d, 0x1b, 0xf0, 0x3b, 0xd7, 0x1e, 0x32, 0xa0, 0xc5, 0x0c, 0x18, 0xbf, 0x4c, 0x74, 0x94,
+	0xe6, 0x4a, 0x79, 0x51, 0x80, 0x94, 0x37, 0x08, 0x5b, 0x57, 0xca, 0x1b, 0xe0, 0xb7, 0x20, 0xe7,
+	0xf9, 0x6e, 0x7f, 0x64, 0xb9, 0x43, 0x1a, 0x76, 0x92, 0xb9, 0x00, 0x57, 0x60, 0xd3, 0xa6, 0x9e,
+	0x70, 0x98, 0x25, 0xbb, 0x9b, 0x9e, 0x51, 0x8a, 0x92, 0x22, 0xfc, 0x00, 0x0a, 0x7d, 0x97, 0xda,
+	0x8e, 0xe8, 0xf6, 0x2d, 0xd7, 0xee, 0x32, 0x1e, 0x34, 0xbd, 0xfd, 0x35, 0x92, 0x0f, 0xe4, 0xbb,
+	0x96, 0x6b, 0x1f, 0x71, 0x7c, 0x1f, 0x72, 0xfd, 0x11, 0xfd, 0xb9, 0x4f, 0x25, 0x24, 0x1b, 0x42,
+	0xb2, 0x81, 0xe8, 0x88, 0xe3, 0x3a, 0x64, 0xb9, 0xeb, 0x0c, 0x1d, 0x66, 0x8d, 0xf5, 0x9c, 0x22,
+	0xe2, 0xee, 0xc5, 0xee, 0xd4, 0x20, 0x31, 0xa8, 0x93, 0x8b, 0xbb, 0xac, 0xf1, 0xaf, 0x14, 0xe4,
+	0x9f, 0x50, 0x4f, 0x7c, 0x4e, 0x5d, 0xcf, 0xe1, 0xac, 0x81, 0xf3, 0x80, 0xa6, 0x61, 0xa5, 0xa1,
+	0x29, 0xde, 0x01, 0x64, 0x85, 0xe4, 0x7e, 0x77, 0xae, 0x33, 0xb9, 0x81, 0x20, 0x4b, 0xa2, 0x7a,
+	0xba, 0x76, 0x35, 0xaa, 0x27, 0x51, 0xfd, 0x30, 0xb9, 0x96, 0xa2, 0xfa, 0xf8, 0x7d, 0x40, 0xb6,
+	0x9e, 0xb9, 0x0a, 0xd5, 0x49, 0x7f, 0xf9, 0xd5, 0xdb, 0x6b, 0x04, 0xd9, 0xb8, 0x00, 0x88, 0xaa,
+	0x7e, 0x9c, 0xd9, 0x5f, 0x23, 0x88, 0xe2, 0x07, 0x80, 0x06, 0x8a, 0xc2, 0xa5, 0x7b, 0x25, 0x6e,
+	0x80, 0x0d, 0x40, 0x43, 0x3d, 0x7b, 0x45, 0x43, 0x46, 0x43, 0xe9, 0xed, 0x48, 0xcf, 0x5d, 0xed,
+	0xed, 0x08, 0xbf, 0x0b, 0xe8, 0x58, 0xcf, 0x2f, 0xe5, 0xbc, 0x93, 0x7e, 0xf6, 0xd5, 0xdb, 0x88,
+	0xa0, 0xe3, 0x4e, 0x06, 0x34, 0xcf, 0x9f, 0x18, 0xbf, 0xd2, 0x16, 0xe8, 0x36, 0xaf, 0x4b, 0xb7,
+	0xb9, 0x12, 0xdd, 0xe6, 0x4a, 0x74, 0x9b, 0x92, 0xee, 0x9d, 0x6f, 0xa2, 0xdb, 0xbc, 0x11, 0xd1,
+	0xe6, 0xeb, 0x22, 0x1a, 0xdf, 0x83, 0x1c, 0xa3, 0x67, 0xdd, 0x81, 0x43, 0xc7, 0xb6, 0xfe, 0x66,
+	0x05, 0x55, 0xd3, 0x24, 0xcb, 0xe8, 0xd9, 0x9e, 0x9c, 0x47, 0x51, 0xf8, 0xed, 0x62, 0x14, 0x9a,
+	0xd7, 0x8d, 0x42, 0x73, 0xa5, 0x28, 0x34, 0x57, 0x8a, 0x42, 0x73, 0xa5, 0x28, 0x34, 0x6f, 0x14,
+	0x85, 0xe6, 0x6b, 0x8b, 0xc2, 0x07, 0x80, 0x19, 0x67, 0xdd, 0xbe, 0xeb, 0x08, 0xa7, 0x6f, 0x8d,
+	0xc3, 0x70, 0xfc, 0x5a, 0xf5, 0x2e, 0x52, 0x64, 0x9c, 0xed, 0x86, 0x2b, 0x0b, 0x71, 0xf9, 0x77,
+	0x0a, 0x4a, 0x49, 0xf7, 0x0f, 0x39, 0xa3, 0x8f, 0x19, 0x7d, 0x3c, 0xf8, 0x5c, 0xfe, 0x94, 0xdf,
+	0xd2, 0x28, 0xdd, 0x1a, 0xf6, 0xff, 0xb3, 0x0e, 0xdf, 0x3b, 0xcf, 0xfe, 0x91, 0xfa, 0xb5, 0x1a,
+	0xde, 0x12, 0xea, 0x1b, 0xf3, 0x82, 0x78, 0xe7, 0x72, 0x54, 0xe2, 0x4c, 0xb7, 0xa4, 0x36, 0xf0,
+	0x27, 0xb0, 0xee, 0x30, 0x46, 0xdd, 0x86, 0x5e, 0x50, 0xca, 0xab, 0xdf, 0x78, 0xb2, 0xda, 0x81,
+	0xc2, 0x93, 0x70, 0x5f, 0xac, 0xc1, 0xd4, 0xb7, 0xae, 0xa5, 0xc1, 0x0c, 0x35, 0x98, 0xa5, 0x3f,
+	0x20, 0x58, 0x0f, 0x94, 0x26, 0xbe, 0x93, 0xb4, 0xa5, 0xdf, 0x49, 0x07, 0xf2, 0x93, 0x9f, 0x51,
+	0x37, 0x8c, 0x7e, 0x73, 0x55, 0x8f, 0x83, 0x7f, 0xea, 0x0f, 0x09, 0x34, 0x94, 0x1e, 0x02, 0xcc,
+	0x85, 0x09, 0xe3, 0xb9, 0xc8, 0xb8, 0xba, 0x93, 0x85, 0xc6, 0xe5, 0xb8, 0xf4, 0xa7, 0xc8, 0x57,
+	0xf3, 0x02, 0x5c, 0x87, 0x8d, 0x3e, 0xf7, 0x59, 0x74, 0x49, 0xcc, 0x91, 0x68, 0x7a, 0x53, 0x8f,
+	0xcd, 0xff, 0x85, 0xc7, 0x51, 0xfd, 0x7d, 0xbd, 0x58, 0x7f, 0xad, 0x6f, 0xeb, 0xef, 0x16, 0xd5,
+	0x5f, 0xeb, 0xa5, 0xeb, 0xaf, 0xf5, 0x8a, 0xeb, 0xaf, 0xf5, 0x52, 0xf5, 0xa7, 0x2d, 0xad, 0xbf,
+	0x2f, 0xfe, 0x6f, 0xf5, 0xd7, 0x5a, 0xa9, 0xfe, 0xcc, 0x2b, 0xeb, 0x6f, 0x3b, 0xf9, 0x70, 0xa0,
+	0x85, 0x8f, 0x04, 0x51, 0x05, 0xfe, 0x15, 0x41, 0x21, 0x61, 0x6f, 0xef, 0xd3, 0x9b, 0x5d, 0x87,
+	0x5e, 0xfb, 0xb5, 0x24, 0x3a, 0xcf, 0x3f, 0xd0, 0xc2, 0xf7, 0xd4, 0xde, 0xa7, 0x8d, 0x9f, 0x3a,
+	0x62, 0xf4, 0x68, 0x2a, 0x5c, 0xab, 0xcd, 0x66, 0xaf, 0xf4, 0x6c, 0x3b, 0xf3, 0xb3, 0x25, 0x70,
+	0x6d, 0x36, 0x8b, 0x3d, 0xba, 0xf6, 0xe9, 0x9e, 0x40, 0x3e, 0xb9, 0x1f, 0x57, 0xe5, 0x01, 0xd0,
+	0x72, 0xfa, 0xa2, 0x0e, 0x60, 0xe1, 0x7c, 0xd4, 0x19, 0x35, 0xd9, 0x01, 0xf3, 0x41, 0x07, 0x54,
+	0xb3, 0xbe, 0xf1, 0x17, 0x04, 0x45, 0x69, 0xf0, 0xb3, 0x13, 0xdb, 0x12, 0xd4, 0x7e, 0x32, 0x25,
+	0xd6, 0x19, 0xbe, 0x0f, 0xd0, 0xe3, 0xf6, 0xac, 0xdb, 0x9b, 0x09, 0xea, 0x29, 0x1b, 0x79, 0x92,
+	0x93, 0x92, 0x8e, 0x14, 0xe0, 0x07, 0xb0, 0x65, 0xf9, 0x62, 0xd4, 0x75, 0xd8, 0x80, 0x87, 0x98,
+	0x94, 0xc2, 0xdc, 0x91, 0xe2, 0x03, 0x36, 0xe0, 0x01, 0xae, 0x0c, 0xe0, 0x39, 0x43, 0x66, 0x09,
+	0xdf, 0xa5, 0x9e, 0xae, 0x55, 0xb4, 0x6a, 0x9e, 0x24, 0x24, 0xb8, 0x0c, 0x9b, 0xf1, 0xdd, 0xa5,
+	0xfb, 0xa1, 0x7a, 0x31, 0xc8, 0x93, 0x5c, 0x74, 0x7b, 0xf9, 0x10, 0x7f, 0x1f, 0x0a, 0xf3, 0xf5,
+	0xc6, 0x43, 0xb3, 0xa5, 0xff, 0x22, 0xab, 0x30, 0xf9, 0x08, 0x23, 0x85, 0xc6, 0x1f, 0x35, 0x78,
+	0x63, 0xe1, 0x08, 0x1d, 0x6e, 0xcf, 0xf0, 0x43, 0xc8, 0x4e, 0xa8, 0xe7, 0x59, 0x43, 0x75, 0x02,
+	0x6d, 0x69, 0x92, 0xc5, 0x28, 0x59, 0xdd, 0x13, 0x3a, 0xe1, 0x51, 0x75, 0xcb, 0xb1, 0x74, 0x41,
+	0x38, 0x13, 0xca, 0x7d, 0xd1, 0x1d, 0x51, 0x67, 0x38, 0x12, 0x21, 0x8f, 0x77, 0x42, 0xe9, 0xbe,
+	0x12, 0xca, 0x97, 0x11, 0x9f, 0x71, 0xd7, 0xa6, 0x2e, 0xb5, 0x15, 0xb7, 0x59, 0x32, 0x17, 0xe0,
+	0x1d, 0x28, 0x78, 0x7c, 0x42, 0xbb, 0xf3, 0x8b, 0x5a, 0x46, 0x5d, 0xd4, 0xf2, 0x52, 0x7a, 0x14,
+	0x1e, 0x05, 0xef, 0xc3, 0x3b, 0x8b, 0xa8, 0xee, 0x25, 0x6d, 0xfb, 0xf7, 0x41, 0xdb, 0x7e, 0x2b,
+	0xb9, 0xf3, 0xe8, 0x7c, 0x0b, 0xef, 0xc0, 0x1b, 0x74, 0x2a, 0x28, 0x93, 0x19, 0xd4, 0xe5, 0xea,
+	0xb1, 0xd9, 0xd3, 0xbf, 0xde, 0xb8, 0x82, 0x84, 0x62, 0x8c, 0x7f, 0x1c, 0xc0, 0xf1, 0x53, 0x28,
+	0x2f, 0x98, 0xbf, 0x44, 0xe1, 0xd6, 0x15, 0x0a, 0xef, 0x25, 0x7e, 0x57, 0x1e, 0x9d, 0xd3, 0x6d,
+	0xfc, 0x19, 0xc1, 0xdd, 0x44, 0xc0, 0xda, 0x61, 0xd2, 0xe0, 0x8f, 0x20, 0x2f, 0xb3, 0x83, 0xba,
+	0x2a, 0xb3, 0xa2, 0xb0, 0x7d, 0xa7, 0x36, 0xee, 0x4d, 0x6a, 0x62, 0x5a, 0x3b, 0x6d, 0xd4, 0x7e,
+	0xa2, 0x96, 0x25, 0x98, 0x6c, 0x7a, 0xf1, 0xd8, 0xc3, 0x95, 0xf9, 0x4b, 0xdc, 0xa6, 0x59, 0x48,
+	0x6c, 0xd8, 0xa3, 0x34, 0x78, 0x99, 0x5b, 0xc8, 0xb5, 0xa6, 0xae, 0x2d, 0xe6, 0x5a, 0x73, 0xd5,
+	0x5c, 0x7b, 0x37, 0x48, 0x35, 0x42, 0x4f, 0xa8, 0x74, 0xfd, 0x33, 0x87, 0x09, 0x95, 0x38, 0xcc,
+	0x9f, 0x04, 0xfe, 0xa6, 0x89, 0x1a, 0x77, 0x76, 0xbf, 0x7c, 0x5e, 0x46, 0xcf, 0x9e, 0x97, 0xd1,
+	0x3f, 0x9f, 0x97, 0xd1, 0x6f, 0x5e, 0x94, 0xd7, 0x9e, 0xbd, 0x28, 0xaf, 0xfd, 0xfd, 0x45, 0x79,
+	0xed, 0xe9, 0x7b, 0x43, 0x47, 0x8c, 0xfc, 0x5e, 0xad, 0xcf, 0x27, 0xf5, 0xb1, 0xc3, 0x68, 0x7d,
+	0xdc, 0x9b, 0x7c, 0xe0, 0xd9, 0xc7, 0x75, 0x41, 0x3d, 0xe1, 0x0b, 0x67, 0x5c, 0x8f, 0x3a, 0x41,
+	0x6f, 0x5d, 0x71, 0xda, 0xfc, 0xef, 0x00, 0x42, 0x32, 0xc3, 0x64, 0xe7, 0x18, 0x00, 0x00,
 }
 
 func (m *Customer1) Marshal() (dAtA []byte, err error) {
@@ -5252,6 +5260,16 @@ func (m *TestUpdatedTxBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	if m.SomeNewField != 0 {
 		i = encodeVarintUnknonwnproto(dAtA, i, uint64(m.SomeNewField))
 		i--
+		dAtA[i] = 0x28
+	}
+	if m.Unordered {
+		i--
+		if m.Unordered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
 		dAtA[i] = 0x20
 	}
 	if m.TimeoutHeight != 0 {
@@ -6423,6 +6441,9 @@ func (m *TestUpdatedTxBody) Size() (n int) {
 	if m.TimeoutHeight != 0 {
 		n += 1 + sovUnknonwnproto(uint64(m.TimeoutHeight))
 	}
+	if m.Unordered {
+		n += 2
+	}
 	if m.SomeNewField != 0 {
 		n += 1 + sovUnknonwnproto(uint64(m.SomeNewField))
 	}
@@ -12594,6 +12615,26 @@ func (m *TestUpdatedTxBody) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unordered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnknonwnproto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unordered = bool(v != 0)
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SomeNewField", wireType)
 			}
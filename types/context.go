@@ -33,9 +33,13 @@ type Context struct {
 	blockGasMeter GasMeter
 	checkTx       bool
 	recheckTx     bool // if recheckTx == true, then checkTx must also be true
+	replaceTx     bool // if replaceTx == true, then checkTx must also be true
+	replacedTx    Tx
+	sigVerified   bool // if sigVerified == true, then checkTx must also be true
 	minGasPrice   DecCoins
 	consParams    *abci.ConsensusParams
 	eventManager  *EventManager
+	priority      int64
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -54,8 +58,12 @@ func (c Context) GasMeter() GasMeter          { return c.gasMeter }
 func (c Context) BlockGasMeter() GasMeter     { return c.blockGasMeter }
 func (c Context) IsCheckTx() bool             { return c.checkTx }
 func (c Context) IsReCheckTx() bool           { return c.recheckTx }
+func (c Context) IsReplaceTx() bool           { return c.replaceTx }
+func (c Context) ReplacedTx() Tx              { return c.replacedTx }
+func (c Context) IsSigVerified() bool         { return c.sigVerified }
 func (c Context) MinGasPrices() DecCoins      { return c.minGasPrice }
 func (c Context) EventManager() *EventManager { return c.eventManager }
+func (c Context) Priority() int64             { return c.priority }
 
 // clone the header before returning
 func (c Context) BlockHeader() ocproto.Header {
@@ -178,6 +186,36 @@ func (c Context) WithIsReCheckTx(isRecheckTx bool) Context {
 	return c
 }
 
+// WithIsReplaceTx called with true will also set true on checkTx. A replacing
+// tx reuses the sequences of the pending tx of its signers it replaces.
+func (c Context) WithIsReplaceTx(isReplaceTx bool) Context {
+	if isReplaceTx {
+		c.checkTx = true
+	}
+	c.replaceTx = isReplaceTx
+	return c
+}
+
+// WithReplacedTx sets the pending tx replaced by the tx checked with the
+// context, and sets true on replaceTx if it is not nil.
+func (c Context) WithReplacedTx(tx Tx) Context {
+	if tx != nil {
+		c = c.WithIsReplaceTx(true)
+	}
+	c.replacedTx = tx
+	return c
+}
+
+// WithIsSigVerified called with true will also set true on checkTx. The
+// signatures of a tx checked with it have been verified ahead of its CheckTx.
+func (c Context) WithIsSigVerified(isSigVerified bool) Context {
+	if isSigVerified {
+		c.checkTx = true
+	}
+	c.sigVerified = isSigVerified
+	return c
+}
+
 // WithMinGasPrices returns a Context with an updated minimum gas price value
 func (c Context) WithMinGasPrices(gasPrices DecCoins) Context {
 	c.minGasPrice = gasPrices
@@ -196,6 +234,12 @@ func (c Context) WithEventManager(em *EventManager) Context {
 	return c
 }
 
+// WithPriority returns a Context with an updated tx priority
+func (c Context) WithPriority(priority int64) Context {
+	c.priority = priority
+	return c
+}
+
 // TODO: remove???
 func (c Context) IsZero() bool {
 	return c.ms == nil
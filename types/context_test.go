@@ -51,6 +51,27 @@ func (s *contextTestSuite) TestCacheContext() {
 	s.Require().Equal(v2, store.Get(k2))
 }
 
+func (s *contextTestSuite) TestTransientStoreContext() {
+	key := types.NewKVStoreKey(s.T().Name())
+	tkey := types.NewTransientStoreKey("transient_" + s.T().Name())
+	k1 := []byte("hello")
+	v1 := []byte("world")
+
+	ctx := testutil.DefaultContextWithTransient(key, tkey)
+	tstore := ctx.KVStore(tkey)
+	tstore.Set(k1, v1)
+	s.Require().Equal(v1, tstore.Get(k1))
+	s.Require().Nil(ctx.KVStore(key).Get(k1))
+
+	cctx, write := ctx.CacheContext()
+	cctx.KVStore(tkey).Delete(k1)
+	s.Require().Equal(v1, tstore.Get(k1))
+
+	write()
+
+	s.Require().Nil(tstore.Get(k1))
+}
+
 func (s *contextTestSuite) TestLogContext() {
 	key := types.NewKVStoreKey(s.T().Name())
 	ctx := testutil.DefaultContext(key)
@@ -122,6 +143,24 @@ func (s *contextTestSuite) TestContextWithCustom() {
 	s.Require().True(ctx.IsCheckTx())
 	s.Require().True(ctx.IsReCheckTx())
 
+	// test IsReplaceTx
+	s.Require().False(ctx.IsReplaceTx())
+	ctx = ctx.WithIsCheckTx(false)
+	ctx = ctx.WithIsReplaceTx(true)
+	s.Require().True(ctx.IsCheckTx())
+	s.Require().True(ctx.IsReplaceTx())
+
+	// test IsSigVerified
+	s.Require().False(ctx.IsSigVerified())
+	ctx = ctx.WithIsCheckTx(false)
+	ctx = ctx.WithIsSigVerified(true)
+	s.Require().True(ctx.IsCheckTx())
+	s.Require().True(ctx.IsSigVerified())
+
+	// test priority
+	s.Require().Equal(int64(0), ctx.Priority())
+	s.Require().Equal(int64(10), ctx.WithPriority(10).Priority())
+
 	// test consensus param
 	s.Require().Nil(ctx.ConsensusParams())
 	cp := &abci.ConsensusParams{}
@@ -46,6 +46,7 @@ func ResponseCheckTx(err error, gw, gu uint64, debug bool) abci.ResponseCheckTx
 	return abci.ResponseCheckTx{
 		Codespace: space,
 		Code:      code,
+		Data:      abciData(err),
 		Log:       log,
 		GasWanted: int64(gw),
 		GasUsed:   int64(gu),
@@ -59,6 +60,7 @@ func ResponseDeliverTx(err error, gw, gu uint64, debug bool) abci.ResponseDelive
 	return abci.ResponseDeliverTx{
 		Codespace: space,
 		Code:      code,
+		Data:      abciData(err),
 		Log:       log,
 		GasWanted: int64(gw),
 		GasUsed:   int64(gu),
@@ -111,6 +113,36 @@ func abciCode(err error) uint32 {
 	}
 }
 
+// ABCIDataError is implemented by the errors carrying structured details, which
+// are returned as the data of the ABCI responses of the txs failing with them, so
+// that clients don't parse them from the log.
+type ABCIDataError interface {
+	error
+
+	ABCIData() []byte
+}
+
+// abciData returns the data of the ABCIDataError contained in the given error,
+// nil if there is none. This function is testing for the causer interface as
+// well and unwraps the error.
+func abciData(err error) []byte {
+	if errIsNil(err) {
+		return nil
+	}
+
+	for {
+		if d, ok := err.(ABCIDataError); ok {
+			return d.ABCIData()
+		}
+
+		if c, ok := err.(causer); ok {
+			err = c.Cause()
+		} else {
+			return nil
+		}
+	}
+}
+
 type codespacer interface {
 	Codespace() string
 }
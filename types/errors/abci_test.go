@@ -163,6 +163,24 @@ func (s *abciTestSuite) TestABCIInfoHidesStacktrace() {
 	s.Require().Equal("wrapped: unauthorized", log)
 }
 
+// dataError is an ABCIDataError of ErrUnauthorized.
+type dataError struct{}
+
+func (dataError) Error() string    { return "data error" }
+func (dataError) Cause() error     { return ErrUnauthorized }
+func (dataError) ABCIData() []byte { return []byte("details") }
+
+func (s *abciTestSuite) TestResponseData() {
+	res := ResponseCheckTx(Wrap(dataError{}, "foo"), 0, 0, false)
+	s.Require().Equal(ErrUnauthorized.code, res.Code)
+	s.Require().Equal([]byte("details"), res.Data)
+
+	deliverRes := ResponseDeliverTx(dataError{}, 0, 0, false)
+	s.Require().Equal([]byte("details"), deliverRes.Data)
+
+	s.Require().Nil(ResponseCheckTx(Wrap(ErrUnauthorized, "foo"), 0, 0, false).Data)
+}
+
 func (s *abciTestSuite) TestRedact() {
 	cases := map[string]struct {
 		err       error
@@ -142,6 +142,18 @@ var (
 	// ErrNotFound defines an error when requested entity doesn't exist in the state.
 	ErrNotFound = Register(RootCodespace, 39, "not found")
 
+	// ErrInsufficientPriority defines an error when a tx replacing a pending tx
+	// in the mempool doesn't bump its priority enough.
+	ErrInsufficientPriority = Register(RootCodespace, 40, "insufficient priority")
+
+	// ErrTxReplaced defines an error when a tx in the mempool has been replaced
+	// by another tx of the same signer.
+	ErrTxReplaced = Register(RootCodespace, 41, "tx replaced")
+
+	// ErrDuplicateUnorderedTx defines an error when an unordered tx has already
+	// been seen before its timeout height.
+	ErrDuplicateUnorderedTx = Register(RootCodespace, 42, "duplicate unordered tx")
+
 	// ErrPanic is only set when we recover from a panic, so we know to
 	// redact potentially sensitive system info
 	ErrPanic = Register(UndefinedCodespace, 111222, "panic")
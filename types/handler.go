@@ -7,6 +7,11 @@ type Handler func(ctx Context, msg Msg) (*Result, error)
 // If newCtx.IsZero(), ctx is used instead.
 type AnteHandler func(ctx Context, tx Tx, simulate bool) (newCtx Context, err error)
 
+// SigVerifyTxsHandler verifies the signatures of txs ahead of their CheckTx,
+// without access to the state. It returns whether all the signatures of each tx
+// have been verified, for the AnteHandler not to verify them again.
+type SigVerifyTxsHandler func(ctx Context, txs []Tx) []bool
+
 // AnteDecorator wraps the next AnteHandler to perform custom pre- and post-processing.
 type AnteDecorator interface {
 	AnteHandle(ctx Context, tx Tx, simulate bool, next AnteHandler) (newCtx Context, err error)
@@ -20,6 +20,7 @@ type (
 	MultiStorePersistentCache = types.MultiStorePersistentCache
 	KVStore                   = types.KVStore
 	Iterator                  = types.Iterator
+	WriteListener             = types.WriteListener
 )
 
 // StoreDecoderRegistry defines each of the modules store decoders. Used for ImportExport
@@ -65,17 +66,19 @@ type (
 type StoreType = types.StoreType
 
 const (
-	StoreTypeMulti  = types.StoreTypeMulti
-	StoreTypeDB     = types.StoreTypeDB
-	StoreTypeIAVL   = types.StoreTypeIAVL
-	StoreTypeMemory = types.StoreTypeMemory
+	StoreTypeMulti     = types.StoreTypeMulti
+	StoreTypeDB        = types.StoreTypeDB
+	StoreTypeIAVL      = types.StoreTypeIAVL
+	StoreTypeMemory    = types.StoreTypeMemory
+	StoreTypeTransient = types.StoreTypeTransient
 )
 
 type (
-	StoreKey       = types.StoreKey
-	CapabilityKey  = types.CapabilityKey
-	KVStoreKey     = types.KVStoreKey
-	MemoryStoreKey = types.MemoryStoreKey
+	StoreKey          = types.StoreKey
+	CapabilityKey     = types.CapabilityKey
+	KVStoreKey        = types.KVStoreKey
+	MemoryStoreKey    = types.MemoryStoreKey
+	TransientStoreKey = types.TransientStoreKey
 )
 
 // NewKVStoreKey returns a new pointer to a KVStoreKey.
@@ -95,6 +98,23 @@ func NewKVStoreKeys(names ...string) map[string]*KVStoreKey {
 	return keys
 }
 
+// NewTransientStoreKey returns a new pointer to a TransientStoreKey.
+// Use a pointer so keys don't collide.
+func NewTransientStoreKey(name string) *TransientStoreKey {
+	return types.NewTransientStoreKey(name)
+}
+
+// NewTransientStoreKeys constructs a new map of TransientStoreKey's
+// Must return pointers according to the ocap principle
+func NewTransientStoreKeys(names ...string) map[string]*TransientStoreKey {
+	keys := make(map[string]*TransientStoreKey)
+	for _, name := range names {
+		keys[name] = NewTransientStoreKey(name)
+	}
+
+	return keys
+}
+
 // NewMemoryStoreKeys constructs a new map matching store key names to their
 // respective MemoryStoreKey references.
 func NewMemoryStoreKeys(names ...string) map[string]*MemoryStoreKey {
@@ -34,9 +34,9 @@ const (
 	// SIGN_MODE_DIRECT specifies a signing mode which uses SignDoc and is
 	// verified with raw bytes from Tx
 	SignMode_SIGN_MODE_DIRECT SignMode = 1
-	// SIGN_MODE_TEXTUAL is a future signing mode that will verify some
-	// human-readable textual representation on top of the binary representation
-	// from SIGN_MODE_DIRECT
+	// SIGN_MODE_TEXTUAL specifies a signing mode which verifies a human-readable
+	// textual representation of the tx, on top of the hash of its binary
+	// representation from SIGN_MODE_DIRECT
 	SignMode_SIGN_MODE_TEXTUAL SignMode = 2
 	// SIGN_MODE_LEGACY_AMINO_JSON is a backwards compatibility mode which uses
 	// Amino JSON and will be removed in the future
@@ -250,6 +250,13 @@ type TxBody struct {
 	// timeout is the block height after which this transaction will not
 	// be processed by the chain
 	TimeoutHeight uint64 `protobuf:"varint,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	// unordered, when set to true, indicates that the transaction may be included
+	// in any order relative to the other transactions of its signers. The
+	// sequences of its signatures are not checked against the accounts of the
+	// signers nor incremented, and serve as nonces. An unordered transaction
+	// must set a timeout_height at most valid_sig_block_period blocks ahead, and
+	// is rejected if a transaction of the same hash was seen before it timed out.
+	Unordered bool `protobuf:"varint,4,opt,name=unordered,proto3" json:"unordered,omitempty"`
 	// extension_options are arbitrary options that can be added by chains
 	// when the default options are not sufficient. If any of these are present
 	// and can't be handled, the transaction will be rejected
@@ -314,6 +321,13 @@ func (m *TxBody) GetTimeoutHeight() uint64 {
 	return 0
 }
 
+func (m *TxBody) GetUnordered() bool {
+	if m != nil {
+		return m.Unordered
+	}
+	return false
+}
+
 func (m *TxBody) GetExtensionOptions() []*types.Any {
 	if m != nil {
 		return m.ExtensionOptions
@@ -759,60 +773,60 @@ func init() {
 func init() { proto.RegisterFile("lbm/tx/v1/tx.proto", fileDescriptor_f80bf4f3a3e5da31) }
 
 var fileDescriptor_f80bf4f3a3e5da31 = []byte{
-	// 835 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0x1c, 0x45,
-	0x10, 0xde, 0xd9, 0x3f, 0xef, 0x96, 0x1d, 0x27, 0x69, 0x0c, 0x5a, 0xaf, 0xc5, 0x7a, 0xb5, 0xc8,
-	0xb0, 0x12, 0x62, 0x26, 0x76, 0x38, 0x00, 0x37, 0xaf, 0x21, 0x72, 0x04, 0x11, 0xd2, 0xd8, 0xe2,
-	0x90, 0xcb, 0xa8, 0x67, 0xb6, 0xdd, 0xdb, 0xf2, 0x4c, 0xf7, 0x32, 0xdd, 0x63, 0x76, 0x0e, 0x48,
-	0xbc, 0x01, 0xb9, 0xf0, 0x12, 0x79, 0x02, 0x1e, 0x21, 0xc7, 0x1c, 0x39, 0x01, 0xb2, 0x1f, 0x04,
-	0xd4, 0x3f, 0x33, 0x18, 0xb4, 0x31, 0x97, 0xdc, 0xaa, 0xaa, 0xbf, 0xaa, 0xaf, 0xe6, 0x9b, 0xaa,
-	0x02, 0x94, 0xc6, 0x59, 0xa0, 0x56, 0xc1, 0xd5, 0x61, 0xa0, 0x56, 0xfe, 0x32, 0x17, 0x4a, 0xa0,
-	0x7e, 0x1a, 0x67, 0xbe, 0x5a, 0xf9, 0x57, 0x87, 0xc3, 0x1d, 0x2a, 0xa8, 0x30, 0xd1, 0x40, 0x5b,
-	0x16, 0x30, 0x3c, 0xd0, 0x49, 0x49, 0x5e, 0x2e, 0x95, 0x08, 0xb2, 0x22, 0x55, 0x4c, 0x32, 0xaa,
-	0x2b, 0x54, 0xb6, 0x83, 0xbd, 0xa7, 0x61, 0x31, 0x96, 0x44, 0xbf, 0x25, 0x82, 0x71, 0x17, 0xdf,
-	0x77, 0x9c, 0x92, 0x51, 0xce, 0xb8, 0xc9, 0x74, 0xa6, 0x03, 0xec, 0x52, 0x21, 0x68, 0x4a, 0x02,
-	0xe3, 0xc5, 0xc5, 0x45, 0x80, 0x79, 0x69, 0x9f, 0x26, 0x3f, 0x42, 0xf3, 0x7c, 0x85, 0x0e, 0xa0,
-	0x1d, 0x8b, 0x79, 0x39, 0xf0, 0xc6, 0xde, 0x74, 0xf3, 0xe8, 0xa1, 0x5f, 0x37, 0xec, 0x9f, 0xaf,
-	0x66, 0x62, 0x5e, 0x86, 0xe6, 0x19, 0x3d, 0x82, 0x3e, 0x2e, 0xd4, 0x22, 0x62, 0xfc, 0x42, 0x0c,
-	0x9a, 0x06, 0xfb, 0xce, 0x2d, 0xec, 0x71, 0xa1, 0x16, 0x4f, 0xf9, 0x85, 0x08, 0x7b, 0xd8, 0x59,
-	0x68, 0x04, 0xa0, 0x5b, 0xc1, 0xaa, 0xc8, 0x89, 0x1c, 0xb4, 0xc6, 0xad, 0xe9, 0x56, 0x78, 0x2b,
-	0x32, 0xe1, 0xd0, 0x39, 0x5f, 0x85, 0xf8, 0x07, 0xf4, 0x3e, 0x80, 0xa6, 0x88, 0xe2, 0x52, 0x11,
-	0x69, 0xfa, 0xd8, 0x0a, 0xfb, 0x3a, 0x32, 0xd3, 0x01, 0xf4, 0x21, 0xdc, 0xaf, 0x99, 0x1d, 0xa6,
-	0x69, 0x30, 0xf7, 0x2a, 0x2a, 0x8b, 0xfb, 0x3f, 0xbe, 0x4b, 0xd8, 0x38, 0x63, 0x94, 0x7f, 0x29,
-	0x92, 0xb7, 0xc5, 0xb8, 0x0b, 0xbd, 0x64, 0x81, 0x19, 0x8f, 0xd8, 0x7c, 0xd0, 0x1a, 0x7b, 0xd3,
-	0x7e, 0xb8, 0x61, 0xfc, 0xa7, 0xf3, 0xc9, 0x2f, 0x4d, 0xe8, 0x5a, 0xfd, 0xd0, 0x23, 0xe8, 0x65,
-	0x44, 0x4a, 0x4c, 0x0d, 0x55, 0x6b, 0xba, 0x79, 0xb4, 0xe3, 0xdb, 0x9f, 0xe2, 0x57, 0x3f, 0xc5,
-	0x3f, 0xe6, 0x65, 0x58, 0xa3, 0x10, 0x82, 0x76, 0x46, 0x32, 0x2b, 0x73, 0x3f, 0x34, 0x36, 0x3a,
-	0x80, 0x6d, 0xc5, 0x32, 0x22, 0x0a, 0x15, 0x2d, 0x08, 0xa3, 0x0b, 0x65, 0x18, 0xdb, 0xe1, 0x3d,
-	0x17, 0x3d, 0x35, 0x41, 0x34, 0x83, 0x87, 0x64, 0xa5, 0x08, 0x97, 0x4c, 0xf0, 0x48, 0x2c, 0x15,
-	0x13, 0x5c, 0x0e, 0xfe, 0xda, 0xb8, 0x83, 0xf6, 0x41, 0x8d, 0xff, 0xd6, 0xc2, 0xd1, 0x73, 0x18,
-	0x71, 0xc1, 0xa3, 0x24, 0x67, 0x8a, 0x25, 0x38, 0x8d, 0xd6, 0x14, 0xbc, 0x7f, 0x47, 0xc1, 0x3d,
-	0x2e, 0xf8, 0x89, 0xcb, 0xfd, 0xea, 0x3f, 0xb5, 0x27, 0x2f, 0x3c, 0xe8, 0x55, 0xb3, 0x82, 0x3e,
-	0x83, 0x2d, 0xfd, 0x7f, 0x48, 0x6e, 0x94, 0xae, 0xd4, 0x79, 0xf7, 0xd6, 0x58, 0x9d, 0x99, 0x67,
-	0x33, 0x58, 0x9b, 0xb2, 0xb6, 0x25, 0x1a, 0x43, 0xeb, 0x82, 0x10, 0x37, 0x87, 0xdb, 0xb7, 0x12,
-	0x9e, 0x10, 0x12, 0xea, 0x27, 0x34, 0x85, 0x07, 0x92, 0xd1, 0x28, 0x4e, 0x45, 0x72, 0xf9, 0x6f,
-	0xc5, 0xb6, 0x25, 0xa3, 0x33, 0x1d, 0xb6, 0x92, 0x4d, 0x7e, 0xf6, 0x00, 0xfe, 0xe1, 0x41, 0x8f,
-	0x01, 0x96, 0x45, 0x9c, 0xb2, 0x24, 0xba, 0x24, 0xd5, 0x56, 0xac, 0xff, 0xd0, 0xbe, 0xc5, 0x7d,
-	0x4d, 0xcc, 0x76, 0x64, 0x62, 0x4e, 0xde, 0xb4, 0x1d, 0xcf, 0xc4, 0x9c, 0xd8, 0xed, 0xc8, 0x9c,
-	0x85, 0x86, 0xd0, 0x93, 0xe4, 0xfb, 0x82, 0xf0, 0x84, 0xb8, 0xbe, 0x6a, 0x7f, 0xf2, 0x6b, 0x13,
-	0x7a, 0x55, 0x0a, 0xfa, 0x14, 0xba, 0x92, 0x71, 0x9a, 0x12, 0xd7, 0xcb, 0x70, 0x4d, 0x5d, 0xff,
-	0xcc, 0x20, 0x4e, 0x1b, 0xa1, 0xc3, 0xa2, 0x43, 0xe8, 0x98, 0x0b, 0xe2, 0x9a, 0xd9, 0x5d, 0x97,
-	0xf4, 0x4c, 0x03, 0x4e, 0x1b, 0xa1, 0x45, 0x0e, 0x3f, 0x87, 0xae, 0x2d, 0x83, 0x02, 0x68, 0xeb,
-	0x3e, 0x0d, 0xe1, 0xf6, 0xd1, 0x5e, 0x95, 0x5b, 0x1d, 0x16, 0xf7, 0x5f, 0x74, 0x9d, 0xd0, 0x00,
-	0x87, 0x3f, 0x79, 0xd0, 0x31, 0xd5, 0xd0, 0x09, 0xf4, 0x62, 0xa6, 0x70, 0x9e, 0xe3, 0x4a, 0xbb,
-	0x8f, 0x4c, 0xba, 0xbd, 0x70, 0x7e, 0x7d, 0xd5, 0xae, 0x0e, 0xfd, 0x13, 0x91, 0x2d, 0x71, 0xa2,
-	0x66, 0x4c, 0x1d, 0x6b, 0x78, 0x58, 0x27, 0xa2, 0x23, 0x80, 0x5a, 0x4d, 0xbd, 0x7a, 0xad, 0x37,
-	0xc9, 0xd9, 0xaf, 0xe4, 0x94, 0xb3, 0x0e, 0xb4, 0x64, 0x91, 0x4d, 0x5e, 0x7a, 0xd0, 0x7a, 0x42,
-	0x08, 0xfa, 0x0e, 0xba, 0x38, 0x13, 0x05, 0x57, 0x6e, 0xa8, 0xec, 0x5d, 0xd3, 0x07, 0xd4, 0x52,
-	0x33, 0x3e, 0xfb, 0xf8, 0xd5, 0xef, 0xfb, 0x8d, 0x97, 0x7f, 0xec, 0x7f, 0x40, 0x99, 0x5a, 0x14,
-	0xb1, 0x9f, 0x88, 0x2c, 0x48, 0x19, 0x27, 0x41, 0x1a, 0x67, 0x9f, 0xc8, 0xf9, 0x65, 0xa0, 0xca,
-	0x25, 0x91, 0x06, 0x2b, 0x43, 0x57, 0x0d, 0xed, 0x41, 0x9f, 0x62, 0x19, 0xa5, 0x2c, 0x63, 0xca,
-	0x68, 0xdb, 0x0e, 0x7b, 0x14, 0xcb, 0x6f, 0xb4, 0x8f, 0x76, 0xa0, 0xb3, 0xc4, 0x25, 0xc9, 0xdd,
-	0x31, 0xb0, 0x0e, 0x1a, 0xc0, 0x06, 0xcd, 0x31, 0x57, 0x24, 0x1f, 0xb4, 0xed, 0x91, 0x70, 0xee,
-	0xec, 0x8b, 0x57, 0xd7, 0x23, 0xef, 0xf5, 0xf5, 0xc8, 0xfb, 0xf3, 0x7a, 0xe4, 0xbd, 0xb8, 0x19,
-	0x35, 0x5e, 0xdf, 0x8c, 0x1a, 0xbf, 0xdd, 0x8c, 0x1a, 0xcf, 0xc7, 0x77, 0xf6, 0x14, 0xa8, 0x55,
-	0xdc, 0x35, 0xa3, 0xf8, 0xf8, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x34, 0x82, 0xea, 0x2b, 0x75,
-	0x06, 0x00, 0x00,
+	// 847 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x5f, 0xef, 0xbf, 0xd8, 0x2f, 0x69, 0xda, 0x0e, 0x01, 0x6d, 0x36, 0xb0, 0x59, 0x2d, 0x0a,
+	0xac, 0x84, 0xb0, 0x9b, 0x94, 0x03, 0x70, 0xcb, 0x06, 0xaa, 0x54, 0x50, 0x21, 0x4d, 0x22, 0x0e,
+	0xbd, 0x58, 0x63, 0x7b, 0xe2, 0x1d, 0xc5, 0x9e, 0x59, 0x3c, 0xe3, 0xb0, 0x7b, 0x40, 0xe2, 0x1b,
+	0xd0, 0xcf, 0xd1, 0x2b, 0x17, 0x3e, 0x42, 0x8f, 0x3d, 0x72, 0x02, 0x94, 0x7c, 0x10, 0xd0, 0x8c,
+	0xc7, 0x6e, 0x40, 0x49, 0xb8, 0xf4, 0xf6, 0xde, 0x9b, 0xdf, 0x7b, 0xbf, 0x37, 0xef, 0x1f, 0xa0,
+	0x2c, 0xca, 0x03, 0xb5, 0x0c, 0x2e, 0xf6, 0x03, 0xb5, 0xf4, 0x17, 0x85, 0x50, 0x02, 0x79, 0x59,
+	0x94, 0xfb, 0x6a, 0xe9, 0x5f, 0xec, 0x0f, 0xb7, 0x52, 0x91, 0x0a, 0x63, 0x0d, 0xb4, 0x54, 0x01,
+	0x86, 0x7b, 0xda, 0x29, 0x2e, 0x56, 0x0b, 0x25, 0x82, 0xbc, 0xcc, 0x14, 0x93, 0x2c, 0xd5, 0x11,
+	0x6a, 0xd9, 0xc2, 0xde, 0xd3, 0xb0, 0x88, 0x48, 0xaa, 0xdf, 0x62, 0xc1, 0xb8, 0xb5, 0xef, 0x5a,
+	0x4e, 0xc9, 0x52, 0xce, 0xb8, 0xf1, 0xb4, 0xa2, 0x05, 0x6c, 0xa7, 0x42, 0xa4, 0x19, 0x0d, 0x8c,
+	0x16, 0x95, 0x67, 0x01, 0xe1, 0xab, 0xea, 0x69, 0xf2, 0x13, 0xb4, 0x4f, 0x97, 0x68, 0x0f, 0xba,
+	0x91, 0x48, 0x56, 0x03, 0x67, 0xec, 0x4c, 0xd7, 0x0f, 0x1e, 0xfa, 0x4d, 0xc2, 0xfe, 0xe9, 0x72,
+	0x26, 0x92, 0x15, 0x36, 0xcf, 0xe8, 0x11, 0x78, 0xa4, 0x54, 0xf3, 0x90, 0xf1, 0x33, 0x31, 0x68,
+	0x1b, 0xec, 0x3b, 0xd7, 0xb0, 0x87, 0xa5, 0x9a, 0x3f, 0xe5, 0x67, 0x02, 0xbb, 0xc4, 0x4a, 0x68,
+	0x04, 0xa0, 0x53, 0x21, 0xaa, 0x2c, 0xa8, 0x1c, 0x74, 0xc6, 0x9d, 0xe9, 0x06, 0xbe, 0x66, 0x99,
+	0x70, 0xe8, 0x9d, 0x2e, 0x31, 0xf9, 0x11, 0x7d, 0x00, 0xa0, 0x29, 0xc2, 0x68, 0xa5, 0xa8, 0x34,
+	0x79, 0x6c, 0x60, 0x4f, 0x5b, 0x66, 0xda, 0x80, 0x3e, 0x82, 0xfb, 0x0d, 0xb3, 0xc5, 0xb4, 0x0d,
+	0xe6, 0x5e, 0x4d, 0x55, 0xe1, 0xfe, 0x8f, 0xef, 0x1c, 0xd6, 0x4e, 0x58, 0xca, 0xbf, 0x12, 0xf1,
+	0xdb, 0x62, 0xdc, 0x06, 0x37, 0x9e, 0x13, 0xc6, 0x43, 0x96, 0x0c, 0x3a, 0x63, 0x67, 0xea, 0xe1,
+	0x35, 0xa3, 0x3f, 0x4d, 0x26, 0xbf, 0xb6, 0xa1, 0x5f, 0xd5, 0x0f, 0x3d, 0x02, 0x37, 0xa7, 0x52,
+	0x92, 0xd4, 0x50, 0x75, 0xa6, 0xeb, 0x07, 0x5b, 0x7e, 0xd5, 0x14, 0xbf, 0x6e, 0x8a, 0x7f, 0xc8,
+	0x57, 0xb8, 0x41, 0x21, 0x04, 0xdd, 0x9c, 0xe6, 0x55, 0x99, 0x3d, 0x6c, 0x64, 0xb4, 0x07, 0x9b,
+	0x8a, 0xe5, 0x54, 0x94, 0x2a, 0x9c, 0x53, 0x96, 0xce, 0x95, 0x61, 0xec, 0xe2, 0x7b, 0xd6, 0x7a,
+	0x6c, 0x8c, 0xe8, 0x7d, 0xf0, 0x4a, 0x2e, 0x8a, 0x84, 0x16, 0x34, 0x19, 0x74, 0xc7, 0xce, 0xd4,
+	0xc5, 0x6f, 0x0c, 0x68, 0x06, 0x0f, 0xe9, 0x52, 0x51, 0x2e, 0x99, 0xe0, 0xa1, 0x58, 0x28, 0x26,
+	0xb8, 0x1c, 0xfc, 0xbd, 0x76, 0x47, 0x52, 0x0f, 0x1a, 0xfc, 0x77, 0x15, 0x1c, 0x3d, 0x87, 0x11,
+	0x17, 0x3c, 0x8c, 0x0b, 0xa6, 0x58, 0x4c, 0xb2, 0xf0, 0x86, 0x80, 0xf7, 0xef, 0x08, 0xb8, 0xc3,
+	0x05, 0x3f, 0xb2, 0xbe, 0x5f, 0xff, 0x27, 0xf6, 0xe4, 0x85, 0x03, 0x6e, 0x3d, 0x49, 0xe8, 0x73,
+	0xd8, 0xd0, 0xdd, 0xa3, 0x85, 0xe9, 0x43, 0x5d, 0xbb, 0x77, 0xaf, 0x0d, 0xdd, 0x89, 0x79, 0x36,
+	0x63, 0xb7, 0x2e, 0x1b, 0x59, 0xa2, 0x31, 0x74, 0xce, 0x28, 0xb5, 0x53, 0xba, 0x79, 0xcd, 0xe1,
+	0x09, 0xa5, 0x58, 0x3f, 0xa1, 0x29, 0x3c, 0x90, 0x2c, 0x0d, 0xa3, 0x4c, 0xc4, 0xe7, 0xff, 0xae,
+	0xe7, 0xa6, 0x64, 0xe9, 0x4c, 0x9b, 0xab, 0x82, 0x4e, 0x7e, 0x71, 0x00, 0xde, 0xf0, 0xa0, 0xc7,
+	0x00, 0x8b, 0x32, 0xca, 0x58, 0x1c, 0x9e, 0xd3, 0x7a, 0x67, 0x6e, 0xfe, 0xa8, 0x57, 0xe1, 0xbe,
+	0xa1, 0x66, 0x77, 0x72, 0x91, 0xd0, 0xdb, 0x76, 0xe7, 0x99, 0x48, 0x68, 0xb5, 0x3b, 0xb9, 0x95,
+	0xd0, 0x10, 0x5c, 0x49, 0x7f, 0x28, 0x29, 0x8f, 0xa9, 0xcd, 0xab, 0xd1, 0x27, 0xbf, 0xb5, 0xc1,
+	0xad, 0x5d, 0xd0, 0x67, 0xd0, 0x97, 0x8c, 0xa7, 0x19, 0xb5, 0xb9, 0x0c, 0x6f, 0x88, 0xeb, 0x9f,
+	0x18, 0xc4, 0x71, 0x0b, 0x5b, 0x2c, 0xda, 0x87, 0x9e, 0xb9, 0x2f, 0x36, 0x99, 0xed, 0x9b, 0x9c,
+	0x9e, 0x69, 0xc0, 0x71, 0x0b, 0x57, 0xc8, 0xe1, 0x17, 0xd0, 0xaf, 0xc2, 0xa0, 0x00, 0xba, 0x3a,
+	0x4f, 0x43, 0xb8, 0x79, 0xb0, 0x53, 0xfb, 0xd6, 0x67, 0xc7, 0xf6, 0x45, 0xc7, 0xc1, 0x06, 0x38,
+	0xfc, 0xd9, 0x81, 0x9e, 0x89, 0x86, 0x8e, 0xc0, 0x8d, 0x98, 0x22, 0x45, 0x41, 0xea, 0xda, 0x7d,
+	0x6c, 0xdc, 0xab, 0xfb, 0xe7, 0x37, 0x37, 0xef, 0x62, 0xdf, 0x3f, 0x12, 0xf9, 0x82, 0xc4, 0x6a,
+	0xc6, 0xd4, 0xa1, 0x86, 0xe3, 0xc6, 0x11, 0x1d, 0x00, 0x34, 0xd5, 0xd4, 0x8b, 0xd9, 0xb9, 0xad,
+	0x9c, 0x5e, 0x5d, 0x4e, 0x39, 0xeb, 0x41, 0x47, 0x96, 0xf9, 0xe4, 0xa5, 0x03, 0x9d, 0x27, 0x94,
+	0xa2, 0xef, 0xa1, 0x4f, 0x72, 0x51, 0x72, 0x65, 0x87, 0xaa, 0xba, 0x7a, 0xfa, 0xbc, 0x56, 0xd4,
+	0x8c, 0xcf, 0x3e, 0x79, 0xf5, 0xc7, 0x6e, 0xeb, 0xe5, 0x9f, 0xbb, 0x1f, 0xa6, 0x4c, 0xcd, 0xcb,
+	0xc8, 0x8f, 0x45, 0x1e, 0x64, 0x8c, 0xd3, 0x20, 0x8b, 0xf2, 0x4f, 0x65, 0x72, 0x1e, 0xa8, 0xd5,
+	0x82, 0x4a, 0x83, 0x95, 0xd8, 0x46, 0x43, 0x3b, 0xe0, 0xa5, 0x44, 0x86, 0x19, 0xcb, 0x99, 0x32,
+	0xb5, 0xed, 0x62, 0x37, 0x25, 0xf2, 0x5b, 0xad, 0xa3, 0x2d, 0xe8, 0x2d, 0xc8, 0x8a, 0x16, 0xf6,
+	0x54, 0x54, 0x0a, 0x1a, 0xc0, 0x5a, 0x5a, 0x10, 0xae, 0x68, 0x61, 0xd6, 0xd5, 0xc3, 0xb5, 0x3a,
+	0xfb, 0xf2, 0xd5, 0xe5, 0xc8, 0x79, 0x7d, 0x39, 0x72, 0xfe, 0xba, 0x1c, 0x39, 0x2f, 0xae, 0x46,
+	0xad, 0xd7, 0x57, 0xa3, 0xd6, 0xef, 0x57, 0xa3, 0xd6, 0xf3, 0xf1, 0x9d, 0x39, 0x05, 0x6a, 0x19,
+	0xf5, 0xcd, 0x28, 0x3e, 0xfe, 0x67, 0x00, 0x30, 0x47, 0xa2, 0xfe, 0x93, 0x06, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -1013,6 +1027,16 @@ func (m *TxBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xfa
 		}
 	}
+	if m.Unordered {
+		i--
+		if m.Unordered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.TimeoutHeight != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutHeight))
 		i--
@@ -1452,6 +1476,9 @@ func (m *TxBody) Size() (n int) {
 	if m.TimeoutHeight != 0 {
 		n += 1 + sovTx(uint64(m.TimeoutHeight))
 	}
+	if m.Unordered {
+		n += 2
+	}
 	if len(m.ExtensionOptions) > 0 {
 		for _, e := range m.ExtensionOptions {
 			l = e.Size()
@@ -2176,6 +2203,26 @@ func (m *TxBody) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unordered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unordered = bool(v != 0)
 		case 1023:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionOptions", wireType)
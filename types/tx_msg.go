@@ -81,6 +81,14 @@ type (
 
 		GetTimeoutHeight() uint64
 	}
+
+	// TxWithUnordered extends the Tx interface by allowing a transaction to be
+	// included regardless of the sequences of its signers.
+	TxWithUnordered interface {
+		TxWithTimeoutHeight
+
+		GetUnordered() bool
+	}
 )
 
 // TxDecoder unmarshals transaction bytes
@@ -11,12 +11,13 @@ import (
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & sig block height, and deducts fees from the first
-// signer.
+// signer. Unordered txs are rejected unless the ak implements UnorderedTxKeeper.
 func NewAnteHandler(
 	ak AccountKeeper, bankKeeper types.BankKeeper, feegrantKeeper keeper.Keeper,
 	sigGasConsumer SignatureVerificationGasConsumer,
 	signModeHandler signing.SignModeHandler,
 ) sdk.AnteHandler {
+	unorderedTxKeeper, _ := ak.(UnorderedTxKeeper)
 	return sdk.ChainAnteDecorators(
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		NewRejectExtensionOptionsDecorator(),
@@ -24,6 +25,7 @@ func NewAnteHandler(
 		NewValidateBasicDecorator(),
 		NewTxSigBlockHeightDecorator(ak),
 		TxTimeoutHeightDecorator{},
+		NewUnorderedTxDecorator(unorderedTxKeeper),
 		NewValidateMemoDecorator(ak),
 		NewConsumeGasForTxSizeDecorator(ak),
 		NewDeductGrantedFeeDecorator(ak.(keeper2.AccountKeeper), bankKeeper.(types2.BankKeeper), feegrantKeeper),
@@ -9,6 +9,7 @@ import (
 	"github.com/line/lbm-sdk/types/tx/signing"
 	"github.com/line/lbm-sdk/x/auth/legacy/legacytx"
 	authsigning "github.com/line/lbm-sdk/x/auth/signing"
+	"github.com/line/lbm-sdk/x/auth/types"
 )
 
 // ValidateBasicDecorator will call tx.ValidateBasic and return any non-nil error.
@@ -216,15 +217,9 @@ func (txs TxSigBlockHeightDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 		params := txs.ak.GetParams(ctx)
 		sbh := tx.GetSigBlockHeight()
 		current := uint64(ctx.BlockHeight())
-		validMin := uint64(0)
-		if current > params.ValidSigBlockPeriod {
-			validMin = current - params.ValidSigBlockPeriod
-		}
-		if sbh > uint64(ctx.BlockHeight()) || sbh < validMin {
-			return ctx, sdkerrors.Wrapf(
-				sdkerrors.ErrInvalidSigBlockHeight, "sig block height: %d, current: %d, valid sig block period: %d",
-				sbh, ctx.BlockHeight(), params.ValidSigBlockPeriod,
-			)
+		validMin, validMax := params.SigBlockHeightWindow(current)
+		if sbh > validMax || sbh < validMin {
+			return ctx, types.NewSigBlockHeightError(sbh, current, validMin, validMax)
 		}
 	}
 	return next(ctx, tx, simulate)
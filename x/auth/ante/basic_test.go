@@ -1,14 +1,17 @@
 package ante_test
 
 import (
+	"errors"
 	"strings"
 
 	cryptotypes "github.com/line/lbm-sdk/crypto/types"
 	"github.com/line/lbm-sdk/crypto/types/multisig"
 	"github.com/line/lbm-sdk/testutil/testdata"
 	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
 	"github.com/line/lbm-sdk/types/tx/signing"
 	"github.com/line/lbm-sdk/x/auth/ante"
+	"github.com/line/lbm-sdk/x/auth/types"
 )
 
 func (suite *AnteTestSuite) TestValidateBasic() {
@@ -222,3 +225,74 @@ func (suite *AnteTestSuite) TestTxHeightTimeoutDecorator() {
 		})
 	}
 }
+
+func (suite *AnteTestSuite) TestTxSigBlockHeightDecorator() {
+	suite.SetupTest(true)
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewTxSigBlockHeightDecorator(suite.app.AccountKeeper))
+	period := suite.app.AccountKeeper.GetParams(suite.ctx).ValidSigBlockPeriod
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+
+	testCases := []struct {
+		name       string
+		sbh        uint64
+		height     int64
+		expErr     *types.SigBlockHeightError
+		expExpired bool
+	}{
+		{"current height", 5000, 5000, nil, false},
+		{"oldest accepted", 5000 - period, 5000, nil, false},
+		{"expired", 5000 - period - 1, 5000, types.NewSigBlockHeightError(5000-period-1, 5000, 5000-period, 5000), true},
+		{"ahead of the current height", 5001, 5000, types.NewSigBlockHeightError(5001, 5000, 5000-period, 5000), false},
+		{"zero at the first period", 0, 10, nil, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+			suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+
+			suite.txBuilder.SetFeeAmount(feeAmount)
+			suite.txBuilder.SetGasLimit(gasLimit)
+
+			privs, sbhs, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{tc.sbh}, []uint64{0}
+			tx, err := suite.CreateTestTx(privs, sbhs, accSeqs, suite.ctx.ChainID())
+			suite.Require().NoError(err)
+
+			ctx := suite.ctx.WithBlockHeight(tc.height)
+			_, err = antehandler(ctx, tx, false)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				return
+			}
+
+			suite.Require().True(sdkerrors.ErrInvalidSigBlockHeight.Is(err))
+			var sbhErr *types.SigBlockHeightError
+			suite.Require().True(errors.As(err, &sbhErr))
+			suite.Require().Equal(tc.expErr, sbhErr)
+			suite.Require().Equal(tc.expExpired, sbhErr.Expired())
+
+			res := sdkerrors.ResponseCheckTx(err, 0, 0, false)
+			suite.Require().Equal(sdkerrors.ErrInvalidSigBlockHeight.Codespace(), res.Codespace)
+			suite.Require().Equal(sdkerrors.ErrInvalidSigBlockHeight.ABCICode(), res.Code)
+
+			// the details are read by clients from the data of the response
+			resErr, err := types.SigBlockHeightErrorFromABCIData(res.Data)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expErr, resErr)
+			suite.Require().Equal(uint64(tc.height), resErr.Height)
+			suite.Require().Equal(5000-period, resErr.MinSigBlockHeight)
+			suite.Require().Equal(uint64(5000), resErr.MaxSigBlockHeight)
+		})
+	}
+}
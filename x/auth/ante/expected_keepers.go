@@ -14,3 +14,11 @@ type AccountKeeper interface {
 	SetAccount(ctx sdk.Context, acc types.AccountI)
 	GetModuleAddress(moduleName string) sdk.AccAddress
 }
+
+// UnorderedTxKeeper defines the contract needed to track the unordered txs seen
+// until their timeout height.
+type UnorderedTxKeeper interface {
+	GetParams(ctx sdk.Context) (params types.Params)
+	ContainsUnorderedTx(ctx sdk.Context, txHash []byte) bool
+	AddUnorderedTx(ctx sdk.Context, txHash []byte, timeoutHeight uint64)
+}
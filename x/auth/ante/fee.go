@@ -2,6 +2,7 @@ package ante
 
 import (
 	"fmt"
+	"math"
 
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
@@ -13,6 +14,8 @@ import (
 // If fee is too low, decorator returns error and tx is rejected from mempool.
 // Note this only applies when ctx.CheckTx = true
 // If fee is high enough or not CheckTx, then call next AnteHandler
+// It also sets the priority of the tx in the mempool to its gas price, see
+// getTxPriority.
 // CONTRACT: Tx must implement FeeTx to use MempoolFeeDecorator
 type MempoolFeeDecorator struct{}
 
@@ -51,7 +54,39 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 		}
 	}
 
-	return next(ctx, tx, simulate)
+	newCtx = ctx.WithPriority(getTxPriority(feeCoins, gas))
+
+	return next(newCtx, tx, simulate)
+}
+
+// txPriorityScale is the factor gas prices are scaled by to make integer tx
+// priorities, so that gas prices below one per gas unit still order txs.
+const txPriorityScale = 1000000
+
+// maxPriorityGasPrice is the gas price above which txs get the highest priority.
+var maxPriorityGasPrice = sdk.NewDec(math.MaxInt64).QuoInt64(txPriorityScale)
+
+// getTxPriority returns the gas price of a tx in its fee denom paying the least,
+// scaled by txPriorityScale and truncated to an integer, as the priority of the
+// tx.
+func getTxPriority(fee sdk.Coins, gas uint64) int64 {
+	if gas == 0 {
+		return 0
+	}
+
+	var priority int64
+	for i, coin := range fee {
+		p := int64(math.MaxInt64)
+		gasPrice := coin.Amount.ToDec().QuoInt(sdk.NewIntFromUint64(gas))
+		if gasPrice.LT(maxPriorityGasPrice) {
+			p = gasPrice.MulInt64(txPriorityScale).TruncateInt64()
+		}
+		if i == 0 || p < priority {
+			priority = p
+		}
+	}
+
+	return priority
 }
 
 // DeductFeeDecorator deducts fees from the first signer of the tx
@@ -84,6 +119,10 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	feePayer := feeTx.FeePayer()
 	feePayerAcc := dfd.ak.NewAccountWithAddress(ctx, feePayer)
 
+	if err := RefundReplacedTxFees(dfd.bankKeeper, ctx); err != nil {
+		return ctx, err
+	}
+
 	// deduct the fees
 	if !feeTx.GetFee().IsZero() {
 		err = DeductFees(dfd.bankKeeper, ctx, feePayerAcc, feeTx.GetFee())
@@ -95,16 +134,50 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	return next(ctx, tx, simulate)
 }
 
-// DeductFees deducts fees from the given account.
+// DeductFees deducts fees from the given account. If the DeferredFees param of the bank
+// module is set, the fees are credited to the fee collector at the end of the block, so
+// that txs of different fee payers don't conflict with each other.
 func DeductFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc types.AccountI, fees sdk.Coins) error {
 	if !fees.IsValid() {
 		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "invalid fee amount: %s", fees)
 	}
 
-	err := bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), types.FeeCollectorName, fees)
+	sendCoins := bankKeeper.SendCoinsFromAccountToModule
+	if bankKeeper.DeferredFees(ctx) {
+		sendCoins = bankKeeper.DeferredSendCoinsFromAccountToModule
+	}
+	err := sendCoins(ctx, acc.GetAddress(), types.FeeCollectorName, fees)
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
 	}
 
 	return nil
 }
+
+// RefundReplacedTxFees refunds the fees deducted by the pending tx replaced by the tx checked with
+// ctx, if any, so that the replacing tx is checked against a state where its sender didn't pay the
+// fees of the replaced tx. The fee allowance used by the replaced tx is not restored.
+func RefundReplacedTxFees(bankKeeper types.BankKeeper, ctx sdk.Context) error {
+	replacedTx, ok := ctx.ReplacedTx().(sdk.FeeTx)
+	if !ok || replacedTx.GetFee().IsZero() {
+		return nil
+	}
+
+	fees := replacedTx.GetFee()
+	refundTo := replacedTx.FeePayer()
+	if granter := replacedTx.FeeGranter(); !granter.Empty() && !granter.Equals(refundTo) {
+		refundTo = granter
+	}
+
+	var err error
+	if bankKeeper.DeferredFees(ctx) {
+		err = bankKeeper.UndoDeferredSendCoinsFromAccountToModule(ctx, refundTo, types.FeeCollectorName, fees)
+	} else {
+		err = bankKeeper.SendCoinsFromModuleToAccount(ctx, types.FeeCollectorName, refundTo, fees)
+	}
+	if err != nil {
+		return sdkerrors.Wrapf(err, "refunding the fees of the replaced tx")
+	}
+
+	return nil
+}
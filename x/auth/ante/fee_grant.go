@@ -70,6 +70,10 @@ func (d DeductGrantedFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	feePayer := feeTx.FeePayer()
 	feeGranter := feeTx.FeeGranter()
 
+	if err := RefundReplacedTxFees(d.bk, ctx); err != nil {
+		return ctx, err
+	}
+
 	deductFeesFrom := feePayer
 
 	// ensure the grant is allowed, if we request a different fee payer
@@ -1,11 +1,15 @@
 package ante_test
 
 import (
+	"math"
+
 	cryptotypes "github.com/line/lbm-sdk/crypto/types"
 	"github.com/line/lbm-sdk/testutil/testdata"
 
 	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/x/auth/ante"
+	"github.com/line/lbm-sdk/x/auth/types"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
 )
 
 func (suite *AnteTestSuite) TestEnsureMempoolFees() {
@@ -60,6 +64,49 @@ func (suite *AnteTestSuite) TestEnsureMempoolFees() {
 	suite.Require().Nil(err, "Decorator should not have errored on fee higher than local gasPrice")
 }
 
+func (suite *AnteTestSuite) TestMempoolFeePriority() {
+	suite.SetupTest(true) // setup
+
+	mfd := ante.NewMempoolFeeDecorator()
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	msg := testdata.NewTestMsg(addr1)
+
+	testCases := []struct {
+		name     string
+		fee      sdk.Coins
+		gas      uint64
+		priority int64
+	}{
+		{"no fee", sdk.NewCoins(), 100000, 0},
+		{"single denom", sdk.NewCoins(sdk.NewInt64Coin("atom", 300000)), 100000, 3000000},
+		{"lowest of denoms", sdk.NewCoins(sdk.NewInt64Coin("atom", 300000), sdk.NewInt64Coin("stake", 200000)), 100000, 2000000},
+		{"fractional gas price", sdk.NewCoins(sdk.NewInt64Coin("atom", 150)), 100000, 1500},
+		{"higher fractional gas price", sdk.NewCoins(sdk.NewInt64Coin("atom", 151)), 100000, 1510},
+		{"gas price below the scale", sdk.NewCoins(sdk.NewInt64Coin("atom", 1)), 10000000, 0},
+		{"gas price above the scale", sdk.NewCoins(sdk.NewInt64Coin("atom", math.MaxInt64)), 1, math.MaxInt64},
+		{"no gas", sdk.NewCoins(sdk.NewInt64Coin("atom", 300000)), 0, 0},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+			suite.txBuilder.SetFeeAmount(tc.fee)
+			suite.txBuilder.SetGasLimit(tc.gas)
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+			tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+			suite.Require().NoError(err)
+
+			newCtx, err := antehandler(suite.ctx.WithIsCheckTx(true), tx, false)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.priority, newCtx.Priority())
+		})
+	}
+}
+
 func (suite *AnteTestSuite) TestDeductFees() {
 	suite.SetupTest(true) // setup
 	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
@@ -98,4 +145,20 @@ func (suite *AnteTestSuite) TestDeductFees() {
 	_, err = antehandler(suite.ctx, tx, false)
 
 	suite.Require().Nil(err, "Tx errored after account has been set with sufficient funds")
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+	suite.Require().Equal(feeAmount, suite.app.BankKeeper.GetAllBalances(suite.ctx, feeCollector))
+
+	// with deferred fees the fee collector is credited at the end of the block
+	params := banktypes.DefaultParams()
+	params.DeferredFees = true
+	suite.app.BankKeeper.SetParams(suite.ctx, params)
+	suite.app.BankKeeper.SetBalances(suite.ctx, addr1, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(200))))
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(50))), suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1))
+	suite.Require().Equal(feeAmount, suite.app.BankKeeper.GetAllBalances(suite.ctx, feeCollector))
+
+	suite.app.BankKeeper.SettleDeferredBalances(suite.ctx)
+	suite.Require().Equal(feeAmount.Add(feeAmount...), suite.app.BankKeeper.GetAllBalances(suite.ctx, feeCollector))
 }
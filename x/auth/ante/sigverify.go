@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/line/lbm-sdk/crypto/batch"
 	"github.com/line/lbm-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/line/lbm-sdk/crypto/keys/multisig"
 	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
@@ -163,7 +164,8 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 }
 
 // Verify all signatures for a tx and return an error if any are invalid. Note,
-// the SigVerificationDecorator decorator will not get executed on ReCheck.
+// on ReCheck the SigVerificationDecorator only checks the account sequences, and
+// reuses the results of the signature verifications of CheckTx cached by tx hash.
 //
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
@@ -171,6 +173,17 @@ type SigVerificationDecorator struct {
 	ak              AccountKeeper
 	signModeHandler authsigning.SignModeHandler
 	txHashCache     sync.Map
+
+	// the block height of the last ReCheck, at which the cache was pruned
+	recheckHeight int64
+	recheckMtx    sync.Mutex
+}
+
+// sigCacheEntry is the hash of the tx a signature has been verified for, and
+// the block height of the CheckTx or ReCheck using it last.
+type sigCacheEntry struct {
+	txHash []byte
+	height int64
 }
 
 func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler authsigning.SignModeHandler) *SigVerificationDecorator {
@@ -203,11 +216,10 @@ func OnlyLegacyAminoSigners(sigData signing.SignatureData) bool {
 }
 
 func (svd *SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-	// TODO https://github.com/line/link/issues/1136
-	// no need to verify signatures on recheck tx
 	if ctx.IsReCheckTx() {
-		return next(ctx, tx, simulate)
+		svd.pruneCache(ctx.BlockHeight())
 	}
+
 	sigTx, ok := tx.(authsigning.SigVerifiableTx)
 	if !ok {
 		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
@@ -222,8 +234,9 @@ func (svd *SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 
 	signerAddrs := sigTx.GetSigners()
 
-	// check that signer length and signature length are the same
-	if len(sigs) != len(signerAddrs) {
+	// check that signer length and signature length are the same, no need to
+	// check it again on recheck tx
+	if !ctx.IsReCheckTx() && len(sigs) != len(signerAddrs) {
 		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
 	}
 
@@ -237,6 +250,14 @@ func (svd *SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 		}
 	}()
 
+	genesis := ctx.BlockHeight() == 0
+	unordered := isUnordered(tx)
+	chainID := ctx.ChainID()
+	// TODO could we use `tx.(*wrapper).getBodyBytes()` instead of `ctx.TxBytes()`?
+	txHash := sha256.Sum256(ctx.TxBytes())
+
+	sigKeys := make([]string, 0, len(sigs))
+	toVerify := make([]sigToVerify, 0, len(sigs))
 	for i, sig := range sigs {
 		var acc types.AccountI
 		acc, err = GetSignerAcc(ctx, svd.ak, signerAddrs[i])
@@ -254,110 +275,265 @@ func (svd *SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
 		}
 
+		// A tx replacing a pending tx in the mempool reuses its sequence, which
+		// has already been incremented in the check state. The sequence of an
+		// unordered tx is a nonce, not checked against the account.
+		sequence := acc.GetSequence()
+		if unordered {
+			sequence = sig.Sequence
+		} else if ctx.IsReplaceTx() {
+			if sequence == 0 {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrWrongSequence, "no pending tx to replace")
+			}
+			sequence--
+		}
+
 		// Check account sequence number.
 		// When using Amino StdSignatures, we actually don't have the Sequence in
 		// the SignatureV2 struct (it's only in the SignDoc). In this case, we
 		// cannot check sequence directly, and must do it via signature
-		// verification (in the VerifySignature call below).
+		// verification (in the verifySignatures call below).
 		onlyAminoSigners := OnlyLegacyAminoSigners(sig.Data)
 		if !onlyAminoSigners {
-			if sig.Sequence != acc.GetSequence() {
+			if sig.Sequence != sequence {
 				return ctx, sdkerrors.Wrapf(
 					sdkerrors.ErrWrongSequence,
-					"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+					"account sequence mismatch, expected %d, got %d", sequence, sig.Sequence,
 				)
 			}
 		}
 
 		// retrieve signer data
-		genesis := ctx.BlockHeight() == 0
-		chainID := ctx.ChainID()
 		signerData := authsigning.SignerData{
 			ChainID:  chainID,
-			Sequence: acc.GetSequence(),
+			Sequence: sequence,
 		}
 
 		if !genesis {
 			sigKey := fmt.Sprintf("%s:%d:%d", acc.GetAddress().String(),
 				tx.GetSigBlockHeight(), signerData.Sequence)
-			// TODO could we use `tx.(*wrapper).getBodyBytes()` instead of `ctx.TxBytes()`?
-			txHash := sha256.Sum256(ctx.TxBytes())
-			stored := false
+			sigKeys = append(sigKeys, sigKey)
 
-			stored, err = svd.verifySignatureWithCache(ctx, pubKey, signerData, sig.Data, tx, sigKey, txHash[:])
-
-			if stored {
-				newSigKeys = append(newSigKeys, sigKey)
+			if svd.isVerified(ctx, sigKey, txHash[:]) {
+				continue
 			}
+		}
+
+		toVerify = append(toVerify, sigToVerify{
+			pubKey:           pubKey,
+			signerData:       signerData,
+			sigData:          sig.Data,
+			onlyAminoSigners: onlyAminoSigners,
+		})
+	}
+
+	if failed, verifyErr := svd.verifySignatures(ctx, tx, toVerify); verifyErr != nil {
+		var errMsg string
+		if toVerify[failed].onlyAminoSigners {
+			// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
+			// and therefore communicate sequence number as a potential cause of error.
+			errMsg = fmt.Sprintf("signature verification failed; please verify sequence (%d) and chain-id (%s)", toVerify[failed].signerData.Sequence, chainID)
 		} else {
-			err = authsigning.VerifySignature(pubKey, signerData, sig.Data, svd.signModeHandler, tx)
+			errMsg = fmt.Sprintf("signature verification failed; please verify chain-id (%s)", chainID)
 		}
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, errMsg)
+	}
 
-		if err != nil {
-			var errMsg string
-			if onlyAminoSigners {
-				// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
-				// and therefore communicate sequence number as a potential cause of error.
-				errMsg = fmt.Sprintf("signature verification failed; please verify sequence (%d) and chain-id (%s)", acc.GetSequence(), chainID)
-			} else {
-				errMsg = fmt.Sprintf("signature verification failed; please verify chain-id (%s)", chainID)
+	// keep the verified signatures for the ReCheck and the DeliverTx of the tx
+	if ctx.IsCheckTx() {
+		for _, sigKey := range sigKeys {
+			svd.txHashCache.Store(sigKey, sigCacheEntry{txHash: txHash[:], height: ctx.BlockHeight()})
+			if !ctx.IsReCheckTx() {
+				newSigKeys = append(newSigKeys, sigKey)
 			}
-			return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, errMsg)
 		}
 	}
 
 	return next(ctx, tx, simulate)
 }
 
-func (svd *SigVerificationDecorator) verifySignatureWithCache(
-	ctx sdk.Context,
-	pubKey cryptotypes.PubKey,
-	signerData authsigning.SignerData,
-	sigData signing.SignatureData,
-	tx sdk.Tx,
-	sigKey string,
-	txHash []byte,
-) (stored bool, err error) {
+// sigToVerify is a signature of a tx left to verify, along with its signer data.
+type sigToVerify struct {
+	pubKey           cryptotypes.PubKey
+	signerData       authsigning.SignerData
+	sigData          signing.SignatureData
+	onlyAminoSigners bool
+}
+
+// isVerified checks whether a signature has already been verified: ahead of the
+// CheckTx, or by the CheckTx (or last ReCheck) of the same tx for its ReCheck and
+// DeliverTx. The DeliverTx consumes the cached signature.
+func (svd *SigVerificationDecorator) isVerified(ctx sdk.Context, sigKey string, txHash []byte) bool {
 	switch {
 	case ctx.IsCheckTx() && !ctx.IsReCheckTx(): // CheckTx
-		err = authsigning.VerifySignature(pubKey, signerData, sigData, svd.signModeHandler, tx)
-		if err == nil {
-			svd.txHashCache.Store(sigKey, txHash)
-			stored = true
-		}
+		return ctx.IsSigVerified()
 
 	case ctx.IsReCheckTx(): // ReCheckTx
-		verified, exist := svd.checkCache(sigKey, txHash)
-		if !verified {
-			if exist {
-				svd.txHashCache.Delete(sigKey)
-			}
-			err = fmt.Errorf("unable to verify signature")
-		}
+		// the cache doesn't survive restarts
+		verified, _ := svd.checkCache(sigKey, txHash)
+		return verified
 
 	default: // DeliverTx
 		verified, exist := svd.checkCache(sigKey, txHash)
 		if exist {
 			svd.txHashCache.Delete(sigKey)
 		}
-		if !verified {
-			err = authsigning.VerifySignature(pubKey, signerData, sigData, svd.signModeHandler, tx)
+		return verified
+	}
+}
+
+// verifySignatures verifies the signatures of a tx. More than a single signature,
+// including the ones of a multisig, are verified in a batch. The signatures that
+// cannot be added to the batch are verified on their own. It returns the index of
+// the first invalid signature along with the error. The sign bytes depending on
+// the state are read from ctx.
+func (svd *SigVerificationDecorator) verifySignatures(ctx sdk.Context, tx sdk.Tx, sigs []sigToVerify) (int, error) {
+	signModeHandler := authsigning.HandlerWithContext(ctx, svd.signModeHandler)
+	switch len(sigs) {
+	case 0:
+		return 0, nil
+	case 1:
+		if _, ok := sigs[0].sigData.(*signing.SingleSignatureData); ok {
+			return 0, authsigning.VerifySignature(sigs[0].pubKey, sigs[0].signerData, sigs[0].sigData, signModeHandler, tx)
 		}
 	}
 
-	return stored, err
+	// the range of the batch entries of each signature, empty if not batched
+	starts := make([]int, len(sigs))
+	ends := make([]int, len(sigs))
+
+	bv := batch.NewVerifier()
+	for i, sig := range sigs {
+		starts[i] = bv.Len()
+		if err := authsigning.AddSignatureToBatch(bv, sig.pubKey, sig.signerData, sig.sigData, signModeHandler, tx); err != nil {
+			// the entries of the signature already added are left unused
+			if err := authsigning.VerifySignature(sig.pubKey, sig.signerData, sig.sigData, signModeHandler, tx); err != nil {
+				return i, err
+			}
+			starts[i] = bv.Len()
+		}
+		ends[i] = bv.Len()
+	}
+
+	ok, results := bv.Verify()
+	if ok {
+		return 0, nil
+	}
+	for i := range sigs {
+		for _, valid := range results[starts[i]:ends[i]] {
+			if !valid {
+				return i, fmt.Errorf("invalid signature")
+			}
+		}
+	}
+	return 0, nil
 }
 
 func (svd *SigVerificationDecorator) checkCache(sigKey string, txHash []byte) (verified, exist bool) {
 	cached, exist := svd.txHashCache.Load(sigKey)
-	verified = exist && bytes.Equal(cached.([]byte), txHash)
+	verified = exist && bytes.Equal(cached.(sigCacheEntry).txHash, txHash)
 	return verified, exist
 }
 
+// pruneCache removes the cached signatures of the txs that were neither checked
+// nor rechecked at the preceding block height, once for every ReCheck. These txs
+// have left the mempool without being delivered.
+func (svd *SigVerificationDecorator) pruneCache(height int64) {
+	svd.recheckMtx.Lock()
+	defer svd.recheckMtx.Unlock()
+
+	if height <= svd.recheckHeight {
+		return
+	}
+	svd.recheckHeight = height
+
+	svd.txHashCache.Range(func(sigKey, cached interface{}) bool {
+		if cached.(sigCacheEntry).height < height-1 {
+			svd.txHashCache.Delete(sigKey)
+		}
+		return true
+	})
+}
+
+// NewSigVerifyTxsHandler returns a SigVerifyTxsHandler verifying the signatures
+// of txs in a single batch, ahead of their CheckTx. It only verifies the txs
+// carrying the public keys of their signers and the sequences of their
+// signatures, the SigVerificationDecorator checking these against the state.
+// The other txs are left to the SigVerificationDecorator to verify.
+func NewSigVerifyTxsHandler(signModeHandler authsigning.SignModeHandler) sdk.SigVerifyTxsHandler {
+	return func(ctx sdk.Context, txs []sdk.Tx) []bool {
+		handler := authsigning.HandlerWithContext(ctx, signModeHandler)
+		verified := make([]bool, len(txs))
+		starts := make([]int, len(txs))
+		ends := make([]int, len(txs))
+
+		bv := batch.NewVerifier()
+		for i, tx := range txs {
+			starts[i] = bv.Len()
+			if addTxSignaturesToBatch(ctx, bv, handler, tx) {
+				verified[i] = true
+			}
+			ends[i] = bv.Len()
+		}
+
+		_, results := bv.Verify()
+		for i := range txs {
+			for _, valid := range results[starts[i]:ends[i]] {
+				if !valid {
+					verified[i] = false
+					break
+				}
+			}
+		}
+		return verified
+	}
+}
+
+// addTxSignaturesToBatch adds the signatures of a tx to a batch, returning false
+// if they cannot be verified without the state.
+func addTxSignaturesToBatch(ctx sdk.Context, bv *batch.Verifier, signModeHandler authsigning.SignModeHandler, tx sdk.Tx) bool {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return false
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return false
+	}
+	pubKeys := sigTx.GetPubKeys()
+	signerAddrs := sigTx.GetSigners()
+	if len(sigs) == 0 || len(sigs) != len(signerAddrs) || len(pubKeys) != len(signerAddrs) {
+		return false
+	}
+
+	for i, sig := range sigs {
+		pk := pubKeys[i]
+		if pk == nil || !sdk.BytesToAccAddress(pk.Address()).Equals(signerAddrs[i]) {
+			return false
+		}
+		// the sequence of a legacy amino signature is only in its sign bytes
+		if OnlyLegacyAminoSigners(sig.Data) {
+			return false
+		}
+
+		signerData := authsigning.SignerData{
+			ChainID:  ctx.ChainID(),
+			Sequence: sig.Sequence,
+		}
+		if err := authsigning.AddSignatureToBatch(bv, pk, signerData, sig.Data, signModeHandler, tx); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
 // IncrementSequenceDecorator handles incrementing sequences of all signers.
 // Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
 // there is no need to execute IncrementSequenceDecorator on RecheckTX since
-// CheckTx would already bump the sequence number.
+// CheckTx would already bump the sequence number, nor on a tx replacing a pending
+// tx in the mempool since the replaced tx did. The sequences are left untouched
+// by unordered txs, which the UnorderedTxDecorator protects from replays.
 //
 // NOTE: Since CheckTx and DeliverTx state are managed separately, subsequent and
 // sequential txs orginating from the same account cannot be handled correctly in
@@ -379,6 +555,10 @@ func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
 	}
 
+	if ctx.IsReplaceTx() || isUnordered(tx) {
+		return next(ctx, tx, simulate)
+	}
+
 	// increment sequence of all signers
 	for _, addr := range sigTx.GetSigners() {
 		acc := isd.ak.GetAccount(ctx, addr)
@@ -13,6 +13,7 @@ import (
 	"github.com/line/lbm-sdk/simapp"
 	"github.com/line/lbm-sdk/testutil/testdata"
 	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
 	"github.com/line/lbm-sdk/types/tx/signing"
 	"github.com/line/lbm-sdk/x/auth/ante"
 	"github.com/line/lbm-sdk/x/auth/legacy/legacytx"
@@ -179,6 +180,30 @@ func (suite *AnteTestSuite) TestSigVerification() {
 			suite.Require().Nil(err, "TestCase %d: %s errored unexpectedly. Err: %v", i, tc.name, err)
 		}
 	}
+
+	// a replacing tx reuses the sequences preceding the ones of the accounts
+	suite.ctx = suite.ctx.WithIsReCheckTx(false)
+	for _, addr := range addrs {
+		acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr)
+		suite.Require().NoError(acc.SetSequence(1))
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	}
+	for _, replace := range []bool{false, true} {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		suite.Require().NoError(suite.txBuilder.SetMsgs(msgs...))
+		suite.txBuilder.SetFeeAmount(feeAmount)
+		suite.txBuilder.SetGasLimit(gasLimit)
+
+		tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv1, priv2, priv3}, []uint64{1, 1, 1}, []uint64{0, 0, 0}, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+
+		_, err = antehandler(suite.ctx.WithIsReplaceTx(replace), tx, false)
+		if replace {
+			suite.Require().NoError(err)
+		} else {
+			suite.Require().True(sdkerrors.ErrWrongSequence.Is(err), err)
+		}
+	}
 }
 
 // This test is exactly like the one above, but we set the codec explicitly to
@@ -267,6 +292,103 @@ func (suite *AnteTestSuite) TestSigVerification_ExplicitAmino() {
 	}
 }
 
+func (suite *AnteTestSuite) TestSigVerificationReCheck() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	priv, _, addr := testdata.KeyTestPubAddr()
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv}, []uint64{1}, []uint64{0}, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+	suite.ctx = suite.ctx.WithTxBytes(txBytes)
+
+	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper)
+	svd := ante.NewSigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+	// check tx caches the verified signature
+	_, err = antehandler(suite.ctx.WithIsCheckTx(true), tx, false)
+	suite.Require().NoError(err)
+
+	// recheck reuses it, at later heights too
+	for height := int64(1); height < 5; height++ {
+		_, err = antehandler(suite.ctx.WithIsReCheckTx(true).WithBlockHeight(height), tx, false)
+		suite.Require().NoError(err)
+	}
+
+	// recheck verifies the signatures missing in the cache
+	antehandler = sdk.ChainAnteDecorators(spkd, ante.NewSigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler()))
+	_, err = antehandler(suite.ctx.WithIsReCheckTx(true), tx, false)
+	suite.Require().NoError(err)
+
+	// recheck checks the sequences, which depend on the state
+	acc = suite.app.AccountKeeper.GetAccount(suite.ctx, addr)
+	suite.Require().NoError(acc.SetSequence(1))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	_, err = antehandler(suite.ctx.WithIsReCheckTx(true), tx, false)
+	suite.Require().True(sdkerrors.ErrWrongSequence.Is(err), err)
+}
+
+func (suite *AnteTestSuite) TestSigVerifyTxsHandler() {
+	suite.SetupTest(true) // setup
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	priv2, _, addr2 := testdata.KeyTestPubAddr()
+	for _, addr := range []sdk.AccAddress{addr1, addr2} {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	}
+
+	createTx := func(chainID string, privs ...cryptotypes.PrivKey) sdk.Tx {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		msgs := make([]sdk.Msg, len(privs))
+		for i, priv := range privs {
+			msgs[i] = testdata.NewTestMsg(sdk.BytesToAccAddress(priv.PubKey().Address()))
+		}
+		suite.Require().NoError(suite.txBuilder.SetMsgs(msgs...))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		tx, err := suite.CreateTestTx(privs, make([]uint64, len(privs)), make([]uint64, len(privs)), chainID)
+		suite.Require().NoError(err)
+		return tx
+	}
+
+	txs := []sdk.Tx{
+		createTx(suite.ctx.ChainID(), priv1),
+		createTx(suite.ctx.ChainID(), priv1, priv2),
+		createTx("wrong-chain-id", priv2),
+	}
+
+	handler := ante.NewSigVerifyTxsHandler(suite.clientCtx.TxConfig.SignModeHandler())
+	suite.Require().Equal([]bool{true, true, false}, handler(suite.ctx, txs))
+
+	// the decorator trusts the txs verified ahead of their CheckTx
+	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper)
+	svd := ante.NewSigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+	_, err := antehandler(suite.ctx.WithIsCheckTx(true), txs[2], false)
+	suite.Require().True(sdkerrors.ErrUnauthorized.Is(err), err)
+	_, err = antehandler(suite.ctx.WithIsSigVerified(true), txs[2], false)
+	suite.Require().NoError(err)
+
+	// and verifies the signatures of several signers in a batch
+	_, err = antehandler(suite.ctx.WithIsCheckTx(true), txs[1], false)
+	suite.Require().NoError(err)
+}
+
 func (suite *AnteTestSuite) TestSigIntegration() {
 	// generate private keys
 	privs := []cryptotypes.PrivKey{
@@ -364,6 +486,7 @@ func (suite *AnteTestSuite) TestIncrementSequenceDecorator() {
 		{suite.ctx.WithIsReCheckTx(true), false, 3},
 		{suite.ctx.WithIsReCheckTx(true), false, 4},
 		{suite.ctx.WithIsReCheckTx(true), true, 5},
+		{suite.ctx.WithIsReplaceTx(true), false, 5},
 	}
 
 	for i, tc := range testCases {
@@ -0,0 +1,103 @@
+package ante
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/address"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	authsigning "github.com/line/lbm-sdk/x/auth/signing"
+)
+
+// UnorderedTxDecorator checks the unordered txs, whose signatures are not
+// ordered by the sequences of their signers. In place of the sequences, an
+// unordered tx must time out within the valid sig block period, the span of the
+// window of sig block heights, and is rejected if a tx of the same signers,
+// nonces and timeout height has been seen before. The tx is identified by this
+// signed content rather than by its bytes, which could be encoded again without
+// invalidating the signatures. The keys are kept until the timeout heights of
+// their txs, after which the TxTimeoutHeightDecorator rejects them. Without an
+// UnorderedTxKeeper, unordered txs are rejected.
+// CONTRACT: Tx must implement TxWithTimeoutHeight and SigVerifiableTx interfaces
+type UnorderedTxDecorator struct {
+	uk UnorderedTxKeeper
+}
+
+func NewUnorderedTxDecorator(uk UnorderedTxKeeper) UnorderedTxDecorator {
+	return UnorderedTxDecorator{
+		uk: uk,
+	}
+}
+
+func (utd UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !isUnordered(tx) {
+		return next(ctx, tx, simulate)
+	}
+	if utd.uk == nil {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unordered txs are not supported")
+	}
+
+	timeoutHeight := tx.(sdk.TxWithUnordered).GetTimeoutHeight()
+	if timeoutHeight == 0 {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unordered tx must set a timeout height")
+	}
+
+	params := utd.uk.GetParams(ctx)
+	maxTimeoutHeight := uint64(ctx.BlockHeight()) + params.ValidSigBlockPeriod
+	if timeoutHeight > maxTimeoutHeight {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "unordered tx timeout height %d exceeds the max of %d", timeoutHeight, maxTimeoutHeight,
+		)
+	}
+
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+	txKey, err := unorderedTxKey(sigTx, timeoutHeight)
+	if err != nil {
+		return ctx, err
+	}
+	if utd.uk.ContainsUnorderedTx(ctx, txKey) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrDuplicateUnorderedTx, "tx %X", txKey)
+	}
+	utd.uk.AddUnorderedTx(ctx, txKey, timeoutHeight)
+
+	return next(ctx, tx, simulate)
+}
+
+// unorderedTxKey returns the sha256 hash of the signers of an unordered tx
+// along with the nonces of their signatures and the timeout height of the tx,
+// all of which are signed.
+func unorderedTxKey(tx authsigning.SigVerifiableTx, timeoutHeight uint64) ([]byte, error) {
+	sigs, err := tx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+	signers := tx.GetSigners()
+	if len(sigs) != len(signers) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signatures; expected: %d, got %d", len(signers), len(sigs))
+	}
+
+	h := sha256.New()
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, timeoutHeight)
+	h.Write(buf)
+	for i, signer := range signers {
+		h.Write(address.MustLengthPrefix(signer.Bytes()))
+		binary.BigEndian.PutUint64(buf, sigs[i].Sequence)
+		h.Write(buf)
+	}
+	return h.Sum(nil), nil
+}
+
+// isUnordered returns true if the tx is unordered.
+func isUnordered(tx sdk.Tx) bool {
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	return ok && unorderedTx.GetUnordered()
+}
@@ -0,0 +1,140 @@
+package ante_test
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	cryptotypes "github.com/line/lbm-sdk/crypto/types"
+	"github.com/line/lbm-sdk/testutil/testdata"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	txtypes "github.com/line/lbm-sdk/types/tx"
+	"github.com/line/lbm-sdk/x/auth/ante"
+)
+
+func (suite *AnteTestSuite) TestUnorderedTx() {
+	suite.SetupTest(false) // reset
+
+	suite.ctx = suite.ctx.WithBlockHeight(100)
+	period := suite.app.AccountKeeper.GetParams(suite.ctx).ValidSigBlockPeriod
+
+	accounts := suite.CreateTestAccounts(1)
+	msg := testdata.NewTestMsg(accounts[0].acc.GetAddress())
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+
+	testCases := []struct {
+		desc          string
+		nonce         uint64
+		timeoutHeight uint64
+		simulate      bool
+		expErr        *sdkerrors.Error
+	}{
+		{"no timeout height", 7, 0, false, sdkerrors.ErrInvalidRequest},
+		{"timeout height too far", 7, 100 + period + 1, false, sdkerrors.ErrInvalidRequest},
+		{"simulated", 7, 110, true, nil},
+		{"unordered tx", 7, 110, false, nil},
+		{"duplicate unordered tx", 7, 110, false, sdkerrors.ErrDuplicateUnorderedTx},
+		{"another nonce", 8, 110, false, nil},
+		{"another timeout height", 7, 100 + period, false, nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.desc, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+			suite.txBuilder.SetFeeAmount(feeAmount)
+			suite.txBuilder.SetGasLimit(gasLimit)
+			suite.txBuilder.SetTimeoutHeight(tc.timeoutHeight)
+			suite.txBuilder.SetUnordered(true)
+
+			privs, sbh, nonces := []cryptotypes.PrivKey{accounts[0].priv}, []uint64{100}, []uint64{tc.nonce}
+			tx, err := suite.CreateTestTx(privs, sbh, nonces, suite.ctx.ChainID())
+			suite.Require().NoError(err)
+			txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+			suite.Require().NoError(err)
+
+			_, err = suite.anteHandler(suite.ctx.WithTxBytes(txBytes), tx, tc.simulate)
+			if tc.expErr != nil {
+				suite.Require().True(tc.expErr.Is(err), err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the sequence of the signer is left untouched
+			acc := suite.app.AccountKeeper.GetAccount(suite.ctx, accounts[0].acc.GetAddress())
+			suite.Require().Equal(uint64(0), acc.GetSequence())
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestUnorderedTxEncodedAgain() {
+	suite.SetupTest(false) // reset
+
+	suite.ctx = suite.ctx.WithBlockHeight(100)
+
+	accounts := suite.CreateTestAccounts(1)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(accounts[0].acc.GetAddress())))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.SetTimeoutHeight(110)
+	suite.txBuilder.SetUnordered(true)
+
+	privs, sbh, nonces := []cryptotypes.PrivKey{accounts[0].priv}, []uint64{100}, []uint64{7}
+	tx, err := suite.CreateTestTx(privs, sbh, nonces, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+
+	_, err = suite.anteHandler(suite.ctx.WithTxBytes(txBytes), tx, false)
+	suite.Require().NoError(err)
+
+	// the fields of the raw tx are encoded in another order, keeping the signatures valid
+	var raw txtypes.TxRaw
+	suite.Require().NoError(raw.Unmarshal(txBytes))
+	encodedAgain := append(protoBytesField(2, raw.AuthInfoBytes), protoBytesField(1, raw.BodyBytes)...)
+	for _, sig := range raw.Signatures {
+		encodedAgain = append(encodedAgain, protoBytesField(3, sig)...)
+	}
+	suite.Require().NotEqual(txBytes, encodedAgain)
+	replayed, err := suite.clientCtx.TxConfig.TxDecoder()(encodedAgain)
+	suite.Require().NoError(err)
+
+	_, err = suite.anteHandler(suite.ctx.WithTxBytes(encodedAgain), replayed, false)
+	suite.Require().True(sdkerrors.ErrDuplicateUnorderedTx.Is(err), err)
+}
+
+// protoBytesField encodes a length-delimited protobuf field.
+func protoBytesField(num uint64, bz []byte) []byte {
+	field := proto.EncodeVarint(num<<3 | 2)
+	field = append(field, proto.EncodeVarint(uint64(len(bz)))...)
+	return append(field, bz...)
+}
+
+func (suite *AnteTestSuite) TestUnorderedTxWithoutKeeper() {
+	suite.SetupTest(false) // reset
+
+	suite.ctx = suite.ctx.WithBlockHeight(100)
+
+	accounts := suite.CreateTestAccounts(1)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(accounts[0].acc.GetAddress())))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.SetTimeoutHeight(110)
+
+	privs, sbh, nonces := []cryptotypes.PrivKey{accounts[0].priv}, []uint64{100}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, sbh, nonces, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(nil))
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+
+	// unordered txs are rejected without a keeper tracking them
+	suite.txBuilder.SetUnordered(true)
+	tx, err = suite.CreateTestTx(privs, sbh, nonces, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().True(sdkerrors.ErrInvalidRequest.Is(err), err)
+}
@@ -36,6 +36,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetAccountCmd(),
 		QueryParamsCmd(),
+		QuerySigBlockHeightWindowCmd(),
 	)
 
 	return cmd
@@ -72,6 +73,39 @@ $ <appd> query auth params
 	return cmd
 }
 
+// QuerySigBlockHeightWindowCmd returns the command handler for querying the
+// range of sig block heights accepted at the latest block height.
+func QuerySigBlockHeightWindowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sig-block-height-window",
+		Short: "Query the range of sig block heights accepted at the latest block height",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Query the range of sig block heights accepted at the latest block height.
+A tx is rejected when its sig block height is out of the range at the block including it:
+
+$ <appd> query auth sig-block-height-window
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SigBlockHeightWindow(context.Background(), &types.QuerySigBlockHeightWindowRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetAccountCmd returns a query account that will display the state of the
 // account at a given address.
 func GetAccountCmd() *cobra.Command {
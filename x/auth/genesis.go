@@ -23,6 +23,10 @@ func InitGenesis(ctx sdk.Context, ak keeper.AccountKeeper, data types.GenesisSta
 		ak.SetAccount(ctx, a)
 	}
 
+	for _, tx := range data.UnorderedTxs {
+		ak.AddUnorderedTx(ctx, tx.TxHash, tx.TimeoutHeight)
+	}
+
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
 }
 
@@ -37,5 +41,13 @@ func ExportGenesis(ctx sdk.Context, ak keeper.AccountKeeper) *types.GenesisState
 		return false
 	})
 
-	return types.NewGenesisState(params, genAccounts)
+	var unorderedTxs []types.UnorderedTx
+	ak.IterateUnorderedTxs(ctx, func(txHash []byte, timeoutHeight uint64) bool {
+		unorderedTxs = append(unorderedTxs, types.UnorderedTx{TxHash: txHash, TimeoutHeight: timeoutHeight})
+		return false
+	})
+
+	genState := types.NewGenesisState(params, genAccounts)
+	genState.UnorderedTxs = unorderedTxs
+	return genState
 }
@@ -50,3 +50,21 @@ func (ak AccountKeeper) Params(c context.Context, req *types.QueryParamsRequest)
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// SigBlockHeightWindow returns the range of sig block heights accepted at the current block height
+func (ak AccountKeeper) SigBlockHeightWindow(c context.Context, req *types.QuerySigBlockHeightWindowRequest) (*types.QuerySigBlockHeightWindowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	params := ak.GetParams(ctx)
+	height := uint64(ctx.BlockHeight())
+	min, max := params.SigBlockHeightWindow(height)
+
+	return &types.QuerySigBlockHeightWindowResponse{
+		Height:              height,
+		MinSigBlockHeight:   min,
+		MaxSigBlockHeight:   max,
+		ValidSigBlockPeriod: params.ValidSigBlockPeriod,
+	}, nil
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 
+	"github.com/line/lbm-sdk/baseapp"
 	"github.com/line/lbm-sdk/testutil/testdata"
 	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/x/auth/types"
@@ -133,3 +134,32 @@ func (suite *KeeperTestSuite) TestGRPCQueryParameters() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQuerySigBlockHeightWindow() {
+	testCases := []struct {
+		msg    string
+		height int64
+		expMin uint64
+	}{
+		{"within the first period", 100, 0},
+		{"after the first period", 5000, 5000 - types.DefaultValidSigBlockPeriod},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			ctx := suite.ctx.WithBlockHeight(tc.height)
+			queryHelper := baseapp.NewQueryServerTestHelper(ctx, suite.app.InterfaceRegistry())
+			types.RegisterQueryServer(queryHelper, suite.app.AccountKeeper)
+			queryClient := types.NewQueryClient(queryHelper)
+
+			res, err := queryClient.SigBlockHeightWindow(sdk.WrapSDKContext(ctx), &types.QuerySigBlockHeightWindowRequest{})
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(tc.height), res.Height)
+			suite.Require().Equal(tc.expMin, res.MinSigBlockHeight)
+			suite.Require().Equal(uint64(tc.height), res.MaxSigBlockHeight)
+			suite.Require().Equal(types.DefaultValidSigBlockPeriod, res.ValidSigBlockPeriod)
+		})
+	}
+}
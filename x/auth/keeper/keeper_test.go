@@ -108,6 +108,31 @@ func TestAccountMapperRemoveAccount(t *testing.T) {
 	require.Equal(t, accSeq2, acc2.GetSequence())
 }
 
+func TestUnorderedTxs(t *testing.T) {
+	app, ctx := createTestApp(true)
+	ak := app.AccountKeeper
+
+	hash1, hash2, hash3 := []byte("hash1"), []byte("hash2"), []byte("hash3")
+	ak.AddUnorderedTx(ctx, hash1, 10)
+	ak.AddUnorderedTx(ctx, hash2, 10)
+	ak.AddUnorderedTx(ctx, hash3, 11)
+	require.True(t, ak.ContainsUnorderedTx(ctx, hash1))
+	require.False(t, ak.ContainsUnorderedTx(ctx, []byte("hash4")))
+
+	// kept until their timeout height
+	ak.RemoveExpiredUnorderedTxs(ctx.WithBlockHeight(9))
+	require.True(t, ak.ContainsUnorderedTx(ctx, hash1))
+	require.True(t, ak.ContainsUnorderedTx(ctx, hash2))
+
+	ak.RemoveExpiredUnorderedTxs(ctx.WithBlockHeight(10))
+	require.False(t, ak.ContainsUnorderedTx(ctx, hash1))
+	require.False(t, ak.ContainsUnorderedTx(ctx, hash2))
+	require.True(t, ak.ContainsUnorderedTx(ctx, hash3))
+
+	ak.RemoveExpiredUnorderedTxs(ctx.WithBlockHeight(11))
+	require.False(t, ak.ContainsUnorderedTx(ctx, hash3))
+}
+
 func TestGetSetParams(t *testing.T) {
 	app, ctx := createTestApp(true)
 	params := types.DefaultParams()
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/auth/types"
+)
+
+// ContainsUnorderedTx returns true if an unordered tx of the hash has been seen
+// and has not timed out yet.
+func (ak AccountKeeper) ContainsUnorderedTx(ctx sdk.Context, txHash []byte) bool {
+	store := ctx.KVStore(ak.key)
+	return store.Has(types.UnorderedTxStoreKey(txHash))
+}
+
+// AddUnorderedTx records an unordered tx until its timeout height.
+func (ak AccountKeeper) AddUnorderedTx(ctx sdk.Context, txHash []byte, timeoutHeight uint64) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.UnorderedTxStoreKey(txHash), sdk.Uint64ToBigEndian(timeoutHeight))
+	store.Set(types.UnorderedTxTimeoutStoreKey(timeoutHeight, txHash), []byte{})
+}
+
+// IterateUnorderedTxs iterates over the unordered txs seen that have not timed
+// out yet, by tx hash, and calls the cb with their timeout heights.
+func (ak AccountKeeper) IterateUnorderedTxs(ctx sdk.Context, cb func(txHash []byte, timeoutHeight uint64) (stop bool)) {
+	store := ctx.KVStore(ak.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.UnorderedTxStoreKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		txHash := iterator.Key()[len(types.UnorderedTxStoreKeyPrefix):]
+		if cb(txHash, sdk.BigEndianToUint64(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// RemoveExpiredUnorderedTxs forgets the unordered txs timed out at the block
+// height, which the TxTimeoutHeightDecorator rejects from now on.
+func (ak AccountKeeper) RemoveExpiredUnorderedTxs(ctx sdk.Context) {
+	store := ctx.KVStore(ak.key)
+	iterator := store.Iterator(
+		types.UnorderedTxTimeoutStoreKeyPrefix,
+		types.UnorderedTxTimeoutStoreKeyPrefixByHeight(uint64(ctx.BlockHeight())+1),
+	)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	prefixLen := len(types.UnorderedTxTimeoutStoreKeyPrefixByHeight(0))
+	for _, key := range keys {
+		store.Delete(key)
+		store.Delete(types.UnorderedTxStoreKey(key[prefixLen:]))
+	}
+}
@@ -91,6 +91,9 @@ func (s *StdTxBuilder) SetTimeoutHeight(height uint64) {
 // SetFeeGranter does nothing for stdtx
 func (s *StdTxBuilder) SetFeeGranter(_ sdk.AccAddress) {}
 
+// SetUnordered does nothing for stdtx, which doesn't support unordered txs
+func (s *StdTxBuilder) SetUnordered(_ bool) {}
+
 // StdTxConfig is a context.TxConfig for StdTx
 type StdTxConfig struct {
 	Cdc *codec.LegacyAmino
@@ -1,6 +1,7 @@
 package auth_test
 
 import (
+	"crypto/sha256"
 	"testing"
 
 	abcitypes "github.com/line/ostracon/abci/types"
@@ -8,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/line/lbm-sdk/simapp"
+	"github.com/line/lbm-sdk/x/auth"
 	"github.com/line/lbm-sdk/x/auth/types"
 )
 
@@ -25,3 +27,30 @@ func TestItCreatesModuleAccountOnInitBlock(t *testing.T) {
 	acc := app.AccountKeeper.GetAccount(ctx, types.NewModuleAddress(types.FeeCollectorName))
 	require.NotNil(t, acc)
 }
+
+func TestUnorderedTxsGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, ocproto.Header{})
+
+	hash1, hash2 := sha256.Sum256([]byte("tx1")), sha256.Sum256([]byte("tx2"))
+	app.AccountKeeper.AddUnorderedTx(ctx, hash1[:], 10)
+	app.AccountKeeper.AddUnorderedTx(ctx, hash2[:], 11)
+
+	genState := auth.ExportGenesis(ctx, app.AccountKeeper)
+	require.NoError(t, types.ValidateGenesis(*genState))
+	require.ElementsMatch(t, []types.UnorderedTx{
+		{TxHash: hash1[:], TimeoutHeight: 10},
+		{TxHash: hash2[:], TimeoutHeight: 11},
+	}, genState.UnorderedTxs)
+
+	// the unordered txs are rejected again until their timeout heights after an import
+	app = simapp.Setup(false)
+	ctx = app.BaseApp.NewContext(false, ocproto.Header{})
+	auth.InitGenesis(ctx, app.AccountKeeper, *genState)
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash1[:]))
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash2[:]))
+
+	app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockHeight(10))
+	require.False(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash1[:]))
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash2[:]))
+}
@@ -16,7 +16,7 @@ type SignModeHandlerMap struct {
 	signModeHandlers map[signing.SignMode]SignModeHandler
 }
 
-var _ SignModeHandler = SignModeHandlerMap{}
+var _ ContextualSignModeHandler = SignModeHandlerMap{}
 
 // NewSignModeHandlerMap returns a new SignModeHandlerMap with the provided defaultMode and handlers
 func NewSignModeHandlerMap(defaultMode signing.SignMode, handlers []SignModeHandler) SignModeHandlerMap {
@@ -58,3 +58,17 @@ func (h SignModeHandlerMap) GetSignBytes(mode signing.SignMode, data SignerData,
 	}
 	return handler.GetSignBytes(mode, data, tx)
 }
+
+// WithContext implements ContextualSignModeHandler.WithContext
+func (h SignModeHandlerMap) WithContext(ctx sdk.Context) SignModeHandler {
+	handlerMap := make(map[signing.SignMode]SignModeHandler, len(h.signModeHandlers))
+	for mode, handler := range h.signModeHandlers {
+		handlerMap[mode] = HandlerWithContext(ctx, handler)
+	}
+
+	return SignModeHandlerMap{
+		defaultMode:      h.defaultMode,
+		modes:            h.modes,
+		signModeHandlers: handlerMap,
+	}
+}
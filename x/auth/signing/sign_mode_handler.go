@@ -20,6 +20,24 @@ type SignModeHandler interface {
 	GetSignBytes(mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error)
 }
 
+// ContextualSignModeHandler defines a SignModeHandler whose sign bytes depend on the
+// state, which is read from the context the signatures are verified under
+type ContextualSignModeHandler interface {
+	SignModeHandler
+
+	// WithContext returns the SignModeHandler reading the state of ctx
+	WithContext(ctx sdk.Context) SignModeHandler
+}
+
+// HandlerWithContext returns the SignModeHandler reading the state of ctx if handler
+// is a ContextualSignModeHandler, handler itself otherwise.
+func HandlerWithContext(ctx sdk.Context, handler SignModeHandler) SignModeHandler {
+	if contextual, ok := handler.(ContextualSignModeHandler); ok {
+		return contextual.WithContext(ctx)
+	}
+	return handler
+}
+
 // SignerData is the specific information needed to sign a transaction that generally
 // isn't included in the transaction body itself
 type SignerData struct {
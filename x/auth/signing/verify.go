@@ -39,3 +39,27 @@ func VerifySignature(pubKey cryptotypes.PubKey, signerData SignerData, sigData s
 		return fmt.Errorf("unexpected SignatureData %T", sigData)
 	}
 }
+
+// AddSignatureToBatch adds the signatures of a transaction contained in SignatureData to a BatchVerifier, abstracting
+// over different signing modes and single vs multi-signatures. The signatures are valid if the batch verifies them.
+func AddSignatureToBatch(bv cryptotypes.BatchVerifier, pubKey cryptotypes.PubKey, signerData SignerData, sigData signing.SignatureData, handler SignModeHandler, tx sdk.Tx) error {
+	switch data := sigData.(type) {
+	case *signing.SingleSignatureData:
+		signBytes, err := handler.GetSignBytes(data.SignMode, signerData, tx)
+		if err != nil {
+			return err
+		}
+		return bv.Add(pubKey, signBytes, data.Signature)
+
+	case *signing.MultiSignatureData:
+		multiPK, ok := pubKey.(multisig.BatchVerifiablePubKey)
+		if !ok {
+			return fmt.Errorf("expected %T, got %T", (multisig.BatchVerifiablePubKey)(nil), pubKey)
+		}
+		return multiPK.AddMultisignatureToBatch(bv, func(mode signing.SignMode) ([]byte, error) {
+			return handler.GetSignBytes(mode, signerData, tx)
+		}, data)
+	default:
+		return fmt.Errorf("unexpected SignatureData %T", sigData)
+	}
+}
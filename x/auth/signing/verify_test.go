@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/line/lbm-sdk/codec"
+	"github.com/line/lbm-sdk/crypto/batch"
 	kmultisig "github.com/line/lbm-sdk/crypto/keys/multisig"
 	cryptotypes "github.com/line/lbm-sdk/crypto/types"
 	"github.com/line/lbm-sdk/crypto/types/multisig"
@@ -65,6 +66,11 @@ func TestVerifySignature(t *testing.T) {
 	err = signing.VerifySignature(pubKey, signerData, sigV2.Data, handler, stdTx)
 	require.NoError(t, err)
 
+	bv := batch.NewVerifier()
+	require.NoError(t, signing.AddSignatureToBatch(bv, pubKey, signerData, sigV2.Data, handler, stdTx))
+	ok, _ := bv.Verify()
+	require.True(t, ok)
+
 	pkSet := []cryptotypes.PubKey{pubKey, pubKey1}
 	multisigKey := kmultisig.NewLegacyAminoPubKey(2, pkSet)
 	multisignature := multisig.NewMultisig(2)
@@ -93,6 +99,19 @@ func TestVerifySignature(t *testing.T) {
 
 	err = signing.VerifySignature(multisigKey, signerData, multisignature, handler, stdTx)
 	require.NoError(t, err)
+
+	bv = batch.NewVerifier()
+	require.NoError(t, signing.AddSignatureToBatch(bv, multisigKey, signerData, multisignature, handler, stdTx))
+	require.Equal(t, 2, bv.Len())
+	ok, _ = bv.Verify()
+	require.True(t, ok)
+
+	// a signature of another sequence fails the batch
+	bv = batch.NewVerifier()
+	otherSignerData := signing.SignerData{ChainID: chainId, Sequence: signerData.Sequence + 1}
+	require.NoError(t, signing.AddSignatureToBatch(bv, multisigKey, otherSignerData, multisignature, handler, stdTx))
+	ok, _ = bv.Verify()
+	require.False(t, ok)
 }
 
 // returns context and app with params set on account keeper
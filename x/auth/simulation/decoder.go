@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/line/lbm-sdk/codec"
+	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/types/kv"
 	"github.com/line/lbm-sdk/x/auth/types"
 )
@@ -32,6 +33,12 @@ func NewDecodeStore(ak AuthUnmarshaler) func(kvA, kvB kv.Pair) string {
 
 			return fmt.Sprintf("%v\n%v", accA, accB)
 
+		case bytes.Equal(kvA.Key[:1], types.UnorderedTxStoreKeyPrefix):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.UnorderedTxTimeoutStoreKeyPrefix):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
@@ -33,6 +33,10 @@ func TestDecodeStore(t *testing.T) {
 				Key:   types.AddressStoreKey(delAddr1),
 				Value: accBz,
 			},
+			{
+				Key:   types.UnorderedTxStoreKey([]byte("tx_hash")),
+				Value: sdk.Uint64ToBigEndian(100),
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -44,6 +48,7 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Account", fmt.Sprintf("%v\n%v", acc, acc)},
+		{"UnorderedTx", "100\n100"},
 		{"other", ""},
 	}
 
@@ -158,6 +158,11 @@ func (w *wrapper) GetTimeoutHeight() uint64 {
 	return w.tx.Body.TimeoutHeight
 }
 
+// GetUnordered returns true if the transaction is unordered.
+func (w *wrapper) GetUnordered() bool {
+	return w.tx.Body.Unordered
+}
+
 func (w *wrapper) GetSignaturesV2() ([]signing.SignatureV2, error) {
 	signerInfos := w.tx.AuthInfo.SignerInfos
 	sigs := w.tx.Signatures
@@ -228,6 +233,15 @@ func (w *wrapper) SetMemo(memo string) {
 	w.bodyBz = nil
 }
 
+// SetUnordered sets the transaction as unordered, in which case the sequences
+// of its signatures serve as nonces.
+func (w *wrapper) SetUnordered(unordered bool) {
+	w.tx.Body.Unordered = unordered
+
+	// set bodyBz to nil because the cached bodyBz no longer matches tx.Body
+	w.bodyBz = nil
+}
+
 func (w *wrapper) SetSigBlockHeight(sbh uint64) {
 	w.tx.AuthInfo.SigBlockHeight = sbh
 }
@@ -24,8 +24,15 @@ type config struct {
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and sign modes. The
 // first enabled sign mode will become the default sign mode.
 func NewTxConfig(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode) client.TxConfig {
+	return NewTxConfigWithTextual(protoCodec, enabledSignModes, nil)
+}
+
+// NewTxConfigWithTextual returns a new protobuf TxConfig like NewTxConfig, whose
+// SIGN_MODE_TEXTUAL sign docs render coins in the display units of the metadata
+// returned by coinMetadata.
+func NewTxConfigWithTextual(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode, coinMetadata CoinMetadataQueryFn) client.TxConfig {
 	return &config{
-		handler:     makeSignModeHandler(enabledSignModes),
+		handler:     makeSignModeHandler(enabledSignModes, signModeTextualHandler{coinMetadata: coinMetadata}),
 		decoder:     DefaultTxDecoder(protoCodec),
 		encoder:     DefaultTxEncoder(),
 		jsonDecoder: DefaultJSONTxDecoder(protoCodec),
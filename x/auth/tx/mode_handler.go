@@ -11,11 +11,21 @@ import (
 var DefaultSignModes = []signingtypes.SignMode{
 	signingtypes.SignMode_SIGN_MODE_DIRECT,
 	signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+	signingtypes.SignMode_SIGN_MODE_TEXTUAL,
+}
+
+// NewContextualSignModeHandler returns the protobuf SignModeHandler of a chain
+// supporting the given sign modes, whose SIGN_MODE_TEXTUAL coins are rendered
+// with the metadata in the state the signatures are verified under. The ante
+// handler binds it to its context.
+func NewContextualSignModeHandler(modes []signingtypes.SignMode, coinMetadata ContextualCoinMetadataFn) signing.ContextualSignModeHandler {
+	return makeSignModeHandler(modes, signModeTextualHandler{contextual: coinMetadata})
 }
 
 // makeSignModeHandler returns the default protobuf SignModeHandler supporting
-// SIGN_MODE_DIRECT and SIGN_MODE_LEGACY_AMINO_JSON.
-func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler {
+// SIGN_MODE_DIRECT, SIGN_MODE_LEGACY_AMINO_JSON and SIGN_MODE_TEXTUAL, the
+// latter being handled by textual.
+func makeSignModeHandler(modes []signingtypes.SignMode, textual signModeTextualHandler) signing.SignModeHandlerMap {
 	if len(modes) < 1 {
 		panic(fmt.Errorf("no sign modes enabled"))
 	}
@@ -28,6 +38,8 @@ func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler
 			handlers[i] = signModeDirectHandler{}
 		case signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
 			handlers[i] = signModeLegacyAminoJSONHandler{}
+		case signingtypes.SignMode_SIGN_MODE_TEXTUAL:
+			handlers[i] = textual
 		default:
 			panic(fmt.Errorf("unsupported sign mode %+v", mode))
 		}
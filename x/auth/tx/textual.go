@@ -0,0 +1,408 @@
+package tx
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/line/lbm-sdk/codec"
+	sdk "github.com/line/lbm-sdk/types"
+	signingtypes "github.com/line/lbm-sdk/types/tx/signing"
+	"github.com/line/lbm-sdk/x/auth/signing"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+)
+
+// CoinMetadataQueryFn returns the bank metadata of a denom, nil if the denom has
+// none.
+type CoinMetadataQueryFn func(denom string) (*banktypes.Metadata, error)
+
+// ContextualCoinMetadataFn returns the bank metadata of a denom in the state of
+// ctx, nil if the denom has none.
+type ContextualCoinMetadataFn func(ctx sdk.Context, denom string) (*banktypes.Metadata, error)
+
+// signModeTextualHandler defines the SIGN_MODE_TEXTUAL SignModeHandler. Its sign
+// bytes are a deterministic human-readable document of the tx, which hardware
+// wallets can display for the signer to verify.
+type signModeTextualHandler struct {
+	coinMetadata CoinMetadataQueryFn
+	// contextual is set if the metadata are read from the state, coinMetadata
+	// being then bound to a context by WithContext
+	contextual ContextualCoinMetadataFn
+}
+
+var _ signing.ContextualSignModeHandler = signModeTextualHandler{}
+
+// NewSignModeTextualHandler returns the SIGN_MODE_TEXTUAL SignModeHandler. The
+// coins are rendered in the display units of their metadata, in their base
+// units if coinMetadata is nil or a denom has no display unit.
+func NewSignModeTextualHandler(coinMetadata CoinMetadataQueryFn) signing.SignModeHandler {
+	return signModeTextualHandler{coinMetadata: coinMetadata}
+}
+
+// NewContextualSignModeTextualHandler returns the SIGN_MODE_TEXTUAL
+// SignModeHandler of a chain, rendering the coins in the display units of their
+// metadata in the state the signatures are verified under. It must be bound to
+// a context with WithContext before getting sign bytes.
+func NewContextualSignModeTextualHandler(coinMetadata ContextualCoinMetadataFn) signing.ContextualSignModeHandler {
+	return signModeTextualHandler{contextual: coinMetadata}
+}
+
+// WithContext implements ContextualSignModeHandler.WithContext
+func (h signModeTextualHandler) WithContext(ctx sdk.Context) signing.SignModeHandler {
+	if h.contextual == nil {
+		return h
+	}
+	return signModeTextualHandler{
+		coinMetadata: func(denom string) (*banktypes.Metadata, error) {
+			return h.contextual(ctx, denom)
+		},
+	}
+}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (signModeTextualHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_TEXTUAL
+}
+
+// Modes implements SignModeHandler.Modes
+func (signModeTextualHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes
+func (h signModeTextualHandler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_TEXTUAL {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, mode)
+	}
+
+	protoTx, ok := tx.(*wrapper)
+	if !ok {
+		return nil, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	}
+	if h.contextual != nil {
+		return nil, fmt.Errorf("%s sign bytes read the state, but no context was given", mode)
+	}
+
+	return h.textualSignDoc(protoTx, data)
+}
+
+// textualSignDoc renders the tx into lines of "Key: value". The messages are
+// rendered from their proto JSON, nested fields being indented. The document
+// ends with the hash of the SIGN_MODE_DIRECT sign doc of the tx, which binds
+// the signature to its binary representation.
+func (h signModeTextualHandler) textualSignDoc(tx *wrapper, data signing.SignerData) ([]byte, error) {
+	r := textualRenderer{coinMetadata: h.coinMetadata}
+
+	r.line(0, "Chain id", data.ChainID)
+	// the sequence of an unordered tx is a nonce
+	if tx.GetUnordered() {
+		r.line(0, "Unordered nonce", strconv.FormatUint(data.Sequence, 10))
+	} else {
+		r.line(0, "Sequence", strconv.FormatUint(data.Sequence, 10))
+	}
+	r.line(0, "Sig block height", strconv.FormatUint(tx.GetSigBlockHeight(), 10))
+
+	msgs := tx.GetMsgs()
+	for i, msg := range msgs {
+		// a service msg is rendered as its request, under its method name
+		var (
+			typeURL               = "/" + proto.MessageName(msg)
+			req     proto.Message = msg
+		)
+		if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
+			typeURL, req = svcMsg.MethodName, svcMsg.Request
+		}
+
+		bz, err := codec.ProtoMarshalJSON(req, nil)
+		if err != nil {
+			return nil, err
+		}
+		value, err := parseOrderedJSON(bz)
+		if err != nil {
+			return nil, err
+		}
+
+		r.line(0, fmt.Sprintf("Message (%d/%d)", i+1, len(msgs)), typeURL)
+		if fields, ok := value.([]jsonField); ok {
+			r.fields(1, fields)
+		}
+	}
+
+	if memo := tx.GetMemo(); memo != "" {
+		r.line(0, "Memo", r.text(memo))
+	}
+	fee := tx.GetFee()
+	feeCoins := make([]sdk.DecCoin, len(fee))
+	for i, coin := range fee {
+		feeCoins[i] = sdk.DecCoin{Denom: coin.Denom, Amount: coin.Amount.ToDec()}
+	}
+	r.line(0, "Fee", r.coins(feeCoins))
+	if granter := tx.FeeGranter(); !granter.Empty() {
+		r.line(0, "Fee granter", granter.String())
+	}
+	r.line(0, "Gas limit", strconv.FormatUint(tx.GetGas(), 10))
+	if timeoutHeight := tx.GetTimeoutHeight(); timeoutHeight != 0 {
+		r.line(0, "Timeout height", strconv.FormatUint(timeoutHeight, 10))
+	}
+
+	directSignBytes, err := DirectSignBytes(tx.getBodyBytes(), tx.getAuthInfoBytes(), data.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	r.line(0, "Hash", fmt.Sprintf("%X", sha256.Sum256(directSignBytes)))
+
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.buf.Bytes(), nil
+}
+
+// textualRenderer writes the lines of a textual sign doc, keeping the first
+// error querying the coin metadata.
+type textualRenderer struct {
+	coinMetadata CoinMetadataQueryFn
+	buf          bytes.Buffer
+	err          error
+}
+
+func (r *textualRenderer) line(indent int, key, value string) {
+	if r.buf.Len() > 0 {
+		r.buf.WriteByte('\n')
+	}
+	r.buf.WriteString(strings.Repeat("  ", indent))
+	r.buf.WriteString(key)
+	r.buf.WriteByte(':')
+	if value != "" {
+		r.buf.WriteByte(' ')
+		r.buf.WriteString(value)
+	}
+}
+
+func (r *textualRenderer) fields(indent int, fields []jsonField) {
+	for _, field := range fields {
+		r.value(indent, textualKey(field.key), field.value)
+	}
+}
+
+func (r *textualRenderer) value(indent int, key string, value interface{}) {
+	switch v := value.(type) {
+	case []jsonField:
+		if coin, ok := jsonCoin(v); ok {
+			r.line(indent, key, r.coin(coin))
+			return
+		}
+		r.line(indent, key, "")
+		r.fields(indent+1, v)
+
+	case []interface{}:
+		if coins, ok := jsonCoins(v); ok {
+			r.line(indent, key, r.coins(coins))
+			return
+		}
+		for i, elem := range v {
+			r.value(indent, fmt.Sprintf("%s (%d/%d)", key, i+1, len(v)), elem)
+		}
+
+	case string:
+		r.line(indent, key, r.text(v))
+
+	case json.Number:
+		r.line(indent, key, v.String())
+
+	case bool:
+		r.line(indent, key, strconv.FormatBool(v))
+
+	default: // null
+		r.line(indent, key, "")
+	}
+}
+
+// text renders a string as is if it is printable ASCII, quoted otherwise, so
+// that a string cannot forge the lines of the document.
+func (r *textualRenderer) text(s string) string {
+	if strings.HasPrefix(s, `"`) {
+		return strconv.QuoteToASCII(s)
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < 0x20 || s[i] > 0x7e {
+			return strconv.QuoteToASCII(s)
+		}
+	}
+	return s
+}
+
+func (r *textualRenderer) coins(coins []sdk.DecCoin) string {
+	rendered := make([]string, len(coins))
+	for i, coin := range coins {
+		rendered[i] = r.coin(coin)
+	}
+	return strings.Join(rendered, ", ")
+}
+
+// coin renders a coin in the display unit of its denom.
+func (r *textualRenderer) coin(coin sdk.DecCoin) string {
+	amount := strings.TrimRight(strings.TrimRight(coin.Amount.String(), "0"), ".")
+	if amount == "" {
+		amount = "0"
+	}
+	if r.coinMetadata == nil {
+		return amount + " " + r.text(coin.Denom)
+	}
+
+	metadata, err := r.coinMetadata(coin.Denom)
+	if err != nil && r.err == nil {
+		r.err = err
+	}
+	if metadata == nil || metadata.Base != coin.Denom || metadata.Display == "" {
+		return amount + " " + r.text(coin.Denom)
+	}
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			return shiftDecimal(amount, unit.Exponent) + " " + r.text(unit.Denom)
+		}
+	}
+	return amount + " " + r.text(coin.Denom)
+}
+
+// shiftDecimal divides a decimal by 10^exponent.
+func shiftDecimal(amount string, exponent uint32) string {
+	neg := strings.HasPrefix(amount, "-")
+	amount = strings.TrimPrefix(amount, "-")
+
+	intPart, fracPart := amount, ""
+	if i := strings.IndexByte(amount, '.'); i >= 0 {
+		intPart, fracPart = amount[:i], amount[i+1:]
+	}
+	if pad := int(exponent) - len(intPart) + 1; pad > 0 {
+		intPart = strings.Repeat("0", pad) + intPart
+	}
+	point := len(intPart) - int(exponent)
+	digits := intPart + fracPart
+
+	intPart = strings.TrimLeft(digits[:point], "0")
+	if intPart == "" {
+		intPart = "0"
+	}
+	fracPart = strings.TrimRight(digits[point:], "0")
+
+	shifted := intPart
+	if fracPart != "" {
+		shifted += "." + fracPart
+	}
+	if neg && shifted != "0" {
+		shifted = "-" + shifted
+	}
+	return shifted
+}
+
+// textualKey renders a JSON field name, e.g. from_address into From address.
+func textualKey(key string) string {
+	if key == "@type" {
+		return "Type"
+	}
+	key = strings.ReplaceAll(key, "_", " ")
+	if key == "" {
+		return key
+	}
+	return strings.ToUpper(key[:1]) + key[1:]
+}
+
+// jsonField is a field of a JSON object, the objects being parsed into their
+// fields in order.
+type jsonField struct {
+	key   string
+	value interface{}
+}
+
+// parseOrderedJSON parses JSON into []jsonField objects, []interface{} arrays,
+// json.Number numbers, strings, bools and nil.
+func parseOrderedJSON(bz []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	return parseJSONValue(dec)
+}
+
+func parseJSONValue(dec *json.Decoder) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		fields := []jsonField{}
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := parseJSONValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, jsonField{key: keyTok.(string), value: value})
+		}
+		_, err = dec.Token()
+		return fields, err
+
+	case json.Delim('['):
+		elems := []interface{}{}
+		for dec.More() {
+			value, err := parseJSONValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			elems = append(elems, value)
+		}
+		_, err = dec.Token()
+		return elems, err
+
+	default:
+		return tok, nil
+	}
+}
+
+// jsonCoin returns the coin of a JSON object of a denom and an amount.
+func jsonCoin(fields []jsonField) (sdk.DecCoin, bool) {
+	if len(fields) != 2 || fields[0].key != "denom" || fields[1].key != "amount" {
+		return sdk.DecCoin{}, false
+	}
+	denom, ok := fields[0].value.(string)
+	if !ok {
+		return sdk.DecCoin{}, false
+	}
+	amountStr, ok := fields[1].value.(string)
+	if !ok {
+		return sdk.DecCoin{}, false
+	}
+
+	amount, err := sdk.NewDecFromStr(amountStr)
+	if err != nil {
+		return sdk.DecCoin{}, false
+	}
+	return sdk.DecCoin{Denom: denom, Amount: amount}, true
+}
+
+// jsonCoins returns the coins of a non-empty JSON array of coins.
+func jsonCoins(elems []interface{}) ([]sdk.DecCoin, bool) {
+	if len(elems) == 0 {
+		return nil, false
+	}
+
+	coins := make([]sdk.DecCoin, len(elems))
+	for i, elem := range elems {
+		fields, ok := elem.([]jsonField)
+		if !ok {
+			return nil, false
+		}
+		if coins[i], ok = jsonCoin(fields); !ok {
+			return nil, false
+		}
+	}
+	return coins, true
+}
@@ -0,0 +1,127 @@
+package tx
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/codec"
+	codectypes "github.com/line/lbm-sdk/codec/types"
+	"github.com/line/lbm-sdk/testutil/testdata"
+	sdk "github.com/line/lbm-sdk/types"
+	signingtypes "github.com/line/lbm-sdk/types/tx/signing"
+	"github.com/line/lbm-sdk/x/auth/signing"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+)
+
+func TestTextualModeHandler(t *testing.T) {
+	_, pubkey, addr := testdata.KeyTestPubAddr()
+	_, _, toAddr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	coinMetadata := func(denom string) (*banktypes.Metadata, error) {
+		if denom != "ucoin" {
+			return nil, nil
+		}
+		return &banktypes.Metadata{
+			DenomUnits: []*banktypes.DenomUnit{{Denom: "ucoin", Exponent: 0}, {Denom: "coin", Exponent: 6}},
+			Base:       "ucoin",
+			Display:    "coin",
+		}, nil
+	}
+	txConfig := NewTxConfigWithTextual(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}, coinMetadata)
+	txBuilder := txConfig.NewTxBuilder()
+
+	msg := banktypes.NewMsgSend(addr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("ucoin", 1500000), sdk.NewInt64Coin("stake", 7)))
+	require.NoError(t, txBuilder.SetMsgs(msg))
+	txBuilder.SetMemo("line\nbreak")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("ucoin", 150)))
+	txBuilder.SetGasLimit(20000)
+	txBuilder.SetSigBlockHeight(3)
+	require.NoError(t, txBuilder.SetSignatures(signingtypes.SignatureV2{
+		PubKey:   pubkey,
+		Data:     &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_TEXTUAL},
+		Sequence: 2,
+	}))
+
+	modeHandler := txConfig.SignModeHandler()
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_TEXTUAL, modeHandler.DefaultMode())
+
+	signerData := signing.SignerData{ChainID: "test-chain", Sequence: 2}
+	signBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	protoTx := txBuilder.(*wrapper)
+	directSignBytes, err := DirectSignBytes(protoTx.getBodyBytes(), protoTx.getAuthInfoBytes(), "test-chain")
+	require.NoError(t, err)
+
+	expected := fmt.Sprintf(`Chain id: test-chain
+Sequence: 2
+Sig block height: 3
+Message (1/1): /lbm.bank.v1.MsgSend
+  From address: %s
+  To address: %s
+  Amount: 7 stake, 1.5 coin
+Memo: "line\nbreak"
+Fee: 0.00015 coin
+Gas limit: 20000
+Hash: %X`, addr, toAddr, sha256.Sum256(directSignBytes))
+	require.Equal(t, expected, string(signBytes))
+
+	// the coins are rendered in base units without metadata
+	signBytes, err = NewSignModeTextualHandler(nil).GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Contains(t, string(signBytes), "\n  Amount: 7 stake, 1500000 ucoin\n")
+	require.Contains(t, string(signBytes), "\nFee: 150 ucoin\n")
+
+	// the errors querying the metadata are returned
+	failing := func(denom string) (*banktypes.Metadata, error) { return nil, errors.New("unavailable") }
+	_, err = NewSignModeTextualHandler(failing).GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, txBuilder.GetTx())
+	require.EqualError(t, err, "unavailable")
+
+	// the contextual handler reads the metadata from the context it is bound to
+	contextual := NewContextualSignModeHandler(DefaultSignModes, func(ctx sdk.Context, denom string) (*banktypes.Metadata, error) {
+		require.Equal(t, "ctx-chain", ctx.ChainID())
+		return coinMetadata(denom)
+	})
+	_, err = contextual.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, txBuilder.GetTx())
+	require.Error(t, err)
+	signBytes, err = contextual.WithContext(sdk.Context{}.WithChainID("ctx-chain")).
+		GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Equal(t, expected, string(signBytes))
+
+	_, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signerData, txBuilder.GetTx())
+	require.Error(t, err)
+
+	// a service msg is rendered as its request
+	require.NoError(t, txBuilder.SetMsgs(sdk.ServiceMsg{MethodName: "/lbm.bank.v1.Msg/Send", Request: msg}))
+	signBytes, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Contains(t, string(signBytes), fmt.Sprintf("\nMessage (1/1): /lbm.bank.v1.Msg/Send\n  From address: %s\n", addr))
+}
+
+func TestShiftDecimal(t *testing.T) {
+	testCases := []struct {
+		amount   string
+		exponent uint32
+		expected string
+	}{
+		{"1500000", 6, "1.5"},
+		{"5", 6, "0.000005"},
+		{"0", 6, "0"},
+		{"120", 0, "120"},
+		{"1.5", 1, "0.15"},
+		{"12345.678", 2, "123.45678"},
+		{"-2500", 3, "-2.5"},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, shiftDecimal(tc.amount, tc.exponent), tc.amount)
+	}
+}
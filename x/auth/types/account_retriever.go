@@ -39,6 +39,17 @@ func (ar AccountRetriever) GetLatestHeight(clientCtx client.Context) (uint64, er
 	return uint64(res.Block.Header.Height), nil
 }
 
+// GetSigBlockHeightWindow queries the lowest and the highest sig block heights
+// accepted at the latest block height.
+func (ar AccountRetriever) GetSigBlockHeightWindow(clientCtx client.Context) (min, max uint64, err error) {
+	queryClient := NewQueryClient(clientCtx)
+	res, err := queryClient.SigBlockHeightWindow(context.Background(), &QuerySigBlockHeightWindowRequest{})
+	if err != nil {
+		return 0, 0, err
+	}
+	return res.MinSigBlockHeight, res.MaxSigBlockHeight, nil
+}
+
 // GetAccountWithHeight queries for an account given an address. Returns the
 // height of the query with the account. An error is returned if the query
 // or decoding fails.
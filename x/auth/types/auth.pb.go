@@ -193,66 +193,146 @@ func (m *Params) GetValidSigBlockPeriod() uint64 {
 	return 0
 }
 
+// SigBlockHeightErrorData defines the details of a tx rejected for its sig block height being
+// out of the accepted window. It is returned as the data of the ABCI response of the tx.
+type SigBlockHeightErrorData struct {
+	// sig_block_height defines the sig block height of the tx.
+	SigBlockHeight uint64 `protobuf:"varint,1,opt,name=sig_block_height,json=sigBlockHeight,proto3" json:"sig_block_height,omitempty"`
+	// height defines the block height the tx was checked at.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// min_sig_block_height defines the lowest sig block height accepted at the height.
+	MinSigBlockHeight uint64 `protobuf:"varint,3,opt,name=min_sig_block_height,json=minSigBlockHeight,proto3" json:"min_sig_block_height,omitempty"`
+	// max_sig_block_height defines the highest sig block height accepted at the height.
+	MaxSigBlockHeight uint64 `protobuf:"varint,4,opt,name=max_sig_block_height,json=maxSigBlockHeight,proto3" json:"max_sig_block_height,omitempty"`
+}
+
+func (m *SigBlockHeightErrorData) Reset()         { *m = SigBlockHeightErrorData{} }
+func (m *SigBlockHeightErrorData) String() string { return proto.CompactTextString(m) }
+func (*SigBlockHeightErrorData) ProtoMessage()    {}
+func (*SigBlockHeightErrorData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d85c5e32d5ed883, []int{3}
+}
+func (m *SigBlockHeightErrorData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigBlockHeightErrorData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigBlockHeightErrorData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigBlockHeightErrorData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigBlockHeightErrorData.Merge(m, src)
+}
+func (m *SigBlockHeightErrorData) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigBlockHeightErrorData) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigBlockHeightErrorData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigBlockHeightErrorData proto.InternalMessageInfo
+
+func (m *SigBlockHeightErrorData) GetSigBlockHeight() uint64 {
+	if m != nil {
+		return m.SigBlockHeight
+	}
+	return 0
+}
+
+func (m *SigBlockHeightErrorData) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SigBlockHeightErrorData) GetMinSigBlockHeight() uint64 {
+	if m != nil {
+		return m.MinSigBlockHeight
+	}
+	return 0
+}
+
+func (m *SigBlockHeightErrorData) GetMaxSigBlockHeight() uint64 {
+	if m != nil {
+		return m.MaxSigBlockHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "lbm.auth.v1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "lbm.auth.v1.ModuleAccount")
 	proto.RegisterType((*Params)(nil), "lbm.auth.v1.Params")
+	proto.RegisterType((*SigBlockHeightErrorData)(nil), "lbm.auth.v1.SigBlockHeightErrorData")
 }
 
 func init() { proto.RegisterFile("lbm/auth/v1/auth.proto", fileDescriptor_1d85c5e32d5ed883) }
 
 var fileDescriptor_1d85c5e32d5ed883 = []byte{
-	// 800 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x94, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0xc7, 0xd7, 0xc4, 0x84, 0x64, 0xb6, 0x3f, 0x88, 0x13, 0xd2, 0xcd, 0x36, 0xf1, 0x2c, 0x16,
-	0x42, 0x39, 0x10, 0x5b, 0x09, 0x0a, 0x52, 0x57, 0xa2, 0x28, 0x2e, 0x1c, 0x2a, 0x5a, 0x14, 0x39,
-	0x52, 0x85, 0xb8, 0x58, 0xb6, 0x77, 0x70, 0x46, 0xeb, 0xd9, 0x71, 0x3d, 0xe3, 0x68, 0xdd, 0x1b,
-	0xe2, 0x82, 0xc4, 0x05, 0x6e, 0x1c, 0xf3, 0x47, 0x54, 0xe2, 0xc0, 0x3f, 0xc0, 0x31, 0xea, 0x89,
-	0x93, 0x85, 0x36, 0x17, 0x94, 0xe3, 0xfe, 0x05, 0xc8, 0x33, 0x5e, 0x77, 0xb6, 0xf1, 0xc9, 0x7e,
-	0xef, 0x7d, 0xdf, 0x9b, 0xcf, 0x8c, 0xbf, 0x63, 0xb0, 0x9d, 0x84, 0xc4, 0x09, 0x72, 0x7e, 0xee,
-	0x5c, 0x1c, 0x8a, 0xa7, 0x9d, 0x66, 0x94, 0x53, 0xa3, 0x9b, 0x84, 0xc4, 0x16, 0xf1, 0xc5, 0x61,
-	0x7f, 0xaf, 0x12, 0x45, 0x59, 0x91, 0x72, 0xea, 0xa0, 0xd1, 0xd1, 0xf1, 0xf1, 0xe1, 0x23, 0x67,
-	0x8c, 0x0a, 0x26, 0xb5, 0x7d, 0x53, 0x29, 0x93, 0x3c, 0xe1, 0x98, 0xe1, 0x58, 0xad, 0x43, 0xa5,
-	0xce, 0x50, 0x94, 0x1e, 0x1d, 0x7f, 0x31, 0x3e, 0x54, 0x05, 0x3b, 0x11, 0x65, 0x84, 0x32, 0x5f,
-	0x44, 0x8e, 0x0c, 0xea, 0xd2, 0x56, 0x4c, 0x63, 0x2a, 0xf3, 0xd5, 0x9b, 0xcc, 0x5a, 0x3f, 0xeb,
-	0xa0, 0xeb, 0x06, 0x0c, 0x9d, 0x44, 0x11, 0xcd, 0x27, 0xdc, 0xe8, 0x81, 0x0f, 0x82, 0xd1, 0x28,
-	0x43, 0x8c, 0xf5, 0xb4, 0x81, 0xb6, 0xbf, 0xee, 0x2d, 0x42, 0xe3, 0x27, 0x0d, 0xdc, 0xaf, 0x91,
-	0xfd, 0x34, 0x0f, 0xfd, 0x31, 0x2a, 0x7a, 0xef, 0x0d, 0xb4, 0xfd, 0xee, 0x51, 0xdf, 0xae, 0xb6,
-	0x28, 0xb1, 0xec, 0x5a, 0x62, 0x9f, 0xe6, 0xe1, 0xb7, 0xa8, 0x70, 0xbf, 0xbc, 0x29, 0xe1, 0xae,
-	0xd2, 0x96, 0xe0, 0xa8, 0xea, 0xfc, 0x8c, 0x12, 0xcc, 0x11, 0x49, 0x79, 0x31, 0x2f, 0xe1, 0x4e,
-	0x11, 0x90, 0x64, 0x68, 0xdd, 0x56, 0x59, 0xde, 0xdd, 0x3a, 0x29, 0xa7, 0x19, 0xbf, 0x6a, 0x60,
-	0xa3, 0xd9, 0x77, 0x43, 0xb1, 0x22, 0x28, 0x76, 0x55, 0x8a, 0x46, 0xb4, 0xe0, 0x38, 0xb9, 0x29,
-	0xa1, 0xb9, 0xd4, 0xda, 0x46, 0xf2, 0x50, 0x92, 0xb4, 0xe9, 0x2c, 0xef, 0x7e, 0x93, 0xae, 0x69,
-	0x7e, 0xd7, 0xc0, 0x87, 0x8b, 0xaf, 0xd4, 0xc0, 0xe8, 0x02, 0xe6, 0x53, 0x15, 0x66, 0xa1, 0xb1,
-	0x9f, 0xa1, 0x38, 0x88, 0x8a, 0x13, 0x82, 0x27, 0xb4, 0xc6, 0xfa, 0xea, 0xa6, 0x84, 0x7b, 0xea,
-	0x8c, 0x36, 0xaa, 0xbe, 0xa4, 0x6a, 0x91, 0x59, 0xde, 0xbd, 0x45, 0xb6, 0x66, 0xea, 0x83, 0x35,
-	0x86, 0x5e, 0xe6, 0x68, 0x12, 0xa1, 0xde, 0xfb, 0x03, 0x6d, 0x5f, 0xf7, 0x9a, 0x78, 0xd8, 0xfb,
-	0xe5, 0x12, 0x76, 0xfe, 0xb8, 0x84, 0x9d, 0xff, 0x2e, 0x61, 0xe7, 0xcd, 0xeb, 0x83, 0xb5, 0xfa,
-	0xa3, 0x3f, 0xb5, 0xfe, 0xd2, 0xc0, 0xdd, 0xe7, 0x74, 0x94, 0x27, 0x8d, 0x0f, 0xbe, 0x07, 0x77,
-	0xc2, 0x80, 0x21, 0x3f, 0x90, 0xb1, 0x30, 0x43, 0xf7, 0xa8, 0x67, 0x2b, 0x66, 0xb6, 0x15, 0xdf,
-	0xb8, 0x0f, 0xaf, 0x4a, 0xa8, 0xcd, 0x4b, 0xb8, 0x29, 0x59, 0xd5, 0x5e, 0xcb, 0xeb, 0x86, 0x8a,
-	0xc3, 0x0c, 0xa0, 0x4f, 0x02, 0x82, 0x84, 0x77, 0xd6, 0x3d, 0xf1, 0x6e, 0x0c, 0x40, 0x37, 0x45,
-	0x19, 0xc1, 0x8c, 0x61, 0x3a, 0x61, 0xbd, 0x95, 0xc1, 0xca, 0xfe, 0xba, 0xa7, 0xa6, 0x86, 0xfd,
-	0x05, 0xfb, 0x9b, 0xd7, 0x07, 0xf7, 0x96, 0x50, 0x9f, 0x5a, 0x7f, 0xea, 0x60, 0xf5, 0x34, 0xc8,
-	0x02, 0xc2, 0x8c, 0xef, 0xc0, 0x26, 0x09, 0xa6, 0x3e, 0x41, 0x84, 0xfa, 0xd1, 0x79, 0x90, 0x05,
-	0x11, 0x47, 0x99, 0xb4, 0xb2, 0xee, 0x9a, 0xca, 0x59, 0xde, 0x16, 0x59, 0xde, 0x06, 0x09, 0xa6,
-	0xcf, 0x11, 0xa1, 0x4f, 0x9a, 0x9c, 0xf1, 0x08, 0xdc, 0xe1, 0x53, 0xbf, 0x3a, 0xf4, 0x04, 0x13,
-	0xcc, 0x05, 0xb4, 0xee, 0x3e, 0x78, 0xbb, 0x51, 0xb5, 0x6a, 0x79, 0x80, 0x4f, 0xcf, 0x70, 0xfc,
-	0xac, 0x0a, 0x0c, 0x0f, 0x7c, 0x24, 0x8a, 0xaf, 0x90, 0x1f, 0x51, 0xc6, 0xfd, 0x14, 0x65, 0x7e,
-	0x58, 0x70, 0x24, 0xec, 0xaa, 0xbb, 0x83, 0x79, 0x09, 0x77, 0x95, 0x19, 0xef, 0xca, 0x2c, 0x6f,
-	0xa3, 0x1a, 0xf6, 0x0a, 0x3d, 0xa1, 0x8c, 0x9f, 0xa2, 0xcc, 0x2d, 0x38, 0x32, 0x5e, 0x82, 0x07,
-	0xd5, 0x6a, 0x17, 0x28, 0xc3, 0x3f, 0x16, 0x52, 0x5f, 0x5f, 0x10, 0xe1, 0x3b, 0xdd, 0x1d, 0xce,
-	0x4a, 0xb8, 0x75, 0x86, 0xe3, 0x17, 0x42, 0x51, 0xb5, 0x7e, 0xf3, 0xb5, 0xa8, 0xcf, 0x4b, 0x68,
-	0xd6, 0xe6, 0x6e, 0x1f, 0x60, 0x79, 0x5b, 0x6c, 0xa9, 0x4f, 0xa6, 0x8d, 0x02, 0xec, 0xbc, 0xdb,
-	0xd1, 0xdc, 0x03, 0xe9, 0x30, 0xf7, 0xf1, 0xac, 0x84, 0xdb, 0x4b, 0x8b, 0x9e, 0x2d, 0x14, 0xf3,
-	0x12, 0x0e, 0xda, 0x97, 0x6d, 0x86, 0x58, 0xde, 0x36, 0x6b, 0xed, 0x35, 0x5e, 0x80, 0xed, 0x8b,
-	0x20, 0xc1, 0x23, 0x71, 0xc2, 0x61, 0x42, 0xa3, 0x71, 0x75, 0x3a, 0x98, 0x8e, 0x7a, 0xab, 0x62,
-	0xdd, 0x8f, 0xe7, 0x25, 0xdc, 0x93, 0xd3, 0xdb, 0x75, 0x96, 0xb7, 0x29, 0x0a, 0x67, 0x38, 0x76,
-	0xab, 0xf4, 0xa9, 0xc8, 0x0e, 0xd7, 0xea, 0x3b, 0xa0, 0xb9, 0x8f, 0xff, 0x9e, 0x99, 0xda, 0xd5,
-	0xcc, 0xd4, 0xfe, 0x9d, 0x99, 0xda, 0x6f, 0xd7, 0x66, 0xe7, 0xea, 0xda, 0xec, 0xfc, 0x73, 0x6d,
-	0x76, 0x7e, 0xf8, 0x24, 0xc6, 0xfc, 0x3c, 0x0f, 0xed, 0x88, 0x12, 0x27, 0xc1, 0x13, 0xe4, 0x24,
-	0x21, 0x39, 0x60, 0xa3, 0xb1, 0x33, 0x95, 0xff, 0x78, 0x5e, 0xa4, 0x88, 0x85, 0xab, 0xe2, 0x27,
-	0xfa, 0xf9, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x25, 0xe6, 0xe9, 0x4f, 0xfc, 0x05, 0x00, 0x00,
+	// 865 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x55, 0x31, 0x6f, 0xdb, 0x46,
+	0x18, 0x15, 0x6b, 0xd6, 0xb5, 0x4f, 0x89, 0x13, 0xd3, 0xae, 0x2c, 0x2b, 0x36, 0xa9, 0x12, 0x45,
+	0xa1, 0xa1, 0x26, 0x61, 0x17, 0x2e, 0x10, 0x01, 0x4d, 0x61, 0x26, 0x01, 0x1a, 0x34, 0x29, 0x8c,
+	0x33, 0x10, 0x14, 0x5d, 0x88, 0x23, 0x75, 0xa5, 0x0e, 0xe2, 0xe9, 0x14, 0xde, 0xc9, 0x10, 0xb3,
+	0x15, 0x5d, 0x0a, 0x74, 0x69, 0xb7, 0x8e, 0xfe, 0x11, 0x01, 0x3a, 0x14, 0xdd, 0x3b, 0x1a, 0x99,
+	0x3a, 0x11, 0x85, 0xbc, 0x14, 0x1e, 0xf5, 0x0b, 0x02, 0xde, 0x51, 0x0c, 0x65, 0x6b, 0x22, 0xbf,
+	0xef, 0xbd, 0xf7, 0xdd, 0xbb, 0xe3, 0x3b, 0x10, 0x34, 0xe2, 0x80, 0xba, 0x68, 0x2c, 0xfa, 0xee,
+	0xf9, 0xa1, 0x7c, 0x3a, 0xa3, 0x84, 0x09, 0x66, 0xd4, 0xe3, 0x80, 0x3a, 0xb2, 0x3e, 0x3f, 0x6c,
+	0xed, 0xe7, 0xa4, 0x30, 0x49, 0x47, 0x82, 0xb9, 0xb8, 0x77, 0x74, 0x7c, 0x7c, 0xf8, 0xd0, 0x1d,
+	0xe0, 0x94, 0x2b, 0x6e, 0xcb, 0xac, 0xc0, 0x74, 0x1c, 0x0b, 0xc2, 0x49, 0x54, 0xc5, 0xad, 0x0a,
+	0xce, 0x71, 0x38, 0x3a, 0x3a, 0xfe, 0x72, 0x70, 0x58, 0x25, 0xec, 0x86, 0x8c, 0x53, 0xc6, 0x7d,
+	0x59, 0xb9, 0xaa, 0x28, 0xa0, 0xed, 0x88, 0x45, 0x4c, 0xf5, 0xf3, 0x37, 0xd5, 0xb5, 0x7f, 0xd6,
+	0x41, 0xdd, 0x43, 0x1c, 0x9f, 0x84, 0x21, 0x1b, 0x0f, 0x85, 0xd1, 0x04, 0x1f, 0xa1, 0x5e, 0x2f,
+	0xc1, 0x9c, 0x37, 0xb5, 0xb6, 0xd6, 0x59, 0x87, 0xf3, 0xd2, 0xf8, 0x49, 0x03, 0xf7, 0x0a, 0xcb,
+	0xfe, 0x68, 0x1c, 0xf8, 0x03, 0x9c, 0x36, 0x3f, 0x68, 0x6b, 0x9d, 0xfa, 0x51, 0xcb, 0xc9, 0xb7,
+	0xa8, 0x6c, 0x39, 0x05, 0xc5, 0x39, 0x1d, 0x07, 0xdf, 0xe2, 0xd4, 0xfb, 0xea, 0x3a, 0xb3, 0xf6,
+	0x2a, 0xb2, 0x98, 0x84, 0xb9, 0xf2, 0x73, 0x46, 0x89, 0xc0, 0x74, 0x24, 0xd2, 0x59, 0x66, 0xed,
+	0xa6, 0x88, 0xc6, 0x5d, 0xfb, 0x36, 0xcb, 0x86, 0x77, 0x8b, 0xa6, 0x9a, 0x66, 0xfc, 0xaa, 0x81,
+	0xcd, 0x72, 0xdf, 0xa5, 0x8b, 0x15, 0xe9, 0x62, 0xaf, 0xea, 0xa2, 0x24, 0xcd, 0x7d, 0x9c, 0x5c,
+	0x67, 0x96, 0xb9, 0x20, 0x5d, 0xe6, 0xe4, 0x81, 0x72, 0xb2, 0x8c, 0x67, 0xc3, 0x7b, 0x65, 0xbb,
+	0x70, 0xf3, 0xbb, 0x06, 0xee, 0xcf, 0xbf, 0x52, 0x69, 0x46, 0x97, 0x66, 0x3e, 0xab, 0x9a, 0x99,
+	0x73, 0x9c, 0xe7, 0x38, 0x42, 0x61, 0x7a, 0x42, 0xc9, 0x90, 0x15, 0xb6, 0xbe, 0xbe, 0xce, 0xac,
+	0xfd, 0xea, 0x8c, 0x65, 0xae, 0x5a, 0xca, 0xd5, 0x12, 0x9a, 0x0d, 0x37, 0xe6, 0xdd, 0xc2, 0x53,
+	0x0b, 0xac, 0x71, 0xfc, 0x6a, 0x8c, 0x87, 0x21, 0x6e, 0x7e, 0xd8, 0xd6, 0x3a, 0x3a, 0x2c, 0xeb,
+	0x6e, 0xf3, 0x97, 0x0b, 0xab, 0xf6, 0xc7, 0x85, 0x55, 0xfb, 0xff, 0xc2, 0xaa, 0xbd, 0x7d, 0x73,
+	0xb0, 0x56, 0x7c, 0xf4, 0x67, 0xf6, 0x5f, 0x1a, 0xb8, 0xfb, 0x82, 0xf5, 0xc6, 0x71, 0x99, 0x83,
+	0xef, 0xc1, 0x9d, 0x00, 0x71, 0xec, 0x23, 0x55, 0xcb, 0x30, 0xd4, 0x8f, 0x9a, 0x4e, 0x25, 0xcc,
+	0x4e, 0x25, 0x37, 0xde, 0x83, 0xcb, 0xcc, 0xd2, 0x66, 0x99, 0xb5, 0xa5, 0xbc, 0x56, 0xb5, 0x36,
+	0xac, 0x07, 0x95, 0x84, 0x19, 0x40, 0x1f, 0x22, 0x8a, 0x65, 0x76, 0xd6, 0xa1, 0x7c, 0x37, 0xda,
+	0xa0, 0x3e, 0xc2, 0x09, 0x25, 0x9c, 0x13, 0x36, 0xe4, 0xcd, 0x95, 0xf6, 0x4a, 0x67, 0x1d, 0x56,
+	0x5b, 0xdd, 0xd6, 0xdc, 0xfb, 0xdb, 0x37, 0x07, 0x1b, 0x0b, 0x56, 0x9f, 0xd9, 0x7f, 0xea, 0x60,
+	0xf5, 0x14, 0x25, 0x88, 0x72, 0xe3, 0x3b, 0xb0, 0x45, 0xd1, 0xc4, 0xa7, 0x98, 0x32, 0x3f, 0xec,
+	0xa3, 0x04, 0x85, 0x02, 0x27, 0x2a, 0xca, 0xba, 0x67, 0x56, 0xce, 0xf2, 0x36, 0xc9, 0x86, 0x9b,
+	0x14, 0x4d, 0x5e, 0x60, 0xca, 0x1e, 0x97, 0x3d, 0xe3, 0x21, 0xb8, 0x23, 0x26, 0x7e, 0x7e, 0xe8,
+	0x31, 0xa1, 0x44, 0x48, 0xd3, 0xba, 0xb7, 0xf3, 0x7e, 0xa3, 0x55, 0xd4, 0x86, 0x40, 0x4c, 0xce,
+	0x48, 0xf4, 0x3c, 0x2f, 0x0c, 0x08, 0x3e, 0x96, 0xe0, 0x6b, 0xec, 0x87, 0x8c, 0x0b, 0x7f, 0x84,
+	0x13, 0x3f, 0x48, 0x05, 0x96, 0x71, 0xd5, 0xbd, 0xf6, 0x2c, 0xb3, 0xf6, 0x2a, 0x33, 0x6e, 0xd2,
+	0x6c, 0xb8, 0x99, 0x0f, 0x7b, 0x8d, 0x1f, 0x33, 0x2e, 0x4e, 0x71, 0xe2, 0xa5, 0x02, 0x1b, 0xaf,
+	0xc0, 0x4e, 0xbe, 0xda, 0x39, 0x4e, 0xc8, 0x8f, 0xa9, 0xe2, 0x17, 0x17, 0x44, 0xe6, 0x4e, 0xf7,
+	0xba, 0xd3, 0xcc, 0xda, 0x3e, 0x23, 0xd1, 0x4b, 0xc9, 0xc8, 0xa5, 0x4f, 0x9f, 0x48, 0x7c, 0x96,
+	0x59, 0x66, 0x11, 0xee, 0xe5, 0x03, 0x6c, 0xb8, 0xcd, 0x17, 0x74, 0xaa, 0x6d, 0xa4, 0x60, 0xf7,
+	0xa6, 0xa2, 0xbc, 0x07, 0x2a, 0x61, 0xde, 0xa3, 0x69, 0x66, 0x35, 0x16, 0x16, 0x3d, 0x9b, 0x33,
+	0x66, 0x99, 0xd5, 0x5e, 0xbe, 0x6c, 0x39, 0xc4, 0x86, 0x0d, 0xbe, 0x54, 0x6b, 0xbc, 0x04, 0x8d,
+	0x73, 0x14, 0x93, 0x9e, 0x3c, 0xe1, 0x20, 0x66, 0xe1, 0x20, 0x3f, 0x1d, 0xc2, 0x7a, 0xcd, 0x55,
+	0xb9, 0xee, 0x27, 0xb3, 0xcc, 0xda, 0x57, 0xd3, 0x97, 0xf3, 0x6c, 0xb8, 0x25, 0x81, 0x33, 0x12,
+	0x79, 0x79, 0xfb, 0x54, 0x76, 0xbb, 0x6b, 0xc5, 0x1d, 0xd0, 0xec, 0xbf, 0x35, 0xb0, 0x33, 0x07,
+	0xbf, 0xc1, 0x24, 0xea, 0x8b, 0xa7, 0x49, 0xc2, 0x92, 0x27, 0x48, 0x20, 0xa3, 0x03, 0xee, 0xbf,
+	0x9f, 0xd7, 0x97, 0xa0, 0xca, 0x11, 0xdc, 0xe0, 0x0b, 0x12, 0xa3, 0x01, 0x56, 0x0b, 0x5c, 0xc6,
+	0x03, 0x16, 0x95, 0xe1, 0x82, 0x6d, 0x4a, 0x86, 0xfe, 0xad, 0x29, 0x32, 0x00, 0x70, 0x93, 0x92,
+	0xe1, 0xe2, 0xda, 0x52, 0x80, 0x26, 0xb7, 0x05, 0x7a, 0x21, 0x40, 0x93, 0x45, 0x81, 0xf7, 0xe8,
+	0x9f, 0xa9, 0xa9, 0x5d, 0x4e, 0x4d, 0xed, 0xbf, 0xa9, 0xa9, 0xfd, 0x76, 0x65, 0xd6, 0x2e, 0xaf,
+	0xcc, 0xda, 0xbf, 0x57, 0x66, 0xed, 0x87, 0x4f, 0x23, 0x22, 0xfa, 0xe3, 0xc0, 0x09, 0x19, 0x75,
+	0x63, 0x32, 0xc4, 0x6e, 0x1c, 0xd0, 0x03, 0xde, 0x1b, 0xb8, 0x13, 0xf5, 0x8f, 0x12, 0xe9, 0x08,
+	0xf3, 0x60, 0x55, 0xfe, 0x04, 0xbe, 0x78, 0x37, 0x00, 0x43, 0x73, 0x03, 0x22, 0xbc, 0x06, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -469,6 +549,49 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SigBlockHeightErrorData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigBlockHeightErrorData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigBlockHeightErrorData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSigBlockHeight != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.MaxSigBlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinSigBlockHeight != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.MinSigBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SigBlockHeight != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.SigBlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -558,6 +681,27 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *SigBlockHeightErrorData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SigBlockHeight != 0 {
+		n += 1 + sovAuth(uint64(m.SigBlockHeight))
+	}
+	if m.Height != 0 {
+		n += 1 + sovAuth(uint64(m.Height))
+	}
+	if m.MinSigBlockHeight != 0 {
+		n += 1 + sovAuth(uint64(m.MinSigBlockHeight))
+	}
+	if m.MaxSigBlockHeight != 0 {
+		n += 1 + sovAuth(uint64(m.MaxSigBlockHeight))
+	}
+	return n
+}
+
 func sovAuth(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1087,6 +1231,132 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SigBlockHeightErrorData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigBlockHeightErrorData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigBlockHeightErrorData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigBlockHeight", wireType)
+			}
+			m.SigBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSigBlockHeight", wireType)
+			}
+			m.MinSigBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSigBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSigBlockHeight", wireType)
+			}
+			m.MaxSigBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSigBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAuth(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
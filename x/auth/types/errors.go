@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+var _ sdkerrors.ABCIDataError = (*SigBlockHeightError)(nil)
+
+// SigBlockHeightError is returned when the sig block height of a tx is out of
+// the window accepted at the current block height. It is an
+// sdkerrors.ErrInvalidSigBlockHeight carrying the details of the rejection,
+// which are returned as the data of the ABCI response of the tx.
+type SigBlockHeightError struct {
+	// SigBlockHeight is the sig block height of the tx.
+	SigBlockHeight uint64
+	// Height is the block height the tx was checked at.
+	Height uint64
+	// MinSigBlockHeight is the lowest sig block height accepted at Height.
+	MinSigBlockHeight uint64
+	// MaxSigBlockHeight is the highest sig block height accepted at Height.
+	MaxSigBlockHeight uint64
+}
+
+// NewSigBlockHeightError returns a SigBlockHeightError for the sig block
+// height rejected at the given height.
+func NewSigBlockHeightError(sigBlockHeight, height, min, max uint64) *SigBlockHeightError {
+	return &SigBlockHeightError{
+		SigBlockHeight:    sigBlockHeight,
+		Height:            height,
+		MinSigBlockHeight: min,
+		MaxSigBlockHeight: max,
+	}
+}
+
+// Expired reports whether the sig block height is too old to be accepted,
+// in which case the tx must be signed again with a recent one.
+func (e *SigBlockHeightError) Expired() bool {
+	return e.SigBlockHeight < e.MinSigBlockHeight
+}
+
+func (e *SigBlockHeightError) Error() string {
+	reason := "ahead of the current height"
+	if e.Expired() {
+		reason = "expired"
+	}
+	return fmt.Sprintf("sig block height %d is %s, accepted range at height %d is [%d, %d]: %s",
+		e.SigBlockHeight, reason, e.Height, e.MinSigBlockHeight, e.MaxSigBlockHeight,
+		sdkerrors.ErrInvalidSigBlockHeight.Error())
+}
+
+// Cause returns sdkerrors.ErrInvalidSigBlockHeight, which provides the ABCI
+// code and codespace of the error.
+func (e *SigBlockHeightError) Cause() error {
+	return sdkerrors.ErrInvalidSigBlockHeight
+}
+
+// Unwrap implements the Go 1.13 errors unwrapping.
+func (e *SigBlockHeightError) Unwrap() error {
+	return sdkerrors.ErrInvalidSigBlockHeight
+}
+
+// ABCIData implements sdkerrors.ABCIDataError, encoding the details of the
+// rejection into a SigBlockHeightErrorData.
+func (e *SigBlockHeightError) ABCIData() []byte {
+	data := SigBlockHeightErrorData{
+		SigBlockHeight:    e.SigBlockHeight,
+		Height:            e.Height,
+		MinSigBlockHeight: e.MinSigBlockHeight,
+		MaxSigBlockHeight: e.MaxSigBlockHeight,
+	}
+	bz, err := data.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// SigBlockHeightErrorFromABCIData returns the SigBlockHeightError of a tx
+// from the data of its ABCI response, the tx having failed with
+// sdkerrors.ErrInvalidSigBlockHeight.
+func SigBlockHeightErrorFromABCIData(bz []byte) (*SigBlockHeightError, error) {
+	var data SigBlockHeightErrorData
+	if err := data.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return NewSigBlockHeightError(data.SigBlockHeight, data.Height, data.MinSigBlockHeight, data.MaxSigBlockHeight), nil
+}
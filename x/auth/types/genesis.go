@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"sort"
@@ -70,7 +71,31 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return ValidateGenAccounts(genAccs)
+	if err := ValidateGenAccounts(genAccs); err != nil {
+		return err
+	}
+
+	return ValidateUnorderedTxs(data.UnorderedTxs)
+}
+
+// ValidateUnorderedTxs validates the unordered txs of a genesis state, which
+// must be identified by distinct sha256 hashes and time out at a height.
+func ValidateUnorderedTxs(txs []UnorderedTx) error {
+	seen := make(map[string]bool, len(txs))
+	for _, tx := range txs {
+		if len(tx.TxHash) != sha256.Size {
+			return fmt.Errorf("invalid unordered tx hash length %d", len(tx.TxHash))
+		}
+		if tx.TimeoutHeight == 0 {
+			return fmt.Errorf("unordered tx %X has no timeout height", tx.TxHash)
+		}
+		key := string(tx.TxHash)
+		if seen[key] {
+			return fmt.Errorf("duplicate unordered tx %X", tx.TxHash)
+		}
+		seen[key] = true
+	}
+	return nil
 }
 
 // SanitizeGenesisAccounts sorts accounts and coin sets.
@@ -30,6 +30,8 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*types.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// unordered_txs are the unordered txs seen that have not timed out yet.
+	UnorderedTxs []UnorderedTx `protobuf:"bytes,3,rep,name=unordered_txs,json=unorderedTxs,proto3" json:"unordered_txs"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,30 +81,99 @@ func (m *GenesisState) GetAccounts() []*types.Any {
 	return nil
 }
 
+func (m *GenesisState) GetUnorderedTxs() []UnorderedTx {
+	if m != nil {
+		return m.UnorderedTxs
+	}
+	return nil
+}
+
+// UnorderedTx defines an unordered tx seen, rejected again until its timeout
+// height.
+type UnorderedTx struct {
+	// tx_hash is the key identifying the tx, the hash of its signers, the nonces
+	// of their signatures and its timeout height.
+	TxHash        []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	TimeoutHeight uint64 `protobuf:"varint,2,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+}
+
+func (m *UnorderedTx) Reset()         { *m = UnorderedTx{} }
+func (m *UnorderedTx) String() string { return proto.CompactTextString(m) }
+func (*UnorderedTx) ProtoMessage()    {}
+func (*UnorderedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0160936833c8bcca, []int{1}
+}
+func (m *UnorderedTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnorderedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnorderedTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnorderedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnorderedTx.Merge(m, src)
+}
+func (m *UnorderedTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnorderedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnorderedTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnorderedTx proto.InternalMessageInfo
+
+func (m *UnorderedTx) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *UnorderedTx) GetTimeoutHeight() uint64 {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lbm.auth.v1.GenesisState")
+	proto.RegisterType((*UnorderedTx)(nil), "lbm.auth.v1.UnorderedTx")
 }
 
 func init() { proto.RegisterFile("lbm/auth/v1/genesis.proto", fileDescriptor_0160936833c8bcca) }
 
 var fileDescriptor_0160936833c8bcca = []byte{
-	// 248 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcc, 0x49, 0xca, 0xd5,
-	0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0xce, 0x49, 0xca, 0xd5, 0x03, 0x49, 0xe9, 0x95, 0x19,
-	0x4a, 0x49, 0xa6, 0xe7, 0xe7, 0xa7, 0xe7, 0xa4, 0xea, 0x83, 0xa5, 0x92, 0x4a, 0xd3, 0xf4, 0x13,
-	0xf3, 0x2a, 0x21, 0xea, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x2a,
-	0x2a, 0x86, 0x6c, 0x30, 0xd8, 0x14, 0xb0, 0xb8, 0x52, 0x31, 0x17, 0x8f, 0x3b, 0xc4, 0x9a, 0xe0,
-	0x92, 0xc4, 0x92, 0x54, 0x21, 0x43, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x09, 0x46,
-	0x05, 0x46, 0x0d, 0x6e, 0x23, 0x61, 0x3d, 0x24, 0x6b, 0xf5, 0x02, 0xc0, 0x52, 0x4e, 0x2c, 0x27,
-	0xee, 0xc9, 0x33, 0x04, 0x41, 0x15, 0x0a, 0x19, 0x70, 0x71, 0x24, 0x26, 0x27, 0xe7, 0x97, 0xe6,
-	0x95, 0x14, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x89, 0xe8, 0x41, 0x9c, 0xa7, 0x07, 0x73,
-	0x9e, 0x9e, 0x63, 0x5e, 0x65, 0x10, 0x5c, 0x95, 0x93, 0xdd, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e,
-	0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37,
-	0x1e, 0xcb, 0x31, 0x44, 0xa9, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea,
-	0xe7, 0x64, 0xe6, 0xa5, 0xea, 0xe7, 0x24, 0xe5, 0xea, 0x16, 0xa7, 0x64, 0xeb, 0x57, 0x40, 0x1c,
-	0x5f, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x36, 0xd7, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff,
-	0xb2, 0xdc, 0xf1, 0x50, 0x2e, 0x01, 0x00, 0x00,
+	// 334 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x41, 0x4f, 0xfa, 0x30,
+	0x18, 0xc6, 0x57, 0x20, 0xfc, 0xff, 0xe9, 0xc0, 0xc3, 0x24, 0x3a, 0x38, 0x4c, 0x42, 0x34, 0xe1,
+	0x62, 0x2b, 0x78, 0x37, 0x11, 0x0f, 0x72, 0x31, 0x31, 0x53, 0x2f, 0x5e, 0x48, 0x07, 0xb5, 0x5d,
+	0xdc, 0x56, 0xb2, 0xb6, 0x64, 0x7c, 0x0b, 0x3f, 0x8e, 0x1f, 0x81, 0x23, 0x47, 0x4f, 0xc6, 0xc0,
+	0x17, 0x31, 0x74, 0x43, 0xe7, 0xed, 0xcd, 0xf3, 0xfc, 0xfa, 0x3e, 0x4f, 0x5f, 0xd8, 0x8e, 0x82,
+	0x18, 0x13, 0xad, 0x38, 0x5e, 0x0c, 0x30, 0xa3, 0x09, 0x95, 0xa1, 0x44, 0xf3, 0x54, 0x28, 0xe1,
+	0xd8, 0x51, 0x10, 0xa3, 0x9d, 0x85, 0x16, 0x83, 0x4e, 0x9b, 0x09, 0xc1, 0x22, 0x8a, 0x8d, 0x15,
+	0xe8, 0x17, 0x4c, 0x92, 0x65, 0xce, 0x75, 0x5a, 0x4c, 0x30, 0x61, 0x46, 0xbc, 0x9b, 0x0a, 0xf5,
+	0xa8, 0xbc, 0xd8, 0x6c, 0x31, 0x7a, 0xef, 0x1d, 0xc0, 0xc6, 0x6d, 0x9e, 0xf3, 0xa0, 0x88, 0xa2,
+	0xce, 0x00, 0xd6, 0xe7, 0x24, 0x25, 0xb1, 0x74, 0x41, 0x17, 0xf4, 0xed, 0xe1, 0x21, 0x2a, 0xe5,
+	0xa2, 0x7b, 0x63, 0x8d, 0x6a, 0xab, 0xcf, 0x13, 0xcb, 0x2f, 0x40, 0xe7, 0x02, 0xfe, 0x27, 0xd3,
+	0xa9, 0xd0, 0x89, 0x92, 0x6e, 0xa5, 0x5b, 0xed, 0xdb, 0xc3, 0x16, 0xca, 0xfb, 0xa1, 0x7d, 0x3f,
+	0x74, 0x9d, 0x2c, 0xfd, 0x1f, 0xca, 0xb9, 0x81, 0x4d, 0x9d, 0x88, 0x74, 0x46, 0x53, 0x3a, 0x9b,
+	0xa8, 0x4c, 0xba, 0x55, 0xf3, 0xcc, 0xfd, 0x93, 0xf5, 0xb4, 0x27, 0x1e, 0xb3, 0x22, 0xb0, 0xa1,
+	0x7f, 0x25, 0xd9, 0xbb, 0x83, 0x76, 0x09, 0x71, 0x8e, 0xe1, 0x3f, 0x95, 0x4d, 0x38, 0x91, 0xdc,
+	0x34, 0x6f, 0xf8, 0x75, 0x95, 0x8d, 0x89, 0xe4, 0xce, 0x19, 0x3c, 0x50, 0x61, 0x4c, 0x85, 0x56,
+	0x13, 0x4e, 0x43, 0xc6, 0x95, 0x5b, 0xe9, 0x82, 0x7e, 0xcd, 0x6f, 0x16, 0xea, 0xd8, 0x88, 0xa3,
+	0xab, 0xd5, 0xc6, 0x03, 0xeb, 0x8d, 0x07, 0xbe, 0x36, 0x1e, 0x78, 0xdb, 0x7a, 0xd6, 0x7a, 0xeb,
+	0x59, 0x1f, 0x5b, 0xcf, 0x7a, 0x3e, 0x65, 0xa1, 0xe2, 0x3a, 0x40, 0x53, 0x11, 0xe3, 0x28, 0x4c,
+	0x28, 0x8e, 0x82, 0xf8, 0x5c, 0xce, 0x5e, 0x71, 0x96, 0x5f, 0x54, 0x2d, 0xe7, 0x54, 0x06, 0x75,
+	0xf3, 0xd7, 0xcb, 0xef, 0x01, 0x00, 0x9e, 0x58, 0x49, 0x98, 0xc3, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +196,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UnorderedTxs) > 0 {
+		for iNdEx := len(m.UnorderedTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnorderedTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -152,6 +237,41 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UnorderedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnorderedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnorderedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeoutHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -177,6 +297,28 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.UnorderedTxs) > 0 {
+		for _, e := range m.UnorderedTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnorderedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.TimeoutHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeoutHeight))
+	}
 	return n
 }
 
@@ -282,6 +424,143 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnorderedTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnorderedTxs = append(m.UnorderedTxs, UnorderedTx{})
+			if err := m.UnorderedTxs[len(m.UnorderedTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnorderedTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnorderedTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnorderedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			m.TimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"testing"
 
@@ -45,6 +46,32 @@ func TestValidateGenesisDuplicateAccounts(t *testing.T) {
 	require.Error(t, types.ValidateGenAccounts(genAccs))
 }
 
+func TestValidateGenesisUnorderedTxs(t *testing.T) {
+	hash1, hash2 := sha256.Sum256([]byte("tx1")), sha256.Sum256([]byte("tx2"))
+
+	testCases := []struct {
+		msg    string
+		txs    []types.UnorderedTx
+		expErr bool
+	}{
+		{"valid", []types.UnorderedTx{{TxHash: hash1[:], TimeoutHeight: 10}, {TxHash: hash2[:], TimeoutHeight: 10}}, false},
+		{"invalid hash", []types.UnorderedTx{{TxHash: hash1[:20], TimeoutHeight: 10}}, true},
+		{"no timeout height", []types.UnorderedTx{{TxHash: hash1[:]}}, true},
+		{"duplicate", []types.UnorderedTx{{TxHash: hash1[:], TimeoutHeight: 10}, {TxHash: hash1[:], TimeoutHeight: 11}}, true},
+	}
+
+	for _, tc := range testCases {
+		genState := types.DefaultGenesisState()
+		genState.UnorderedTxs = tc.txs
+		err := types.ValidateGenesis(*genState)
+		if tc.expErr {
+			require.Error(t, err, tc.msg)
+		} else {
+			require.NoError(t, err, tc.msg)
+		}
+	}
+}
+
 func TestGenesisAccountIterator(t *testing.T) {
 	acc1 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr1))
 	acc2 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr2))
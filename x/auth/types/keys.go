@@ -24,9 +24,35 @@ const (
 var (
 	// AddressStoreKeyPrefix prefix for account-by-address store
 	AddressStoreKeyPrefix = []byte{0x01}
+
+	// UnorderedTxStoreKeyPrefix prefix for the timeout heights of the unordered
+	// txs seen, by tx hash
+	UnorderedTxStoreKeyPrefix = []byte{0x02}
+
+	// UnorderedTxTimeoutStoreKeyPrefix prefix for the hashes of the unordered
+	// txs seen, by timeout height
+	UnorderedTxTimeoutStoreKeyPrefix = []byte{0x03}
 )
 
 // AddressStoreKey turn an address to key used to get it from the account store
 func AddressStoreKey(addr sdk.AccAddress) []byte {
 	return append(AddressStoreKeyPrefix, addr.Bytes()...)
 }
+
+// UnorderedTxStoreKey turns the hash of an unordered tx to the key used to get
+// its timeout height from the store
+func UnorderedTxStoreKey(txHash []byte) []byte {
+	return append(UnorderedTxStoreKeyPrefix, txHash...)
+}
+
+// UnorderedTxTimeoutStoreKey returns the key of an unordered tx in the index by
+// timeout height
+func UnorderedTxTimeoutStoreKey(timeoutHeight uint64, txHash []byte) []byte {
+	return append(UnorderedTxTimeoutStoreKeyPrefixByHeight(timeoutHeight), txHash...)
+}
+
+// UnorderedTxTimeoutStoreKeyPrefixByHeight returns the prefix of the unordered
+// txs of a timeout height in the index by timeout height
+func UnorderedTxTimeoutStoreKeyPrefixByHeight(timeoutHeight uint64) []byte {
+	return append(UnorderedTxTimeoutStoreKeyPrefix, sdk.Uint64ToBigEndian(timeoutHeight)...)
+}
@@ -76,6 +76,15 @@ func DefaultParams() Params {
 	}
 }
 
+// SigBlockHeightWindow returns the lowest and the highest sig block heights
+// accepted at the given block height.
+func (p Params) SigBlockHeightWindow(height uint64) (min, max uint64) {
+	if height > p.ValidSigBlockPeriod {
+		min = height - p.ValidSigBlockPeriod
+	}
+	return min, height
+}
+
 // String implements the stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
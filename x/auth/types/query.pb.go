@@ -199,43 +199,164 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QuerySigBlockHeightWindowRequest is the request type for the Query/SigBlockHeightWindow RPC method.
+type QuerySigBlockHeightWindowRequest struct {
+}
+
+func (m *QuerySigBlockHeightWindowRequest) Reset()         { *m = QuerySigBlockHeightWindowRequest{} }
+func (m *QuerySigBlockHeightWindowRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySigBlockHeightWindowRequest) ProtoMessage()    {}
+func (*QuerySigBlockHeightWindowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a570ff87c0485f0a, []int{4}
+}
+func (m *QuerySigBlockHeightWindowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigBlockHeightWindowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigBlockHeightWindowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySigBlockHeightWindowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigBlockHeightWindowRequest.Merge(m, src)
+}
+func (m *QuerySigBlockHeightWindowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigBlockHeightWindowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigBlockHeightWindowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigBlockHeightWindowRequest proto.InternalMessageInfo
+
+// QuerySigBlockHeightWindowResponse is the response type for the Query/SigBlockHeightWindow RPC method.
+type QuerySigBlockHeightWindowResponse struct {
+	// height defines the block height the window is computed at.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// min_sig_block_height defines the lowest sig block height accepted at the height.
+	MinSigBlockHeight uint64 `protobuf:"varint,2,opt,name=min_sig_block_height,json=minSigBlockHeight,proto3" json:"min_sig_block_height,omitempty"`
+	// max_sig_block_height defines the highest sig block height accepted at the height.
+	MaxSigBlockHeight uint64 `protobuf:"varint,3,opt,name=max_sig_block_height,json=maxSigBlockHeight,proto3" json:"max_sig_block_height,omitempty"`
+	// valid_sig_block_period defines the valid_sig_block_period param of the module.
+	ValidSigBlockPeriod uint64 `protobuf:"varint,4,opt,name=valid_sig_block_period,json=validSigBlockPeriod,proto3" json:"valid_sig_block_period,omitempty"`
+}
+
+func (m *QuerySigBlockHeightWindowResponse) Reset()         { *m = QuerySigBlockHeightWindowResponse{} }
+func (m *QuerySigBlockHeightWindowResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySigBlockHeightWindowResponse) ProtoMessage()    {}
+func (*QuerySigBlockHeightWindowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a570ff87c0485f0a, []int{5}
+}
+func (m *QuerySigBlockHeightWindowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigBlockHeightWindowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigBlockHeightWindowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySigBlockHeightWindowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigBlockHeightWindowResponse.Merge(m, src)
+}
+func (m *QuerySigBlockHeightWindowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigBlockHeightWindowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigBlockHeightWindowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigBlockHeightWindowResponse proto.InternalMessageInfo
+
+func (m *QuerySigBlockHeightWindowResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QuerySigBlockHeightWindowResponse) GetMinSigBlockHeight() uint64 {
+	if m != nil {
+		return m.MinSigBlockHeight
+	}
+	return 0
+}
+
+func (m *QuerySigBlockHeightWindowResponse) GetMaxSigBlockHeight() uint64 {
+	if m != nil {
+		return m.MaxSigBlockHeight
+	}
+	return 0
+}
+
+func (m *QuerySigBlockHeightWindowResponse) GetValidSigBlockPeriod() uint64 {
+	if m != nil {
+		return m.ValidSigBlockPeriod
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountRequest)(nil), "lbm.auth.v1.QueryAccountRequest")
 	proto.RegisterType((*QueryAccountResponse)(nil), "lbm.auth.v1.QueryAccountResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "lbm.auth.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lbm.auth.v1.QueryParamsResponse")
+	proto.RegisterType((*QuerySigBlockHeightWindowRequest)(nil), "lbm.auth.v1.QuerySigBlockHeightWindowRequest")
+	proto.RegisterType((*QuerySigBlockHeightWindowResponse)(nil), "lbm.auth.v1.QuerySigBlockHeightWindowResponse")
 }
 
 func init() { proto.RegisterFile("lbm/auth/v1/query.proto", fileDescriptor_a570ff87c0485f0a) }
 
 var fileDescriptor_a570ff87c0485f0a = []byte{
-	// 415 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0xbd, 0xee, 0xd3, 0x30,
-	0x10, 0x4f, 0x10, 0xb4, 0x7f, 0x5c, 0x26, 0x37, 0x40, 0x09, 0x28, 0x69, 0x23, 0x24, 0x58, 0x6a,
-	0xab, 0x65, 0x02, 0x21, 0xa4, 0x76, 0x82, 0x0d, 0x22, 0x26, 0x16, 0xe4, 0xa4, 0x26, 0x8d, 0x48,
-	0xec, 0x34, 0x76, 0x0a, 0x15, 0x62, 0x61, 0x62, 0x44, 0xe2, 0x05, 0xfa, 0x10, 0x3c, 0x44, 0xc5,
-	0x54, 0x89, 0x85, 0x09, 0xa1, 0x96, 0x01, 0xf1, 0x14, 0x28, 0xb6, 0x23, 0x35, 0xe2, 0x63, 0xf3,
-	0xdd, 0xfd, 0xbe, 0xee, 0x0c, 0xae, 0x66, 0x51, 0x8e, 0x49, 0x25, 0x97, 0x78, 0x3d, 0xc1, 0xab,
-	0x8a, 0x96, 0x1b, 0x54, 0x94, 0x5c, 0x72, 0xd8, 0xcb, 0xa2, 0x1c, 0xd5, 0x03, 0xb4, 0x9e, 0xb8,
-	0x4e, 0xc2, 0x13, 0xae, 0xfa, 0xb8, 0x7e, 0x69, 0x88, 0x7b, 0x2d, 0xe1, 0x3c, 0xc9, 0x28, 0x56,
-	0x55, 0x54, 0xbd, 0xc0, 0x84, 0x19, 0xb6, 0x7b, 0xc3, 0x8c, 0x48, 0x91, 0x62, 0xc2, 0x18, 0x97,
-	0x44, 0xa6, 0x9c, 0x09, 0x33, 0xbd, 0x72, 0x6a, 0xaa, 0x3c, 0x8c, 0x60, 0xcc, 0x45, 0xce, 0xc5,
-	0x73, 0xed, 0xa4, 0x0b, 0x3d, 0x0a, 0xee, 0x82, 0xfe, 0x93, 0x3a, 0xdd, 0x2c, 0x8e, 0x79, 0xc5,
-	0x64, 0x48, 0x57, 0x15, 0x15, 0x12, 0x0e, 0x40, 0x97, 0x2c, 0x16, 0x25, 0x15, 0x62, 0x60, 0x0f,
-	0xed, 0xdb, 0x17, 0xc3, 0xa6, 0xbc, 0x77, 0xf6, 0x7e, 0xeb, 0x5b, 0x3f, 0xb7, 0xbe, 0x15, 0x3c,
-	0x05, 0x4e, 0x9b, 0x2a, 0x0a, 0xce, 0x04, 0x85, 0xf7, 0x41, 0x97, 0xe8, 0x96, 0xe2, 0xf6, 0xa6,
-	0x0e, 0xd2, 0xa9, 0x51, 0xb3, 0x10, 0x9a, 0xb1, 0xcd, 0xfc, 0xd2, 0xe7, 0x4f, 0xe3, 0x33, 0xc3,
-	0x7d, 0x14, 0x36, 0x94, 0xc0, 0x01, 0x50, 0xa9, 0x3e, 0x26, 0x25, 0xc9, 0x85, 0xc9, 0x13, 0x3c,
-	0x34, 0x31, 0x9b, 0xae, 0xb1, 0x9a, 0x80, 0x4e, 0xa1, 0x3a, 0xc6, 0xa9, 0x8f, 0x4e, 0xae, 0x8b,
-	0x34, 0x78, 0x7e, 0x7e, 0xf7, 0xcd, 0xb7, 0x42, 0x03, 0x9c, 0xfe, 0xb2, 0xc1, 0x05, 0x25, 0x05,
-	0x5f, 0x81, 0xae, 0xb1, 0x87, 0xc3, 0x16, 0xef, 0x2f, 0x07, 0x71, 0x47, 0xff, 0x41, 0xe8, 0x30,
-	0xc1, 0xad, 0x77, 0x5f, 0x7e, 0x7c, 0x3c, 0x37, 0x82, 0x3e, 0x6e, 0x7d, 0x83, 0x46, 0x09, 0xfc,
-	0xc6, 0x5c, 0xf0, 0x2d, 0x5c, 0x82, 0x8e, 0x8e, 0x06, 0xfd, 0x3f, 0x55, 0x5b, 0x7b, 0xbb, 0xc3,
-	0x7f, 0x03, 0x8c, 0xeb, 0x75, 0xe5, 0x7a, 0x19, 0xf6, 0x5b, 0xae, 0x7a, 0xd9, 0xf9, 0x83, 0xdd,
-	0xc1, 0xb3, 0xf7, 0x07, 0xcf, 0xfe, 0x7e, 0xf0, 0xec, 0x0f, 0x47, 0xcf, 0xda, 0x1f, 0x3d, 0xeb,
-	0xeb, 0xd1, 0xb3, 0x9e, 0xdd, 0x4c, 0x52, 0xb9, 0xac, 0x22, 0x14, 0xf3, 0x1c, 0x67, 0x29, 0xa3,
-	0x35, 0x7b, 0x2c, 0x16, 0x2f, 0xf1, 0x6b, 0xad, 0x21, 0x37, 0x05, 0x15, 0x51, 0x47, 0xfd, 0xd8,
-	0x9d, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6e, 0x74, 0xd9, 0xf7, 0xce, 0x02, 0x00, 0x00,
+	// 550 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xb7, 0xdb, 0x92, 0x94, 0x2b, 0x0b, 0x17, 0x13, 0x82, 0x41, 0x4e, 0x62, 0x55, 0xc0, 0x40,
+	0x7c, 0x4a, 0x3b, 0x81, 0x10, 0x52, 0x33, 0x95, 0xad, 0x04, 0x24, 0x24, 0x96, 0xe8, 0x1c, 0x1f,
+	0xce, 0xa9, 0xf6, 0x9d, 0x9b, 0xb3, 0xf3, 0x47, 0x88, 0x85, 0x89, 0xb1, 0x12, 0x5f, 0xa0, 0x12,
+	0x5f, 0x81, 0x0f, 0x51, 0x31, 0x55, 0xb0, 0x30, 0x21, 0x94, 0x30, 0xf0, 0x31, 0x50, 0xee, 0xce,
+	0x52, 0xdc, 0x94, 0x88, 0xcd, 0xef, 0xbd, 0xdf, 0x9f, 0x77, 0xef, 0x3d, 0x83, 0xdb, 0x91, 0x1f,
+	0x23, 0x9c, 0xa5, 0x03, 0x34, 0x6a, 0xa3, 0x93, 0x8c, 0x0c, 0xa7, 0x5e, 0x32, 0xe4, 0x29, 0x87,
+	0x3b, 0x91, 0x1f, 0x7b, 0x8b, 0x82, 0x37, 0x6a, 0xdb, 0x56, 0xc8, 0x43, 0x2e, 0xf3, 0x68, 0xf1,
+	0xa5, 0x20, 0xf6, 0x9d, 0x90, 0xf3, 0x30, 0x22, 0x48, 0x46, 0x7e, 0xf6, 0x16, 0x61, 0xa6, 0xd9,
+	0xf6, 0x3d, 0x5d, 0xc2, 0x09, 0x45, 0x98, 0x31, 0x9e, 0xe2, 0x94, 0x72, 0x26, 0x74, 0xb5, 0xba,
+	0x6c, 0x2a, 0x3d, 0xb4, 0x60, 0x9f, 0x8b, 0x98, 0x8b, 0x9e, 0x72, 0x52, 0x81, 0x2a, 0xb9, 0x8f,
+	0x41, 0xe5, 0xc5, 0xa2, 0xbb, 0x83, 0x7e, 0x9f, 0x67, 0x2c, 0xed, 0x92, 0x93, 0x8c, 0x88, 0x14,
+	0xd6, 0x40, 0x19, 0x07, 0xc1, 0x90, 0x08, 0x51, 0x33, 0x1b, 0xe6, 0xc3, 0xeb, 0xdd, 0x3c, 0x7c,
+	0xb2, 0xfd, 0xf1, 0xac, 0x6e, 0xfc, 0x39, 0xab, 0x1b, 0xee, 0x2b, 0x60, 0x15, 0xa9, 0x22, 0xe1,
+	0x4c, 0x10, 0xf8, 0x14, 0x94, 0xb1, 0x4a, 0x49, 0xee, 0xce, 0x9e, 0xe5, 0xa9, 0xae, 0xbd, 0xfc,
+	0x41, 0xde, 0x01, 0x9b, 0x76, 0x6e, 0x7c, 0xfd, 0xd2, 0xda, 0xd6, 0xdc, 0xe7, 0xdd, 0x9c, 0xe2,
+	0x5a, 0x00, 0x4a, 0xd5, 0x23, 0x3c, 0xc4, 0xb1, 0xd0, 0xfd, 0xb8, 0x87, 0xa0, 0x52, 0xc8, 0x6a,
+	0xab, 0x36, 0x28, 0x25, 0x32, 0xa3, 0x9d, 0x2a, 0xde, 0xd2, 0x74, 0x3d, 0x05, 0xee, 0x6c, 0x9d,
+	0xff, 0xac, 0x1b, 0x5d, 0x0d, 0x74, 0x5d, 0xd0, 0x90, 0x4a, 0x2f, 0x69, 0xd8, 0x89, 0x78, 0xff,
+	0xf8, 0x90, 0xd0, 0x70, 0x90, 0xbe, 0xa6, 0x2c, 0xe0, 0xe3, 0xdc, 0xed, 0x9b, 0x09, 0x9a, 0x6b,
+	0x40, 0xda, 0xbc, 0x0a, 0x4a, 0x03, 0x99, 0x97, 0xe6, 0x5b, 0x5d, 0x1d, 0x41, 0x04, 0xac, 0x98,
+	0xb2, 0x9e, 0xa0, 0x61, 0xcf, 0x5f, 0x90, 0x7b, 0x1a, 0xb5, 0x21, 0x51, 0x37, 0x63, 0xca, 0x8a,
+	0xb2, 0x92, 0x80, 0x27, 0xab, 0x84, 0x4d, 0x4d, 0xc0, 0x93, 0x4b, 0x84, 0x7d, 0x50, 0x1d, 0xe1,
+	0x88, 0x06, 0x4b, 0x94, 0x84, 0x0c, 0x29, 0x0f, 0x6a, 0x5b, 0x92, 0x52, 0x91, 0xd5, 0x9c, 0x74,
+	0x24, 0x4b, 0x7b, 0xa7, 0x9b, 0xe0, 0x9a, 0x7c, 0x14, 0x1c, 0x83, 0xb2, 0x9e, 0x3b, 0x6c, 0x14,
+	0x06, 0x76, 0xc5, 0x25, 0xd8, 0xcd, 0x35, 0x08, 0x35, 0x08, 0xf7, 0xc1, 0x87, 0xef, 0xbf, 0x3f,
+	0x6d, 0x34, 0x61, 0x1d, 0x15, 0xee, 0x4f, 0xa1, 0x04, 0x7a, 0xa7, 0x4f, 0xe7, 0x3d, 0x1c, 0x80,
+	0x92, 0xda, 0x09, 0xac, 0xaf, 0xaa, 0x16, 0x16, 0x6e, 0x37, 0xfe, 0x0d, 0xd0, 0xae, 0x77, 0xa5,
+	0xeb, 0x2d, 0x58, 0x29, 0xb8, 0xaa, 0x2d, 0xc3, 0xcf, 0x26, 0xb0, 0xae, 0x5a, 0x1e, 0x6c, 0xad,
+	0xea, 0xae, 0xb9, 0x04, 0xdb, 0xfb, 0x5f, 0xb8, 0x6e, 0xea, 0x91, 0x6c, 0xea, 0x3e, 0xdc, 0x2d,
+	0x34, 0x75, 0x79, 0xb3, 0xbd, 0xb1, 0x64, 0x75, 0x9e, 0x9d, 0xcf, 0x1c, 0xf3, 0x62, 0xe6, 0x98,
+	0xbf, 0x66, 0x8e, 0x79, 0x3a, 0x77, 0x8c, 0x8b, 0xb9, 0x63, 0xfc, 0x98, 0x3b, 0xc6, 0x9b, 0xdd,
+	0x90, 0xa6, 0x83, 0xcc, 0xf7, 0xfa, 0x3c, 0x46, 0x11, 0x65, 0x64, 0x21, 0xd7, 0x12, 0xc1, 0x31,
+	0x9a, 0x28, 0xd1, 0x74, 0x9a, 0x10, 0xe1, 0x97, 0xe4, 0x0f, 0xb5, 0xff, 0x77, 0x00, 0x91, 0x79,
+	0xc2, 0x8c, 0x6d, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -254,6 +375,8 @@ type QueryClient interface {
 	Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*QueryAccountResponse, error)
 	// Params queries all parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// SigBlockHeightWindow queries the range of sig block heights accepted at the latest block height.
+	SigBlockHeightWindow(ctx context.Context, in *QuerySigBlockHeightWindowRequest, opts ...grpc.CallOption) (*QuerySigBlockHeightWindowResponse, error)
 }
 
 type queryClient struct {
@@ -282,12 +405,23 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) SigBlockHeightWindow(ctx context.Context, in *QuerySigBlockHeightWindowRequest, opts ...grpc.CallOption) (*QuerySigBlockHeightWindowResponse, error) {
+	out := new(QuerySigBlockHeightWindowResponse)
+	err := c.cc.Invoke(ctx, "/lbm.auth.v1.Query/SigBlockHeightWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Account returns account details based on address.
 	Account(context.Context, *QueryAccountRequest) (*QueryAccountResponse, error)
 	// Params queries all parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// SigBlockHeightWindow queries the range of sig block heights accepted at the latest block height.
+	SigBlockHeightWindow(context.Context, *QuerySigBlockHeightWindowRequest) (*QuerySigBlockHeightWindowResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -300,6 +434,9 @@ func (*UnimplementedQueryServer) Account(ctx context.Context, req *QueryAccountR
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) SigBlockHeightWindow(ctx context.Context, req *QuerySigBlockHeightWindowRequest) (*QuerySigBlockHeightWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigBlockHeightWindow not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -341,6 +478,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SigBlockHeightWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigBlockHeightWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigBlockHeightWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.auth.v1.Query/SigBlockHeightWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigBlockHeightWindow(ctx, req.(*QuerySigBlockHeightWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lbm.auth.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -353,6 +508,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "SigBlockHeightWindow",
+			Handler:    _Query_SigBlockHeightWindow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lbm/auth/v1/query.proto",
@@ -479,6 +638,72 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySigBlockHeightWindowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigBlockHeightWindowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigBlockHeightWindowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigBlockHeightWindowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigBlockHeightWindowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigBlockHeightWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidSigBlockPeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidSigBlockPeriod))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxSigBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxSigBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinSigBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinSigBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -536,6 +761,36 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySigBlockHeightWindowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySigBlockHeightWindowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.MinSigBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinSigBlockHeight))
+	}
+	if m.MaxSigBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxSigBlockHeight))
+	}
+	if m.ValidSigBlockPeriod != 0 {
+		n += 1 + sovQuery(uint64(m.ValidSigBlockPeriod))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -843,6 +1098,182 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySigBlockHeightWindowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigBlockHeightWindowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigBlockHeightWindowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigBlockHeightWindowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigBlockHeightWindowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigBlockHeightWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSigBlockHeight", wireType)
+			}
+			m.MinSigBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSigBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSigBlockHeight", wireType)
+			}
+			m.MaxSigBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSigBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidSigBlockPeriod", wireType)
+			}
+			m.ValidSigBlockPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidSigBlockPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
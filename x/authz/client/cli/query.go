@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/flags"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/version"
+	"github.com/line/lbm-sdk/x/authz/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	authorizationQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the authz module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	authorizationQueryCmd.AddCommand(
+		GetCmdQueryAuthorization(),
+		GetCmdQueryAuthorizations(),
+	)
+
+	return authorizationQueryCmd
+}
+
+// GetCmdQueryAuthorizations implements the query authorizations command.
+func GetCmdQueryAuthorizations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorizations [granter] [grantee]",
+		Args:  cobra.ExactArgs(2),
+		Short: "query list of authorizations for a granter-grantee pair",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query list of authorizations for a granter-grantee pair:
+Example:
+$ %s query %s authorizations link1skj.. link1skjwj..
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			err := sdk.ValidateAccAddress(args[0])
+			if err != nil {
+				return err
+			}
+			granter := sdk.AccAddress(args[0])
+
+			err = sdk.ValidateAccAddress(args[1])
+			if err != nil {
+				return err
+			}
+			grantee := sdk.AccAddress(args[1])
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Authorizations(
+				cmd.Context(),
+				&types.QueryAuthorizationsRequest{
+					Granter:    granter.String(),
+					Grantee:    grantee.String(),
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "authorizations")
+	return cmd
+}
+
+// GetCmdQueryAuthorization implements the query authorization command.
+func GetCmdQueryAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorization [granter] [grantee] [method_name]",
+		Args:  cobra.ExactArgs(3),
+		Short: "query authorization for a granter-grantee pair",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query authorization for a granter-grantee pair:
+Example:
+$ %s query %s authorization link1skj.. link1skjwj.. /lbm.bank.v1.Msg/Send
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			err := sdk.ValidateAccAddress(args[0])
+			if err != nil {
+				return err
+			}
+			granter := sdk.AccAddress(args[0])
+
+			err = sdk.ValidateAccAddress(args[1])
+			if err != nil {
+				return err
+			}
+			grantee := sdk.AccAddress(args[1])
+
+			res, err := queryClient.Authorization(
+				cmd.Context(),
+				&types.QueryAuthorizationRequest{
+					Granter:    granter.String(),
+					Grantee:    grantee.String(),
+					MethodName: args[2],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Authorization)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
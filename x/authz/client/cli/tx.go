@@ -0,0 +1,265 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/client/tx"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/version"
+	authclient "github.com/line/lbm-sdk/x/auth/client"
+	"github.com/line/lbm-sdk/x/authz/types"
+	bank "github.com/line/lbm-sdk/x/bank/types"
+	staking "github.com/line/lbm-sdk/x/staking/types"
+)
+
+// flag for authz module
+const (
+	FlagSpendLimit        = "spend-limit"
+	FlagMsgType           = "msg-type"
+	FlagExpiration        = "expiration"
+	FlagAllowedValidators = "allowed-validators"
+	FlagDenyValidators    = "deny-validators"
+	delegate              = "delegate"
+	redelegate            = "redelegate"
+	unbond                = "unbond"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	authorizationTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Authorization transactions subcommands",
+		Long:                       "Authorize and revoke access to execute transactions on behalf of your address",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	authorizationTxCmd.AddCommand(
+		NewCmdGrantAuthorization(),
+		NewCmdRevokeAuthorization(),
+		NewCmdExecAuthorization(),
+	)
+
+	return authorizationTxCmd
+}
+
+// NewCmdGrantAuthorization returns a CLI command handler for creating a MsgGrantAuthorization transaction.
+func NewCmdGrantAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from [granter]",
+		Short: "Grant authorization to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`grant authorization to an address to execute a transaction on your behalf:
+
+Examples:
+ $ %s tx %s grant link1skjw.. send --spend-limit=1000stake --from=link1skl..
+ $ %s tx %s grant link1skjw.. generic --msg-type=/lbm.gov.v1.Msg/Vote --from=link1sk..
+	`, version.AppName, types.ModuleName, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			err = sdk.ValidateAccAddress(args[0])
+			if err != nil {
+				return err
+			}
+			grantee := sdk.AccAddress(args[0])
+
+			exp, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			var authorization types.Authorization
+			switch args[1] {
+			case "send":
+				limit, err := cmd.Flags().GetString(FlagSpendLimit)
+				if err != nil {
+					return err
+				}
+
+				spendLimit, err := sdk.ParseCoinsNormalized(limit)
+				if err != nil {
+					return err
+				}
+
+				if !spendLimit.IsAllPositive() {
+					return fmt.Errorf("spend-limit should be greater than zero")
+				}
+
+				authorization = bank.NewSendAuthorization(spendLimit)
+			case "generic":
+				msgType, err := cmd.Flags().GetString(FlagMsgType)
+				if err != nil {
+					return err
+				}
+
+				authorization = types.NewGenericAuthorization(msgType)
+			case delegate, unbond, redelegate:
+				limit, err := cmd.Flags().GetString(FlagSpendLimit)
+				if err != nil {
+					return err
+				}
+
+				allowValidators, err := cmd.Flags().GetStringSlice(FlagAllowedValidators)
+				if err != nil {
+					return err
+				}
+
+				denyValidators, err := cmd.Flags().GetStringSlice(FlagDenyValidators)
+				if err != nil {
+					return err
+				}
+
+				var delegateLimit *sdk.Coin
+				if limit != "" {
+					spendLimit, err := sdk.ParseCoinsNormalized(limit)
+					if err != nil {
+						return err
+					}
+
+					if !spendLimit.IsAllPositive() {
+						return fmt.Errorf("spend-limit should be greater than zero")
+					}
+					if len(spendLimit) != 1 {
+						return fmt.Errorf("spend-limit should be a single coin")
+					}
+					delegateLimit = &spendLimit[0]
+				}
+
+				allowed, err := bech32toValidatorAddresses(allowValidators)
+				if err != nil {
+					return err
+				}
+
+				denied, err := bech32toValidatorAddresses(denyValidators)
+				if err != nil {
+					return err
+				}
+
+				authzType := staking.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE
+				switch args[1] {
+				case unbond:
+					authzType = staking.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE
+				case redelegate:
+					authzType = staking.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE
+				}
+
+				authorization, err = staking.NewStakeAuthorization(allowed, denied, authzType, delegateLimit)
+				if err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("invalid authorization type, %s", args[1])
+			}
+
+			msg, err := types.NewMsgGrantAuthorization(clientCtx.GetFromAddress(), grantee, authorization, time.Unix(exp, 0))
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagMsgType, "", "The Msg service method name for the generic authorization")
+	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for Send Authorization, an array of Coins allowed spend")
+	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
+	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
+	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The Unix timestamp. Default is one year.")
+	return cmd
+}
+
+// NewCmdRevokeAuthorization returns a CLI command handler for creating a MsgRevokeAuthorization transaction.
+func NewCmdRevokeAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [grantee] [method_name] --from=[granter]",
+		Short: "revoke authorization",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`revoke authorization from a granter to a grantee:
+Example:
+ $ %s tx %s revoke link1skj.. /lbm.bank.v1.Msg/Send --from=link1skj..
+			`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			err = sdk.ValidateAccAddress(args[0])
+			if err != nil {
+				return err
+			}
+			grantee := sdk.AccAddress(args[0])
+
+			msg := types.NewMsgRevokeAuthorization(clientCtx.GetFromAddress(), grantee, args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdExecAuthorization returns a CLI command handler for creating a MsgExecAuthorized transaction.
+func NewCmdExecAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [msg_tx_json_file] --from [grantee]",
+		Short: "execute tx on behalf of granter account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`execute tx on behalf of granter account:
+Example:
+ $ %s tx %s exec tx.json --from grantee
+ $ %s tx bank send <granter> <recipient> --from <granter> --chain-id <chain-id> --generate-only > tx.json && %s tx %s exec tx.json --from grantee
+			`, version.AppName, types.ModuleName, version.AppName, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			msgs := theTx.GetMsgs()
+
+			msg, err := types.NewMsgExecAuthorized(clientCtx.GetFromAddress(), msgs)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func bech32toValidatorAddresses(validators []string) ([]sdk.ValAddress, error) {
+	vals := make([]sdk.ValAddress, len(validators))
+	for i, validator := range validators {
+		if err := sdk.ValidateValAddress(validator); err != nil {
+			return nil, err
+		}
+		vals[i] = sdk.ValAddress(validator)
+	}
+	return vals, nil
+}
@@ -0,0 +1,17 @@
+/*
+Package authz provides functionality for granting arbitrary privileges from one
+account (the granter) to another account (the grantee).
+
+An authorization grants the grantee the right to execute a Msg service method
+on behalf of the granter, as defined by an implementation of the Authorization
+interface. GenericAuthorization grants the unrestricted execution of a method,
+while modules define authorizations restricting it, e.g. SendAuthorization of
+x/bank limiting the coins which can be sent.
+
+A granter authorizes a grantee using MsgGrantAuthorization, until an expiration
+time, and revokes the authorization using MsgRevokeAuthorization. The grantee
+executes msgs on behalf of the granters using MsgExecAuthorized, the msgs being
+dispatched through the Msg service router once accepted by their
+authorizations.
+*/
+package authz
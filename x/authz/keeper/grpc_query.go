@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	codectypes "github.com/line/lbm-sdk/codec/types"
+	"github.com/line/lbm-sdk/store/prefix"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/query"
+	"github.com/line/lbm-sdk/x/authz/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Authorizations implements the Query/Authorizations gRPC method.
+func (k Keeper) Authorizations(c context.Context, req *types.QueryAuthorizationsRequest) (*types.QueryAuthorizationsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateAccAddress(req.Granter); err != nil {
+		return nil, err
+	}
+	granter := sdk.AccAddress(req.Granter)
+
+	if err := sdk.ValidateAccAddress(req.Grantee); err != nil {
+		return nil, err
+	}
+	grantee := sdk.AccAddress(req.Grantee)
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	authStore := prefix.NewStore(store, grantStoreKeyPrefix(grantee, granter))
+
+	var authorizations []*types.AuthorizationGrant
+	pageRes, err := query.FilteredPaginate(authStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var grant types.AuthorizationGrant
+		if err := k.cdc.UnmarshalBinaryBare(value, &grant); err != nil {
+			return false, err
+		}
+		if grant.Expiration.Before(ctx.BlockHeader().Time) {
+			return false, nil
+		}
+
+		if accumulate {
+			authorizations = append(authorizations, &grant)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuthorizationsResponse{
+		Authorizations: authorizations,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// Authorization implements the Query/Authorization gRPC method.
+func (k Keeper) Authorization(c context.Context, req *types.QueryAuthorizationRequest) (*types.QueryAuthorizationResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.MethodName == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty method-name")
+	}
+
+	if err := sdk.ValidateAccAddress(req.Granter); err != nil {
+		return nil, err
+	}
+	granter := sdk.AccAddress(req.Granter)
+
+	if err := sdk.ValidateAccAddress(req.Grantee); err != nil {
+		return nil, err
+	}
+	grantee := sdk.AccAddress(req.Grantee)
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	authorization, expiration := k.GetCleanAuthorization(ctx, grantee, granter, req.MethodName)
+	if authorization == nil {
+		return nil, status.Errorf(codes.NotFound, "no authorization found for %s type", req.MethodName)
+	}
+
+	authorizationAny, err := codectypes.NewAnyWithValue(authorization)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuthorizationResponse{
+		Authorization: &types.AuthorizationGrant{
+			Authorization: authorizationAny,
+			Expiration:    expiration,
+		},
+	}, nil
+}
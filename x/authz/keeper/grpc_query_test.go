@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/query"
+	"github.com/line/lbm-sdk/x/authz/types"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+)
+
+func (s *KeeperTestSuite) TestGRPCQueryAuthorizations() {
+	granter, grantee := s.addrs[0], s.addrs[1]
+	now := s.ctx.BlockHeader().Time
+
+	_, err := s.keeper.Authorization(s.goCtx, nil)
+	s.Require().Error(err)
+	_, err = s.keeper.Authorization(s.goCtx, &types.QueryAuthorizationRequest{Granter: granter.String(), Grantee: grantee.String()})
+	s.Require().Error(err)
+	_, err = s.keeper.Authorization(s.goCtx, &types.QueryAuthorizationRequest{Granter: granter.String(), Grantee: grantee.String(), MethodName: bankSendMethodName})
+	s.Require().Error(err)
+
+	authorization := banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	s.Require().NoError(s.keeper.Grant(s.ctx, grantee, granter, authorization, now.Add(time.Hour)))
+	s.Require().NoError(s.keeper.Grant(s.ctx, grantee, granter, types.NewGenericAuthorization("/lbm.gov.v1.Msg/Vote"), now.Add(-time.Hour)))
+	s.Require().NoError(s.keeper.Grant(s.ctx, grantee, granter, types.NewGenericAuthorization("/lbm.gov.v1.Msg/Deposit"), now.Add(time.Hour)))
+
+	res, err := s.keeper.Authorization(s.goCtx, &types.QueryAuthorizationRequest{Granter: granter.String(), Grantee: grantee.String(), MethodName: bankSendMethodName})
+	s.Require().NoError(err)
+	s.Require().Equal(authorization, res.Authorization.GetAuthorizationGrant())
+
+	_, err = s.keeper.Authorizations(s.goCtx, &types.QueryAuthorizationsRequest{Granter: "invalid", Grantee: grantee.String()})
+	s.Require().Error(err)
+
+	// the expired authorization is filtered out
+	resAll, err := s.keeper.Authorizations(s.goCtx, &types.QueryAuthorizationsRequest{Granter: granter.String(), Grantee: grantee.String()})
+	s.Require().NoError(err)
+	s.Require().Len(resAll.Authorizations, 2)
+	s.Require().Equal(uint64(2), resAll.Pagination.Total)
+
+	resAll, err = s.keeper.Authorizations(s.goCtx, &types.QueryAuthorizationsRequest{Granter: granter.String(), Grantee: grantee.String(), Pagination: &query.PageRequest{Limit: 1}})
+	s.Require().NoError(err)
+	s.Require().Len(resAll.Authorizations, 1)
+	s.Require().NotNil(resAll.Pagination.NextKey)
+
+	resAll, err = s.keeper.Authorizations(s.goCtx, &types.QueryAuthorizationsRequest{Granter: grantee.String(), Grantee: granter.String()})
+	s.Require().NoError(err)
+	s.Require().Empty(resAll.Authorizations)
+}
@@ -0,0 +1,287 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/line/ostracon/libs/log"
+
+	"github.com/line/lbm-sdk/baseapp"
+	"github.com/line/lbm-sdk/codec"
+	codectypes "github.com/line/lbm-sdk/codec/types"
+	storetypes "github.com/line/lbm-sdk/store/types"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/authz/types"
+)
+
+// Keeper manages the authorizations, dispatching the authorized msgs through
+// the Msg service router.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryMarshaler
+	router   *baseapp.MsgServiceRouter
+}
+
+// NewKeeper constructs a message authorization Keeper
+func NewKeeper(storeKey storetypes.StoreKey, cdc codec.BinaryMarshaler, router *baseapp.MsgServiceRouter) Keeper {
+	return Keeper{
+		storeKey: storeKey,
+		cdc:      cdc,
+		router:   router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// getAuthorizationGrant returns grant between granter and grantee for the given method name.
+func (k Keeper) getAuthorizationGrant(ctx sdk.Context, grantStoreKey []byte) (grant types.AuthorizationGrant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(grantStoreKey)
+	if bz == nil {
+		return grant, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+// update updates the authorization of a grant, keeping its expiration.
+func (k Keeper) update(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, updated types.Authorization) error {
+	grantStoreKey := grantStoreKey(grantee, granter, updated.MethodName())
+	grant, found := k.getAuthorizationGrant(ctx, grantStoreKey)
+	if !found {
+		return sdkerrors.Wrap(types.ErrNoAuthorizationFound, "authorization not found")
+	}
+
+	any, err := codectypes.NewAnyWithValue(updated)
+	if err != nil {
+		return err
+	}
+
+	grant.Authorization = any
+	store := ctx.KVStore(k.storeKey)
+	store.Set(grantStoreKey, k.cdc.MustMarshalBinaryBare(&grant))
+	return nil
+}
+
+// DispatchActions attempts to execute the provided messages via authorization
+// grants from the message signer to the grantee, returning the data of their
+// results.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
+	results := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		serviceMsg, err := k.serviceMsg(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		signers := serviceMsg.GetSigners()
+		if len(signers) != 1 {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "authorization can be given to msg with only one signer")
+		}
+		granter := signers[0]
+
+		// if granter != grantee then check authorization.Accept, otherwise we implicitly accept.
+		if !granter.Equals(grantee) {
+			authorization, _ := k.GetCleanAuthorization(ctx, grantee, granter, serviceMsg.MethodName)
+			if authorization == nil {
+				return nil, sdkerrors.Wrapf(types.ErrNoAuthorizationFound, "method name: %s", serviceMsg.MethodName)
+			}
+			resp, err := authorization.Accept(ctx, serviceMsg)
+			if err != nil {
+				return nil, err
+			}
+			if resp.Delete {
+				err = k.DeleteGrant(ctx, grantee, granter, serviceMsg.MethodName)
+			} else if resp.Updated != nil {
+				err = k.update(ctx, grantee, granter, resp.Updated)
+			}
+			if err != nil {
+				return nil, err
+			}
+			if !resp.Accept {
+				return nil, sdkerrors.ErrUnauthorized
+			}
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeExecAuthorized,
+					sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+					sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+					sdk.NewAttribute(types.AttributeKeyMethodName, serviceMsg.MethodName),
+				),
+			)
+		}
+
+		handler := k.router.Handler(serviceMsg.MethodName)
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message service method: %s; message index: %d", serviceMsg.MethodName, i)
+		}
+
+		msgResult, err := handler(ctx, serviceMsg.Request)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		results[i] = msgResult.Data
+		ctx.EventManager().EmitEvents(msgResult.GetEvents())
+	}
+
+	return results, nil
+}
+
+// serviceMsg returns a msg as a service msg, routing a legacy msg to the Msg
+// service method taking it as request.
+func (k Keeper) serviceMsg(msg sdk.Msg) (sdk.ServiceMsg, error) {
+	if serviceMsg, ok := msg.(sdk.ServiceMsg); ok {
+		return serviceMsg, nil
+	}
+
+	typeURL := sdk.MsgTypeURL(msg)
+	methodName := k.router.MethodName(typeURL)
+	if methodName == "" {
+		return sdk.ServiceMsg{}, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no message service method for %s", typeURL)
+	}
+	return sdk.ServiceMsg{MethodName: methodName, Request: msg}, nil
+}
+
+// Grant method grants the provided authorization to the grantee on the granter's account with the provided expiration
+// time. If there is an existing authorization grant for the same method name, it gets overwritten.
+func (k Keeper) Grant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization types.Authorization, expiration time.Time) error {
+	store := ctx.KVStore(k.storeKey)
+
+	grant, err := types.NewAuthorizationGrant(authorization, expiration)
+	if err != nil {
+		return err
+	}
+
+	bz := k.cdc.MustMarshalBinaryBare(&grant)
+	methodName := authorization.MethodName()
+	store.Set(grantStoreKey(grantee, granter, methodName), bz)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrantAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMethodName, methodName),
+		),
+	)
+	return nil
+}
+
+// DeleteGrant revokes any authorization for the provided method name granted to the grantee
+// by the granter.
+func (k Keeper) DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, methodName string) error {
+	store := ctx.KVStore(k.storeKey)
+	grantStoreKey := grantStoreKey(grantee, granter, methodName)
+	if _, found := k.getAuthorizationGrant(ctx, grantStoreKey); !found {
+		return sdkerrors.Wrap(types.ErrNoAuthorizationFound, "authorization not found")
+	}
+	store.Delete(grantStoreKey)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMethodName, methodName),
+		),
+	)
+	return nil
+}
+
+// GetAuthorizations returns the unexpired authorizations granted to the
+// grantee by the granter.
+func (k Keeper) GetAuthorizations(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress) []types.Authorization {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, grantStoreKeyPrefix(grantee, granter))
+	defer iter.Close()
+
+	var authorizations []types.Authorization
+	for ; iter.Valid(); iter.Next() {
+		var grant types.AuthorizationGrant
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+		if grant.Expiration.Before(ctx.BlockHeader().Time) {
+			continue
+		}
+		authorizations = append(authorizations, grant.GetAuthorizationGrant())
+	}
+	return authorizations
+}
+
+// GetCleanAuthorization returns an `Authorization` and it's expiration time for
+// (grantee, granter, method name) grant. If there is no grant `nil` is returned.
+// If the grant is expired, the grant is revoked, removed from the storage, and `nil` is returned.
+func (k Keeper) GetCleanAuthorization(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, methodName string) (cap types.Authorization, expiration time.Time) {
+	grant, found := k.getAuthorizationGrant(ctx, grantStoreKey(grantee, granter, methodName))
+	if !found {
+		return nil, time.Time{}
+	}
+	if grant.Expiration.Before(ctx.BlockHeader().Time) {
+		k.DeleteGrant(ctx, grantee, granter, methodName) //nolint:errcheck
+		return nil, time.Time{}
+	}
+
+	return grant.GetAuthorizationGrant(), grant.Expiration
+}
+
+// IterateGrants iterates over all authorization grants
+// This function should be used with caution because it can involve significant IO operations.
+// It should not be used in query or msg services without charging additional gas.
+func (k Keeper) IterateGrants(ctx sdk.Context,
+	handler func(granterAddr sdk.AccAddress, granteeAddr sdk.AccAddress, grant types.AuthorizationGrant) bool,
+) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, GrantKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var grant types.AuthorizationGrant
+		granterAddr, granteeAddr := addressesFromGrantStoreKey(iter.Key())
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+		if handler(granterAddr, granteeAddr, grant) {
+			break
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	var entries []types.GrantAuthorization
+	k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.AuthorizationGrant) bool {
+		exp := grant.Expiration
+		if exp.Before(ctx.BlockHeader().Time) {
+			return false
+		}
+
+		entries = append(entries, types.GrantAuthorization{
+			Granter:       granter.String(),
+			Grantee:       grantee.String(),
+			Expiration:    exp,
+			Authorization: grant.Authorization,
+		})
+		return false
+	})
+
+	return types.NewGenesisState(entries)
+}
+
+// InitGenesis new authz genesis
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	for _, entry := range data.Authorization {
+		grantee := sdk.AccAddress(entry.Grantee)
+		granter := sdk.AccAddress(entry.Granter)
+		authorization := entry.GetGrantAuthorization()
+		if authorization == nil {
+			panic(sdkerrors.Wrap(types.ErrUnknownAuthorization, "failed to get authorization"))
+		}
+
+		err := k.Grant(ctx, grantee, granter, authorization, entry.Expiration)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ocproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/line/lbm-sdk/simapp"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/authz/keeper"
+	"github.com/line/lbm-sdk/x/authz/types"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+)
+
+var (
+	bankSendMethodName = banktypes.SendAuthorization{}.MethodName()
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app     *simapp.SimApp
+	ctx     sdk.Context
+	goCtx   context.Context
+	addrs   []sdk.AccAddress
+	keeper  keeper.Keeper
+	msgSrvr types.MsgServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, ocproto.Header{Time: time.Now().UTC()})
+
+	s.app = app
+	s.ctx = ctx
+	s.goCtx = sdk.WrapSDKContext(ctx)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+	s.keeper = app.AuthzKeeper
+	s.msgSrvr = keeper.NewMsgServerImpl(s.keeper)
+}
+
+func (s *KeeperTestSuite) TestKeeper() {
+	granter, grantee := s.addrs[0], s.addrs[1]
+	now := s.ctx.BlockHeader().Time
+
+	authorization, _ := s.keeper.GetCleanAuthorization(s.ctx, grantee, granter, bankSendMethodName)
+	s.Require().Nil(authorization)
+
+	// an expired grant is removed when got
+	newCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	x := banktypes.NewSendAuthorization(newCoins)
+	s.Require().NoError(s.keeper.Grant(s.ctx, grantee, granter, x, now.Add(-time.Hour)))
+	authorization, _ = s.keeper.GetCleanAuthorization(s.ctx, grantee, granter, bankSendMethodName)
+	s.Require().Nil(authorization)
+	s.Require().Error(s.keeper.DeleteGrant(s.ctx, grantee, granter, bankSendMethodName))
+
+	s.Require().NoError(s.keeper.Grant(s.ctx, grantee, granter, x, now.Add(time.Hour)))
+	authorization, expiration := s.keeper.GetCleanAuthorization(s.ctx, grantee, granter, bankSendMethodName)
+	s.Require().Equal(x, authorization)
+	s.Require().True(now.Add(time.Hour).Equal(expiration))
+	s.Require().Len(s.keeper.GetAuthorizations(s.ctx, grantee, granter), 1)
+
+	// the grants are directed
+	authorization, _ = s.keeper.GetCleanAuthorization(s.ctx, granter, grantee, bankSendMethodName)
+	s.Require().Nil(authorization)
+
+	s.Require().NoError(s.keeper.DeleteGrant(s.ctx, grantee, granter, bankSendMethodName))
+	authorization, _ = s.keeper.GetCleanAuthorization(s.ctx, grantee, granter, bankSendMethodName)
+	s.Require().Nil(authorization)
+}
+
+func (s *KeeperTestSuite) TestDispatchActions() {
+	granter, grantee, recipient := s.addrs[0], s.addrs[1], s.addrs[2]
+	now := s.ctx.BlockHeader().Time
+	sendAmt := sdk.NewCoins(sdk.NewInt64Coin("stake", 20))
+	msg := banktypes.NewMsgSend(granter, recipient, sendAmt)
+
+	// no authorization
+	_, err := s.keeper.DispatchActions(s.ctx, grantee, []sdk.Msg{msg})
+	s.Require().Error(err)
+	s.Require().True(types.ErrNoAuthorizationFound.Is(err))
+
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	s.Require().NoError(s.keeper.Grant(s.ctx, grantee, granter, banktypes.NewSendAuthorization(spendLimit), now.Add(time.Hour)))
+
+	// legacy msgs are routed to their Msg service method
+	results, err := s.keeper.DispatchActions(s.ctx, grantee, []sdk.Msg{msg})
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+	s.Require().Equal(sdk.NewInt(30000020), s.app.BankKeeper.GetBalance(s.ctx, recipient, "stake").Amount)
+
+	authorization, _ := s.keeper.GetCleanAuthorization(s.ctx, grantee, granter, bankSendMethodName)
+	s.Require().Equal(banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 30))), authorization)
+
+	// exceeding the spend limit
+	svcMsg := sdk.ServiceMsg{MethodName: bankSendMethodName, Request: banktypes.NewMsgSend(granter, recipient, spendLimit)}
+	_, err = s.keeper.DispatchActions(s.ctx, grantee, []sdk.Msg{svcMsg})
+	s.Require().Error(err)
+
+	// spending the rest of the limit deletes the authorization
+	svcMsg.Request = banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)))
+	_, err = s.keeper.DispatchActions(s.ctx, grantee, []sdk.Msg{svcMsg})
+	s.Require().NoError(err)
+	authorization, _ = s.keeper.GetCleanAuthorization(s.ctx, grantee, granter, bankSendMethodName)
+	s.Require().Nil(authorization)
+
+	revoked := false
+	for _, event := range s.ctx.EventManager().Events() {
+		revoked = revoked || event.Type == types.EventTypeRevokeAuthorization
+	}
+	s.Require().True(revoked)
+
+	// the msgs of the grantee itself do not need any authorization
+	_, err = s.keeper.DispatchActions(s.ctx, grantee, []sdk.Msg{banktypes.NewMsgSend(grantee, recipient, sendAmt)})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestGenericAuthorization() {
+	granter, grantee, recipient := s.addrs[0], s.addrs[1], s.addrs[2]
+	now := s.ctx.BlockHeader().Time
+
+	grant, err := types.NewMsgGrantAuthorization(granter, grantee, types.NewGenericAuthorization(bankSendMethodName), now.Add(time.Hour))
+	s.Require().NoError(err)
+	_, err = s.msgSrvr.GrantAuthorization(s.goCtx, grant)
+	s.Require().NoError(err)
+
+	exec, err := types.NewMsgExecAuthorized(grantee, []sdk.Msg{banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)))})
+	s.Require().NoError(err)
+	// unpack the msgs as the tx decoder does
+	s.Require().NoError(exec.UnpackInterfaces(s.app.InterfaceRegistry()))
+	_, err = s.msgSrvr.ExecAuthorized(s.goCtx, &exec)
+	s.Require().NoError(err)
+
+	// a generic authorization is kept after being used
+	authorization, _ := s.keeper.GetCleanAuthorization(s.ctx, grantee, granter, bankSendMethodName)
+	s.Require().NotNil(authorization)
+
+	revoke := types.NewMsgRevokeAuthorization(granter, grantee, bankSendMethodName)
+	_, err = s.msgSrvr.RevokeAuthorization(s.goCtx, &revoke)
+	s.Require().NoError(err)
+	_, err = s.msgSrvr.ExecAuthorized(s.goCtx, &exec)
+	s.Require().Error(err)
+	_, err = s.msgSrvr.RevokeAuthorization(s.goCtx, &revoke)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestGrantAuthorizationInvalid() {
+	granter, grantee := s.addrs[0], s.addrs[1]
+	now := s.ctx.BlockHeader().Time
+
+	// expired
+	grant, err := types.NewMsgGrantAuthorization(granter, grantee, types.NewGenericAuthorization(bankSendMethodName), now.Add(-time.Hour))
+	s.Require().NoError(err)
+	_, err = s.msgSrvr.GrantAuthorization(s.goCtx, grant)
+	s.Require().True(types.ErrInvalidExpirationTime.Is(err))
+
+	// unknown method
+	grant, err = types.NewMsgGrantAuthorization(granter, grantee, types.NewGenericAuthorization("/lbm.bank.v1.Msg/Unknown"), now.Add(time.Hour))
+	s.Require().NoError(err)
+	_, err = s.msgSrvr.GrantAuthorization(s.goCtx, grant)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	granter, grantee := s.addrs[0], s.addrs[1]
+	now := s.ctx.BlockHeader().Time
+
+	authorization := banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	s.Require().NoError(s.keeper.Grant(s.ctx, grantee, granter, authorization, now.Add(time.Hour)))
+	// the expired grants are not exported
+	s.Require().NoError(s.keeper.Grant(s.ctx, granter, grantee, authorization, now.Add(-time.Hour)))
+
+	genesis := s.keeper.ExportGenesis(s.ctx)
+	s.Require().Len(genesis.Authorization, 1)
+	s.Require().Equal(granter.String(), genesis.Authorization[0].Granter)
+	s.Require().Equal(grantee.String(), genesis.Authorization[0].Grantee)
+
+	s.Require().NoError(s.keeper.DeleteGrant(s.ctx, grantee, granter, bankSendMethodName))
+	s.keeper.InitGenesis(s.ctx, genesis)
+	got, _ := s.keeper.GetCleanAuthorization(s.ctx, grantee, granter, bankSendMethodName)
+	s.Require().Equal(authorization, got)
+}
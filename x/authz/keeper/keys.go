@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/address"
+)
+
+// Keys for store prefixes
+var (
+	GrantKey = []byte{0x01} // prefix for each key
+)
+
+// grantStoreKey returns the store key of an authorization:
+// 0x01<granter_address_len (1 Byte)><granter_address_bytes><grantee_address_len (1 Byte)><grantee_address_bytes><method_name_bytes>
+func grantStoreKey(grantee sdk.AccAddress, granter sdk.AccAddress, methodName string) []byte {
+	return append(grantStoreKeyPrefix(grantee, granter), []byte(methodName)...)
+}
+
+// grantStoreKeyPrefix returns the prefix of the authorizations granted by the
+// granter to the grantee.
+func grantStoreKeyPrefix(grantee sdk.AccAddress, granter sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(GrantKey))
+	key = append(key, GrantKey...)
+	key = append(key, address.MustLengthPrefix(granter.Bytes())...)
+	return append(key, address.MustLengthPrefix(grantee.Bytes())...)
+}
+
+// addressesFromGrantStoreKey splits the granter and the grantee address from
+// a grant store key.
+func addressesFromGrantStoreKey(key []byte) (granter sdk.AccAddress, grantee sdk.AccAddress) {
+	// key is of format:
+	// 0x01<granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><methodName_Bytes>
+	granterAddrLen := int(key[1])
+	granter = sdk.AccAddress(string(key[2 : 2+granterAddrLen]))
+	granteeAddrLen := int(key[2+granterAddrLen])
+	grantee = sdk.AccAddress(string(key[3+granterAddrLen : 3+granterAddrLen+granteeAddrLen]))
+	return granter, grantee
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/authz/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the authz MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// GrantAuthorization implements the MsgServer.GrantAuthorization method.
+func (k msgServer) GrantAuthorization(goCtx context.Context, msg *types.MsgGrantAuthorization) (*types.MsgGrantAuthorizationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := sdk.ValidateAccAddress(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+	grantee := sdk.AccAddress(msg.Grantee)
+
+	err = sdk.ValidateAccAddress(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+	granter := sdk.AccAddress(msg.Granter)
+
+	if !msg.Expiration.After(ctx.BlockHeader().Time) {
+		return nil, types.ErrInvalidExpirationTime
+	}
+
+	authorization := msg.GetGrantAuthorization()
+	if authorization == nil {
+		return nil, sdkerrors.Wrap(types.ErrUnknownAuthorization, "failed to get authorization")
+	}
+	// If the method is not registered, then a grant can't be executed.
+	methodName := authorization.MethodName()
+	if k.router.Handler(methodName) == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s doesn't exist.", methodName)
+	}
+
+	err = k.Keeper.Grant(ctx, grantee, granter, authorization, msg.Expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGrantAuthorizationResponse{}, nil
+}
+
+// RevokeAuthorization implements the MsgServer.RevokeAuthorization method.
+func (k msgServer) RevokeAuthorization(goCtx context.Context, msg *types.MsgRevokeAuthorization) (*types.MsgRevokeAuthorizationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := sdk.ValidateAccAddress(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+	grantee := sdk.AccAddress(msg.Grantee)
+
+	err = sdk.ValidateAccAddress(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+	granter := sdk.AccAddress(msg.Granter)
+
+	err = k.Keeper.DeleteGrant(ctx, grantee, granter, msg.MethodName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeAuthorizationResponse{}, nil
+}
+
+// ExecAuthorized implements the MsgServer.ExecAuthorized method.
+func (k msgServer) ExecAuthorized(goCtx context.Context, msg *types.MsgExecAuthorized) (*types.MsgExecAuthorizedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := sdk.ValidateAccAddress(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+	grantee := sdk.AccAddress(msg.Grantee)
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := k.Keeper.DispatchActions(ctx, grantee, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExecAuthorizedResponse{Results: results}, nil
+}
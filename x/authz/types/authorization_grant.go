@@ -0,0 +1,39 @@
+package types
+
+import (
+	"time"
+
+	"github.com/line/lbm-sdk/codec/types"
+)
+
+var (
+	_ types.UnpackInterfacesMessage = &AuthorizationGrant{}
+)
+
+// NewAuthorizationGrant returns new AuthorizationGrant
+func NewAuthorizationGrant(authorization Authorization, expiration time.Time) (AuthorizationGrant, error) {
+	any, err := types.NewAnyWithValue(authorization)
+	if err != nil {
+		return AuthorizationGrant{}, err
+	}
+
+	return AuthorizationGrant{
+		Authorization: any,
+		Expiration:    expiration,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (auth AuthorizationGrant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(auth.Authorization, &authorization)
+}
+
+// GetAuthorizationGrant returns the cached value from the AuthorizationGrant.Authorization if present.
+func (auth AuthorizationGrant) GetAuthorizationGrant() Authorization {
+	authorization, ok := auth.Authorization.GetCachedValue().(Authorization)
+	if !ok {
+		return nil
+	}
+	return authorization
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"github.com/line/lbm-sdk/codec/types"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/msgservice"
+)
+
+// RegisterInterfaces registers the interfaces types with the interface registry
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgGrantAuthorization{},
+		&MsgRevokeAuthorization{},
+		&MsgExecAuthorized{},
+	)
+
+	registry.RegisterInterface(
+		"lbm.authz.v1.Authorization",
+		(*Authorization)(nil),
+		&GenericAuthorization{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// x/authz module sentinel errors
+var (
+	// ErrInvalidExpirationTime error if the set expiration time is in the past
+	ErrInvalidExpirationTime = sdkerrors.Register(ModuleName, 2, "expiration time of authorization should be more than current time")
+	// ErrNoAuthorizationFound error if there is no authorization found given the granter, grantee and method name
+	ErrNoAuthorizationFound = sdkerrors.Register(ModuleName, 3, "authorization not found")
+	// ErrUnknownAuthorization error if the authorization is not registered
+	ErrUnknownAuthorization = sdkerrors.Register(ModuleName, 4, "unknown authorization")
+)
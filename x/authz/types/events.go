@@ -0,0 +1,14 @@
+package types
+
+// authz module events
+const (
+	EventTypeGrantAuthorization  = "grant_authorization"
+	EventTypeRevokeAuthorization = "revoke_authorization"
+	EventTypeExecAuthorized      = "exec_authorized"
+
+	AttributeKeyGranter    = "granter"
+	AttributeKeyGrantee    = "grantee"
+	AttributeKeyMethodName = "method_name"
+
+	AttributeValueCategory = ModuleName
+)
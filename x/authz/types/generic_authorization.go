@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+var (
+	_ Authorization = &GenericAuthorization{}
+)
+
+// NewGenericAuthorization creates a new GenericAuthorization object.
+func NewGenericAuthorization(methodName string) *GenericAuthorization {
+	return &GenericAuthorization{
+		MessageName: methodName,
+	}
+}
+
+// MethodName implements Authorization.MethodName.
+func (authorization GenericAuthorization) MethodName() string {
+	return authorization.MessageName
+}
+
+// Accept implements Authorization.Accept.
+func (authorization GenericAuthorization) Accept(ctx sdk.Context, msg sdk.ServiceMsg) (AcceptResponse, error) {
+	return AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (authorization GenericAuthorization) ValidateBasic() error {
+	if !isServiceMsg(authorization.MessageName) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid method name: %s", authorization.MessageName)
+	}
+	return nil
+}
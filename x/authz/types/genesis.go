@@ -0,0 +1,79 @@
+package types
+
+import (
+	"time"
+
+	cdctypes "github.com/line/lbm-sdk/codec/types"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+var (
+	_ cdctypes.UnpackInterfacesMessage = GenesisState{}
+	_ cdctypes.UnpackInterfacesMessage = GrantAuthorization{}
+)
+
+// NewGenesisState creates new GenesisState object
+func NewGenesisState(entries []GrantAuthorization) *GenesisState {
+	return &GenesisState{
+		Authorization: entries,
+	}
+}
+
+// DefaultGenesisState returns default state for authz module.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// ValidateGenesis check the given genesis state has no integrity issues
+func ValidateGenesis(data GenesisState) error {
+	for _, a := range data.Authorization {
+		if err := sdk.ValidateAccAddress(a.Granter); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address: %s", err)
+		}
+		if err := sdk.ValidateAccAddress(a.Grantee); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address: %s", err)
+		}
+		if a.Granter == a.Grantee {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "granter and grantee cannot be same")
+		}
+		if a.Expiration.Equal(time.Time{}) {
+			return sdkerrors.Wrap(ErrInvalidExpirationTime, "expiration cannot be empty")
+		}
+
+		authorization := a.GetGrantAuthorization()
+		if authorization == nil {
+			return sdkerrors.Wrap(ErrUnknownAuthorization, "failed to get authorization")
+		}
+		if err := authorization.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, a := range data.Authorization {
+		err := a.UnpackInterfaces(unpacker)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetGrantAuthorization returns the cached value from the GrantAuthorization.Authorization if present.
+func (a GrantAuthorization) GetGrantAuthorization() Authorization {
+	authorization, ok := a.Authorization.GetCachedValue().(Authorization)
+	if !ok {
+		return nil
+	}
+	return authorization
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a GrantAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(a.Authorization, &authorization)
+}
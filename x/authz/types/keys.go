@@ -0,0 +1,15 @@
+package types
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "authz"
+
+	// StoreKey is the store key string for authz
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for authz
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for authz
+	QuerierRoute = ModuleName
+)
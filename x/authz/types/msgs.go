@@ -0,0 +1,264 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	"github.com/line/lbm-sdk/codec/legacy"
+	"github.com/line/lbm-sdk/codec/types"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+var (
+	_, _, _ sdk.Msg = &MsgGrantAuthorization{}, &MsgRevokeAuthorization{}, &MsgExecAuthorized{}
+
+	_, _ types.UnpackInterfacesMessage = &MsgGrantAuthorization{}, &MsgExecAuthorized{}
+)
+
+// NewMsgGrantAuthorization creates a new MsgGrantAuthorization
+//nolint:interfacer
+func NewMsgGrantAuthorization(granter sdk.AccAddress, grantee sdk.AccAddress, authorization Authorization, expiration time.Time) (*MsgGrantAuthorization, error) {
+	m := &MsgGrantAuthorization{
+		Granter:    granter.String(),
+		Grantee:    grantee.String(),
+		Expiration: expiration,
+	}
+	err := m.SetAuthorization(authorization)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetSigners implements Msg
+func (msg MsgGrantAuthorization) GetSigners() []sdk.AccAddress {
+	granter := sdk.AccAddress(msg.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgGrantAuthorization) ValidateBasic() error {
+	if err := sdk.ValidateAccAddress(msg.Granter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address: %s", err)
+	}
+	if err := sdk.ValidateAccAddress(msg.Grantee); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address: %s", err)
+	}
+	if msg.Granter == msg.Grantee {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "granter and grantee cannot be same")
+	}
+
+	authorization := msg.GetGrantAuthorization()
+	if authorization == nil {
+		return sdkerrors.Wrap(ErrUnknownAuthorization, "failed to get authorization")
+	}
+	return authorization.ValidateBasic()
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgGrantAuthorization) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgGrantAuthorization) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgGrantAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+// GetGrantAuthorization returns the cache value from the MsgGrantAuthorization.Authorization if present.
+func (msg *MsgGrantAuthorization) GetGrantAuthorization() Authorization {
+	authorization, ok := msg.Authorization.GetCachedValue().(Authorization)
+	if !ok {
+		return nil
+	}
+	return authorization
+}
+
+// SetAuthorization converts Authorization to any and adds it to MsgGrantAuthorization.Authorization.
+func (msg *MsgGrantAuthorization) SetAuthorization(authorization Authorization) error {
+	any, err := types.NewAnyWithValue(authorization)
+	if err != nil {
+		return err
+	}
+	msg.Authorization = any
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrantAuthorization) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(msg.Authorization, &authorization)
+}
+
+// NewMsgRevokeAuthorization creates a new MsgRevokeAuthorization
+//nolint:interfacer
+func NewMsgRevokeAuthorization(granter sdk.AccAddress, grantee sdk.AccAddress, methodName string) MsgRevokeAuthorization {
+	return MsgRevokeAuthorization{
+		Granter:    granter.String(),
+		Grantee:    grantee.String(),
+		MethodName: methodName,
+	}
+}
+
+// GetSigners implements Msg
+func (msg MsgRevokeAuthorization) GetSigners() []sdk.AccAddress {
+	granter := sdk.AccAddress(msg.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// ValidateBasic implements MsgRequest.ValidateBasic
+func (msg MsgRevokeAuthorization) ValidateBasic() error {
+	if err := sdk.ValidateAccAddress(msg.Granter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address: %s", err)
+	}
+	if err := sdk.ValidateAccAddress(msg.Grantee); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address: %s", err)
+	}
+	if msg.Granter == msg.Grantee {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "granter and grantee cannot be same")
+	}
+	if msg.MethodName == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing method name")
+	}
+
+	return nil
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRevokeAuthorization) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRevokeAuthorization) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgRevokeAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgExecAuthorized creates a new MsgExecAuthorized
+//nolint:interfacer
+func NewMsgExecAuthorized(grantee sdk.AccAddress, msgs []sdk.Msg) (MsgExecAuthorized, error) {
+	msgsAny := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := packMsg(msg)
+		if err != nil {
+			return MsgExecAuthorized{}, err
+		}
+		msgsAny[i] = any
+	}
+
+	return MsgExecAuthorized{
+		Grantee: grantee.String(),
+		Msgs:    msgsAny,
+	}, nil
+}
+
+// packMsg packs a service msg into an Any of its method name, as in a tx body,
+// and any other msg into an Any of its type.
+func packMsg(msg sdk.Msg) (*types.Any, error) {
+	if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
+		return types.NewAnyWithCustomTypeURL(svcMsg.Request, svcMsg.MethodName)
+	}
+	return types.NewAnyWithValue(msg)
+}
+
+// GetMessages returns the msgs to execute, the msgs packed under their method
+// name being sdk.ServiceMsg.
+func (msg MsgExecAuthorized) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, msgAny := range msg.Msgs {
+		if isServiceMsg(msgAny.TypeUrl) {
+			req, ok := msgAny.GetCachedValue().(sdk.MsgRequest)
+			if !ok {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "messages contains %s which is not a sdk.MsgRequest", msgAny.TypeUrl)
+			}
+			msgs[i] = sdk.ServiceMsg{MethodName: msgAny.TypeUrl, Request: req}
+			continue
+		}
+
+		m, ok := msgAny.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "messages contains %s which is not a sdk.Msg", msgAny.TypeUrl)
+		}
+		msgs[i] = m
+	}
+	return msgs, nil
+}
+
+// GetSigners implements Msg
+func (msg MsgExecAuthorized) GetSigners() []sdk.AccAddress {
+	grantee := sdk.AccAddress(msg.Grantee)
+	return []sdk.AccAddress{grantee}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgExecAuthorized) ValidateBasic() error {
+	if err := sdk.ValidateAccAddress(msg.Grantee); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address: %s", err)
+	}
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "messages cannot be empty")
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+	for _, m := range msgs {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgExecAuthorized) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgExecAuthorized) Route() string {
+	return RouterKey
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgExecAuthorized) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgExecAuthorized) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, msgAny := range msg.Msgs {
+		if isServiceMsg(msgAny.TypeUrl) {
+			var req sdk.MsgRequest
+			if err := unpacker.UnpackAny(msgAny, &req); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var m sdk.Msg
+		if err := unpacker.UnpackAny(msgAny, &m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isServiceMsg checks if a type URL corresponds to a service method name,
+// i.e. /lbm.bank.v1.Msg/Send vs /lbm.bank.v1.MsgSend
+func isServiceMsg(typeURL string) bool {
+	return strings.Count(typeURL, "/") >= 2
+}
@@ -0,0 +1,103 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/simapp"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/authz/types"
+	banktypes "github.com/line/lbm-sdk/x/bank/types"
+)
+
+var (
+	granter = sdk.BytesToAccAddress([]byte("_______granter______"))
+	grantee = sdk.BytesToAccAddress([]byte("_______grantee______"))
+	coins   = sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+)
+
+func TestMsgExecAuthorized(t *testing.T) {
+	msgSend := banktypes.NewMsgSend(granter, grantee, coins)
+	svcMsg := sdk.ServiceMsg{MethodName: "/lbm.bank.v1.Msg/Send", Request: msgSend}
+
+	msg, err := types.NewMsgExecAuthorized(grantee, []sdk.Msg{msgSend, svcMsg})
+	require.NoError(t, err)
+	require.Equal(t, "/lbm.bank.v1.MsgSend", msg.Msgs[0].TypeUrl)
+	require.Equal(t, "/lbm.bank.v1.Msg/Send", msg.Msgs[1].TypeUrl)
+	require.Equal(t, []sdk.AccAddress{grantee}, msg.GetSigners())
+
+	// the msgs round trip through the codec
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	bz, err := cdc.MarshalBinaryBare(&msg)
+	require.NoError(t, err)
+	var decoded types.MsgExecAuthorized
+	require.NoError(t, cdc.UnmarshalBinaryBare(bz, &decoded))
+	require.NoError(t, decoded.ValidateBasic())
+
+	msgs, err := decoded.GetMessages()
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	require.Equal(t, msgSend, msgs[0])
+	require.Equal(t, svcMsg, msgs[1])
+
+	empty, err := types.NewMsgExecAuthorized(grantee, nil)
+	require.NoError(t, err)
+	require.Error(t, empty.ValidateBasic())
+
+	invalid, err := types.NewMsgExecAuthorized(grantee, []sdk.Msg{banktypes.NewMsgSend(granter, grantee, nil)})
+	require.NoError(t, err)
+	require.NoError(t, invalid.UnpackInterfaces(simapp.MakeTestEncodingConfig().InterfaceRegistry))
+	require.Error(t, invalid.ValidateBasic())
+}
+
+func TestMsgGrantAuthorization(t *testing.T) {
+	tests := []struct {
+		title            string
+		granter, grantee sdk.AccAddress
+		authorization    types.Authorization
+		expectPass       bool
+	}{
+		{"nil granter address", "", grantee, banktypes.NewSendAuthorization(coins), false},
+		{"nil grantee address", granter, "", banktypes.NewSendAuthorization(coins), false},
+		{"nil granter and grantee address", "", "", banktypes.NewSendAuthorization(coins), false},
+		{"equal granter and grantee", granter, granter, banktypes.NewSendAuthorization(coins), false},
+		{"invalid authorization", granter, grantee, banktypes.NewSendAuthorization(nil), false},
+		{"invalid method name", granter, grantee, types.NewGenericAuthorization("/lbm.bank.v1.MsgSend"), false},
+		{"valid send authorization", granter, grantee, banktypes.NewSendAuthorization(coins), true},
+		{"valid generic authorization", granter, grantee, types.NewGenericAuthorization("/lbm.bank.v1.Msg/Send"), true},
+	}
+	for i, tc := range tests {
+		msg, err := types.NewMsgGrantAuthorization(tc.granter, tc.grantee, tc.authorization, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgRevokeAuthorization(t *testing.T) {
+	tests := []struct {
+		title            string
+		granter, grantee sdk.AccAddress
+		methodName       string
+		expectPass       bool
+	}{
+		{"nil granter address", "", grantee, "/lbm.bank.v1.Msg/Send", false},
+		{"nil grantee address", granter, "", "/lbm.bank.v1.Msg/Send", false},
+		{"equal granter and grantee", granter, granter, "/lbm.bank.v1.Msg/Send", false},
+		{"empty method name", granter, grantee, "", false},
+		{"valid test case", granter, grantee, "/lbm.bank.v1.Msg/Send", true},
+	}
+	for i, tc := range tests {
+		msg := types.NewMsgRevokeAuthorization(tc.granter, tc.grantee, tc.methodName)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"github.com/line/lbm-sdk/store/prefix"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/bank/types"
+)
+
+// DeferredFees returns true if the fees of the txs are credited to the fee collector at the end of
+// the block through DeferredSendCoinsFromAccountToModule, as set by the DeferredFees param. It is
+// false if the param is not set.
+func (k BaseKeeper) DeferredFees(ctx sdk.Context) bool {
+	var deferred bool
+	k.paramSpace.GetIfExists(ctx, types.KeyDeferredFees, &deferred)
+	return deferred
+}
+
+// DeferredSendCoinsFromAccountToModule transfers coins from an account to a module account
+// like SendCoinsFromAccountToModule, except that the module account is credited only at the
+// end of the block by SettleDeferredBalances. Until then the coins are held in a deferred
+// balance of the sender, so txs of different senders paying the same module account, e.g.
+// the fee collector, don't write to the same state. It will panic if the module account does
+// not exist.
+func (k BaseKeeper) DeferredSendCoinsFromAccountToModule(
+	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+) error {
+
+	recipientAcc := k.ak.GetModuleAccount(ctx, recipientModule)
+	if recipientAcc == nil {
+		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipientAcc.GetAddress().String()),
+			sdk.NewAttribute(types.AttributeKeySender, senderAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, senderAddr.String()),
+		),
+	})
+
+	if err := k.SubtractCoins(ctx, senderAddr, amt); err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeferredBalancesPrefix)
+	key := types.DeferredBalanceKey(recipientModule, senderAddr)
+
+	balance := types.Balance{Address: senderAddr.String()}
+	if bz := store.Get(key); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &balance)
+	}
+	balance.Coins = balance.Coins.Add(amt...)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&balance))
+
+	return nil
+}
+
+// UndoDeferredSendCoinsFromAccountToModule returns coins sent by DeferredSendCoinsFromAccountToModule
+// and not credited to the module account yet to their sender. It fails if the deferred balance of
+// the sender doesn't hold the coins.
+func (k BaseKeeper) UndoDeferredSendCoinsFromAccountToModule(
+	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+) error {
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeferredBalancesPrefix)
+	key := types.DeferredBalanceKey(recipientModule, senderAddr)
+
+	balance := types.Balance{Address: senderAddr.String()}
+	if bz := store.Get(key); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &balance)
+	}
+
+	coins, hasNeg := balance.Coins.SafeSub(amt)
+	if hasNeg {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientFunds, "deferred balance %s is smaller than %s", balance.Coins, amt,
+		)
+	}
+
+	if coins.IsZero() {
+		store.Delete(key)
+	} else {
+		balance.Coins = coins
+		store.Set(key, k.cdc.MustMarshalBinaryBare(&balance))
+	}
+
+	return k.AddCoins(ctx, senderAddr, amt)
+}
+
+// IterateDeferredBalances iterates over the deferred balances not credited to their module
+// accounts yet, calling cb with the module and the deferred balance of each sender. Iteration
+// stops when cb returns true.
+func (k BaseKeeper) IterateDeferredBalances(ctx sdk.Context, cb func(module string, balance types.Balance) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeferredBalancesPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var balance types.Balance
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &balance)
+
+		if cb(types.ModuleFromDeferredBalanceKey(iterator.Key()), balance) {
+			break
+		}
+	}
+}
+
+// SettleDeferredBalances credits the deferred balances to their module accounts and clears
+// them. It is called at the end of every block.
+func (k BaseKeeper) SettleDeferredBalances(ctx sdk.Context) {
+	var modules []string
+	var keys [][]byte
+	amounts := map[string]sdk.Coins{}
+
+	k.IterateDeferredBalances(ctx, func(module string, balance types.Balance) bool {
+		if _, ok := amounts[module]; !ok {
+			modules = append(modules, module)
+		}
+		amounts[module] = amounts[module].Add(balance.Coins...)
+		keys = append(keys, types.DeferredBalanceKey(module, sdk.AccAddress(balance.Address)))
+		return false
+	})
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeferredBalancesPrefix)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, module := range modules {
+		if err := k.AddCoins(ctx, k.ak.GetModuleAddress(module), amounts[module]); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -56,6 +56,10 @@ func TotalSupply(k Keeper) sdk.Invariant {
 			expectedTotal = expectedTotal.Add(balance)
 			return false
 		})
+		k.IterateDeferredBalances(ctx, func(_ string, balance types.Balance) bool {
+			expectedTotal = expectedTotal.Add(balance.Coins...)
+			return false
+		})
 
 		broken := !expectedTotal.IsEqual(supply.GetTotal())
 
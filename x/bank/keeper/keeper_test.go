@@ -405,6 +405,68 @@ func (suite *IntegrationTestSuite) TestSendCoins() {
 	suite.Require().Equal(expected, acc2Balances)
 }
 
+func (suite *IntegrationTestSuite) TestDeferredSendCoinsFromAccountToModule() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	addr1 := sdk.BytesToAccAddress([]byte("addr1_______________"))
+	addr2 := sdk.BytesToAccAddress([]byte("addr2_______________"))
+	for _, addr := range []sdk.AccAddress{addr1, addr2} {
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+		suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr, balances))
+	}
+	app.BankKeeper.SetSupply(ctx, types.NewSupply(balances.Add(balances...)))
+
+	suite.Require().Error(app.BankKeeper.DeferredSendCoinsFromAccountToModule(
+		ctx, addr1, authtypes.FeeCollectorName, sdk.NewCoins(newFooCoin(101))))
+	suite.Require().NoError(app.BankKeeper.DeferredSendCoinsFromAccountToModule(
+		ctx, addr1, authtypes.FeeCollectorName, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().NoError(app.BankKeeper.DeferredSendCoinsFromAccountToModule(
+		ctx, addr1, authtypes.FeeCollectorName, sdk.NewCoins(newBarCoin(5))))
+	suite.Require().NoError(app.BankKeeper.DeferredSendCoinsFromAccountToModule(
+		ctx, addr2, authtypes.FeeCollectorName, sdk.NewCoins(newFooCoin(20))))
+
+	// the coins are taken from the senders, but not credited to the module account yet
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(90), newBarCoin(45)), app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(80), newBarCoin(50)), app.BankKeeper.GetAllBalances(ctx, addr2))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, feeCollector).IsZero())
+
+	var deferred []types.Balance
+	app.BankKeeper.IterateDeferredBalances(ctx, func(module string, balance types.Balance) bool {
+		suite.Require().Equal(authtypes.FeeCollectorName, module)
+		deferred = append(deferred, balance)
+		return false
+	})
+	suite.Require().Equal([]types.Balance{
+		{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(10), newBarCoin(5))},
+		{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(20))},
+	}, deferred)
+
+	_, broken := keeper.TotalSupply(app.BankKeeper)(ctx)
+	suite.Require().False(broken)
+
+	// undoing a send returns the coins to the sender
+	suite.Require().Error(app.BankKeeper.UndoDeferredSendCoinsFromAccountToModule(
+		ctx, addr2, authtypes.FeeCollectorName, sdk.NewCoins(newFooCoin(21))))
+	suite.Require().NoError(app.BankKeeper.UndoDeferredSendCoinsFromAccountToModule(
+		ctx, addr2, authtypes.FeeCollectorName, sdk.NewCoins(newFooCoin(20))))
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr2))
+
+	_, broken = keeper.TotalSupply(app.BankKeeper)(ctx)
+	suite.Require().False(broken)
+
+	app.BankKeeper.SettleDeferredBalances(ctx)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10), newBarCoin(5)), app.BankKeeper.GetAllBalances(ctx, feeCollector))
+	app.BankKeeper.IterateDeferredBalances(ctx, func(string, types.Balance) bool {
+		suite.Fail("deferred balances are not cleared")
+		return true
+	})
+
+	_, broken = keeper.TotalSupply(app.BankKeeper)(ctx)
+	suite.Require().False(broken)
+}
+
 func (suite *IntegrationTestSuite) TestValidateBalance() {
 	app, ctx := suite.app, suite.ctx
 	now := osttime.Now()
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/bank/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -15,6 +16,9 @@ func NewMigrator(keeper BaseKeeper) Migrator {
 }
 
 // Migrate1to2 migrates from version 1 to 2.
+// It sets the deferred fees param, disabled by default, so that fees are still credited to the fee
+// collector by every tx.
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyDeferredFees, types.DefaultParams().DeferredFees)
 	return nil
 }
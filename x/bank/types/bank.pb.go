@@ -30,6 +30,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty" yaml:"send_enabled,omitempty"`
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty" yaml:"default_send_enabled,omitempty"`
+	// deferred_fees credits the fees of the txs of a block to the fee collector at the end of the block,
+	// so that txs of different fee payers don't conflict with each other when the txs are executed in
+	// parallel. The balance of the fee collector does not include the fees of the current block.
+	DeferredFees bool `protobuf:"varint,3,opt,name=deferred_fees,json=deferredFees,proto3" json:"deferred_fees,omitempty" yaml:"deferred_fees,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -78,6 +82,13 @@ func (m *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
+func (m *Params) GetDeferredFees() bool {
+	if m != nil {
+		return m.DeferredFees
+	}
+	return false
+}
+
 // SendEnabled maps coin denom to a send_enabled status (whether a denom is
 // sendable).
 type SendEnabled struct {
@@ -403,43 +414,45 @@ func init() {
 func init() { proto.RegisterFile("lbm/bank/v1/bank.proto", fileDescriptor_000561d9f641a64d) }
 
 var fileDescriptor_000561d9f641a64d = []byte{
-	// 566 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x53, 0x3f, 0x8b, 0x13, 0x4f,
-	0x18, 0xde, 0xb9, 0xfc, 0xf9, 0xe5, 0x26, 0x3f, 0x0b, 0x97, 0x70, 0xac, 0x01, 0x77, 0xe3, 0xaa,
-	0x10, 0xff, 0xdc, 0xae, 0xa7, 0x85, 0x90, 0x42, 0x24, 0x2a, 0x72, 0x85, 0x28, 0x7b, 0x8a, 0xa0,
-	0x60, 0x98, 0xcd, 0x8c, 0x71, 0xb9, 0x9d, 0x99, 0x25, 0x33, 0x7b, 0x5c, 0x4a, 0xad, 0x2c, 0x05,
-	0x1b, 0xcb, 0xd4, 0xd6, 0x7e, 0x88, 0x03, 0x9b, 0xc3, 0xca, 0x2a, 0x4a, 0xd2, 0x58, 0xdf, 0x27,
-	0x90, 0x99, 0xd9, 0x9c, 0x1b, 0xf0, 0xb0, 0xb0, 0xb0, 0xda, 0x79, 0xde, 0x79, 0xe6, 0x79, 0x1f,
-	0x9e, 0x77, 0x5f, 0xb8, 0x91, 0xc6, 0x34, 0x8c, 0x11, 0xdb, 0x0d, 0xf7, 0xb6, 0xf4, 0x37, 0xc8,
-	0xc6, 0x5c, 0x72, 0xbb, 0x99, 0xc6, 0x34, 0xd0, 0x78, 0x6f, 0xab, 0xdd, 0x1a, 0xf1, 0x11, 0xd7,
-	0xf5, 0x50, 0x9d, 0x0c, 0xa5, 0x7d, 0x66, 0xc8, 0x05, 0xe5, 0x62, 0x60, 0x2e, 0x0c, 0x28, 0xae,
-	0x0a, 0x55, 0x41, 0x94, 0xea, 0x90, 0x27, 0xcc, 0xd4, 0xfd, 0xcf, 0x00, 0xd6, 0x1f, 0xa1, 0x31,
-	0xa2, 0xc2, 0x7e, 0x01, 0xff, 0x17, 0x84, 0xe1, 0x01, 0x61, 0x28, 0x4e, 0x09, 0x76, 0x40, 0xa7,
-	0xd2, 0x6d, 0x5e, 0x77, 0x82, 0x52, 0xdf, 0x60, 0x87, 0x30, 0x7c, 0xcf, 0xdc, 0xf7, 0xcf, 0x1d,
-	0xcd, 0xbc, 0xb3, 0x13, 0x44, 0xd3, 0x9e, 0x5f, 0x7e, 0x77, 0x95, 0xd3, 0x44, 0x12, 0x9a, 0xc9,
-	0x89, 0x1f, 0x35, 0xc5, 0x2f, 0xbe, 0xfd, 0x1c, 0xb6, 0x30, 0x79, 0x89, 0xf2, 0x54, 0x0e, 0x56,
-	0xfa, 0xac, 0x75, 0x40, 0xb7, 0xd1, 0xbf, 0x74, 0x34, 0xf3, 0x2e, 0x1a, 0xb5, 0xdf, 0xb1, 0xca,
-	0xaa, 0x76, 0x41, 0x28, 0x99, 0xe9, 0x55, 0x3f, 0x4c, 0x3d, 0xcb, 0xbf, 0x0f, 0x9b, 0xa5, 0xa2,
-	0xdd, 0x82, 0x35, 0x4c, 0x18, 0xa7, 0x0e, 0xe8, 0x80, 0xee, 0x7a, 0x64, 0x80, 0xed, 0xc0, 0xff,
-	0x56, 0x5a, 0x47, 0x4b, 0xd8, 0x6b, 0x28, 0x91, 0x1f, 0x53, 0x0f, 0xf8, 0xaf, 0x01, 0xac, 0x6d,
-	0xb3, 0x2c, 0x97, 0x8a, 0x8d, 0x30, 0x1e, 0x13, 0x21, 0x0a, 0x95, 0x25, 0xb4, 0x1f, 0xc3, 0x9a,
-	0x0a, 0x52, 0x38, 0x6b, 0x3a, 0xa8, 0xd3, 0x45, 0x50, 0x82, 0xa8, 0xa0, 0xee, 0xf0, 0x84, 0xf5,
-	0xaf, 0x1c, 0xcc, 0x3c, 0xeb, 0xe3, 0x37, 0xef, 0xfc, 0x28, 0x91, 0xaf, 0xf2, 0x38, 0x18, 0x72,
-	0x1a, 0xa6, 0x09, 0x23, 0x61, 0x1a, 0xd3, 0x4d, 0x81, 0x77, 0x43, 0x39, 0xc9, 0x88, 0xd0, 0x5c,
-	0x11, 0x19, 0xb1, 0x5e, 0xe3, 0xad, 0xf1, 0x60, 0xf9, 0x6f, 0x00, 0xac, 0x3f, 0xcc, 0xe5, 0xbf,
-	0x35, 0x31, 0x05, 0xb0, 0xbe, 0x93, 0x67, 0x59, 0x3a, 0x51, 0xad, 0x24, 0x97, 0x28, 0x2d, 0x7e,
-	0x8c, 0xbf, 0x6e, 0xa5, 0xc5, 0x7a, 0xb7, 0x55, 0xab, 0x65, 0xee, 0x5f, 0x3e, 0x6d, 0x5e, 0xbb,
-	0x7c, 0xd2, 0xe3, 0x7d, 0xb3, 0x15, 0x64, 0x3f, 0xe3, 0x63, 0x49, 0x70, 0x60, 0x6c, 0x6d, 0xfb,
-	0x4f, 0xe1, 0xfa, 0x5d, 0x35, 0xd8, 0x27, 0x2c, 0x91, 0x27, 0x8c, 0xbc, 0x0d, 0x1b, 0xea, 0x19,
-	0x23, 0x4c, 0xea, 0x99, 0x9f, 0x8a, 0x8e, 0xb1, 0xce, 0x36, 0x4d, 0x90, 0x20, 0xc2, 0xa9, 0x74,
-	0x2a, 0x3a, 0x5b, 0x03, 0xfd, 0xf7, 0x00, 0x36, 0x1e, 0x10, 0x89, 0x30, 0x92, 0xc8, 0xee, 0xc0,
-	0x26, 0x26, 0x62, 0x38, 0x4e, 0x32, 0x99, 0x70, 0x56, 0xc8, 0x97, 0x4b, 0xf6, 0x4d, 0xc5, 0x60,
-	0x9c, 0x0e, 0x72, 0x96, 0xc8, 0xe5, 0x40, 0x36, 0x56, 0xd6, 0xe7, 0xd8, 0x67, 0x04, 0xf1, 0xf2,
-	0x28, 0x6c, 0x1b, 0x56, 0x55, 0x8c, 0x4e, 0x45, 0x6b, 0xea, 0xb3, 0x72, 0x85, 0x13, 0x91, 0xa5,
-	0x68, 0xe2, 0x54, 0xcd, 0xc4, 0x0b, 0xd8, 0xbf, 0x75, 0x30, 0x77, 0xc1, 0xe1, 0xdc, 0x05, 0xdf,
-	0xe7, 0x2e, 0x78, 0xb7, 0x70, 0xad, 0xc3, 0x85, 0x6b, 0x7d, 0x5d, 0xb8, 0xd6, 0xb3, 0x0b, 0x7f,
-	0x48, 0x4e, 0xa7, 0x1f, 0xd7, 0xf5, 0xe2, 0xdf, 0xf8, 0x19, 0x00, 0x00, 0xff, 0xff, 0x33, 0x53,
-	0x00, 0xb9, 0x68, 0x04, 0x00, 0x00,
+	// 596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0xbd, 0x6f, 0xd4, 0x3e,
+	0x18, 0xc7, 0xe3, 0xbe, 0xdc, 0xef, 0xea, 0x6b, 0x87, 0x5f, 0x54, 0x55, 0xa1, 0x12, 0xc9, 0x11,
+	0x40, 0x2a, 0x2f, 0x4d, 0x28, 0x0c, 0x48, 0x37, 0x20, 0x74, 0xbc, 0x54, 0x1d, 0x10, 0x28, 0x05,
+	0x21, 0x81, 0xc4, 0xc9, 0x39, 0x3f, 0x2d, 0x51, 0x13, 0x3b, 0x8a, 0x9d, 0xaa, 0x37, 0xc2, 0xc4,
+	0x88, 0x04, 0x03, 0x63, 0x67, 0x66, 0xfe, 0x88, 0x8e, 0x15, 0x13, 0xd3, 0x81, 0xda, 0x85, 0xb9,
+	0x7f, 0x01, 0xb2, 0x9d, 0xb4, 0xa9, 0x44, 0xc5, 0x82, 0xc4, 0x74, 0xfe, 0xfa, 0x79, 0xfc, 0x79,
+	0x9e, 0xfb, 0x3e, 0x76, 0xf0, 0x42, 0x1a, 0x67, 0x61, 0x4c, 0xd8, 0x56, 0xb8, 0xbd, 0xa2, 0x7f,
+	0x83, 0xbc, 0xe0, 0x92, 0xdb, 0x9d, 0x34, 0xce, 0x02, 0xad, 0xb7, 0x57, 0x16, 0xe7, 0x37, 0xf9,
+	0x26, 0xd7, 0xfb, 0xa1, 0x5a, 0x99, 0x94, 0xc5, 0x73, 0x43, 0x2e, 0x32, 0x2e, 0x06, 0x26, 0x60,
+	0x44, 0x15, 0xaa, 0xa8, 0x02, 0x14, 0x75, 0xc8, 0x13, 0x66, 0xf6, 0xfd, 0x8f, 0x13, 0xb8, 0xf5,
+	0x84, 0x14, 0x24, 0x13, 0xf6, 0x2b, 0x3c, 0x2b, 0x80, 0xd1, 0x01, 0x30, 0x12, 0xa7, 0x40, 0x1d,
+	0xd4, 0x9d, 0x5c, 0xea, 0xdc, 0x74, 0x82, 0x46, 0xdd, 0x60, 0x1d, 0x18, 0x7d, 0x60, 0xe2, 0xfd,
+	0x0b, 0x47, 0x63, 0xef, 0xfc, 0x88, 0x64, 0x69, 0xcf, 0x6f, 0x9e, 0xbb, 0xce, 0xb3, 0x44, 0x42,
+	0x96, 0xcb, 0x91, 0x1f, 0x75, 0xc4, 0x49, 0xbe, 0xfd, 0x12, 0xcf, 0x53, 0xd8, 0x20, 0x65, 0x2a,
+	0x07, 0xa7, 0xea, 0x4c, 0x74, 0xd1, 0x52, 0xbb, 0x7f, 0xe5, 0x68, 0xec, 0x5d, 0x36, 0xb4, 0xdf,
+	0x65, 0x35, 0xa9, 0x76, 0x95, 0xd0, 0x68, 0xc6, 0x5e, 0xc5, 0x73, 0x14, 0x36, 0xa0, 0x28, 0x80,
+	0x0e, 0x36, 0x00, 0x84, 0x33, 0xa9, 0xa9, 0xfe, 0xd1, 0xd8, 0x73, 0x8f, 0xa9, 0x27, 0xe1, 0x26,
+	0x6e, 0xb6, 0x8e, 0x3c, 0x04, 0x10, 0xbd, 0xa9, 0x4f, 0xbb, 0x9e, 0xe5, 0xaf, 0xe2, 0x4e, 0x93,
+	0x3e, 0x8f, 0xa7, 0x29, 0x30, 0x9e, 0x39, 0xa8, 0x8b, 0x96, 0x66, 0x22, 0x23, 0x6c, 0x07, 0xff,
+	0x77, 0xea, 0x3f, 0x44, 0xb5, 0xec, 0xb5, 0x15, 0xe4, 0xe7, 0xae, 0x87, 0xfc, 0x37, 0x08, 0x4f,
+	0xaf, 0xb1, 0xbc, 0x94, 0x2a, 0x9b, 0x50, 0x5a, 0x80, 0x10, 0x15, 0xa5, 0x96, 0xf6, 0x53, 0x3c,
+	0xad, 0x26, 0x22, 0x9c, 0x09, 0xed, 0xf8, 0xff, 0x95, 0xe3, 0x02, 0x94, 0xe3, 0xf7, 0x78, 0xc2,
+	0xfa, 0xd7, 0xf6, 0xc6, 0x9e, 0xf5, 0xf9, 0xbb, 0x77, 0x71, 0x33, 0x91, 0xaf, 0xcb, 0x38, 0x18,
+	0xf2, 0x2c, 0x4c, 0x13, 0x06, 0x61, 0x1a, 0x67, 0xcb, 0x82, 0x6e, 0x85, 0x72, 0x94, 0x83, 0xd0,
+	0xb9, 0x22, 0x32, 0xb0, 0x5e, 0xfb, 0x9d, 0xe9, 0xc1, 0xf2, 0xdf, 0x22, 0xdc, 0x7a, 0x5c, 0xca,
+	0x7f, 0xdb, 0xc4, 0x2e, 0xc2, 0xad, 0xf5, 0x32, 0xcf, 0xd3, 0x91, 0x2a, 0x25, 0xb9, 0x24, 0xa9,
+	0x83, 0xfe, 0x4e, 0x29, 0x0d, 0xeb, 0xdd, 0x55, 0xa5, 0x6a, 0xdf, 0xbf, 0x7e, 0x59, 0xbe, 0x71,
+	0xf5, 0xac, 0xc3, 0x3b, 0xe6, 0x79, 0xc1, 0x4e, 0xce, 0x0b, 0x09, 0x34, 0x30, 0x6d, 0xad, 0xf9,
+	0xcf, 0xf1, 0xcc, 0x7d, 0x35, 0xd8, 0x67, 0x2c, 0x91, 0x67, 0x8c, 0x7c, 0x11, 0xb7, 0xd5, 0x31,
+	0x06, 0x4c, 0xea, 0x99, 0xcf, 0x45, 0xc7, 0x5a, 0x7b, 0x9b, 0x26, 0x44, 0xe8, 0xcb, 0x37, 0xa9,
+	0xbd, 0x35, 0xd2, 0xff, 0x80, 0x70, 0xfb, 0x11, 0x48, 0x42, 0x89, 0x24, 0x76, 0x17, 0x77, 0x28,
+	0x88, 0x61, 0x91, 0xe4, 0x32, 0xe1, 0xac, 0xc2, 0x37, 0xb7, 0xec, 0xdb, 0x2a, 0x83, 0xf1, 0x6c,
+	0x50, 0xb2, 0x44, 0xd6, 0x03, 0x59, 0x38, 0xf5, 0x0e, 0x8f, 0xfb, 0x8c, 0x30, 0xad, 0x97, 0xc2,
+	0xb6, 0xf1, 0x94, 0xb2, 0x51, 0xdf, 0xfd, 0x99, 0x48, 0xaf, 0x55, 0x57, 0x34, 0x11, 0x79, 0x4a,
+	0x46, 0xce, 0x94, 0x99, 0x78, 0x25, 0xfb, 0x77, 0xf6, 0x0e, 0x5c, 0xb4, 0x7f, 0xe0, 0xa2, 0x1f,
+	0x07, 0x2e, 0x7a, 0x7f, 0xe8, 0x5a, 0xfb, 0x87, 0xae, 0xf5, 0xed, 0xd0, 0xb5, 0x5e, 0x5c, 0xfa,
+	0x83, 0x73, 0xda, 0xfd, 0xb8, 0xa5, 0xbf, 0x20, 0xb7, 0x7e, 0x0d, 0x00, 0xe3, 0x24, 0x7e, 0xbc,
+	0xb1, 0x04, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -518,6 +531,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DeferredFees {
+		i--
+		if m.DeferredFees {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.DefaultSendEnabled {
 		i--
 		if m.DefaultSendEnabled {
@@ -838,6 +861,9 @@ func (m *Params) Size() (n int) {
 	if m.DefaultSendEnabled {
 		n += 2
 	}
+	if m.DeferredFees {
+		n += 2
+	}
 	return n
 }
 
@@ -1048,6 +1074,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DefaultSendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferredFees", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeferredFees = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
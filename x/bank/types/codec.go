@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/line/lbm-sdk/crypto/codec"
 	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/types/msgservice"
+	authztypes "github.com/line/lbm-sdk/x/authz/types"
 	"github.com/line/lbm-sdk/x/bank/exported"
 )
 
@@ -30,6 +31,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&Supply{},
 	)
 
+	registry.RegisterImplementations(
+		(*authztypes.Authorization)(nil),
+		&SendAuthorization{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
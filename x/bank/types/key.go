@@ -24,9 +24,10 @@ const (
 
 // KVStore keys
 var (
-	BalancesPrefix      = []byte{0x02}
-	SupplyKey           = []byte{0x00}
-	DenomMetadataPrefix = []byte{0x1}
+	BalancesPrefix         = []byte{0x02}
+	SupplyKey              = []byte{0x00}
+	DenomMetadataPrefix    = []byte{0x1}
+	DeferredBalancesPrefix = []byte{0x03}
 
 	// Contract: Address must not contain this character
 	AddressDenomDelimiter = ","
@@ -38,6 +39,18 @@ func DenomMetadataKey(denom string) []byte {
 	return append(DenomMetadataPrefix, d...)
 }
 
+// DeferredBalanceKey returns the key of the coins sent by an account to a module account that
+// are credited at the end of the block. The key must be used with a DeferredBalancesPrefix
+// prefix store.
+func DeferredBalanceKey(module string, addr sdk.AccAddress) []byte {
+	return append(address.MustLengthPrefix([]byte(module)), []byte(addr)...)
+}
+
+// ModuleFromDeferredBalanceKey returns the module name of a deferred balance key.
+func ModuleFromDeferredBalanceKey(key []byte) string {
+	return string(key[1 : 1+key[0]])
+}
+
 // AddressFromBalancesStore returns an account address from a balances prefix
 // store. The key must not contain the perfix BalancesPrefix as the prefix store
 // iterator discards the actual prefix.
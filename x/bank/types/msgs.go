@@ -58,6 +58,12 @@ func (msg MsgSend) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.FromAddress)}
 }
 
+// AccessedAccounts returns the recipient of the send, which is written to
+// besides the signer.
+func (msg MsgSend) AccessedAccounts() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.ToAddress)}
+}
+
 var _ sdk.Msg = &MsgMultiSend{}
 
 // NewMsgMultiSend - construct arbitrary multi-in, multi-out send msg.
@@ -101,6 +107,17 @@ func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
 	return addrs
 }
 
+// AccessedAccounts returns the recipients of the send, which are written to
+// besides the signers.
+func (msg MsgMultiSend) AccessedAccounts() []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, len(msg.Outputs))
+	for i, out := range msg.Outputs {
+		addrs[i] = sdk.AccAddress(out.Address)
+	}
+
+	return addrs
+}
+
 // ValidateBasic - validate transaction input
 func (in Input) ValidateBasic() error {
 	err := sdk.ValidateAccAddress(in.Address)
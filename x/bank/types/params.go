@@ -19,6 +19,8 @@ var (
 	KeySendEnabled = []byte("SendEnabled")
 	// KeyDefaultSendEnabled is store's key for the DefaultSendEnabled option
 	KeyDefaultSendEnabled = []byte("DefaultSendEnabled")
+	// KeyDeferredFees is store's key for the DeferredFees option
+	KeyDeferredFees = []byte("DeferredFees")
 )
 
 // ParamKeyTable for bank module.
@@ -48,7 +50,10 @@ func (p Params) Validate() error {
 	if err := validateSendEnabledParams(p.SendEnabled); err != nil {
 		return err
 	}
-	return validateIsBool(p.DefaultSendEnabled)
+	if err := validateIsBool(p.DefaultSendEnabled); err != nil {
+		return err
+	}
+	return validateIsBool(p.DeferredFees)
 }
 
 // String implements the Stringer interface.
@@ -77,7 +82,9 @@ func (p Params) SetSendEnabledParam(denom string, sendEnabled bool) Params {
 		}
 	}
 	sendParams = append(sendParams, NewSendEnabled(denom, sendEnabled))
-	return NewParams(p.DefaultSendEnabled, sendParams)
+	params := NewParams(p.DefaultSendEnabled, sendParams)
+	params.DeferredFees = p.DeferredFees
+	return params
 }
 
 // ParamSetPairs implements params.ParamSet
@@ -85,6 +92,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySendEnabled, &p.SendEnabled, validateSendEnabledParams),
 		paramtypes.NewParamSetPair(KeyDefaultSendEnabled, &p.DefaultSendEnabled, validateIsBool),
+		paramtypes.NewParamSetPair(KeyDeferredFees, &p.DeferredFees, validateIsBool),
 	}
 }
 
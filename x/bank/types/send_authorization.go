@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	authztypes "github.com/line/lbm-sdk/x/authz/types"
+)
+
+var (
+	_ authztypes.Authorization = &SendAuthorization{}
+)
+
+// NewSendAuthorization creates a new SendAuthorization object.
+func NewSendAuthorization(spendLimit sdk.Coins) *SendAuthorization {
+	return &SendAuthorization{
+		SpendLimit: spendLimit,
+	}
+}
+
+// MethodName implements Authorization.MethodName.
+func (authorization SendAuthorization) MethodName() string {
+	return "/lbm.bank.v1.Msg/Send"
+}
+
+// Accept implements Authorization.Accept.
+func (authorization SendAuthorization) Accept(ctx sdk.Context, msg sdk.ServiceMsg) (authztypes.AcceptResponse, error) {
+	msgSend, ok := msg.Request.(*MsgSend)
+	if !ok || msg.MethodName != authorization.MethodName() {
+		return authztypes.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+
+	limitLeft, isNegative := authorization.SpendLimit.SafeSub(msgSend.Amount)
+	if isNegative {
+		return authztypes.AcceptResponse{}, sdkerrors.ErrInsufficientFunds
+	}
+	if limitLeft.IsZero() {
+		return authztypes.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authztypes.AcceptResponse{Accept: true, Updated: &SendAuthorization{SpendLimit: limitLeft}}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (authorization SendAuthorization) ValidateBasic() error {
+	if authorization.SpendLimit == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "spend limit cannot be nil")
+	}
+	if !authorization.SpendLimit.IsAllPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit cannot be negative")
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+
+	ocproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/simapp"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/bank/types"
+)
+
+var (
+	coins1000 = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	coins500  = sdk.NewCoins(sdk.NewInt64Coin("stake", 500))
+	fromAddr  = sdk.BytesToAccAddress([]byte("_____from _____"))
+	toAddr    = sdk.BytesToAccAddress([]byte("_______to________"))
+)
+
+func TestSendAuthorization(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, ocproto.Header{})
+	authorization := types.NewSendAuthorization(coins1000)
+
+	t.Log("verify authorization returns valid method name")
+	require.Equal(t, "/lbm.bank.v1.Msg/Send", authorization.MethodName())
+	require.NoError(t, authorization.ValidateBasic())
+	send := types.NewMsgSend(fromAddr, toAddr, coins1000)
+	srvMsg := sdk.ServiceMsg{
+		MethodName: "/lbm.bank.v1.Msg/Send",
+		Request:    send,
+	}
+
+	t.Log("verify updated authorization returns nil")
+	resp, err := authorization.Accept(ctx, srvMsg)
+	require.NoError(t, err)
+	require.True(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	authorization = types.NewSendAuthorization(coins1000)
+	require.Equal(t, "/lbm.bank.v1.Msg/Send", authorization.MethodName())
+	require.NoError(t, authorization.ValidateBasic())
+	send = types.NewMsgSend(fromAddr, toAddr, coins500)
+	srvMsg = sdk.ServiceMsg{
+		MethodName: "/lbm.bank.v1.Msg/Send",
+		Request:    send,
+	}
+	resp, err = authorization.Accept(ctx, srvMsg)
+
+	t.Log("verify updated authorization returns remaining spent limit")
+	require.NoError(t, err)
+	require.False(t, resp.Delete)
+	require.NotNil(t, resp.Updated)
+	sendAuth := types.NewSendAuthorization(coins500)
+	require.Equal(t, sendAuth.String(), resp.Updated.String())
+
+	t.Log("expect updated authorization nil after spending remaining amount")
+	resp, err = resp.Updated.Accept(ctx, srvMsg)
+	require.NoError(t, err)
+	require.True(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	t.Log("verify the spend limit cannot be exceeded")
+	send = types.NewMsgSend(fromAddr, toAddr, coins1000.Add(coins500...))
+	_, err = authorization.Accept(ctx, sdk.ServiceMsg{MethodName: "/lbm.bank.v1.Msg/Send", Request: send})
+	require.Error(t, err)
+
+	t.Log("verify the msg types are checked")
+	multiSend := types.NewMsgMultiSend([]types.Input{types.NewInput(fromAddr, coins500)}, []types.Output{types.NewOutput(toAddr, coins500)})
+	_, err = authorization.Accept(ctx, sdk.ServiceMsg{MethodName: "/lbm.bank.v1.Msg/MultiSend", Request: multiSend})
+	require.Error(t, err)
+
+	t.Log("verify the spend limit is validated")
+	require.Error(t, types.NewSendAuthorization(nil).ValidateBasic())
+	require.Error(t, types.NewSendAuthorization(sdk.Coins{sdk.NewInt64Coin("stake", 0)}).ValidateBasic())
+}
@@ -0,0 +1,165 @@
+package types
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	authztypes "github.com/line/lbm-sdk/x/authz/types"
+)
+
+// Msg service method names of the staking authorizations
+const (
+	delegateMethodName   = "/lbm.staking.v1.Msg/Delegate"
+	undelegateMethodName = "/lbm.staking.v1.Msg/Undelegate"
+	redelegateMethodName = "/lbm.staking.v1.Msg/BeginRedelegate"
+
+	// gasCostPerIteration is the gas consumed checking a validator of the lists
+	gasCostPerIteration = uint64(10)
+)
+
+var (
+	_ authztypes.Authorization = &StakeAuthorization{}
+)
+
+// NewStakeAuthorization creates a new StakeAuthorization object.
+func NewStakeAuthorization(allowed []sdk.ValAddress, denied []sdk.ValAddress, authzType AuthorizationType, amount *sdk.Coin) (*StakeAuthorization, error) {
+	allowedValidators, deniedValidators, err := validateAndBech32fy(allowed, denied)
+	if err != nil {
+		return nil, err
+	}
+
+	authorization := StakeAuthorization{}
+	if allowedValidators != nil {
+		authorization.Validators = &StakeAuthorization_AllowList{AllowList: &StakeAuthorization_Validators{Address: allowedValidators}}
+	} else {
+		authorization.Validators = &StakeAuthorization_DenyList{DenyList: &StakeAuthorization_Validators{Address: deniedValidators}}
+	}
+
+	if amount != nil {
+		authorization.MaxTokens = amount
+	}
+	authorization.AuthorizationType = authzType
+
+	return &authorization, nil
+}
+
+// MethodName implements Authorization.MethodName.
+func (authorization StakeAuthorization) MethodName() string {
+	authzType, err := normalizeAuthzType(authorization.AuthorizationType)
+	if err != nil {
+		panic(err)
+	}
+	return authzType
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (authorization StakeAuthorization) ValidateBasic() error {
+	if authorization.MaxTokens != nil && authorization.MaxTokens.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "negative coin amount: %v", authorization.MaxTokens)
+	}
+	if authorization.AuthorizationType == AuthorizationType_AUTHORIZATION_TYPE_UNSPECIFIED {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidType, "unknown authorization type")
+	}
+
+	return nil
+}
+
+// Accept implements Authorization.Accept.
+func (authorization StakeAuthorization) Accept(ctx sdk.Context, msg sdk.ServiceMsg) (authztypes.AcceptResponse, error) {
+	var validatorAddress string
+	var amount sdk.Coin
+
+	switch msg := msg.Request.(type) {
+	case *MsgDelegate:
+		validatorAddress = msg.ValidatorAddress
+		amount = msg.Amount
+	case *MsgUndelegate:
+		validatorAddress = msg.ValidatorAddress
+		amount = msg.Amount
+	case *MsgBeginRedelegate:
+		validatorAddress = msg.ValidatorDstAddress
+		amount = msg.Amount
+	default:
+		return authztypes.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown msg type")
+	}
+	if msg.MethodName != authorization.MethodName() {
+		return authztypes.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+
+	isValidatorExists := false
+	allowedList := authorization.GetAllowList().GetAddress()
+	for _, validator := range allowedList {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "stake authorization")
+		if validator == validatorAddress {
+			isValidatorExists = true
+			break
+		}
+	}
+
+	denyList := authorization.GetDenyList().GetAddress()
+	for _, validator := range denyList {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "stake authorization")
+		if validator == validatorAddress {
+			return authztypes.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot delegate/undelegate to %s validator", validator)
+		}
+	}
+
+	if len(allowedList) > 0 && !isValidatorExists {
+		return authztypes.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot delegate/undelegate to %s validator", validatorAddress)
+	}
+
+	if authorization.MaxTokens == nil {
+		return authztypes.AcceptResponse{Accept: true, Delete: false,
+			Updated: &StakeAuthorization{Validators: authorization.GetValidators(), AuthorizationType: authorization.GetAuthorizationType()}}, nil
+	}
+
+	if amount.Denom != authorization.MaxTokens.Denom {
+		return authztypes.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "expected %s, got %s", authorization.MaxTokens.Denom, amount.Denom)
+	}
+	if authorization.MaxTokens.IsLT(amount) {
+		return authztypes.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is more than the %s max tokens", amount, authorization.MaxTokens)
+	}
+	limitLeft := authorization.MaxTokens.Sub(amount)
+	if limitLeft.IsZero() {
+		return authztypes.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+	return authztypes.AcceptResponse{Accept: true, Delete: false,
+		Updated: &StakeAuthorization{Validators: authorization.GetValidators(), AuthorizationType: authorization.GetAuthorizationType(), MaxTokens: &limitLeft}}, nil
+}
+
+func validateAndBech32fy(allowed []sdk.ValAddress, denied []sdk.ValAddress) ([]string, []string, error) {
+	if len(allowed) == 0 && len(denied) == 0 {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "both allowed & deny list cannot be empty")
+	}
+
+	if len(allowed) > 0 && len(denied) > 0 {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot set both allowed & deny list")
+	}
+
+	allowedValidators := make([]string, len(allowed))
+	if len(allowed) > 0 {
+		for i, validator := range allowed {
+			allowedValidators[i] = validator.String()
+		}
+		return allowedValidators, nil, nil
+	}
+
+	deniedValidators := make([]string, len(denied))
+	for i, validator := range denied {
+		deniedValidators[i] = validator.String()
+	}
+
+	return nil, deniedValidators, nil
+}
+
+func normalizeAuthzType(authzType AuthorizationType) (string, error) {
+	switch authzType {
+	case AuthorizationType_AUTHORIZATION_TYPE_DELEGATE:
+		return delegateMethodName, nil
+	case AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE:
+		return undelegateMethodName, nil
+	case AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE:
+		return redelegateMethodName, nil
+	default:
+		return "", sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "unknown authorization type %s", authzType)
+	}
+}
@@ -0,0 +1,194 @@
+package types_test
+
+import (
+	"testing"
+
+	ocproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/simapp"
+	sdk "github.com/line/lbm-sdk/types"
+	stakingtypes "github.com/line/lbm-sdk/x/staking/types"
+)
+
+var (
+	coin100 = sdk.NewInt64Coin("steak", 100)
+	coin150 = sdk.NewInt64Coin("steak", 150)
+	coin50  = sdk.NewInt64Coin("steak", 50)
+	delAddr = sdk.BytesToAccAddress([]byte("_____delegator _____"))
+)
+
+func delegateMsg(valAddr sdk.ValAddress, amount sdk.Coin) sdk.ServiceMsg {
+	return sdk.ServiceMsg{
+		MethodName: "/lbm.staking.v1.Msg/Delegate",
+		Request:    stakingtypes.NewMsgDelegate(delAddr, valAddr, amount),
+	}
+}
+
+func TestAuthzAuthorizations(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, ocproto.Header{})
+
+	// verify ValidateBasic returns error for the AUTHORIZATION_TYPE_UNSPECIFIED authorization type
+	delAuth, err := stakingtypes.NewStakeAuthorization([]sdk.ValAddress{valAddr1, valAddr2}, []sdk.ValAddress{}, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNSPECIFIED, &coin100)
+	require.NoError(t, err)
+	require.Error(t, delAuth.ValidateBasic())
+
+	// both lists can't be empty or set at once
+	_, err = stakingtypes.NewStakeAuthorization([]sdk.ValAddress{}, []sdk.ValAddress{}, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, &coin100)
+	require.Error(t, err)
+	_, err = stakingtypes.NewStakeAuthorization([]sdk.ValAddress{valAddr1}, []sdk.ValAddress{valAddr2}, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, &coin100)
+	require.Error(t, err)
+
+	validators1_2 := []string{valAddr1.String(), valAddr2.String()}
+
+	testCases := []struct {
+		msg                  string
+		allowed              []sdk.ValAddress
+		denied               []sdk.ValAddress
+		msgType              stakingtypes.AuthorizationType
+		limit                *sdk.Coin
+		srvMsg               sdk.ServiceMsg
+		expectErr            bool
+		isDelete             bool
+		updatedAuthorization *stakingtypes.StakeAuthorization
+	}{
+		{
+			"delegate: expect 0 remaining coins",
+			[]sdk.ValAddress{valAddr1, valAddr2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			&coin100,
+			delegateMsg(valAddr1, coin100),
+			false,
+			true,
+			nil,
+		},
+		{
+			"delegate: verify remaining coins",
+			[]sdk.ValAddress{valAddr1, valAddr2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			&coin100,
+			delegateMsg(valAddr1, coin50),
+			false,
+			false,
+			&stakingtypes.StakeAuthorization{
+				Validators: &stakingtypes.StakeAuthorization_AllowList{
+					AllowList: &stakingtypes.StakeAuthorization_Validators{Address: validators1_2},
+				}, MaxTokens: &coin50, AuthorizationType: stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE},
+		},
+		{
+			"delegate: testing with invalid validator",
+			[]sdk.ValAddress{valAddr1, valAddr2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			&coin100,
+			delegateMsg(valAddr3, coin100),
+			true,
+			false,
+			nil,
+		},
+		{
+			"delegate: testing delegate with exceeding the max tokens",
+			[]sdk.ValAddress{valAddr1, valAddr2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			&coin100,
+			delegateMsg(valAddr1, coin150),
+			true,
+			false,
+			nil,
+		},
+		{
+			"delegate: testing with a validator out of denylist",
+			[]sdk.ValAddress{},
+			[]sdk.ValAddress{valAddr1},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			nil,
+			delegateMsg(valAddr2, coin100),
+			false,
+			false,
+			&stakingtypes.StakeAuthorization{
+				Validators: &stakingtypes.StakeAuthorization_DenyList{
+					DenyList: &stakingtypes.StakeAuthorization_Validators{Address: []string{valAddr1.String()}},
+				}, AuthorizationType: stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE},
+		},
+		{
+			"delegate: testing with a denied validator",
+			[]sdk.ValAddress{},
+			[]sdk.ValAddress{valAddr1},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			nil,
+			delegateMsg(valAddr1, coin100),
+			true,
+			false,
+			nil,
+		},
+		{
+			"undelegate: expect 0 remaining coins",
+			[]sdk.ValAddress{valAddr1, valAddr2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE,
+			&coin100,
+			sdk.ServiceMsg{
+				MethodName: "/lbm.staking.v1.Msg/Undelegate",
+				Request:    stakingtypes.NewMsgUndelegate(delAddr, valAddr1, coin100),
+			},
+			false,
+			true,
+			nil,
+		},
+		{
+			"redelegate: a delegation is not a redelegation",
+			[]sdk.ValAddress{valAddr1, valAddr2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE,
+			&coin100,
+			delegateMsg(valAddr1, coin100),
+			true,
+			false,
+			nil,
+		},
+		{
+			"redelegate: verify remaining coins",
+			[]sdk.ValAddress{valAddr1, valAddr2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE,
+			&coin100,
+			sdk.ServiceMsg{
+				MethodName: "/lbm.staking.v1.Msg/BeginRedelegate",
+				Request:    stakingtypes.NewMsgBeginRedelegate(delAddr, valAddr3, valAddr1, coin50),
+			},
+			false,
+			false,
+			&stakingtypes.StakeAuthorization{
+				Validators: &stakingtypes.StakeAuthorization_AllowList{
+					AllowList: &stakingtypes.StakeAuthorization_Validators{Address: validators1_2},
+				}, MaxTokens: &coin50, AuthorizationType: stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.msg, func(t *testing.T) {
+			delAuth, err := stakingtypes.NewStakeAuthorization(tc.allowed, tc.denied, tc.msgType, tc.limit)
+			require.NoError(t, err)
+			require.NoError(t, delAuth.ValidateBasic())
+
+			resp, err := delAuth.Accept(ctx, tc.srvMsg)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+			require.Equal(t, tc.isDelete, resp.Delete)
+			if tc.updatedAuthorization == nil {
+				require.Nil(t, resp.Updated)
+				return
+			}
+			require.Equal(t, tc.updatedAuthorization.String(), resp.Updated.(*stakingtypes.StakeAuthorization).String())
+		})
+	}
+}
@@ -71,6 +71,7 @@ var (
 	NewQuerier                = keeper.Querier
 	ContractFromPortID        = keeper.ContractFromPortID
 	WithWasmEngine            = keeper.WithWasmEngine
+	NewWasmSnapshotter        = keeper.NewWasmSnapshotter
 
 	// variable aliases
 	ModuleCdc            = types.ModuleCdc
@@ -102,6 +103,8 @@ type (
 	MsgStoreCodeResponse                       = types.MsgStoreCodeResponse
 	MsgInstantiateContract                     = types.MsgInstantiateContract
 	MsgInstantiateContractResponse             = types.MsgInstantiateContractResponse
+	MsgInstantiateContract2                    = types.MsgInstantiateContract2
+	MsgInstantiateContract2Response            = types.MsgInstantiateContract2Response
 	MsgStoreCodeAndInstantiateContract         = types.MsgStoreCodeAndInstantiateContract
 	MsgStoreCodeAndInstantiateContractResponse = types.MsgStoreCodeAndInstantiateContractResponse
 	MsgExecuteContract                         = types.MsgExecuteContract
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 
 	"github.com/line/lbm-sdk/client"
 	"github.com/line/lbm-sdk/client/flags"
@@ -71,8 +72,7 @@ func GenesisStoreCodeCmd(defaultNodeHome string, genesisMutator GenesisMutator)
 	cmd.Flags().String(flagSource, "", "A valid URI reference to the contract's source code, optional")
 	cmd.Flags().String(flagBuilder, "", "A valid docker tag for the build system, optional")
 	cmd.Flags().String(flagRunAs, "", "The address that is stored as code creator")
-	cmd.Flags().String(flagInstantiateByEverybody, "", "Everybody can instantiate a contract from the code, optional")
-	cmd.Flags().String(flagInstantiateByAddress, "", "Only this address can instantiate a contract instance from the code, optional")
+	addInstantiatePermissionFlags(cmd)
 
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
 	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
@@ -197,6 +197,80 @@ func GenesisExecuteContractCmd(defaultNodeHome string, genesisMutator GenesisMut
 	return cmd
 }
 
+// GenesisImportContractCmd cli command to load a contract exported with `export-contract` into the wasm section
+// of the genesis. The contract keeps its address while its code is stored with a new code id unless
+// a code with the same hash exists in the genesis already.
+func GenesisImportContractCmd(defaultNodeHome string, genesisMutator GenesisMutator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-contract [export_file]",
+		Short: "Import a contract exported with export-contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var export types.ContractExport
+			if err := clientCtx.JSONMarshaler.UnmarshalJSON(bz, &export); err != nil {
+				return sdkerrors.Wrap(err, "export file")
+			}
+			if err := export.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return genesisMutator.AlterWasmModuleState(cmd, func(state *types.GenesisState, _ map[string]json.RawMessage) error {
+				if hasContract(state, export.Contract.ContractAddress) {
+					return fmt.Errorf("contract %s exists already", export.Contract.ContractAddress)
+				}
+				importGenesisContract(state, export)
+				return nil
+			})
+		},
+	}
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// importGenesisContract adds the code and contract of the export to the genesis state and
+// moves the id sequences ahead accordingly. The contract keeps its code history, whose entries
+// of the exported code refer to the code id it is stored with. The entries of the codes the
+// contract was migrated from keep the code ids of the exporting chain.
+func importGenesisContract(state *types.GenesisState, export types.ContractExport) {
+	codeID := codeSeqValue(state)
+	found := false
+	for _, c := range state.Codes {
+		if bytes.Equal(c.CodeInfo.CodeHash, export.Code.CodeInfo.CodeHash) {
+			codeID, found = c.CodeID, true
+			break
+		}
+	}
+	if !found {
+		code := export.Code
+		code.CodeID = codeID
+		code.Verifications = make([]types.CodeVerification, len(export.Code.Verifications))
+		for i, verification := range export.Code.Verifications {
+			verification.CodeID = codeID
+			code.Verifications[i] = verification
+		}
+		state.Codes = append(state.Codes, code)
+		setSeqValue(state, types.KeyLastCodeID, codeID+1)
+	}
+
+	contract := export.Contract
+	contract.ContractInfo.CodeID = codeID
+	contract.ContractCodeHistory = make([]types.ContractCodeHistoryEntry, len(export.History))
+	for i, entry := range export.History {
+		if entry.CodeID == export.Code.CodeID {
+			entry.CodeID = codeID
+		}
+		contract.ContractCodeHistory[i] = entry
+	}
+	state.Contracts = append(state.Contracts, contract)
+	setSeqValue(state, types.KeyLastInstanceID, contractSeqValue(state)+1)
+}
+
 // GenesisListCodesCmd cli command to list all codes stored in the genesis wasm.code section
 // as well as from messages that are queued in the wasm.genMsgs section.
 func GenesisListCodesCmd(defaultNodeHome string, genReader GenesisReader) *cobra.Command {
@@ -478,6 +552,17 @@ func codeSeqValue(state *types.GenesisState) uint64 {
 	return seq
 }
 
+// setSeqValue sets the value of the sequence with the given key in the genesis
+func setSeqValue(state *types.GenesisState, key []byte, value uint64) {
+	for i, s := range state.Sequences {
+		if bytes.Equal(s.IDKey, key) {
+			state.Sequences[i].Value = value
+			return
+		}
+	}
+	state.Sequences = append(state.Sequences, types.Sequence{IDKey: key, Value: value})
+}
+
 // getActorAddress returns the account address for the `--run-as` flag.
 // The flag value can either be an address already or a key name where the
 // address is read from the keyring instead.
@@ -514,6 +514,114 @@ func TestExecuteContractCmd(t *testing.T) {
 		})
 	}
 }
+func TestGenesisImportContractCmd(t *testing.T) {
+	code := types.CodeFixture(func(c *types.Code) { c.CodeID = 7 })
+	contract := types.ContractFixture(func(c *types.Contract) { c.ContractInfo.CodeID = 7 })
+	history := []types.ContractCodeHistoryEntry{
+		{Operation: types.ContractCodeHistoryOperationTypeInit, CodeID: 5, Updated: &types.AbsoluteTxPosition{BlockHeight: 10}, Msg: []byte(`{"init":{}}`)},
+		{Operation: types.ContractCodeHistoryOperationTypeMigrate, CodeID: 7, Updated: &types.AbsoluteTxPosition{BlockHeight: 20}, Msg: []byte(`{"migrate":{}}`)},
+	}
+	export := types.ContractExport{Height: 100, Code: code, Contract: contract, History: history}
+	hash, err := export.ComputeHash()
+	require.NoError(t, err)
+	export.Hash = hash
+
+	specs := map[string]struct {
+		srcGenesis   types.GenesisState
+		export       types.ContractExport
+		expCodeID    uint64
+		expCodes     int
+		expSequences []types.Sequence
+		expError     bool
+	}{
+		"empty genesis": {
+			srcGenesis: types.GenesisState{Params: types.DefaultParams()},
+			export:     export,
+			expCodeID:  1,
+			expCodes:   1,
+			expSequences: []types.Sequence{
+				{IDKey: types.KeyLastCodeID, Value: 2},
+				{IDKey: types.KeyLastInstanceID, Value: 2},
+			},
+		},
+		"code with same hash in genesis": {
+			srcGenesis: types.GenesisState{
+				Params: types.DefaultParams(),
+				Codes:  []types.Code{types.CodeFixture(func(c *types.Code) { c.CodeID = 3; c.CodeBytes = code.CodeBytes; c.CodeInfo = code.CodeInfo })},
+				Sequences: []types.Sequence{
+					{IDKey: types.KeyLastCodeID, Value: 4},
+					{IDKey: types.KeyLastInstanceID, Value: 10},
+				},
+			},
+			export:    export,
+			expCodeID: 3,
+			expCodes:  1,
+			expSequences: []types.Sequence{
+				{IDKey: types.KeyLastCodeID, Value: 4},
+				{IDKey: types.KeyLastInstanceID, Value: 11},
+			},
+		},
+		"contract exists": {
+			srcGenesis: types.GenesisState{
+				Params:    types.DefaultParams(),
+				Codes:     []types.Code{types.CodeFixture()},
+				Contracts: []types.Contract{types.ContractFixture()},
+			},
+			export:   export,
+			expError: true,
+		},
+		"hash does not match": {
+			srcGenesis: types.GenesisState{Params: types.DefaultParams()},
+			export: func() types.ContractExport {
+				e := export
+				e.Height++
+				return e
+			}(),
+			expError: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			homeDir := setupGenesis(t, spec.srcGenesis)
+			exportFile := path.Join(t.TempDir(), "export.json")
+			bz, err := keeper.MakeEncodingConfig(t).Marshaler.MarshalJSON(&spec.export)
+			require.NoError(t, err)
+			require.NoError(t, ioutil.WriteFile(exportFile, bz, 0600))
+
+			// when
+			cmd := GenesisImportContractCmd(homeDir, NewDefaultGenesisIO())
+			cmd.SetArgs([]string{exportFile})
+			err = executeCmdWithContext(t, homeDir, cmd)
+			if spec.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			// then
+			moduleState := loadModuleState(t, homeDir)
+			require.NoError(t, moduleState.ValidateBasic())
+			assert.Len(t, moduleState.Codes, spec.expCodes)
+			require.Len(t, moduleState.Contracts, 1)
+			assert.Equal(t, spec.export.Contract.ContractAddress, moduleState.Contracts[0].ContractAddress)
+			assert.Equal(t, spec.expCodeID, moduleState.Contracts[0].ContractInfo.CodeID)
+			assert.Equal(t, spec.export.Contract.ContractState, moduleState.Contracts[0].ContractState)
+			assert.Equal(t, spec.expSequences, moduleState.Sequences)
+			// the history is kept, referring to the code id of the genesis
+			gotHistory := moduleState.Contracts[0].ContractCodeHistory
+			require.Len(t, gotHistory, len(history))
+			for i, exp := range history {
+				if exp.CodeID == code.CodeID {
+					exp.CodeID = spec.expCodeID
+				}
+				assert.Equal(t, exp.Operation, gotHistory[i].Operation)
+				assert.Equal(t, exp.CodeID, gotHistory[i].CodeID)
+				assert.Equal(t, exp.Updated, gotHistory[i].Updated)
+				assert.JSONEq(t, string(exp.Msg), string(gotHistory[i].Msg))
+			}
+		})
+	}
+}
+
 func TestGetAllContracts(t *testing.T) {
 	specs := map[string]struct {
 		src types.GenesisState
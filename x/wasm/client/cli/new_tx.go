@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"encoding/hex"
+	"io/ioutil"
 	"strconv"
 
 	"github.com/line/lbm-sdk/client"
@@ -145,3 +147,91 @@ func UpdateContractStatusCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// UpdateInstantiateConfigCmd updates the instantiate config of a stored code
+func UpdateInstantiateConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-instantiate-config [code_id]",
+		Short: "Update the instantiate permission of a stored code",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "code id")
+			}
+			perm, err := parseAccessConfigFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if perm == nil {
+				return sdkerrors.Wrap(types.ErrEmpty, "instantiate permission")
+			}
+
+			msg := types.MsgUpdateInstantiateConfig{
+				Sender:                   clientCtx.GetFromAddress().String(),
+				CodeID:                   codeID,
+				NewInstantiatePermission: *perm,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	addInstantiatePermissionFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// SubmitCodeVerificationCmd links a stored code to the reproducible build it was compiled from
+func SubmitCodeVerificationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-code-verification [code_id] [source_hash] [builder_digest] --attestation [file]",
+		Short: "Link a stored code to the source archive and optimizer image it was built with",
+		Long:  "Link a stored code to the hex encoded sha256 hash of the source archive and the digest of the optimizer image it was built with. An optional attestation file can be attached that allows to verify the build off-chain.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "code id")
+			}
+			sourceHash, err := hex.DecodeString(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "source hash")
+			}
+			var attestation []byte
+			if file, err := cmd.Flags().GetString(flagAttestation); err != nil {
+				return sdkerrors.Wrap(err, "attestation")
+			} else if file != "" {
+				if attestation, err = ioutil.ReadFile(file); err != nil {
+					return sdkerrors.Wrap(err, "attestation")
+				}
+			}
+
+			msg := types.MsgSubmitCodeVerification{
+				Sender:        clientCtx.GetFromAddress().String(),
+				CodeID:        codeID,
+				SourceHash:    sourceHash,
+				BuilderDigest: args[2],
+				Attestation:   attestation,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().String(flagAttestation, "", "File with an off-chain verifiable attestation of the build")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/line/lbm-sdk/client"
 	"github.com/line/lbm-sdk/client/flags"
 	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/types/query"
 	"github.com/line/lbm-sdk/x/wasm/types"
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
@@ -30,9 +31,20 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdListCode(),
 		GetCmdListContractByCode(),
 		GetCmdQueryCode(),
+		GetCmdBuildAddress(),
 		GetCmdGetContractInfo(),
 		GetCmdGetContractHistory(),
 		GetCmdGetContractState(),
+		GetCmdGetContractStorageUsage(),
+		GetCmdProfileExecuteContract(),
+		GetCmdSimulateExecuteContract(),
+		GetCmdListBlockHooks(),
+		GetCmdExportContract(),
+		GetCmdGetEventSchema(),
+		GetCmdGetStargateAllowlist(),
+		GetCmdListCodeVerifications(),
+		GetCmdListVerifiedCodes(),
+		GetCmdVerifyCode(),
 	)
 	return queryCmd
 }
@@ -72,6 +84,41 @@ func GetCmdListCode() *cobra.Command {
 	return cmd
 }
 
+// GetCmdListBlockHooks lists all contracts registered for begin/end block callbacks
+func GetCmdListBlockHooks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-block-hooks",
+		Short: "List all contracts registered for begin/end block callbacks",
+		Long:  "List all contracts registered for begin/end block callbacks",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BlockHooks(
+				context.Background(),
+				&types.QueryBlockHooksRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list block hooks")
+	return cmd
+}
+
 // GetCmdListContractByCode lists all wasm code uploaded for given code id
 func GetCmdListContractByCode() *cobra.Command {
 	cmd := &cobra.Command{
@@ -113,6 +160,57 @@ func GetCmdListContractByCode() *cobra.Command {
 	return cmd
 }
 
+// GetCmdBuildAddress builds the predictable address of a contract instantiated with instantiate2
+func GetCmdBuildAddress() *cobra.Command {
+	decoder := newArgDecoder(hex.DecodeString)
+	cmd := &cobra.Command{
+		Use:   "build-address [code-hash] [creator-address] [salt-hex-encoded] [json_encoded_init_args (required when set as fixed)]",
+		Short: "build contract address",
+		Long:  "Builds the address of a contract instantiated with instantiate2 from the hex encoded code hash, creator address and salt",
+		Args:  cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			err = sdk.ValidateAccAddress(args[1])
+			if err != nil {
+				return err
+			}
+			salt, err := decoder.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("salt: %s", err)
+			}
+			var initArgs []byte
+			if len(args) == 4 {
+				initArgs = []byte(args[3])
+				if !json.Valid(initArgs) {
+					return errors.New("init args must be json")
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BuildAddress(
+				context.Background(),
+				&types.QueryBuildAddressRequest{
+					CodeHash:       args[0],
+					CreatorAddress: args[1],
+					Salt:           hex.EncodeToString(salt),
+					InitArgs:       initArgs,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	decoder.RegisterFlags(cmd.PersistentFlags(), "salt")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryCode returns the bytecode for a given contract
 func GetCmdQueryCode() *cobra.Command {
 	cmd := &cobra.Command{
@@ -188,6 +286,40 @@ func GetCmdGetContractInfo() *cobra.Command {
 	return cmd
 }
 
+// GetCmdGetContractStorageUsage gets the state size and the storage deposit of a contract
+func GetCmdGetContractStorageUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-storage-usage [bech32_address]",
+		Short: "Prints out the state size and the storage deposit of a contract given its address",
+		Long:  "Prints out the state size and the storage deposit of a contract given its address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			err = sdk.ValidateAccAddress(args[0])
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractStorageUsage(
+				context.Background(),
+				&types.QueryContractStorageUsageRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdGetContractState dumps full internal state of a given contract
 func GetCmdGetContractState() *cobra.Command {
 	cmd := &cobra.Command{
@@ -335,6 +467,124 @@ func GetCmdGetContractStateSmart() *cobra.Command {
 	return cmd
 }
 
+// GetCmdProfileExecuteContract simulates a contract execution and prints the recorded call tree
+func GetCmdProfileExecuteContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "profile-execute [bech32_address] [json_encoded_send_args] --run-as [sender]",
+		Short: "Simulates a contract execution and prints the call tree with gas and storage usage",
+		Long:  "Simulates a contract execution and prints the call tree with gas and storage usage. Requires call profiling to be enabled on the node.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			err = sdk.ValidateAccAddress(args[0])
+			if err != nil {
+				return err
+			}
+			execMsg := []byte(args[1])
+			if !json.Valid(execMsg) {
+				return errors.New("msg must be json")
+			}
+			sender, err := cmd.Flags().GetString(flagRunAs)
+			if err != nil {
+				return fmt.Errorf("run-as: %s", err)
+			}
+			if err := sdk.ValidateAccAddress(sender); err != nil {
+				return fmt.Errorf("run-as: %s", err)
+			}
+			amountStr, err := cmd.Flags().GetString(flagAmount)
+			if err != nil {
+				return fmt.Errorf("amount: %s", err)
+			}
+			amount, err := sdk.ParseCoinsNormalized(amountStr)
+			if err != nil {
+				return fmt.Errorf("amount: %s", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ProfileExecuteContract(
+				context.Background(),
+				&types.QueryProfileExecuteContractRequest{
+					Sender:   sender,
+					Contract: args[0],
+					Msg:      execMsg,
+					Funds:    amount,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagRunAs, "", "The address that sends the execute message")
+	cmd.Flags().String(flagAmount, "", "Coins to send to the contract along with command")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSimulateExecuteContract simulates a contract execution and prints the dispatched messages, replies and events
+func GetCmdSimulateExecuteContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-execute [bech32_address] [json_encoded_send_args] --run-as [sender]",
+		Short: "Simulates a contract execution and prints the dispatched messages, replies and events",
+		Long:  "Simulates a contract execution without committing any state and prints the tree of dispatched messages and submessages with their replies, events and gas usage.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			err = sdk.ValidateAccAddress(args[0])
+			if err != nil {
+				return err
+			}
+			execMsg := []byte(args[1])
+			if !json.Valid(execMsg) {
+				return errors.New("msg must be json")
+			}
+			sender, err := cmd.Flags().GetString(flagRunAs)
+			if err != nil {
+				return fmt.Errorf("run-as: %s", err)
+			}
+			if err := sdk.ValidateAccAddress(sender); err != nil {
+				return fmt.Errorf("run-as: %s", err)
+			}
+			amountStr, err := cmd.Flags().GetString(flagAmount)
+			if err != nil {
+				return fmt.Errorf("amount: %s", err)
+			}
+			amount, err := sdk.ParseCoinsNormalized(amountStr)
+			if err != nil {
+				return fmt.Errorf("amount: %s", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SimulateExecute(
+				context.Background(),
+				&types.QuerySimulateExecuteRequest{
+					Sender:   sender,
+					Contract: args[0],
+					Msg:      execMsg,
+					Funds:    amount,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagRunAs, "", "The address that sends the execute message")
+	cmd.Flags().String(flagAmount, "", "Coins to send to the contract along with command")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdGetContractHistory prints the code history for a given contract
 func GetCmdGetContractHistory() *cobra.Command {
 	cmd := &cobra.Command{
@@ -378,6 +628,83 @@ func GetCmdGetContractHistory() *cobra.Command {
 	return cmd
 }
 
+// GetCmdExportContract writes the code, contract info, history and full state of a contract
+// to a portable file that can be loaded into a local genesis with `import-contract`.
+func GetCmdExportContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-contract [bech32_address] [output_file]",
+		Short: "Exports a contract with its code, history and full state to a file",
+		Long: `Exports a contract with its code, history and full state to a file. The contract state is
+read page by page at a single height which is the latest one unless set with --height.
+The file carries a sha256 integrity hash and can be loaded into a local genesis with "import-contract".`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			err = sdk.ValidateAccAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			export, err := queryContractExport(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			bz, err := clientCtx.JSONMarshaler.MarshalJSON(export)
+			if err != nil {
+				return err
+			}
+			if err := ioutil.WriteFile(args[1], bz, 0600); err != nil {
+				return err
+			}
+			return clientCtx.PrintString(fmt.Sprintf("exported contract %s at height %d with %d state entries, hash: %X\n",
+				args[0], export.Height, len(export.Contract.ContractState), export.Hash))
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// queryContractExport pages through the ExportContract query and assembles the full export.
+// All pages are read at the height of the first one. The queries are served over the unary
+// ABCI query, which cannot stream, so the state is read in pages bounded by the query limit.
+func queryContractExport(clientCtx client.Context, contractAddr string) (*types.ContractExport, error) {
+	var export types.ContractExport
+	var nextKey []byte
+	for {
+		res, err := types.NewQueryClient(clientCtx).ExportContract(
+			context.Background(),
+			&types.QueryExportContractRequest{
+				Address:    contractAddr,
+				Pagination: &query.PageRequest{Key: nextKey},
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		if nextKey == nil {
+			export = res.Export
+			clientCtx = clientCtx.WithHeight(export.Height)
+		} else {
+			export.Contract.ContractState = append(export.Contract.ContractState, res.Export.Contract.ContractState...)
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = res.Pagination.NextKey
+	}
+
+	hash, err := export.ComputeHash()
+	if err != nil {
+		return nil, err
+	}
+	export.Hash = hash
+	return &export, nil
+}
+
 type argumentDecoder struct {
 	// dec is the default decoder
 	dec                func(string) ([]byte, error)
@@ -434,3 +761,142 @@ func withPageKeyDecoded(flagSet *flag.FlagSet) *flag.FlagSet {
 	flagSet.Set(flags.FlagPageKey, string(raw))
 	return flagSet
 }
+
+// GetCmdGetEventSchema gets the event schema registered with a code
+func GetCmdGetEventSchema() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "event-schema [code_id]",
+		Short: "Prints out the event schema registered with a code",
+		Long: "Prints out the JSON schema of the attributes of the wasm event emitted by the contracts of a code. " +
+			"The VM lets contracts emit the wasm event only, not custom wasm-{type} events, so a code has a single event schema",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EventSchema(
+				context.Background(),
+				&types.QueryEventSchemaRequest{
+					CodeId: codeID,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdGetStargateAllowlist gets the query paths and message type URLs contracts can use for stargate calls
+func GetCmdGetStargateAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stargate-allowlist",
+		Short: "Prints out the stargate query paths and message type urls that contracts can use",
+		Long:  "Prints out the stargate query paths and message type urls that contracts can use",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.StargateAllowlist(
+				context.Background(),
+				&types.QueryStargateAllowlistRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdListCodeVerifications lists the reproducible builds a code is linked to
+func GetCmdListCodeVerifications() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code-verifications [code_id]",
+		Short: "List the source hash, builder digest and attestation submitted for a code by each submitter",
+		Long:  "List the source hash, builder digest and attestation submitted for a code by each submitter",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CodeVerifications(
+				context.Background(),
+				&types.QueryCodeVerificationsRequest{
+					CodeId:     codeID,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list code verifications")
+	return cmd
+}
+
+// GetCmdListVerifiedCodes lists the verifications of all codes linked to a reproducible build
+func GetCmdListVerifiedCodes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-verified-codes",
+		Short: "List all codes linked to a reproducible build",
+		Long:  "List all codes linked to a reproducible build",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.VerifiedCodes(
+				context.Background(),
+				&types.QueryVerifiedCodesRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list verified codes")
+	return cmd
+}
@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"io/ioutil"
 	"strconv"
+	"time"
 
 	"github.com/line/lbm-sdk/client"
 	"github.com/line/lbm-sdk/client/flags"
 	"github.com/line/lbm-sdk/client/tx"
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	authztypes "github.com/line/lbm-sdk/x/authz/types"
 	wasmUtils "github.com/line/lbm-sdk/x/wasm/client/utils"
 	"github.com/line/lbm-sdk/x/wasm/types"
 	"github.com/spf13/cobra"
@@ -26,7 +28,18 @@ const (
 	flagRunAs                  = "run-as"
 	flagInstantiateByEverybody = "instantiate-everybody"
 	flagInstantiateByAddress   = "instantiate-only-address"
+	flagInstantiateByAnyOf     = "instantiate-anyof-addresses"
+	flagInstantiateNobody      = "instantiate-nobody"
 	flagProposalType           = "type"
+	flagSpendLimit             = "spend-limit"
+	flagExpiration             = "expiration"
+	flagFixMsg                 = "fix-msg"
+	flagBeginBlock             = "begin-block"
+	flagEndBlock               = "end-block"
+	flagGasLimit               = "gas-limit"
+	flagEventSchema            = "event-schema"
+	flagAttestation            = "attestation"
+	flagSourceArchive          = "source-archive"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -41,12 +54,16 @@ func GetTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		StoreCodeCmd(),
 		InstantiateContractCmd(),
+		InstantiateContract2Cmd(),
 		StoreCodeAndInstantiateContractCmd(),
 		ExecuteContractCmd(),
 		MigrateContractCmd(),
 		UpdateContractAdminCmd(),
 		ClearContractAdminCmd(),
 		UpdateContractStatusCmd(),
+		UpdateInstantiateConfigCmd(),
+		SubmitCodeVerificationCmd(),
+		GrantExecuteContractCmd(),
 	)
 	return txCmd
 }
@@ -66,6 +83,9 @@ func StoreCodeCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if msg.EventSchema, err = parseEventSchemaFlag(cmd.Flags()); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -75,8 +95,8 @@ func StoreCodeCmd() *cobra.Command {
 
 	cmd.Flags().String(flagSource, "", "A valid URI reference to the contract's source code, optional")
 	cmd.Flags().String(flagBuilder, "", "A valid docker tag for the build system, optional")
-	cmd.Flags().String(flagInstantiateByEverybody, "", "Everybody can instantiate a contract from the code, optional")
-	cmd.Flags().String(flagInstantiateByAddress, "", "Only this address can instantiate a contract instance from the code, optional")
+	addInstantiatePermissionFlags(cmd)
+	addEventSchemaFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -98,32 +118,9 @@ func parseStoreCodeArgs(file string, sender sdk.AccAddress, flags *flag.FlagSet)
 		return types.MsgStoreCode{}, fmt.Errorf("invalid input file. Use wasm binary or gzip")
 	}
 
-	var perm *types.AccessConfig
-	onlyAddrStr, err := flags.GetString(flagInstantiateByAddress)
+	perm, err := parseAccessConfigFlags(flags)
 	if err != nil {
-		return types.MsgStoreCode{}, fmt.Errorf("instantiate by address: %s", err)
-	}
-	if onlyAddrStr != "" {
-		err := sdk.ValidateAccAddress(onlyAddrStr)
-		if err != nil {
-			return types.MsgStoreCode{}, sdkerrors.Wrap(err, flagInstantiateByAddress)
-		}
-		x := types.AccessTypeOnlyAddress.With(sdk.AccAddress(onlyAddrStr))
-		perm = &x
-	} else {
-		everybodyStr, err := flags.GetString(flagInstantiateByEverybody)
-		if err != nil {
-			return types.MsgStoreCode{}, fmt.Errorf("instantiate by everybody: %s", err)
-		}
-		if everybodyStr != "" {
-			ok, err := strconv.ParseBool(everybodyStr)
-			if err != nil {
-				return types.MsgStoreCode{}, fmt.Errorf("boolean value expected for instantiate by everybody: %s", err)
-			}
-			if ok {
-				perm = &types.AllowEverybody
-			}
-		}
+		return types.MsgStoreCode{}, err
 	}
 
 	// build and sign the transaction, then broadcast to Tendermint
@@ -146,6 +143,27 @@ func parseStoreCodeArgs(file string, sender sdk.AccAddress, flags *flag.FlagSet)
 	return msg, nil
 }
 
+func addEventSchemaFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flagEventSchema, "", "A JSON schema file of the attributes of the wasm event emitted by the contracts, optional. "+
+		"The VM lets contracts emit the wasm event only, not custom wasm-{type} events, so a code has a single event schema")
+}
+
+// parseEventSchemaFlag reads the JSON schema file of the event schema flag
+func parseEventSchemaFlag(flags *flag.FlagSet) ([]byte, error) {
+	file, err := flags.GetString(flagEventSchema)
+	if err != nil {
+		return nil, fmt.Errorf("event schema: %s", err)
+	}
+	if file == "" {
+		return nil, nil
+	}
+	schema, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("event schema: %s", err)
+	}
+	return schema, nil
+}
+
 // InstantiateContractCmd will instantiate a contract from previously uploaded code.
 func InstantiateContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -215,6 +233,60 @@ func parseInstantiateArgs(rawCodeID, initMsg string, sender sdk.AccAddress, flag
 	return msg, nil
 }
 
+// InstantiateContract2Cmd will instantiate a contract from previously uploaded code with a predictable address.
+func InstantiateContract2Cmd() *cobra.Command {
+	decoder := newArgDecoder(asciiDecodeString)
+	cmd := &cobra.Command{
+		Use: "instantiate2 [code_id_int64] [json_encoded_init_args] [salt] --label [text] --admin [address,optional] --amount [coins,optional] " +
+			"--fix-msg [bool,optional]",
+		Short: "Instantiate a wasm contract with predictable address",
+		Long: `Creates a new instance of an uploaded wasm code with the given 'constructor' message.
+The address is derived from the code checksum, the sender and the salt. With --fix-msg the init message is part of the
+address, too. The salt is ascii encoded by default, use --hex or --b64 for binary salts.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			salt, err := decoder.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("salt: %s", err)
+			}
+			fixMsg, err := cmd.Flags().GetBool(flagFixMsg)
+			if err != nil {
+				return fmt.Errorf("fix msg: %s", err)
+			}
+			data, err := parseInstantiateArgs(args[0], args[1], clientCtx.GetFromAddress(), cmd.Flags())
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgInstantiateContract2{
+				Sender:  data.Sender,
+				Admin:   data.Admin,
+				CodeID:  data.CodeID,
+				Label:   data.Label,
+				InitMsg: data.InitMsg,
+				Funds:   data.Funds,
+				Salt:    salt,
+				FixMsg:  fixMsg,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAmount, "", "Coins to send to the contract during instantiation")
+	cmd.Flags().String(flagLabel, "", "A human-readable name for this contract in lists")
+	cmd.Flags().String(flagAdmin, "", "Address of an admin")
+	cmd.Flags().Bool(flagFixMsg, false, "An optional flag to include the json_encoded_init_args for the predictable address generation mode")
+	decoder.RegisterFlags(cmd.PersistentFlags(), "salt")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // StoreCodeAndInstantiatecontractcmd will upload code and instantiate a contract using it
 func StoreCodeAndInstantiateContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -230,6 +302,9 @@ func StoreCodeAndInstantiateContractCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if msg.EventSchema, err = parseEventSchemaFlag(cmd.Flags()); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -239,8 +314,8 @@ func StoreCodeAndInstantiateContractCmd() *cobra.Command {
 
 	cmd.Flags().String(flagSource, "", "A valid URI reference to the contract's source code, optional")
 	cmd.Flags().String(flagBuilder, "", "A valid docker tag for the build system, optional")
-	cmd.Flags().String(flagInstantiateByEverybody, "", "Everybody can instantiate a contract from the code, optional")
-	cmd.Flags().String(flagInstantiateByAddress, "", "Only this address can instantiate a contract instance from the code, optional")
+	addInstantiatePermissionFlags(cmd)
+	addEventSchemaFlag(cmd)
 	cmd.Flags().String(flagAmount, "", "Coins to send to the contract during instantiation")
 	cmd.Flags().String(flagLabel, "", "A human-readable name for this contract in lists")
 	cmd.Flags().String(flagAdmin, "", "Address of an admin")
@@ -265,32 +340,9 @@ func parseStoreCodeAndInstantiateContractArgs(file string, initMsg string, sende
 		return types.MsgStoreCodeAndInstantiateContract{}, fmt.Errorf("invalid input file. Use wasm binary or gzip")
 	}
 
-	var perm *types.AccessConfig
-	onlyAddrStr, err := flags.GetString(flagInstantiateByAddress)
+	perm, err := parseAccessConfigFlags(flags)
 	if err != nil {
-		return types.MsgStoreCodeAndInstantiateContract{}, fmt.Errorf("instantiate by address: %s", err)
-	}
-	if onlyAddrStr != "" {
-		err := sdk.ValidateAccAddress(onlyAddrStr)
-		if err != nil {
-			return types.MsgStoreCodeAndInstantiateContract{}, sdkerrors.Wrap(err, flagInstantiateByAddress)
-		}
-		x := types.AccessTypeOnlyAddress.With(sdk.AccAddress(onlyAddrStr))
-		perm = &x
-	} else {
-		everybodyStr, err := flags.GetString(flagInstantiateByEverybody)
-		if err != nil {
-			return types.MsgStoreCodeAndInstantiateContract{}, fmt.Errorf("instantiate by everybody: %s", err)
-		}
-		if everybodyStr != "" {
-			ok, err := strconv.ParseBool(everybodyStr)
-			if err != nil {
-				return types.MsgStoreCodeAndInstantiateContract{}, fmt.Errorf("boolean value expected for instantiate by everybody: %s", err)
-			}
-			if ok {
-				perm = &types.AllowEverybody
-			}
-		}
+		return types.MsgStoreCodeAndInstantiateContract{}, err
 	}
 
 	// build and sign the transaction, then broadcast to Tendermint
@@ -383,3 +435,126 @@ func parseExecuteArgs(contractAddr string, execMsg string, sender sdk.AccAddress
 		Msg:      []byte(execMsg),
 	}, nil
 }
+
+// GrantExecuteContractCmd will grant an address the execution of a contract on behalf of the sender.
+func GrantExecuteContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-execute [grantee_addr_bech32] [contract_addr_bech32] --spend-limit [coins,optional] --expiration [unix_timestamp,optional]",
+		Short: "Grant an address the execution of a wasm contract on your behalf",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseGrantExecuteArgs(args[0], args[1], clientCtx.GetFromAddress(), cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagSpendLimit, "", "Coins which can be sent to the contract along with the executions")
+	cmd.Flags().Int64(flagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The Unix timestamp the grant expires at. Default is one year.")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseGrantExecuteArgs(granteeAddr string, contractAddr string, granter sdk.AccAddress, flags *flag.FlagSet) (*authztypes.MsgGrantAuthorization, error) {
+	if err := sdk.ValidateAccAddress(granteeAddr); err != nil {
+		return nil, sdkerrors.Wrap(err, "grantee")
+	}
+	if err := sdk.ValidateAccAddress(contractAddr); err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	spendLimitStr, err := flags.GetString(flagSpendLimit)
+	if err != nil {
+		return nil, fmt.Errorf("spend limit: %s", err)
+	}
+	spendLimit, err := sdk.ParseCoinsNormalized(spendLimitStr)
+	if err != nil {
+		return nil, err
+	}
+
+	expiration, err := flags.GetInt64(flagExpiration)
+	if err != nil {
+		return nil, fmt.Errorf("expiration: %s", err)
+	}
+
+	authorization := types.NewExecuteContractAuthorization(types.NewContractGrant(sdk.AccAddress(contractAddr), spendLimit))
+	return authztypes.NewMsgGrantAuthorization(granter, sdk.AccAddress(granteeAddr), authorization, time.Unix(expiration, 0))
+}
+
+func addInstantiatePermissionFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagInstantiateByEverybody, "", "Everybody can instantiate a contract from the code, optional")
+	cmd.Flags().String(flagInstantiateNobody, "", "Nobody except the governance process can instantiate a contract from the code, optional")
+	cmd.Flags().String(flagInstantiateByAddress, "", "Only this address can instantiate a contract instance from the code, optional")
+	cmd.Flags().StringSlice(flagInstantiateByAnyOf, []string{}, "Any of the addresses can instantiate a contract from the code, optional")
+}
+
+// parseAccessConfigFlags returns the instantiate permission set by the flags or nil when none is set
+func parseAccessConfigFlags(flags *flag.FlagSet) (*types.AccessConfig, error) {
+	anyOfAddrStrs, err := flags.GetStringSlice(flagInstantiateByAnyOf)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate by any of addresses: %s", err)
+	}
+	if len(anyOfAddrStrs) != 0 {
+		addrs := make([]sdk.AccAddress, len(anyOfAddrStrs))
+		for i, addrStr := range anyOfAddrStrs {
+			if err := sdk.ValidateAccAddress(addrStr); err != nil {
+				return nil, sdkerrors.Wrap(err, flagInstantiateByAnyOf)
+			}
+			addrs[i] = sdk.AccAddress(addrStr)
+		}
+		x := types.AccessTypeAnyOfAddresses.With(addrs...)
+		return &x, nil
+	}
+
+	onlyAddrStr, err := flags.GetString(flagInstantiateByAddress)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate by address: %s", err)
+	}
+	if onlyAddrStr != "" {
+		err := sdk.ValidateAccAddress(onlyAddrStr)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, flagInstantiateByAddress)
+		}
+		x := types.AccessTypeOnlyAddress.With(sdk.AccAddress(onlyAddrStr))
+		return &x, nil
+	}
+
+	everybodyStr, err := flags.GetString(flagInstantiateByEverybody)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate by everybody: %s", err)
+	}
+	if everybodyStr != "" {
+		ok, err := strconv.ParseBool(everybodyStr)
+		if err != nil {
+			return nil, fmt.Errorf("boolean value expected for instantiate by everybody: %s", err)
+		}
+		if ok {
+			return &types.AllowEverybody, nil
+		}
+	}
+
+	nobodyStr, err := flags.GetString(flagInstantiateNobody)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate by nobody: %s", err)
+	}
+	if nobodyStr != "" {
+		ok, err := strconv.ParseBool(nobodyStr)
+		if err != nil {
+			return nil, fmt.Errorf("boolean value expected for instantiate by nobody: %s", err)
+		}
+		if ok {
+			return &types.AllowNobody, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os/exec"
+	"strconv"
+
+	"github.com/line/lbm-sdk/client"
+	"github.com/line/lbm-sdk/client/flags"
+	"github.com/line/lbm-sdk/types/query"
+	"github.com/line/lbm-sdk/x/wasm/types"
+	"github.com/spf13/cobra"
+)
+
+// GetCmdVerifyCode rebuilds a code locally and compares it with the code stored on chain
+func GetCmdVerifyCode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-code [code_id] [build_script] [wasm_file] --source-archive [file]",
+		Short: "Rebuilds a code with the given script and compares the hash with the stored code",
+		Long: `Runs the build script in the current directory and compares the sha256 hash of the wasm file it produced
+with the hash of the code stored on chain. When a source archive is given, its hash is compared with the
+source hashes of the code verifications submitted for the code and the submitters of the matching ones are printed.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			sourceArchive, err := cmd.Flags().GetString(flagSourceArchive)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Code(context.Background(), &types.QueryCodeRequest{CodeId: codeID})
+			if err != nil {
+				return err
+			}
+			if res.CodeInfoResponse == nil {
+				return fmt.Errorf("code not found")
+			}
+
+			out := cmd.OutOrStdout()
+			codeHash, err := runVerificationBuild(args[1], args[2], cmd.ErrOrStderr())
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(codeHash, res.CodeInfoResponse.DataHash) {
+				return fmt.Errorf("code hash mismatch: built %X, stored %X", codeHash, res.CodeInfoResponse.DataHash)
+			}
+			fmt.Fprintf(out, "code hash matches: %X\n", codeHash)
+
+			if sourceArchive == "" {
+				return nil
+			}
+			sourceHash, err := fileHash(sourceArchive)
+			if err != nil {
+				return err
+			}
+			var matched int
+			pageReq := &query.PageRequest{}
+			for {
+				res, err := queryClient.CodeVerifications(context.Background(), &types.QueryCodeVerificationsRequest{CodeId: codeID, Pagination: pageReq})
+				if err != nil {
+					return err
+				}
+				for _, v := range res.Verifications {
+					if bytes.Equal(sourceHash, v.SourceHash) {
+						fmt.Fprintf(out, "source hash matches verification of %s: %X\n", v.Submitter, sourceHash)
+						matched++
+					}
+				}
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+			}
+			if matched == 0 {
+				return fmt.Errorf("source hash mismatch: archive %X matches no submitted verification", sourceHash)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String(flagSourceArchive, "", "Source archive to compare with the submitted code verifications")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// runVerificationBuild runs the build script and returns the sha256 hash of the wasm file it produced.
+// The script output is written to the given writer.
+func runVerificationBuild(script string, wasmFile string, out io.Writer) ([]byte, error) {
+	build := exec.Command(script)
+	build.Stdout = out
+	build.Stderr = out
+	if err := build.Run(); err != nil {
+		return nil, fmt.Errorf("build script: %s", err)
+	}
+	return fileHash(wasmFile)
+}
+
+func fileHash(file string) ([]byte, error) {
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunVerificationBuild(t *testing.T) {
+	dir, err := ioutil.TempDir("", "verify")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	wasmFile := filepath.Join(dir, "contract.wasm")
+
+	specs := map[string]struct {
+		script string
+		exp    []byte
+		expErr bool
+	}{
+		"build succeeds": {
+			script: "#!/bin/sh\necho building\nprintf 'wasm' > " + wasmFile + "\n",
+			exp:    func() []byte { h := sha256.Sum256([]byte("wasm")); return h[:] }(),
+		},
+		"build fails": {
+			script: "#!/bin/sh\nexit 1\n",
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			os.Remove(wasmFile)
+			script := filepath.Join(dir, "build.sh")
+			require.NoError(t, ioutil.WriteFile(script, []byte(spec.script), 0700))
+
+			var out bytes.Buffer
+			got, err := runVerificationBuild(script, wasmFile, &out)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, got)
+			assert.Equal(t, "building\n", out.String())
+		})
+	}
+}
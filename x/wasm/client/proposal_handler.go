@@ -10,7 +10,10 @@ import (
 var ProposalHandlers = []govclient.ProposalHandler{
 	govclient.NewProposalHandler(cli.ProposalStoreCodeCmd, rest.StoreCodeProposalHandler),
 	govclient.NewProposalHandler(cli.ProposalInstantiateContractCmd, rest.InstantiateProposalHandler),
+	govclient.NewProposalHandler(cli.ProposalInstantiateContract2Cmd, rest.Instantiate2ProposalHandler),
 	govclient.NewProposalHandler(cli.ProposalMigrateContractCmd, rest.MigrateProposalHandler),
 	govclient.NewProposalHandler(cli.ProposalUpdateContractAdminCmd, rest.UpdateContractAdminProposalHandler),
 	govclient.NewProposalHandler(cli.ProposalClearContractAdminCmd, rest.ClearContractAdminProposalHandler),
+	govclient.NewProposalHandler(cli.ProposalRegisterBlockHookCmd, rest.RegisterBlockHookProposalHandler),
+	govclient.NewProposalHandler(cli.ProposalDeregisterBlockHookCmd, rest.DeregisterBlockHookProposalHandler),
 }
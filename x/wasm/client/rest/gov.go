@@ -119,6 +119,64 @@ func InstantiateProposalHandler(cliCtx client.Context) govrest.ProposalRESTHandl
 	}
 }
 
+type Instantiate2ProposalJSONReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Proposer string    `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins `json:"deposit" yaml:"deposit"`
+
+	RunAs string `json:"run_as" yaml:"run_as"`
+	// Admin is an optional address that can execute migrations
+	Admin   string          `json:"admin,omitempty" yaml:"admin"`
+	Code    uint64          `json:"code_id" yaml:"code_id"`
+	Label   string          `json:"label" yaml:"label"`
+	InitMsg json.RawMessage `json:"init_msg" yaml:"init_msg"`
+	Funds   sdk.Coins       `json:"funds" yaml:"funds"`
+	// Salt is an arbitrary value used to build the predictable contract address
+	Salt   []byte `json:"salt" yaml:"salt"`
+	FixMsg bool   `json:"fix_msg,omitempty" yaml:"fix_msg"`
+}
+
+func (s Instantiate2ProposalJSONReq) Content() govtypes.Content {
+	return &types.InstantiateContract2Proposal{
+		Title:       s.Title,
+		Description: s.Description,
+		RunAs:       s.RunAs,
+		Admin:       s.Admin,
+		CodeID:      s.Code,
+		Label:       s.Label,
+		InitMsg:     s.InitMsg,
+		Funds:       s.Funds,
+		Salt:        s.Salt,
+		FixMsg:      s.FixMsg,
+	}
+}
+func (s Instantiate2ProposalJSONReq) GetProposer() string {
+	return s.Proposer
+}
+func (s Instantiate2ProposalJSONReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s Instantiate2ProposalJSONReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+
+func Instantiate2ProposalHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_instantiate2",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req Instantiate2ProposalJSONReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
+
 type MigrateProposalJSONReq struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 
@@ -251,6 +309,90 @@ func ClearContractAdminProposalHandler(cliCtx client.Context) govrest.ProposalRE
 	}
 }
 
+type RegisterBlockHookJSONReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Proposer string    `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins `json:"deposit" yaml:"deposit"`
+
+	Contract  string          `json:"contract" yaml:"contract"`
+	BlockHook types.BlockHook `json:"block_hook" yaml:"block_hook"`
+}
+
+func (s RegisterBlockHookJSONReq) Content() govtypes.Content {
+	return &types.RegisterBlockHookProposal{
+		Title:       s.Title,
+		Description: s.Description,
+		Contract:    s.Contract,
+		BlockHook:   s.BlockHook,
+	}
+}
+func (s RegisterBlockHookJSONReq) GetProposer() string {
+	return s.Proposer
+}
+func (s RegisterBlockHookJSONReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s RegisterBlockHookJSONReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+func RegisterBlockHookProposalHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_register_block_hook",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req RegisterBlockHookJSONReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
+
+type DeregisterBlockHookJSONReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Proposer string    `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.Coins `json:"deposit" yaml:"deposit"`
+
+	Contract string `json:"contract" yaml:"contract"`
+}
+
+func (s DeregisterBlockHookJSONReq) Content() govtypes.Content {
+	return &types.DeregisterBlockHookProposal{
+		Title:       s.Title,
+		Description: s.Description,
+		Contract:    s.Contract,
+	}
+}
+func (s DeregisterBlockHookJSONReq) GetProposer() string {
+	return s.Proposer
+}
+func (s DeregisterBlockHookJSONReq) GetDeposit() sdk.Coins {
+	return s.Deposit
+}
+func (s DeregisterBlockHookJSONReq) GetBaseReq() rest.BaseReq {
+	return s.BaseReq
+}
+func DeregisterBlockHookProposalHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "wasm_deregister_block_hook",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req DeregisterBlockHookJSONReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			toStdTxResponse(cliCtx, w, req)
+		},
+	}
+}
+
 type wasmProposalData interface {
 	Content() govtypes.Content
 	GetProposer() string
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/line/lbm-sdk/types"
+	authtypes "github.com/line/lbm-sdk/x/auth/types"
+	"github.com/line/lbm-sdk/x/wasm/types"
+	"github.com/line/ostracon/crypto"
+)
+
+// AddressGenerator abstract address generator to be used for a single contract address
+type AddressGenerator func(ctx sdk.Context, codeID uint64, checksum []byte) sdk.AccAddress
+
+// ClassicAddressGenerator generates a contract address using codeID and instanceID sequence
+func (k Keeper) ClassicAddressGenerator() AddressGenerator {
+	return func(ctx sdk.Context, codeID uint64, _ []byte) sdk.AccAddress {
+		return k.generateContractAddress(ctx, codeID)
+	}
+}
+
+// PredictableAddressGenerator generates a predictable contract address from the code checksum, creator and salt.
+// The init message is only part of the address when fixMsg is set.
+func PredictableAddressGenerator(creator sdk.AccAddress, salt []byte, initMsg []byte, fixMsg bool) AddressGenerator {
+	return func(ctx sdk.Context, _ uint64, checksum []byte) sdk.AccAddress {
+		msg := initMsg
+		if !fixMsg { // clear msg to not be included in the address generation
+			msg = []byte{}
+		}
+		return BuildContractAddressPredictable(checksum, creator, salt, msg)
+	}
+}
+
+// BuildContractAddressPredictable generates a contract address for the wasm module with len = 20 bytes.
+// The address is the truncated sha256 hash of:
+//
+//	"wasm\0" | len(checksum) | checksum | len(creator) | creator | len(salt) | salt | len(initMsg) | initMsg
+//
+// with all lengths encoded as 8 bytes big endian and creator as raw address bytes.
+func BuildContractAddressPredictable(checksum []byte, creator sdk.AccAddress, salt, initMsg []byte) sdk.AccAddress {
+	creatorBz, err := sdk.AccAddressToBytes(creator.String())
+	if err != nil {
+		panic(fmt.Sprintf("invalid creator address: %s", err))
+	}
+	key := make([]byte, 0, len(types.ModuleName)+1+4*8+len(checksum)+len(creatorBz)+len(salt)+len(initMsg))
+	key = append(key, []byte(types.ModuleName)...)
+	key = append(key, 0)
+	key = appendLengthPrefixed(key, checksum)
+	key = appendLengthPrefixed(key, creatorBz)
+	key = appendLengthPrefixed(key, salt)
+	key = appendLengthPrefixed(key, initMsg)
+	return sdk.BytesToAccAddress(crypto.AddressHash(key))
+}
+
+func appendLengthPrefixed(dst, bz []byte) []byte {
+	var l [8]byte
+	binary.BigEndian.PutUint64(l[:], uint64(len(bz)))
+	return append(append(dst, l[:]...), bz...)
+}
+
+// isUnusedAccount returns true for plain accounts that never signed a tx. They may have been pre-funded with
+// a counterfactual contract address and can be taken over by the contract.
+func isUnusedAccount(acc authtypes.AccountI) bool {
+	baseAcc, ok := acc.(*authtypes.BaseAccount)
+	return ok && baseAcc.GetPubKey() == nil && baseAcc.GetSequence() == 0
+}
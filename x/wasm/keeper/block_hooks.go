@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/line/lbm-sdk/store/prefix"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/wasm/types"
+)
+
+// block hook names used in events and logs
+const (
+	blockHookBeginBlock = "begin_block"
+	blockHookEndBlock   = "end_block"
+)
+
+// setBlockHook registers the contract for begin/end block sudo callbacks or replaces an existing registration
+func (k Keeper) setBlockHook(ctx sdk.Context, contractAddress sdk.AccAddress, hook types.BlockHook) error {
+	if err := hook.ValidateBasic(); err != nil {
+		return err
+	}
+	if !k.HasContractInfo(ctx, contractAddress) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBlockHookKey(contractAddress), k.cdc.MustMarshalBinaryBare(&hook))
+	return nil
+}
+
+// removeBlockHook deletes the begin/end block callback registration of the contract
+func (k Keeper) removeBlockHook(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetBlockHookKey(contractAddress)
+	if !store.Has(key) {
+		return sdkerrors.Wrap(types.ErrNotFound, "block hook")
+	}
+	store.Delete(key)
+	return nil
+}
+
+// GetBlockHook returns the begin/end block callback registration of the contract or nil when not registered
+func (k Keeper) GetBlockHook(ctx sdk.Context, contractAddress sdk.AccAddress) *types.BlockHook {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetBlockHookKey(contractAddress))
+	if bz == nil {
+		return nil
+	}
+	var hook types.BlockHook
+	k.cdc.MustUnmarshalBinaryBare(bz, &hook)
+	return &hook
+}
+
+// IterateBlockHooks iterates over all contracts registered for begin/end block callbacks
+func (k Keeper) IterateBlockHooks(ctx sdk.Context, cb func(sdk.AccAddress, types.BlockHook) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlockHookKeyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var hook types.BlockHook
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &hook)
+		// cb returns true to stop early
+		if cb(sdk.AccAddress(string(iter.Key())), hook) {
+			break
+		}
+	}
+}
+
+// BeginBlocker sends the `begin_block` sudo message to all active contracts registered for it
+func (k Keeper) BeginBlocker(ctx sdk.Context) {
+	k.runBlockHooks(ctx, blockHookBeginBlock, types.BlockHookSudoMsg{BeginBlock: &struct{}{}}, func(h types.BlockHook) bool {
+		return h.BeginBlock
+	})
+}
+
+// EndBlocker sends the `end_block` sudo message to all active contracts registered for it
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	k.runBlockHooks(ctx, blockHookEndBlock, types.BlockHookSudoMsg{EndBlock: &struct{}{}}, func(h types.BlockHook) bool {
+		return h.EndBlock
+	})
+}
+
+// runBlockHooks calls all selected contracts in order of their address. A contract that fails or runs out of
+// gas has all its state changes reverted and is set to inactive so that it is skipped in subsequent blocks.
+func (k Keeper) runBlockHooks(ctx sdk.Context, name string, msg types.BlockHookSudoMsg, selected func(types.BlockHook) bool) {
+	type call struct {
+		contract sdk.AccAddress
+		gasLimit uint64
+	}
+	// collect first as the contracts may modify the store
+	var calls []call
+	k.IterateBlockHooks(ctx, func(contract sdk.AccAddress, hook types.BlockHook) bool {
+		if selected(hook) {
+			calls = append(calls, call{contract: contract, gasLimit: hook.GasLimit})
+		}
+		return false
+	})
+	if len(calls) == 0 {
+		return
+	}
+	msgBz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	for _, c := range calls {
+		contractInfo := k.GetContractInfo(ctx, c.contract)
+		if contractInfo == nil || contractInfo.Status != types.ContractStatusActive {
+			continue
+		}
+		if err := k.callBlockHook(ctx, c.contract, msgBz, c.gasLimit); err != nil {
+			k.Logger(ctx).Error("block hook failed, disabling contract", "hook", name, "contract", c.contract.String(), "error", err.Error())
+			contractInfo.Status = types.ContractStatusInactive
+			k.storeContractInfo(ctx, c.contract, contractInfo)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeBlockHookFailed,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyContract, c.contract.String()),
+				sdk.NewAttribute(types.AttributeKeyBlockHook, name),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				sdk.NewAttribute(types.AttributeKeyContractStatus, contractInfo.Status.String()),
+			))
+		}
+	}
+}
+
+// callBlockHook executes the sudo message in a cached context with its own gas meter. State changes and
+// events are only committed when the call succeeds. Any panic of the call is recovered and returned as an
+// error, so that a failing contract can not halt the chain.
+func (k Keeper) callBlockHook(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte, gasLimit uint64) (err error) {
+	cacheCtx, commit := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+	defer func() {
+		if r := recover(); r != nil {
+			if oog, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", oog.Descriptor)
+				return
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "%v", r)
+			k.Logger(ctx).Error("recovering panic of block hook",
+				"contract", contractAddress.String(),
+				"panic", fmt.Sprintf("%v", r),
+				"stacktrace", string(debug.Stack()))
+		}
+	}()
+	if _, err = k.Sudo(cacheCtx, contractAddress, msg); err != nil {
+		return err
+	}
+	commit()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/wasm/keeper/wasmtesting"
+	"github.com/line/lbm-sdk/x/wasm/types"
+	wasmvm "github.com/line/wasmvm"
+	wasmvmtypes "github.com/line/wasmvm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockHooks(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+
+	var gotMsgs []string
+	type sudoFn func(store wasmvm.KVStore, gasLimit uint64) (*wasmvmtypes.Response, uint64, error)
+	var mockFn sudoFn
+	mock := &wasmtesting.MockWasmer{
+		CreateFn:      wasmtesting.NoOpCreateFn,
+		AnalyzeCodeFn: wasmtesting.WithoutIBCAnalyzeFn,
+		InstantiateFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, initMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64) (*wasmvmtypes.Response, uint64, error) {
+			return &wasmvmtypes.Response{}, 0, nil
+		},
+		SudoFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64) (*wasmvmtypes.Response, uint64, error) {
+			gotMsgs = append(gotMsgs, string(sudoMsg))
+			return mockFn(store, gasLimit)
+		},
+	}
+	example := SeedNewContractInstance(t, parentCtx, keepers, mock)
+
+	specs := map[string]struct {
+		hook      types.BlockHook
+		status    types.ContractStatus
+		sudo      sudoFn
+		expMsgs   []string
+		expStatus types.ContractStatus
+		expStored bool
+		expEvent  string
+	}{
+		"begin and end block": {
+			hook: types.BlockHook{BeginBlock: true, EndBlock: true, GasLimit: 200000},
+			sudo: func(store wasmvm.KVStore, _ uint64) (*wasmvmtypes.Response, uint64, error) {
+				store.Set([]byte("key"), []byte("value"))
+				return &wasmvmtypes.Response{Attributes: []wasmvmtypes.EventAttribute{{Key: "foo", Value: "bar"}}}, 0, nil
+			},
+			expMsgs:   []string{`{"begin_block":{}}`, `{"end_block":{}}`},
+			expStatus: types.ContractStatusActive,
+			expStored: true,
+			expEvent:  types.CustomEventType,
+		},
+		"end block only": {
+			hook: types.BlockHook{EndBlock: true, GasLimit: 200000},
+			sudo: func(wasmvm.KVStore, uint64) (*wasmvmtypes.Response, uint64, error) {
+				return &wasmvmtypes.Response{}, 0, nil
+			},
+			expMsgs:   []string{`{"end_block":{}}`},
+			expStatus: types.ContractStatusActive,
+			expEvent:  types.CustomEventType,
+		},
+		"contract error disables the contract": {
+			hook: types.BlockHook{BeginBlock: true, EndBlock: true, GasLimit: 200000},
+			sudo: func(store wasmvm.KVStore, _ uint64) (*wasmvmtypes.Response, uint64, error) {
+				store.Set([]byte("key"), []byte("value"))
+				return nil, 0, errors.New("test, ignore")
+			},
+			expMsgs:   []string{`{"begin_block":{}}`},
+			expStatus: types.ContractStatusInactive,
+			expEvent:  types.EventTypeBlockHookFailed,
+		},
+		"out of gas disables the contract": {
+			hook: types.BlockHook{BeginBlock: true, GasLimit: 200000},
+			sudo: func(store wasmvm.KVStore, _ uint64) (*wasmvmtypes.Response, uint64, error) {
+				store.Set([]byte("key"), []byte("value"))
+				return &wasmvmtypes.Response{}, math.MaxUint64, nil
+			},
+			expMsgs:   []string{`{"begin_block":{}}`},
+			expStatus: types.ContractStatusInactive,
+			expEvent:  types.EventTypeBlockHookFailed,
+		},
+		"panic disables the contract": {
+			hook: types.BlockHook{BeginBlock: true, GasLimit: 200000},
+			sudo: func(store wasmvm.KVStore, _ uint64) (*wasmvmtypes.Response, uint64, error) {
+				store.Set([]byte("key"), []byte("value"))
+				panic("test, ignore")
+			},
+			expMsgs:   []string{`{"begin_block":{}}`},
+			expStatus: types.ContractStatusInactive,
+			expEvent:  types.EventTypeBlockHookFailed,
+		},
+		"inactive contract is skipped": {
+			hook:      types.BlockHook{BeginBlock: true, EndBlock: true, GasLimit: 200000},
+			status:    types.ContractStatusInactive,
+			expStatus: types.ContractStatusInactive,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			gotMsgs, mockFn = nil, spec.sudo
+			if spec.status != types.ContractStatusUnspecified {
+				require.NoError(t, NewGovPermissionKeeper(k).UpdateContractStatus(ctx, example.Contract, example.CreatorAddr, spec.status))
+			}
+			require.NoError(t, keepers.ContractKeeper.RegisterBlockHook(ctx, example.Contract, spec.hook))
+
+			// when
+			em := sdk.NewEventManager()
+			k.BeginBlocker(ctx.WithEventManager(em))
+			k.EndBlocker(ctx.WithEventManager(em))
+
+			// then
+			assert.Equal(t, spec.expMsgs, gotMsgs)
+			assert.Equal(t, spec.expStatus, k.GetContractInfo(ctx, example.Contract).Status)
+			gotStored := k.QueryRaw(ctx, example.Contract, []byte("key"))
+			if spec.expStored {
+				assert.Equal(t, []byte("value"), gotStored)
+			} else {
+				assert.Nil(t, gotStored)
+			}
+			if spec.expEvent == "" {
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NotEmpty(t, em.Events())
+			assert.Equal(t, spec.expEvent, em.Events()[0].Type)
+		})
+	}
+}
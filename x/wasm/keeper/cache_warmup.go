@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"container/list"
+	"sort"
+	"sync"
+
+	dbm "github.com/line/tm-db/v2"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/wasm/types"
+)
+
+// codeCacheDBName is the name of the node local db the execution counts of the codes are persisted to
+const codeCacheDBName = "code_executions"
+
+// codeCache pins the most recently executed codes into the wasmvm cache in addition to the codes
+// pinned by governance, unpinning the least recently executed ones when the size of the pinned
+// codes exceeds the budget. It counts the executions of every code to warm up the cache with the
+// most frequently executed codes on start. Nothing of it is part of the consensus state: the counts
+// are kept in memory and persisted to a node local db on shutdown.
+type codeCache struct {
+	mtx    sync.Mutex
+	db     dbm.DB
+	budget uint64
+	used   uint64
+	counts map[uint64]uint64
+	// lru holds the *cachedCode pinned by the cache, the most recently executed first
+	lru    *list.List
+	pinned map[uint64]*list.Element
+}
+
+type cachedCode struct {
+	codeID   uint64
+	checksum []byte
+	size     uint64
+}
+
+// newCodeCache returns a code cache with a budget in bytes, loading the execution counts from db
+func newCodeCache(db dbm.DB, budget uint64) (*codeCache, error) {
+	c := &codeCache{
+		db:     db,
+		budget: budget,
+		counts: make(map[uint64]uint64),
+		lru:    list.New(),
+		pinned: make(map[uint64]*list.Element),
+	}
+	iter, err := db.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		c.counts[sdk.BigEndianToUint64(iter.Key())] = sdk.BigEndianToUint64(iter.Value())
+	}
+	return c, iter.Error()
+}
+
+// recordCodeExecution counts an execution of the code and pins it into the wasmvm cache when the
+// warm-up is enabled. Failures are logged only as the cache must not affect the execution.
+func (k Keeper) recordCodeExecution(ctx sdk.Context, codeID uint64, checksum []byte) {
+	if k.codeCache == nil {
+		return
+	}
+	c := k.codeCache
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.counts[codeID]++
+	if e, ok := c.pinned[codeID]; ok {
+		c.lru.MoveToFront(e)
+		return
+	}
+	// the pinned code index is read without gas so that the gas costs of the contract calls stay the same
+	if ctx.MultiStore().GetKVStore(k.storeKey).Has(types.GetPinnedCodeIndexPrefix(codeID)) {
+		return
+	}
+	if err := k.pinCached(codeID, checksum, true); err != nil {
+		k.Logger(ctx).Error("failed to pin code into the wasm cache", "code_id", codeID, "err", err)
+	}
+}
+
+// pinCached pins the code into the wasmvm cache if it fits the budget. With evict, the least recently
+// executed codes are unpinned to make room for it, otherwise it is pinned as the least recently executed.
+func (k Keeper) pinCached(codeID uint64, checksum []byte, evict bool) error {
+	c := k.codeCache
+	code, err := k.wasmVM.GetCode(checksum)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrNotFound, err.Error())
+	}
+	size := uint64(len(code))
+	if size > c.budget || (!evict && c.used+size > c.budget) {
+		return nil
+	}
+	for c.used+size > c.budget {
+		oldest := c.lru.Remove(c.lru.Back()).(*cachedCode)
+		delete(c.pinned, oldest.codeID)
+		c.used -= oldest.size
+		if err := k.wasmVM.Unpin(oldest.checksum); err != nil {
+			return sdkerrors.Wrap(types.ErrUnpinContractFailed, err.Error())
+		}
+	}
+	if err := k.wasmVM.Pin(checksum); err != nil {
+		return sdkerrors.Wrap(types.ErrPinContractFailed, err.Error())
+	}
+	entry := &cachedCode{codeID: codeID, checksum: checksum, size: size}
+	if evict {
+		c.pinned[codeID] = c.lru.PushFront(entry)
+	} else {
+		c.pinned[codeID] = c.lru.PushBack(entry)
+	}
+	c.used += size
+	return nil
+}
+
+// forgetCachedCode drops a code pinned by governance from the codes pinned by the cache without
+// unpinning it, so that it is neither accounted in the budget nor evicted.
+func (k Keeper) forgetCachedCode(codeID uint64) {
+	if k.codeCache == nil {
+		return
+	}
+	c := k.codeCache
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if e, ok := c.pinned[codeID]; ok {
+		c.used -= c.lru.Remove(e).(*cachedCode).size
+		delete(c.pinned, codeID)
+	}
+}
+
+// getCodeExecutionCount returns the number of recorded executions of the code
+func (k Keeper) getCodeExecutionCount(codeID uint64) uint64 {
+	if k.codeCache == nil {
+		return 0
+	}
+	k.codeCache.mtx.Lock()
+	defer k.codeCache.mtx.Unlock()
+	return k.codeCache.counts[codeID]
+}
+
+// warmupCache pins the most frequently executed codes into the wasmvm cache until the budget is
+// used up. Codes pinned by governance are pinned already and not accounted.
+func (k Keeper) warmupCache(ctx sdk.Context) error {
+	if k.codeCache == nil {
+		return nil
+	}
+	c := k.codeCache
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	type codeExecutions struct {
+		codeID uint64
+		count  uint64
+	}
+	candidates := make([]codeExecutions, 0, len(c.counts))
+	for codeID, count := range c.counts {
+		candidates = append(candidates, codeExecutions{codeID: codeID, count: count})
+	}
+	// most executed first, the code id breaks ties
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].count != candidates[j].count {
+			return candidates[i].count > candidates[j].count
+		}
+		return candidates[i].codeID < candidates[j].codeID
+	})
+
+	for _, e := range candidates {
+		if _, ok := c.pinned[e.codeID]; ok || k.IsPinnedCode(ctx, e.codeID) {
+			continue
+		}
+		codeInfo := k.GetCodeInfo(ctx, e.codeID)
+		if codeInfo == nil {
+			continue
+		}
+		if err := k.pinCached(e.codeID, codeInfo.CodeHash, false); err != nil {
+			return err
+		}
+	}
+	k.Logger(ctx).Info("wasm cache warmed up", "pinned", c.lru.Len(), "size", c.used)
+	return nil
+}
+
+// close persists the execution counts to the node local db and closes it
+func (c *codeCache) close() error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	batch := c.db.NewBatch()
+	defer batch.Close()
+	for codeID, count := range c.counts {
+		if err := batch.Set(sdk.Uint64ToBigEndian(codeID), sdk.Uint64ToBigEndian(count)); err != nil {
+			return err
+		}
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+	return c.db.Close()
+}
+
+// observeVMCache returns a callback to be invoked after the wasmvm call of the code that records the
+// cache hit or miss by code id when the cache metrics are enabled
+func (k Keeper) observeVMCache(codeID uint64) func() {
+	if k.vmCacheMetrics == nil {
+		return func() {}
+	}
+	return k.vmCacheMetrics.observe(codeID)
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"github.com/line/lbm-sdk/store/prefix"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/wasm/types"
+)
+
+// submitCodeVerification links the code to the reproducible build. Any address can submit a verification, so that
+// auditors can vouch for a code next to its creator. A verification submitted by the caller before is replaced.
+func (k Keeper) submitCodeVerification(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, sourceHash []byte, builderDigest string, attestation []byte) error {
+	codeInfo := k.GetCodeInfo(ctx, codeID)
+	if codeInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "code info")
+	}
+	verification := types.CodeVerification{
+		CodeID:        codeID,
+		CodeHash:      codeInfo.CodeHash,
+		Submitter:     caller.String(),
+		SourceHash:    sourceHash,
+		BuilderDigest: builderDigest,
+		Attestation:   attestation,
+		Submitted:     types.NewAbsoluteTxPosition(ctx),
+	}
+	if err := verification.ValidateBasic(); err != nil {
+		return err
+	}
+	k.storeCodeVerification(ctx, verification)
+	return nil
+}
+
+func (k Keeper) storeCodeVerification(ctx sdk.Context, verification types.CodeVerification) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetCodeVerificationKey(verification.CodeID, sdk.AccAddress(verification.Submitter))
+	store.Set(key, k.cdc.MustMarshalBinaryBare(&verification))
+}
+
+// GetCodeVerification returns the verification the submitter linked the code to or nil
+func (k Keeper) GetCodeVerification(ctx sdk.Context, codeID uint64, submitter sdk.AccAddress) *types.CodeVerification {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCodeVerificationKey(codeID, submitter))
+	if bz == nil {
+		return nil
+	}
+	var verification types.CodeVerification
+	k.cdc.MustUnmarshalBinaryBare(bz, &verification)
+	return &verification
+}
+
+// GetCodeVerifications returns the verifications submitted for the code ordered by submitter
+func (k Keeper) GetCodeVerifications(ctx sdk.Context, codeID uint64) []types.CodeVerification {
+	var verifications []types.CodeVerification
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCodeVerificationPrefix(codeID)).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var verification types.CodeVerification
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &verification)
+		verifications = append(verifications, verification)
+	}
+	return verifications
+}
+
+// IterateCodeVerifications calls the callback for the verifications of all codes ordered by code id and submitter.
+// Iteration stops when the callback returns true.
+func (k Keeper) IterateCodeVerifications(ctx sdk.Context, cb func(types.CodeVerification) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.CodeVerificationPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var verification types.CodeVerification
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &verification)
+		if cb(verification) {
+			return
+		}
+	}
+}
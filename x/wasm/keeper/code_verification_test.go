@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/wasm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitCodeVerification(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	sourceHash := bytes.Repeat([]byte{0x1}, 32)
+	digest := "sha256:" + strings.Repeat("ab", 32)
+
+	specs := map[string]struct {
+		codeID uint64
+		caller sdk.AccAddress
+		expErr *sdkerrors.Error
+	}{
+		"code creator": {
+			codeID: example.CodeID,
+			caller: example.CreatorAddr,
+		},
+		"other address": {
+			codeID: example.CodeID,
+			caller: RandomAccountAddress(t),
+		},
+		"unknown code": {
+			codeID: example.CodeID + 1,
+			caller: example.CreatorAddr,
+			expErr: types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			err := keepers.ContractKeeper.SubmitCodeVerification(xCtx, spec.codeID, spec.caller, sourceHash, digest, []byte("report"))
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(err), "got %+v", err)
+				assert.Nil(t, k.GetCodeVerification(xCtx, spec.codeID, spec.caller))
+				return
+			}
+			require.NoError(t, err)
+			exp := &types.CodeVerification{
+				CodeID:        example.CodeID,
+				CodeHash:      k.GetCodeInfo(xCtx, example.CodeID).CodeHash,
+				Submitter:     spec.caller.String(),
+				SourceHash:    sourceHash,
+				BuilderDigest: digest,
+				Attestation:   []byte("report"),
+				Submitted:     types.NewAbsoluteTxPosition(xCtx),
+			}
+			assert.Equal(t, exp, k.GetCodeVerification(xCtx, spec.codeID, spec.caller))
+			assert.Equal(t, []types.CodeVerification{*exp}, k.GetCodeVerifications(xCtx, spec.codeID))
+		})
+	}
+}
+
+func TestSubmitCodeVerificationPerSubmitter(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	auditor := RandomAccountAddress(t)
+	digest := "sha256:" + strings.Repeat("ab", 32)
+
+	submit := func(caller sdk.AccAddress, sourceHash byte) {
+		err := keepers.ContractKeeper.SubmitCodeVerification(ctx, example.CodeID, caller, bytes.Repeat([]byte{sourceHash}, 32), digest, nil)
+		require.NoError(t, err)
+	}
+	submit(example.CreatorAddr, 0x1)
+	submit(auditor, 0x2)
+
+	// the auditor does not replace the verification of the creator
+	assert.EqualValues(t, bytes.Repeat([]byte{0x1}, 32), k.GetCodeVerification(ctx, example.CodeID, example.CreatorAddr).SourceHash)
+	assert.EqualValues(t, bytes.Repeat([]byte{0x2}, 32), k.GetCodeVerification(ctx, example.CodeID, auditor).SourceHash)
+	require.Len(t, k.GetCodeVerifications(ctx, example.CodeID), 2)
+
+	// resubmitting replaces the own verification only
+	submit(auditor, 0x3)
+	assert.EqualValues(t, bytes.Repeat([]byte{0x1}, 32), k.GetCodeVerification(ctx, example.CodeID, example.CreatorAddr).SourceHash)
+	assert.EqualValues(t, bytes.Repeat([]byte{0x3}, 32), k.GetCodeVerification(ctx, example.CodeID, auditor).SourceHash)
+	require.Len(t, k.GetCodeVerifications(ctx, example.CodeID), 2)
+}
+
+func TestQueryCodeVerifications(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	other := StoreHackatomExampleContract(t, ctx, keepers)
+	digest := "sha256:" + strings.Repeat("ab", 32)
+	for _, c := range []ExampleContract{example, other} {
+		err := keepers.ContractKeeper.SubmitCodeVerification(ctx, c.CodeID, c.CreatorAddr, bytes.Repeat([]byte{byte(c.CodeID)}, 32), digest, nil)
+		require.NoError(t, err)
+	}
+	auditor := RandomAccountAddress(t)
+	err := keepers.ContractKeeper.SubmitCodeVerification(ctx, other.CodeID, auditor, bytes.Repeat([]byte{0xff}, 32), digest, nil)
+	require.NoError(t, err)
+	q := Querier(k)
+
+	got, err := q.CodeVerifications(sdk.WrapSDKContext(ctx), &types.QueryCodeVerificationsRequest{CodeId: other.CodeID})
+	require.NoError(t, err)
+	require.Len(t, got.Verifications, 2)
+	assert.ElementsMatch(t, []types.CodeVerification{
+		*k.GetCodeVerification(ctx, other.CodeID, other.CreatorAddr),
+		*k.GetCodeVerification(ctx, other.CodeID, auditor),
+	}, got.Verifications)
+
+	got, err = q.CodeVerifications(sdk.WrapSDKContext(ctx), &types.QueryCodeVerificationsRequest{CodeId: other.CodeID + 1})
+	require.NoError(t, err)
+	assert.Empty(t, got.Verifications)
+
+	all, err := q.VerifiedCodes(sdk.WrapSDKContext(ctx), &types.QueryVerifiedCodesRequest{})
+	require.NoError(t, err)
+	require.Len(t, all.Verifications, 3)
+	assert.Equal(t, example.CodeID, all.Verifications[0].CodeID)
+	assert.Equal(t, other.CodeID, all.Verifications[1].CodeID)
+	assert.Equal(t, other.CodeID, all.Verifications[2].CodeID)
+}
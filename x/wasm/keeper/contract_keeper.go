@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"encoding/json"
+
 	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
 	"github.com/line/lbm-sdk/x/wasm/types"
 )
 
@@ -9,8 +12,8 @@ var _ types.ContractOpsKeeper = PermissionedKeeper{}
 
 // decoratedKeeper contains a subset of the wasm keeper that are already or can be guarded by an authorization policy in the future
 type decoratedKeeper interface {
-	create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, source string, builder string, instantiateAccess *types.AccessConfig, authZ AuthorizationPolicy) (codeID uint64, err error)
-	instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error)
+	create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, source string, builder string, instantiateAccess *types.AccessConfig, eventSchema json.RawMessage, authZ AuthorizationPolicy) (codeID uint64, err error)
+	instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, addressGenerator AddressGenerator, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error)
 	migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, authZ AuthorizationPolicy) (*sdk.Result, error)
 	setContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress, authZ AuthorizationPolicy) error
 	pinCode(ctx sdk.Context, codeID uint64) error
@@ -18,6 +21,11 @@ type decoratedKeeper interface {
 	execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (*sdk.Result, error)
 	setContractInfoExtension(ctx sdk.Context, contract sdk.AccAddress, extra types.ContractInfoExtension) error
 	setContractStatus(ctx sdk.Context, contract sdk.AccAddress, caller sdk.AccAddress, status types.ContractStatus, authZ AuthorizationPolicy) error
+	setAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig types.AccessConfig, authZ AuthorizationPolicy) error
+	submitCodeVerification(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, sourceHash []byte, builderDigest string, attestation []byte) error
+	setBlockHook(ctx sdk.Context, contract sdk.AccAddress, hook types.BlockHook) error
+	removeBlockHook(ctx sdk.Context, contract sdk.AccAddress) error
+	ClassicAddressGenerator() AddressGenerator
 }
 
 type PermissionedKeeper struct {
@@ -38,11 +46,26 @@ func NewDefaultPermissionKeeper(nested decoratedKeeper) *PermissionedKeeper {
 }
 
 func (p PermissionedKeeper) Create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, source string, builder string, instantiateAccess *types.AccessConfig) (codeID uint64, err error) {
-	return p.nested.create(ctx, creator, wasmCode, source, builder, instantiateAccess, p.authZPolicy)
+	return p.nested.create(ctx, creator, wasmCode, source, builder, instantiateAccess, nil, p.authZPolicy)
+}
+
+// CreateWithEventSchema uploads a WASM contract and registers the schema of the wasm event emitted by its contracts
+func (p PermissionedKeeper) CreateWithEventSchema(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, source string, builder string, instantiateAccess *types.AccessConfig, eventSchema json.RawMessage) (codeID uint64, err error) {
+	if len(eventSchema) != 0 {
+		if err := types.ValidateEventSchema(eventSchema); err != nil {
+			return 0, sdkerrors.Wrap(err, "event schema")
+		}
+	}
+	return p.nested.create(ctx, creator, wasmCode, source, builder, instantiateAccess, eventSchema, p.authZPolicy)
 }
 
 func (p PermissionedKeeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins) (sdk.AccAddress, []byte, error) {
-	return p.nested.instantiate(ctx, codeID, creator, admin, initMsg, label, deposit, p.authZPolicy)
+	return p.nested.instantiate(ctx, codeID, creator, admin, initMsg, label, deposit, p.nested.ClassicAddressGenerator(), p.authZPolicy)
+}
+
+// Instantiate2 creates an instance of a WASM contract using the predictable address generator
+func (p PermissionedKeeper) Instantiate2(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, salt []byte, fixMsg bool) (sdk.AccAddress, []byte, error) {
+	return p.nested.instantiate(ctx, codeID, creator, admin, initMsg, label, deposit, PredictableAddressGenerator(creator, salt, initMsg, fixMsg), p.authZPolicy)
 }
 
 func (p PermissionedKeeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (*sdk.Result, error) {
@@ -77,3 +100,19 @@ func (p PermissionedKeeper) SetContractInfoExtension(ctx sdk.Context, contract s
 func (p PermissionedKeeper) UpdateContractStatus(ctx sdk.Context, contract sdk.AccAddress, caller sdk.AccAddress, status types.ContractStatus) error {
 	return p.nested.setContractStatus(ctx, contract, caller, status, p.authZPolicy)
 }
+
+func (p PermissionedKeeper) SetAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig types.AccessConfig) error {
+	return p.nested.setAccessConfig(ctx, codeID, caller, newConfig, p.authZPolicy)
+}
+
+func (p PermissionedKeeper) SubmitCodeVerification(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, sourceHash []byte, builderDigest string, attestation []byte) error {
+	return p.nested.submitCodeVerification(ctx, codeID, caller, sourceHash, builderDigest, attestation)
+}
+
+func (p PermissionedKeeper) RegisterBlockHook(ctx sdk.Context, contract sdk.AccAddress, hook types.BlockHook) error {
+	return p.nested.setBlockHook(ctx, contract, hook)
+}
+
+func (p PermissionedKeeper) DeregisterBlockHook(ctx sdk.Context, contract sdk.AccAddress) error {
+	return p.nested.removeBlockHook(ctx, contract)
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/wasm/types"
+)
+
+// setEventSchema registers the schema of the wasm event emitted by the contracts of the code, if any
+func (k Keeper) setEventSchema(ctx sdk.Context, codeID uint64, schema json.RawMessage) {
+	if len(schema) == 0 {
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetEventSchemaKey(codeID), schema)
+}
+
+// GetEventSchema returns the schema of the wasm event registered with the code or nil. The key is
+// iterated instead of read so that the calls of contracts without a schema are not charged the flat read cost.
+func (k Keeper) GetEventSchema(ctx sdk.Context, codeID uint64) json.RawMessage {
+	key := types.GetEventSchemaKey(codeID)
+	iter := ctx.KVStore(k.storeKey).Iterator(key, sdk.PrefixEndBytes(key))
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil
+	}
+	return iter.Value()
+}
+
+// validateContractEvents checks the wasm events emitted by a contract against the schema registered with its
+// code. The events are not validated when the code has no schema.
+func (k Keeper) validateContractEvents(ctx sdk.Context, codeID uint64, events sdk.Events) error {
+	schema := k.GetEventSchema(ctx, codeID)
+	if schema == nil {
+		return nil
+	}
+	for _, e := range events {
+		if e.Type != types.CustomEventType {
+			continue
+		}
+		if err := types.ValidateEvent(schema, e); err != nil {
+			return sdkerrors.Wrapf(err, "code id %d", codeID)
+		}
+	}
+	return nil
+}
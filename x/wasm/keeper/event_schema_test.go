@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/wasm/keeper/wasmtesting"
+	"github.com/line/lbm-sdk/x/wasm/types"
+	wasmvm "github.com/line/wasmvm"
+	wasmvmtypes "github.com/line/wasmvm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateContractEvents(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+
+	// the msg is interpreted as comma separated "<key>=<value>" event attributes
+	mock := &wasmtesting.MockWasmer{
+		CreateFn:      wasmtesting.NoOpCreateFn,
+		AnalyzeCodeFn: wasmtesting.WithoutIBCAnalyzeFn,
+		InstantiateFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, initMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64) (*wasmvmtypes.Response, uint64, error) {
+			return &wasmvmtypes.Response{Attributes: []wasmvmtypes.EventAttribute{{Key: "action", Value: "send"}}}, 0, nil
+		},
+		ExecuteFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64) (*wasmvmtypes.Response, uint64, error) {
+			var attrs []wasmvmtypes.EventAttribute
+			if len(executeMsg) == 0 {
+				return &wasmvmtypes.Response{}, 0, nil
+			}
+			for _, a := range strings.Split(string(executeMsg), ",") {
+				kv := strings.SplitN(a, "=", 2)
+				attrs = append(attrs, wasmvmtypes.EventAttribute{Key: kv[0], Value: kv[1]})
+			}
+			return &wasmvmtypes.Response{Attributes: attrs}, 0, nil
+		},
+	}
+	example := StoreRandomContract(t, ctx, keepers, mock)
+	schema := json.RawMessage(`{"type":"object","properties":{"action":{"type":"string","enum":["send"]},"amount":{"type":"integer"}},"required":["action"]}`)
+	codeID, err := keepers.ContractKeeper.CreateWithEventSchema(ctx, example.CreatorAddr, append(wasmIdent, 1), "", "", nil, schema)
+	require.NoError(t, err)
+	assert.Equal(t, schema, k.GetEventSchema(ctx, codeID))
+	assert.Nil(t, k.GetEventSchema(ctx, example.CodeID))
+
+	withSchema, _, err := keepers.ContractKeeper.Instantiate(ctx, codeID, example.CreatorAddr, "", []byte("{}"), "with schema", nil)
+	require.NoError(t, err)
+	withoutSchema, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, "", []byte("{}"), "without schema", nil)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		contract sdk.AccAddress
+		msg      string
+		expErr   bool
+	}{
+		"valid event": {
+			contract: withSchema,
+			msg:      "action=send,amount=1",
+		},
+		"required attribute missing": {
+			contract: withSchema,
+			msg:      "amount=1",
+			expErr:   true,
+		},
+		"invalid attribute value": {
+			contract: withSchema,
+			msg:      "action=send,amount=x",
+			expErr:   true,
+		},
+		"value not in enum": {
+			contract: withSchema,
+			msg:      "action=mint",
+			expErr:   true,
+		},
+		"contract address only": {
+			contract: withSchema,
+			expErr:   true,
+		},
+		"code without schema": {
+			contract: withoutSchema,
+			msg:      "amount=x",
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			xCtx, _ := ctx.CacheContext()
+			em := sdk.NewEventManager()
+			_, err := keepers.ContractKeeper.Execute(xCtx.WithEventManager(em), spec.contract, example.CreatorAddr, []byte(spec.msg), nil)
+			if spec.expErr {
+				assert.True(t, types.ErrInvalidEvent.Is(err), "got %+v", err)
+				for _, e := range em.Events() {
+					assert.NotEqual(t, types.CustomEventType, e.Type)
+				}
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestQueryEventSchema(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	q := NewGrpcQuerier(k.cdc, k.storeKey, k, k.queryGasLimit)
+
+	_, err := q.EventSchema(sdk.WrapSDKContext(ctx), &types.QueryEventSchemaRequest{CodeId: example.CodeID})
+	assert.True(t, types.ErrNotFound.Is(err))
+
+	schema := json.RawMessage(`{"type":"object","properties":{"amount":{"type":"integer"}}}`)
+	k.setEventSchema(ctx, example.CodeID, schema)
+	got, err := q.EventSchema(sdk.WrapSDKContext(ctx), &types.QueryEventSchemaRequest{CodeId: example.CodeID})
+	require.NoError(t, err)
+	assert.Equal(t, schema, got.EventSchema)
+
+	_, err = q.EventSchema(sdk.WrapSDKContext(ctx), &types.QueryEventSchemaRequest{CodeId: example.CodeID + 1})
+	assert.True(t, types.ErrNotFound.Is(err))
+}
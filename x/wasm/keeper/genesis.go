@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 	"github.com/line/lbm-sdk/x/wasm/types"
@@ -24,6 +26,13 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState, staki
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "code %d with id: %d", i, code.CodeID)
 		}
+		keeper.setEventSchema(ctx, code.CodeID, code.EventSchema)
+		for _, verification := range code.Verifications {
+			if !bytes.Equal(verification.CodeHash, code.CodeInfo.CodeHash) {
+				return nil, sdkerrors.Wrapf(types.ErrInvalid, "verification code hash of code %d with id: %d", i, code.CodeID)
+			}
+			keeper.storeCodeVerification(ctx, verification)
+		}
 		if code.CodeID > maxCodeID {
 			maxCodeID = code.CodeID
 		}
@@ -41,10 +50,20 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState, staki
 			return nil, sdkerrors.Wrapf(err, "address in contract number %d", i)
 		}
 		err = keeper.importContract(ctx, sdk.AccAddress(contract.ContractAddress), &contract.ContractInfo,
-			contract.ContractState)
+			contract.ContractState, contract.ContractCodeHistory)
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "contract number %d", i)
 		}
+		if !contract.StorageDeposit.IsZero() {
+			usage := keeper.GetContractStorageUsage(ctx, sdk.AccAddress(contract.ContractAddress))
+			usage.Deposit = contract.StorageDeposit
+			keeper.setContractStorageUsage(ctx, sdk.AccAddress(contract.ContractAddress), usage)
+		}
+		if contract.BlockHook != nil {
+			if err := contractKeeper.RegisterBlockHook(ctx, sdk.AccAddress(contract.ContractAddress), *contract.BlockHook); err != nil {
+				return nil, sdkerrors.Wrapf(err, "block hook of contract number %d", i)
+			}
+		}
 		maxContractID = i + 1 // not ideal but max(contractID) is not persisted otherwise
 	}
 
@@ -91,10 +110,12 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 			panic(err)
 		}
 		genState.Codes = append(genState.Codes, types.Code{
-			CodeID:    codeID,
-			CodeInfo:  info,
-			CodeBytes: bytecode,
-			Pinned:    keeper.IsPinnedCode(ctx, codeID),
+			CodeID:        codeID,
+			CodeInfo:      info,
+			CodeBytes:     bytecode,
+			Pinned:        keeper.IsPinnedCode(ctx, codeID),
+			EventSchema:   keeper.GetEventSchema(ctx, codeID),
+			Verifications: keeper.GetCodeVerifications(ctx, codeID),
 		})
 		return false
 	})
@@ -115,6 +136,8 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 			ContractAddress: addr.String(),
 			ContractInfo:    contract,
 			ContractState:   state,
+			BlockHook:       keeper.GetBlockHook(ctx, addr),
+			StorageDeposit:  keeper.GetContractStorageUsage(ctx, addr).Deposit,
 		})
 
 		return false
@@ -83,7 +83,7 @@ func TestGenesisExportImport(t *testing.T) {
 		contractAddr := wasmKeeper.generateContractAddress(srcCtx, codeID)
 		wasmKeeper.storeContractInfo(srcCtx, contractAddr, &contract)
 		wasmKeeper.appendToContractHistory(srcCtx, contractAddr, history...)
-		wasmKeeper.importContractState(srcCtx, contractAddr, stateModels)
+		require.NoError(t, wasmKeeper.importContractState(srcCtx, contractAddr, uniqueModels(stateModels)))
 	}
 	var wasmParams types.Params
 	f.NilChance(0).Fuzz(&wasmParams)
@@ -566,6 +566,49 @@ func TestImportContractWithCodeHistoryReset(t *testing.T) {
 	assert.Equal(t, expHistory, keeper.GetContractHistory(ctx, contractAddr))
 }
 
+// uniqueModels drops the fuzzed models with a key seen before, which importContractState rejects.
+func uniqueModels(models []types.Model) []types.Model {
+	seen := make(map[string]bool, len(models))
+	var unique []types.Model
+	for _, m := range models {
+		if seen[string(m.Key)] {
+			continue
+		}
+		seen[string(m.Key)] = true
+		unique = append(unique, m)
+	}
+	return unique
+}
+
+func TestImportContractWithCodeHistory(t *testing.T) {
+	keeper, ctx, _ := setupKeeper(t)
+	contractKeeper := NewGovPermissionKeeper(keeper)
+	keeper.setParams(ctx, types.DefaultParams())
+
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	codeID, err := contractKeeper.Create(ctx, RandomAccountAddress(t), wasmCode, "", "", nil)
+	require.NoError(t, err)
+
+	contractAddr := RandomAccountAddress(t)
+	contractInfo := types.ContractInfoFixture(func(c *types.ContractInfo) { c.CodeID = codeID })
+	history := []types.ContractCodeHistoryEntry{
+		{Operation: types.ContractCodeHistoryOperationTypeInit, CodeID: codeID + 1, Updated: &types.AbsoluteTxPosition{BlockHeight: 10}},
+		{Operation: types.ContractCodeHistoryOperationTypeMigrate, CodeID: codeID, Updated: &types.AbsoluteTxPosition{BlockHeight: 20}},
+	}
+	require.NoError(t, keeper.importContract(ctx, contractAddr, &contractInfo, nil, history))
+
+	// the history is kept as is and the contract is indexed by its last entry
+	assert.Equal(t, history, keeper.GetContractHistory(ctx, contractAddr))
+	assert.Equal(t, history[0].Updated, keeper.GetContractInfo(ctx, contractAddr).Created)
+	var contracts []sdk.AccAddress
+	keeper.IterateContractsByCode(ctx, codeID, func(addr sdk.AccAddress) bool {
+		contracts = append(contracts, addr)
+		return false
+	})
+	assert.Equal(t, []sdk.AccAddress{contractAddr}, contracts)
+}
+
 func TestSupportedGenMsgTypes(t *testing.T) {
 	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"path/filepath"
 	"time"
@@ -59,6 +60,7 @@ type Keeper struct {
 	cdc                   codec.Marshaler
 	accountKeeper         types.AccountKeeper
 	bank                  CoinTransferrer
+	bankKeeper            types.BankKeeper
 	portKeeper            types.PortKeeper
 	capabilityKeeper      types.CapabilityKeeper
 	wasmVM                types.WasmerEngine
@@ -66,9 +68,13 @@ type Keeper struct {
 	wasmVMResponseHandler WasmVMResponseHandler
 	messenger             Messenger
 	metrics               *Metrics
+	callProfiling         bool
 	// queryGasLimit is the max wasmvm gas that can be spent on executing a query with a contract
 	queryGasLimit uint64
-	paramSpace    *paramtypes.Subspace
+	// codeCache pins frequently executed codes into the wasmvm cache, nil when the warm-up is disabled
+	codeCache      *codeCache
+	vmCacheMetrics *WasmVMCacheMetricsCollector
+	paramSpace     *paramtypes.Subspace
 }
 
 // NewKeeper creates a new contract Keeper instance
@@ -110,6 +116,7 @@ func NewKeeper(
 		wasmVM:           wasmer,
 		accountKeeper:    accountKeeper,
 		bank:             NewBankCoinTransferrer(bankKeeper),
+		bankKeeper:       bankKeeper,
 		portKeeper:       portKeeper,
 		capabilityKeeper: capabilityKeeper,
 		messenger:        NewDefaultMessageHandler(router, encodeRouter, channelKeeper, capabilityKeeper, bankKeeper, cdc, portSource, customEncoders),
@@ -117,16 +124,33 @@ func NewKeeper(
 		paramSpace:       paramSpace,
 		metrics:          NopMetrics(),
 	}
+	if wasmConfig.CacheWarmupSize != 0 {
+		db, err := sdk.NewLevelDB(codeCacheDBName, filepath.Join(homeDir, "wasm"))
+		if err != nil {
+			panic(err)
+		}
+		if keeper.codeCache, err = newCodeCache(db, uint64(wasmConfig.CacheWarmupSize)<<20); err != nil {
+			panic(err)
+		}
+	}
 
 	keeper.wasmVMQueryHandler = DefaultQueryPlugins(bankKeeper, stakingKeeper, distKeeper, channelKeeper, queryRouter, keeper).Merge(customPlugins)
 	for _, o := range opts {
 		o.apply(keeper)
 	}
 	// not updateable, yet
-	keeper.wasmVMResponseHandler = NewDefaultWasmVMContractResponseHandler(NewMessageDispatcher(keeper.messenger, keeper))
+	keeper.wasmVMResponseHandler = NewDefaultWasmVMContractResponseHandler(NewMessageDispatcher(newStargateAllowlistMessenger(keeper.messenger, keeper), keeper))
 	return *keeper
 }
 
+// Close persists the node local execution counts of the codes on shutdown.
+func (k Keeper) Close() error {
+	if k.codeCache == nil {
+		return nil
+	}
+	return k.codeCache.close()
+}
+
 func (k Keeper) getUploadAccessConfig(ctx sdk.Context) types.AccessConfig {
 	var a types.AccessConfig
 	k.paramSpace.Get(ctx, types.ParamStoreKeyUploadAccess, &a)
@@ -180,7 +204,7 @@ func (k Keeper) setParams(ctx sdk.Context, ps types.Params) {
 	k.paramSpace.SetParamSet(ctx, &ps)
 }
 
-func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, source string, builder string, instantiateAccess *types.AccessConfig, authZ AuthorizationPolicy) (codeID uint64, err error) {
+func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, source string, builder string, instantiateAccess *types.AccessConfig, eventSchema json.RawMessage, authZ AuthorizationPolicy) (codeID uint64, err error) {
 	if !authZ.CanCreateCode(k.getUploadAccessConfig(ctx), creator) {
 		return 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not create code")
 	}
@@ -201,6 +225,7 @@ func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte,
 	}
 	codeInfo := types.NewCodeInfo(codeHash, creator, source, builder, *instantiateAccess)
 	k.storeCodeInfo(ctx, codeID, codeInfo)
+	k.setEventSchema(ctx, codeID, eventSchema)
 	return codeID, nil
 }
 
@@ -233,17 +258,40 @@ func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeIn
 	return nil
 }
 
-func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error) {
+func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, addressGenerator AddressGenerator, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error) {
 	defer func(begin time.Time) { k.metrics.InstantiateElapsedTimes.Observe(time.Since(begin).Seconds()) }(time.Now())
+	ctx, profile := startCallProfile(ctx, callKindInstantiate, "")
+	defer profile.finish(ctx)
+	profile.setDetail(fmt.Sprintf("code_id=%d", codeID))
 	if !k.IsPinnedCode(ctx, codeID) {
 		ctx.GasMeter().ConsumeGas(k.getInstanceCost(ctx), "Loading CosmWasm module: instantiate")
 	}
 
+	// get contact info
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCodeKey(codeID))
+	if bz == nil {
+		return "", nil, sdkerrors.Wrap(types.ErrNotFound, "code")
+	}
+	var codeInfo types.CodeInfo
+	k.cdc.MustUnmarshalBinaryBare(bz, &codeInfo)
+
+	if !authZ.CanInstantiateContract(codeInfo.InstantiateConfig, creator) {
+		return "", nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not instantiate")
+	}
+
 	// create contract address
-	contractAddress := k.generateContractAddress(ctx, codeID)
+	contractAddress := addressGenerator(ctx, codeID, codeInfo.CodeHash)
+	profile.setContract(contractAddress)
 	existingAcct := k.accountKeeper.GetAccount(ctx, contractAddress)
 	if existingAcct != nil {
-		return "", nil, sdkerrors.Wrap(types.ErrAccountExists, existingAcct.GetAddress().String())
+		if k.HasContractInfo(ctx, contractAddress) {
+			return "", nil, sdkerrors.Wrap(types.ErrDuplicate, "instance with this code id, sender, salt and init msg exists")
+		}
+		// accounts pre-funded for the predictable address are taken over by the contract
+		if !isUnusedAccount(existingAcct) {
+			return "", nil, sdkerrors.Wrap(types.ErrAccountExists, existingAcct.GetAddress().String())
+		}
 	}
 
 	// deposit initial contract funds
@@ -252,26 +300,13 @@ func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 			return "", nil, err
 		}
 
-	} else {
+	} else if existingAcct == nil {
 		// create an empty account (so we don't have issues later)
 		// TODO: can we remove this?
 		contractAccount := k.accountKeeper.NewAccountWithAddress(ctx, contractAddress)
 		k.accountKeeper.SetAccount(ctx, contractAccount)
 	}
 
-	// get contact info
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetCodeKey(codeID))
-	if bz == nil {
-		return "", nil, sdkerrors.Wrap(types.ErrNotFound, "code")
-	}
-	var codeInfo types.CodeInfo
-	k.cdc.MustUnmarshalBinaryBare(bz, &codeInfo)
-
-	if !authZ.CanInstantiateContract(codeInfo.InstantiateConfig, creator) {
-		return "", nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not instantiate")
-	}
-
 	// prepare params for contract instantiate call
 	env := types.NewEnv(ctx, contractAddress)
 	info := types.NewInfo(creator, deposit)
@@ -280,21 +315,31 @@ func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 	// 0x03 | contractAddress (sdk.AccAddress)
 	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
-	wasmStore := types.NewWasmStore(prefixStore)
+	store, storageUsage := k.newStorageUsageStore(ctx, contractAddress, prefixStore)
+	wasmStore := newWasmStore(profile, store)
 
 	// prepare querier
 	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddress, k.getGasMultiplier(ctx))
 
 	// instantiate wasm contract
 	gas := gasForContract(ctx, k.getGasMultiplier(ctx))
+	k.recordCodeExecution(ctx, codeID, codeInfo.CodeHash)
+	vmCalled := k.observeVMCache(codeID)
 	res, gasUsed, err := k.wasmVM.Instantiate(codeInfo.CodeHash, env, info, initMsg, wasmStore, k.cosmwasmAPI(ctx), querier, k.gasMeter(ctx), gas)
+	vmCalled()
 	k.consumeGas(ctx, gasUsed)
 	if err != nil {
 		return contractAddress, nil, sdkerrors.Wrap(types.ErrInstantiateFailed, err.Error())
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddress, storageUsage); err != nil {
+		return contractAddress, nil, err
+	}
 
 	// emit all events from this contract itself
 	events := types.ParseEvents(res.Attributes, contractAddress)
+	if err := k.validateContractEvents(ctx, codeID, events); err != nil {
+		return contractAddress, nil, err
+	}
 	ctx.EventManager().EmitEvents(events)
 
 	// persist instance first
@@ -333,6 +378,8 @@ func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 // Execute executes the contract instance
 func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (*sdk.Result, error) {
 	defer func(begin time.Time) { k.metrics.ExecuteElapsedTimes.Observe(time.Since(begin).Seconds()) }(time.Now())
+	ctx, profile := startCallProfile(ctx, callKindExecute, contractAddress)
+	defer profile.finish(ctx)
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
@@ -358,15 +405,25 @@ func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	// prepare querier
 	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddress, k.getGasMultiplier(ctx))
 	gas := gasForContract(ctx, k.getGasMultiplier(ctx))
-	wasmStore := types.NewWasmStore(prefixStore)
+	store, storageUsage := k.newStorageUsageStore(ctx, contractAddress, prefixStore)
+	wasmStore := newWasmStore(profile, store)
+	k.recordCodeExecution(ctx, contractInfo.CodeID, codeInfo.CodeHash)
+	vmCalled := k.observeVMCache(contractInfo.CodeID)
 	res, gasUsed, execErr := k.wasmVM.Execute(codeInfo.CodeHash, env, info, msg, wasmStore, k.cosmwasmAPI(ctx), querier, k.gasMeter(ctx), gas)
+	vmCalled()
 	k.consumeGas(ctx, gasUsed)
 	if execErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddress, storageUsage); err != nil {
+		return nil, err
+	}
 
 	// emit all events from this contract itself
 	events := types.ParseEvents(res.Attributes, contractAddress)
+	if err := k.validateContractEvents(ctx, contractInfo.CodeID, events); err != nil {
+		return nil, err
+	}
 	ctx.EventManager().EmitEvents(events)
 
 	// dispatch submessages then messages
@@ -382,6 +439,9 @@ func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 
 func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, authZ AuthorizationPolicy) (*sdk.Result, error) {
 	defer func(begin time.Time) { k.metrics.MigrateElapsedTimes.Observe(time.Since(begin).Seconds()) }(time.Now())
+	ctx, profile := startCallProfile(ctx, callKindMigrate, contractAddress)
+	defer profile.finish(ctx)
+	profile.setDetail(fmt.Sprintf("code_id=%d", newCodeID))
 	if !k.IsPinnedCode(ctx, newCodeID) {
 		ctx.GasMeter().ConsumeGas(k.getInstanceCost(ctx), "Loading CosmWasm module: migrate")
 	}
@@ -426,15 +486,25 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
 	gas := gasForContract(ctx, k.getGasMultiplier(ctx))
-	wasmStore := types.NewWasmStore(prefixStore)
+	store, storageUsage := k.newStorageUsageStore(ctx, contractAddress, prefixStore)
+	wasmStore := newWasmStore(profile, store)
+	k.recordCodeExecution(ctx, newCodeID, newCodeInfo.CodeHash)
+	vmCalled := k.observeVMCache(newCodeID)
 	res, gasUsed, err := k.wasmVM.Migrate(newCodeInfo.CodeHash, env, msg, &wasmStore, k.cosmwasmAPI(ctx), &querier, k.gasMeter(ctx), gas)
+	vmCalled()
 	k.consumeGas(ctx, gasUsed)
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrMigrationFailed, err.Error())
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddress, storageUsage); err != nil {
+		return nil, err
+	}
 
 	// emit all events from this contract migration itself
 	events := types.ParseEvents(res.Attributes, contractAddress)
+	if err := k.validateContractEvents(ctx, newCodeID, events); err != nil {
+		return nil, err
+	}
 	ctx.EventManager().EmitEvents(events)
 
 	// delete old secondary index entry
@@ -461,6 +531,8 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 // responsibility or the app developer (who passes the wasm.Keeper in app.go)
 func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) (*sdk.Result, error) {
 	defer func(begin time.Time) { k.metrics.SudoElapsedTimes.Observe(time.Since(begin).Seconds()) }(time.Now())
+	ctx, profile := startCallProfile(ctx, callKindSudo, contractAddress)
+	defer profile.finish(ctx)
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
@@ -475,15 +547,25 @@ func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte
 	// prepare querier
 	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddress, k.getGasMultiplier(ctx))
 	gas := gasForContract(ctx, k.getGasMultiplier(ctx))
-	wasmStore := types.NewWasmStore(prefixStore)
+	store, storageUsage := k.newStorageUsageStore(ctx, contractAddress, prefixStore)
+	wasmStore := newWasmStore(profile, store)
+	k.recordCodeExecution(ctx, contractInfo.CodeID, codeInfo.CodeHash)
+	vmCalled := k.observeVMCache(contractInfo.CodeID)
 	res, gasUsed, execErr := k.wasmVM.Sudo(codeInfo.CodeHash, env, msg, wasmStore, k.cosmwasmAPI(ctx), querier, k.gasMeter(ctx), gas)
+	vmCalled()
 	k.consumeGas(ctx, gasUsed)
 	if execErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddress, storageUsage); err != nil {
+		return nil, err
+	}
 
 	// emit all events from this contract itself
 	events := types.ParseEvents(res.Attributes, contractAddress)
+	if err := k.validateContractEvents(ctx, contractInfo.CodeID, events); err != nil {
+		return nil, err
+	}
 	ctx.EventManager().EmitEvents(events)
 
 	// dispatch submessages then messages
@@ -499,7 +581,13 @@ func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte
 
 // reply is only called from keeper internal functions (dispatchSubmessages) after processing the submessage
 // it
-func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) (*sdk.Result, error) {
+func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) (result *sdk.Result, err error) {
+	ctx, profile := startCallProfile(ctx, callKindReply, contractAddress)
+	defer func() {
+		profile.finish(ctx)
+		profile.setReplyResult(ctx, result, err)
+	}()
+	profile.setDetail(fmt.Sprintf("id=%d", reply.ID))
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
@@ -519,15 +607,25 @@ func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply was
 		GasMultiplier: k.getGasMultiplier(ctx),
 	}
 	gas := gasForContract(ctx, k.getGasMultiplier(ctx))
-	wasmStore := types.NewWasmStore(prefixStore)
+	store, storageUsage := k.newStorageUsageStore(ctx, contractAddress, prefixStore)
+	wasmStore := newWasmStore(profile, store)
+	k.recordCodeExecution(ctx, contractInfo.CodeID, codeInfo.CodeHash)
+	vmCalled := k.observeVMCache(contractInfo.CodeID)
 	res, gasUsed, execErr := k.wasmVM.Reply(codeInfo.CodeHash, env, reply, wasmStore, k.cosmwasmAPI(ctx), querier, k.gasMeter(ctx), gas)
+	vmCalled()
 	k.consumeGas(ctx, gasUsed)
 	if execErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddress, storageUsage); err != nil {
+		return nil, err
+	}
 
 	// emit all events from this contract itself
 	events := types.ParseEvents(res.Attributes, contractAddress)
+	if err := k.validateContractEvents(ctx, contractInfo.CodeID, events); err != nil {
+		return nil, err
+	}
 	ctx.EventManager().EmitEvents(events)
 
 	// dispatch submessages then messages
@@ -578,6 +676,22 @@ func (k Keeper) setContractStatus(ctx sdk.Context, contractAddress sdk.AccAddres
 	return nil
 }
 
+func (k Keeper) setAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig types.AccessConfig, authZ AuthorizationPolicy) error {
+	if err := newConfig.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "instantiate permission")
+	}
+	codeInfo := k.GetCodeInfo(ctx, codeID)
+	if codeInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "code info")
+	}
+	if !authZ.CanModifyCodeAccessConfig(sdk.AccAddress(codeInfo.Creator), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify code access config")
+	}
+	codeInfo.InstantiateConfig = newConfig
+	k.storeCodeInfo(ctx, codeID, *codeInfo)
+	return nil
+}
+
 func (k Keeper) setContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress, authZ AuthorizationPolicy) error {
 	contractInfo := k.GetContractInfo(ctx, contractAddress)
 	if contractInfo == nil {
@@ -638,6 +752,8 @@ func (k Keeper) getLastContractHistoryEntry(ctx sdk.Context, contractAddr sdk.Ac
 // QuerySmart queries the smart contract itself.
 func (k Keeper) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
 	defer func(begin time.Time) { k.metrics.QuerySmartElapsedTimes.Observe(time.Since(begin).Seconds()) }(time.Now())
+	ctx, profile := startCallProfile(ctx, callKindSmartQuery, contractAddr)
+	defer profile.finish(ctx)
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return nil, err
@@ -650,8 +766,10 @@ func (k Keeper) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []b
 	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddr, k.getGasMultiplier(ctx))
 
 	env := types.NewEnv(ctx, contractAddr)
-	wasmStore := types.NewWasmStore(prefixStore)
+	wasmStore := newWasmStore(profile, prefixStore)
+	vmCalled := k.observeVMCache(contractInfo.CodeID)
 	queryResult, gasUsed, qErr := k.wasmVM.Query(codeInfo.CodeHash, env, req, wasmStore, k.cosmwasmAPI(ctx), querier, k.gasMeter(ctx), gasForContract(ctx, k.getGasMultiplier(ctx)))
+	vmCalled()
 	k.consumeGas(ctx, gasUsed)
 	if qErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrQueryFailed, qErr.Error())
@@ -735,6 +853,7 @@ func (k Keeper) GetContractState(ctx sdk.Context, contractAddress sdk.AccAddress
 func (k Keeper) importContractState(ctx sdk.Context, contractAddress sdk.AccAddress, models []types.Model) error {
 	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
+	usage := k.GetContractStorageUsage(ctx, contractAddress)
 	for _, model := range models {
 		if model.Value == nil {
 			model.Value = []byte{}
@@ -743,7 +862,9 @@ func (k Keeper) importContractState(ctx sdk.Context, contractAddress sdk.AccAddr
 			return sdkerrors.Wrapf(types.ErrDuplicate, "duplicate key: %x", model.Key)
 		}
 		prefixStore.Set(model.Key, model.Value)
+		usage.Bytes += uint64(len(model.Key) + len(model.Value))
 	}
+	k.setContractStorageUsage(ctx, contractAddress, usage)
 	return nil
 }
 
@@ -797,6 +918,8 @@ func (k Keeper) pinCode(ctx sdk.Context, codeID uint64) error {
 	if err := k.wasmVM.Pin(codeInfo.CodeHash); err != nil {
 		return sdkerrors.Wrap(types.ErrPinContractFailed, err.Error())
 	}
+	// the code must not be unpinned when the cache evicts it
+	k.forgetCachedCode(codeID)
 	store := ctx.KVStore(k.storeKey)
 	// store 1 byte to not run into `nil` debugging issues
 	store.Set(types.GetPinnedCodeIndexPrefix(codeID), []byte{1})
@@ -824,7 +947,8 @@ func (k Keeper) IsPinnedCode(ctx sdk.Context, codeID uint64) bool {
 	return store.Has(types.GetPinnedCodeIndexPrefix(codeID))
 }
 
-// InitializePinnedCodes updates wasmvm to pin to cache all contracts marked as pinned
+// InitializePinnedCodes updates wasmvm to pin to cache all contracts marked as pinned.
+// When a cache warm-up size is configured, the most frequently executed codes are pinned as well.
 func (k Keeper) InitializePinnedCodes(ctx sdk.Context) error {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PinnedCodeIndexPrefix)
 	iter := store.Iterator(nil, nil)
@@ -837,7 +961,7 @@ func (k Keeper) InitializePinnedCodes(ctx sdk.Context) error {
 			return sdkerrors.Wrap(types.ErrPinContractFailed, err.Error())
 		}
 	}
-	return nil
+	return k.warmupCache(ctx)
 }
 
 // setContractInfoExtension updates the extension point data that is stored with the contract info
@@ -936,7 +1060,9 @@ func (k Keeper) importAutoIncrementID(ctx sdk.Context, lastIDKey []byte, val uin
 	return nil
 }
 
-func (k Keeper) importContract(ctx sdk.Context, contractAddr sdk.AccAddress, c *types.ContractInfo, state []types.Model) error {
+// importContract stores a contract of the genesis. The contract keeps its code history if
+// given, being created at its first entry, which is started anew with a genesis entry otherwise.
+func (k Keeper) importContract(ctx sdk.Context, contractAddr sdk.AccAddress, c *types.ContractInfo, state []types.Model, history []types.ContractCodeHistoryEntry) error {
 	if !k.containsCodeInfo(ctx, c.CodeID) {
 		return sdkerrors.Wrapf(types.ErrNotFound, "code id: %d", c.CodeID)
 	}
@@ -944,10 +1070,14 @@ func (k Keeper) importContract(ctx sdk.Context, contractAddr sdk.AccAddress, c *
 		return sdkerrors.Wrapf(types.ErrDuplicate, "contract: %s", contractAddr)
 	}
 
-	historyEntry := c.ResetFromGenesis(ctx)
-	k.appendToContractHistory(ctx, contractAddr, historyEntry)
+	if len(history) == 0 {
+		history = []types.ContractCodeHistoryEntry{c.ResetFromGenesis(ctx)}
+	} else {
+		c.Created = history[0].Updated
+	}
+	k.appendToContractHistory(ctx, contractAddr, history...)
 	k.storeContractInfo(ctx, contractAddr, c)
-	k.addToContractCodeSecondaryIndex(ctx, contractAddr, historyEntry)
+	k.addToContractCodeSecondaryIndex(ctx, contractAddr, history[len(history)-1])
 	return k.importContractState(ctx, contractAddr, state)
 }
 
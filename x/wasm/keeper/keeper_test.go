@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/line/lbm-sdk/crypto/keys/secp256k1"
 	stypes "github.com/line/lbm-sdk/store/types"
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
@@ -16,6 +17,7 @@ import (
 	"github.com/line/lbm-sdk/x/wasm/keeper/wasmtesting"
 	"github.com/line/lbm-sdk/x/wasm/types"
 	tmproto "github.com/line/ostracon/proto/ostracon/types"
+	"github.com/line/tm-db/v2/memdb"
 	wasmvm "github.com/line/wasmvm"
 	wasmvmtypes "github.com/line/wasmvm/types"
 	"github.com/stretchr/testify/assert"
@@ -460,6 +462,103 @@ func TestInstantiateWithContractDataResponse(t *testing.T) {
 	assert.Equal(t, []byte("my-response-data"), data)
 }
 
+func TestInstantiate2(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+
+	wasmerMock := &wasmtesting.MockWasmer{
+		InstantiateFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, initMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64) (*wasmvmtypes.Response, uint64, error) {
+			return &wasmvmtypes.Response{}, 0, nil
+		},
+		AnalyzeCodeFn: wasmtesting.WithoutIBCAnalyzeFn,
+		CreateFn:      wasmtesting.NoOpCreateFn,
+	}
+	example := StoreRandomContract(t, ctx, keepers, wasmerMock)
+	checksum := keepers.WasmKeeper.GetCodeInfo(ctx, example.CodeID).CodeHash
+	initMsg := []byte(`{"foo":"bar"}`)
+	prefund := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+
+	specs := map[string]struct {
+		salt    []byte
+		fixMsg  bool
+		setup   func(ctx sdk.Context, addr sdk.AccAddress)
+		expAddr sdk.AccAddress
+		expBal  sdk.Coins
+		expErr  *sdkerrors.Error
+	}{
+		"address from checksum, creator and salt": {
+			salt:    []byte("salt1"),
+			expAddr: BuildContractAddressPredictable(checksum, example.CreatorAddr, []byte("salt1"), nil),
+		},
+		"address with fixed msg": {
+			salt:    []byte("salt1"),
+			fixMsg:  true,
+			expAddr: BuildContractAddressPredictable(checksum, example.CreatorAddr, []byte("salt1"), initMsg),
+		},
+		"pre-funded account is taken over": {
+			salt: []byte("salt2"),
+			setup: func(ctx sdk.Context, addr sdk.AccAddress) {
+				fundAccounts(t, ctx, keepers.AccountKeeper, keepers.BankKeeper, addr, prefund)
+			},
+			expAddr: BuildContractAddressPredictable(checksum, example.CreatorAddr, []byte("salt2"), nil),
+			expBal:  prefund,
+		},
+		"used account": {
+			salt: []byte("salt3"),
+			setup: func(ctx sdk.Context, addr sdk.AccAddress) {
+				acc := keepers.AccountKeeper.NewAccountWithAddress(ctx, addr)
+				require.NoError(t, acc.SetPubKey(secp256k1.GenPrivKey().PubKey()))
+				keepers.AccountKeeper.SetAccount(ctx, acc)
+			},
+			expErr: types.ErrAccountExists,
+		},
+		"existing contract": {
+			salt: []byte("salt4"),
+			setup: func(ctx sdk.Context, addr sdk.AccAddress) {
+				_, _, err := keepers.ContractKeeper.Instantiate2(ctx, example.CodeID, example.CreatorAddr, "", initMsg, "first", nil, []byte("salt4"), false)
+				require.NoError(t, err)
+			},
+			expErr: types.ErrDuplicate,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			predicted := BuildContractAddressPredictable(checksum, example.CreatorAddr, spec.salt, nil)
+			if spec.setup != nil {
+				spec.setup(ctx, predicted)
+			}
+			gotAddr, _, gotErr := keepers.ContractKeeper.Instantiate2(ctx, example.CodeID, example.CreatorAddr, "", initMsg, "test", nil, spec.salt, spec.fixMsg)
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(gotErr), gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expAddr, gotAddr)
+			assert.NotNil(t, keepers.WasmKeeper.GetContractInfo(ctx, gotAddr))
+			assert.True(t, spec.expBal.IsEqual(keepers.BankKeeper.GetAllBalances(ctx, gotAddr)))
+		})
+	}
+}
+
+func TestBuildContractAddressPredictable(t *testing.T) {
+	_, _, creator := keyPubAddr()
+	_, _, otherCreator := keyPubAddr()
+	checksum := []byte("checksum")
+	salt := []byte("salt")
+
+	addr := BuildContractAddressPredictable(checksum, creator, salt, nil)
+	require.NoError(t, sdk.ValidateAccAddress(addr.String()))
+	assert.Equal(t, addr, BuildContractAddressPredictable(checksum, creator, salt, []byte{}))
+
+	// every input changes the address
+	assert.NotEqual(t, addr, BuildContractAddressPredictable([]byte("other"), creator, salt, nil))
+	assert.NotEqual(t, addr, BuildContractAddressPredictable(checksum, otherCreator, salt, nil))
+	assert.NotEqual(t, addr, BuildContractAddressPredictable(checksum, creator, []byte("other"), nil))
+	assert.NotEqual(t, addr, BuildContractAddressPredictable(checksum, creator, salt, []byte("{}")))
+	// length prefixes prevent collisions by moving bytes between the elements
+	assert.NotEqual(t, addr, BuildContractAddressPredictable([]byte("checksums"), creator, []byte("alt"), nil))
+}
+
 func TestExecute(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
 	accKeeper, keeper, bankKeeper := keepers.AccountKeeper, keepers.ContractKeeper, keepers.BankKeeper
@@ -1611,6 +1710,92 @@ func runUpdateStatusSpec(t *testing.T, msg string, contractKeeper types.Contract
 	})
 }
 
+func TestSetAccessConfig(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	mock := &wasmtesting.MockWasmer{
+		CreateFn:      wasmtesting.NoOpCreateFn,
+		AnalyzeCodeFn: wasmtesting.WithoutIBCAnalyzeFn,
+		InstantiateFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, initMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64) (*wasmvmtypes.Response, uint64, error) {
+			return &wasmvmtypes.Response{}, 0, nil
+		},
+	}
+	example := StoreRandomContract(t, parentCtx, keepers, mock)
+	_, _, deployer1 := keyPubAddr()
+	_, _, deployer2 := keyPubAddr()
+	_, _, other := keyPubAddr()
+
+	specs := map[string]struct {
+		authz     AuthorizationPolicy
+		caller    sdk.AccAddress
+		codeID    uint64
+		newConfig types.AccessConfig
+		expErr    *sdkerrors.Error
+	}{
+		"creator sets any of addresses": {
+			authz:     DefaultAuthorizationPolicy{},
+			caller:    example.CreatorAddr,
+			codeID:    example.CodeID,
+			newConfig: types.AccessTypeAnyOfAddresses.With(deployer1, deployer2),
+		},
+		"creator sets nobody": {
+			authz:     DefaultAuthorizationPolicy{},
+			caller:    example.CreatorAddr,
+			codeID:    example.CodeID,
+			newConfig: types.AllowNobody,
+		},
+		"other address": {
+			authz:     DefaultAuthorizationPolicy{},
+			caller:    other,
+			codeID:    example.CodeID,
+			newConfig: types.AccessTypeAnyOfAddresses.With(other),
+			expErr:    sdkerrors.ErrUnauthorized,
+		},
+		"gov": {
+			authz:     GovAuthorizationPolicy{},
+			caller:    other,
+			codeID:    example.CodeID,
+			newConfig: types.AccessTypeAnyOfAddresses.With(deployer1),
+		},
+		"invalid config": {
+			authz:     DefaultAuthorizationPolicy{},
+			caller:    example.CreatorAddr,
+			codeID:    example.CodeID,
+			newConfig: types.AccessConfig{Permission: types.AccessTypeAnyOfAddresses},
+			expErr:    types.ErrEmpty,
+		},
+		"unknown code": {
+			authz:     DefaultAuthorizationPolicy{},
+			caller:    example.CreatorAddr,
+			codeID:    999,
+			newConfig: types.AllowEverybody,
+			expErr:    types.ErrNotFound,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			k := NewPermissionedKeeper(keepers.WasmKeeper, spec.authz)
+			gotErr := k.SetAccessConfig(ctx, spec.codeID, spec.caller, spec.newConfig)
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), "got %+v", gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.newConfig, keepers.WasmKeeper.GetCodeInfo(ctx, spec.codeID).InstantiateConfig)
+
+			// and the new config is enforced on instantiation
+			for _, addr := range []sdk.AccAddress{deployer1, deployer2, other} {
+				_, _, err := keepers.ContractKeeper.Instantiate(ctx, spec.codeID, addr, "", []byte(`{}`), "label", nil)
+				if spec.newConfig.Allowed(addr) {
+					assert.NoError(t, err)
+				} else {
+					assert.True(t, sdkerrors.ErrUnauthorized.Is(err), "got %+v", err)
+				}
+			}
+		})
+	}
+}
+
 func TestInitializePinnedCodes(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
 	k := keepers.WasmKeeper
@@ -1642,6 +1827,134 @@ func TestInitializePinnedCodes(t *testing.T) {
 	}
 }
 
+func TestInitializePinnedCodesWithCacheWarmup(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+
+	var capturedChecksums []wasmvm.Checksum
+	codeSizes := make(map[string]int)
+	mock := wasmtesting.MockWasmer{
+		PinFn: func(checksum wasmvm.Checksum) error {
+			capturedChecksums = append(capturedChecksums, checksum)
+			return nil
+		},
+		GetCodeFn: func(checksum wasmvm.Checksum) (wasmvm.WasmCode, error) {
+			return make([]byte, codeSizes[string(checksum)]), nil
+		},
+	}
+	wasmtesting.MakeIBCInstantiable(&mock)
+
+	specs := []struct {
+		size       int
+		executions uint64
+		govPinned  bool
+	}{
+		{size: 900 << 10, executions: 10, govPinned: true},
+		{size: 600 << 10, executions: 5},
+		{size: 600 << 10, executions: 3},
+		{size: 300 << 10, executions: 1},
+		{size: 1, executions: 0},
+	}
+	// the execution counts persisted by a previous run of the node
+	db := memdb.NewDB()
+	codeIDs := make([]uint64, len(specs))
+	checksums := make([]wasmvm.Checksum, len(specs))
+	for i, spec := range specs {
+		codeID := StoreRandomContract(t, ctx, keepers, &mock).CodeID
+		codeIDs[i] = codeID
+		checksums[i] = k.GetCodeInfo(ctx, codeID).CodeHash
+		codeSizes[string(checksums[i])] = spec.size
+		if spec.executions != 0 {
+			require.NoError(t, db.Set(sdk.Uint64ToBigEndian(codeID), sdk.Uint64ToBigEndian(spec.executions)))
+		}
+		if spec.govPinned {
+			require.NoError(t, k.pinCode(ctx, codeID))
+		}
+	}
+	var err error
+	k.codeCache, err = newCodeCache(db, 1<<20)
+	require.NoError(t, err)
+	for i, spec := range specs {
+		assert.Equal(t, spec.executions, k.getCodeExecutionCount(codeIDs[i]))
+	}
+	capturedChecksums = nil
+
+	// when
+	gotErr := k.InitializePinnedCodes(ctx)
+
+	// then the governance pinned code and the most executed codes that fit into 1 MiB are pinned
+	require.NoError(t, gotErr)
+	assert.Equal(t, []wasmvm.Checksum{checksums[0], checksums[1], checksums[3]}, capturedChecksums)
+}
+
+func TestCodeCacheEvictsLeastRecentlyExecuted(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+
+	var pinned, unpinned []wasmvm.Checksum
+	codeSizes := make(map[string]int)
+	mock := wasmtesting.MockWasmer{
+		PinFn: func(checksum wasmvm.Checksum) error {
+			pinned = append(pinned, checksum)
+			return nil
+		},
+		UnpinFn: func(checksum wasmvm.Checksum) error {
+			unpinned = append(unpinned, checksum)
+			return nil
+		},
+		GetCodeFn: func(checksum wasmvm.Checksum) (wasmvm.WasmCode, error) {
+			return make([]byte, codeSizes[string(checksum)]), nil
+		},
+	}
+	wasmtesting.MakeIBCInstantiable(&mock)
+
+	sizes := []int{600 << 10, 300 << 10, 300 << 10, 2 << 20}
+	codeIDs := make([]uint64, len(sizes))
+	checksums := make([]wasmvm.Checksum, len(sizes))
+	for i, size := range sizes {
+		codeIDs[i] = StoreRandomContract(t, ctx, keepers, &mock).CodeID
+		checksums[i] = k.GetCodeInfo(ctx, codeIDs[i]).CodeHash
+		codeSizes[string(checksums[i])] = size
+	}
+	db := memdb.NewDB()
+	var err error
+	k.codeCache, err = newCodeCache(db, 1<<20)
+	require.NoError(t, err)
+	execute := func(i int) {
+		k.recordCodeExecution(ctx, codeIDs[i], checksums[i])
+	}
+
+	// codes are pinned on execution while they fit
+	execute(0)
+	execute(1)
+	execute(0)
+	assert.Equal(t, []wasmvm.Checksum{checksums[0], checksums[1]}, pinned)
+	assert.Empty(t, unpinned)
+
+	// the least recently executed code is unpinned to make room
+	execute(2)
+	assert.Equal(t, []wasmvm.Checksum{checksums[0], checksums[1], checksums[2]}, pinned)
+	assert.Equal(t, []wasmvm.Checksum{checksums[1]}, unpinned)
+
+	// a code larger than the budget is not pinned
+	execute(3)
+	assert.Len(t, pinned, 3)
+
+	// a code pinned by governance is not evicted by the cache
+	require.NoError(t, k.pinCode(ctx, codeIDs[0]))
+	pinned, unpinned = nil, nil
+	execute(1)
+	execute(0)
+	assert.Equal(t, []wasmvm.Checksum{checksums[1]}, pinned)
+	assert.Empty(t, unpinned)
+
+	// the execution counts are persisted on close
+	require.NoError(t, k.Close())
+	reopened, err := newCodeCache(db, 1<<20)
+	require.NoError(t, err)
+	assert.Equal(t, map[uint64]uint64{codeIDs[0]: 3, codeIDs[1]: 2, codeIDs[2]: 1, codeIDs[3]: 1}, reopened.counts)
+}
+
 func TestNewDefaultWasmVMContractResponseHandler(t *testing.T) {
 	noopDMsgs := func(ctx sdk.Context, contractAddr sdk.AccAddress, ibcPort string, msgs []wasmvmtypes.CosmosMsg) error {
 		return nil
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"strconv"
+	"sync"
+
 	"github.com/go-kit/kit/metrics"
 	"github.com/go-kit/kit/metrics/discard"
 	go_prometheus "github.com/go-kit/kit/metrics/prometheus"
@@ -102,21 +105,31 @@ var _ prometheus.Collector = (*WasmVMCacheMetricsCollector)(nil)
 
 // WasmVMCacheMetricsCollector custom metrics collector to be used with Prometheus
 type WasmVMCacheMetricsCollector struct {
-	source             metricSource
-	CacheHitsDescr     *prometheus.Desc
-	CacheMissesDescr   *prometheus.Desc
-	CacheElementsDescr *prometheus.Desc
-	CacheSizeDescr     *prometheus.Desc
+	source               metricSource
+	CacheHitsDescr       *prometheus.Desc
+	CacheMissesDescr     *prometheus.Desc
+	CacheElementsDescr   *prometheus.Desc
+	CacheSizeDescr       *prometheus.Desc
+	CodeCacheHitsDescr   *prometheus.Desc
+	CodeCacheMissesDescr *prometheus.Desc
+
+	mtx        sync.Mutex
+	codeHits   map[uint64]uint64
+	codeMisses map[uint64]uint64
 }
 
 //NewWasmVMCacheMetricsCollector constructor
 func NewWasmVMCacheMetricsCollector(s metricSource) *WasmVMCacheMetricsCollector {
 	return &WasmVMCacheMetricsCollector{
-		source:             s,
-		CacheHitsDescr:     prometheus.NewDesc("wasmvm_cache_hits_total", "Total number of cache hits", []string{"type"}, nil),
-		CacheMissesDescr:   prometheus.NewDesc("wasmvm_cache_misses_total", "Total number of cache misses", nil, nil),
-		CacheElementsDescr: prometheus.NewDesc("wasmvm_cache_elements_total", "Total number of elements in the cache", []string{"type"}, nil),
-		CacheSizeDescr:     prometheus.NewDesc("wasmvm_cache_size_bytes", "Total number of elements in the cache", []string{"type"}, nil),
+		source:               s,
+		CacheHitsDescr:       prometheus.NewDesc("wasmvm_cache_hits_total", "Total number of cache hits", []string{"type"}, nil),
+		CacheMissesDescr:     prometheus.NewDesc("wasmvm_cache_misses_total", "Total number of cache misses", nil, nil),
+		CacheElementsDescr:   prometheus.NewDesc("wasmvm_cache_elements_total", "Total number of elements in the cache", []string{"type"}, nil),
+		CacheSizeDescr:       prometheus.NewDesc("wasmvm_cache_size_bytes", "Total number of elements in the cache", []string{"type"}, nil),
+		CodeCacheHitsDescr:   prometheus.NewDesc("wasmvm_code_cache_hits_total", "Total number of cache hits by code id", []string{"code_id"}, nil),
+		CodeCacheMissesDescr: prometheus.NewDesc("wasmvm_code_cache_misses_total", "Total number of cache misses by code id", []string{"code_id"}, nil),
+		codeHits:             make(map[uint64]uint64),
+		codeMisses:           make(map[uint64]uint64),
 	}
 }
 
@@ -131,6 +144,8 @@ func (p *WasmVMCacheMetricsCollector) Describe(descs chan<- *prometheus.Desc) {
 	descs <- p.CacheMissesDescr
 	descs <- p.CacheElementsDescr
 	descs <- p.CacheSizeDescr
+	descs <- p.CodeCacheHitsDescr
+	descs <- p.CodeCacheMissesDescr
 }
 
 // Collect is called by the Prometheus registry when collecting metrics.
@@ -147,8 +162,39 @@ func (p *WasmVMCacheMetricsCollector) Collect(c chan<- prometheus.Metric) {
 	c <- prometheus.MustNewConstMetric(p.CacheElementsDescr, prometheus.GaugeValue, float64(m.ElementsMemoryCache), labelMemory)
 	c <- prometheus.MustNewConstMetric(p.CacheSizeDescr, prometheus.GaugeValue, float64(m.SizeMemoryCache), labelMemory)
 	c <- prometheus.MustNewConstMetric(p.CacheSizeDescr, prometheus.GaugeValue, float64(m.SizePinnedMemoryCache), labelPinned)
+	p.mtx.Lock()
+	for codeID, n := range p.codeHits {
+		c <- prometheus.MustNewConstMetric(p.CodeCacheHitsDescr, prometheus.CounterValue, float64(n), strconv.FormatUint(codeID, 10))
+	}
+	for codeID, n := range p.codeMisses {
+		c <- prometheus.MustNewConstMetric(p.CodeCacheMissesDescr, prometheus.CounterValue, float64(n), strconv.FormatUint(codeID, 10))
+	}
+	p.mtx.Unlock()
 	// Node about fs metrics:
 	// The number of elements and the size of elements in the file system cache cannot easily be obtained.
 	// We had to either scan the whole directory of potentially thousands of files or track the values when files are added or removed.
 	// Such a tracking would need to be on disk such that the values are not cleared when the node is restarted.
 }
+
+// observe returns a callback to be invoked after a wasmvm call of the code. The call is counted as a cache miss
+// when the total misses of wasmvm increased in between, otherwise as a hit. Concurrent or nested calls can be
+// attributed to the wrong code.
+func (p *WasmVMCacheMetricsCollector) observe(codeID uint64) func() {
+	before, err := p.source.GetMetrics()
+	if err != nil {
+		return func() {}
+	}
+	return func() {
+		after, err := p.source.GetMetrics()
+		if err != nil {
+			return
+		}
+		p.mtx.Lock()
+		defer p.mtx.Unlock()
+		if after.Misses > before.Misses {
+			p.codeMisses[codeID]++
+		} else {
+			p.codeHits[codeID]++
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/line/lbm-sdk/x/wasm/keeper/wasmtesting"
+	wasmvmtypes "github.com/line/wasmvm/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWasmVMCacheMetricsByCodeID(t *testing.T) {
+	var misses uint32
+	mock := &wasmtesting.MockWasmer{GetMetricsFn: func() (*wasmvmtypes.Metrics, error) {
+		return &wasmvmtypes.Metrics{Misses: misses}, nil
+	}}
+	collector := NewWasmVMCacheMetricsCollector(mock)
+
+	// when code 1 is loaded on the first call and code 2 is cached already
+	called := collector.observe(1)
+	misses++
+	called()
+	collector.observe(1)()
+	collector.observe(2)()
+
+	// then
+	reg := prometheus.NewPedanticRegistry()
+	collector.Register(reg)
+	exp := `
+# HELP wasmvm_code_cache_hits_total Total number of cache hits by code id
+# TYPE wasmvm_code_cache_hits_total counter
+wasmvm_code_cache_hits_total{code_id="1"} 1
+wasmvm_code_cache_hits_total{code_id="2"} 1
+# HELP wasmvm_code_cache_misses_total Total number of cache misses by code id
+# TYPE wasmvm_code_cache_misses_total counter
+wasmvm_code_cache_misses_total{code_id="1"} 1
+`
+	require.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(exp), "wasmvm_code_cache_hits_total", "wasmvm_code_cache_misses_total"))
+}
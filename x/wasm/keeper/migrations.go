@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/wasm/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -15,6 +16,49 @@ func NewMigrator(keeper Keeper) Migrator {
 }
 
 // Migrate1to2 migrates from version 1 to 2.
+// The instantiate config of stored codes with the AccessTypeOnlyAddress type is converted to the equivalent
+// AccessTypeAnyOfAddresses config so that further addresses can be added with MsgUpdateInstantiateConfig.
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	var updated []types.Code
+	m.keeper.IterateCodeInfos(ctx, func(codeID uint64, info types.CodeInfo) bool {
+		if info.InstantiateConfig.Permission == types.AccessTypeOnlyAddress {
+			info.InstantiateConfig = types.AccessTypeAnyOfAddresses.With(sdk.AccAddress(info.InstantiateConfig.Address))
+			updated = append(updated, types.Code{CodeID: codeID, CodeInfo: info})
+		}
+		return false
+	})
+	for _, c := range updated {
+		m.keeper.storeCodeInfo(ctx, c.CodeID, c.CodeInfo)
+	}
+	return nil
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// It sets the storage deposit param, disabled by default, and records the storage usage of all
+// existing contracts. No deposit is locked for the state stored before.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyStorageDepositPerByte, types.DefaultParams().StorageDepositPerByte)
+	var contracts []sdk.AccAddress
+	m.keeper.IterateContractInfo(ctx, func(addr sdk.AccAddress, _ types.ContractInfo) bool {
+		contracts = append(contracts, addr)
+		return false
+	})
+	for _, addr := range contracts {
+		var usage types.ContractStorageUsage
+		iter := m.keeper.GetContractState(ctx, addr)
+		for ; iter.Valid(); iter.Next() {
+			usage.Bytes += uint64(len(iter.Key()) + len(iter.Value()))
+		}
+		iter.Close()
+		m.keeper.setContractStorageUsage(ctx, addr, usage)
+	}
+	return nil
+}
+
+// Migrate3to4 sets the stargate allowlist params to their defaults which do not allow any stargate calls
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyStargateQueryAllowlist, defaults.StargateQueryAllowlist)
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyStargateMsgAllowlist, defaults.StargateMsgAllowlist)
 	return nil
 }
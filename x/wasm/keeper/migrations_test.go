@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/line/lbm-sdk/store/prefix"
+	"github.com/line/lbm-sdk/x/wasm/keeper/wasmtesting"
+	"github.com/line/lbm-sdk/x/wasm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	mock := &wasmtesting.MockWasmer{CreateFn: wasmtesting.NoOpCreateFn, AnalyzeCodeFn: wasmtesting.WithoutIBCAnalyzeFn}
+	_, _, anyAddr := keyPubAddr()
+
+	configs := []types.AccessConfig{
+		types.AccessTypeOnlyAddress.With(anyAddr),
+		types.AllowEverybody,
+		types.AllowNobody,
+		types.AccessTypeAnyOfAddresses.With(anyAddr),
+	}
+	codeIDs := make([]uint64, len(configs))
+	for i, c := range configs {
+		example := StoreRandomContract(t, ctx, keepers, mock)
+		codeInfo := k.GetCodeInfo(ctx, example.CodeID)
+		codeInfo.InstantiateConfig = c
+		k.storeCodeInfo(ctx, example.CodeID, *codeInfo)
+		codeIDs[i] = example.CodeID
+	}
+
+	// when
+	require.NoError(t, NewMigrator(*k).Migrate1to2(ctx))
+
+	// then
+	exp := []types.AccessConfig{
+		types.AccessTypeAnyOfAddresses.With(anyAddr),
+		types.AllowEverybody,
+		types.AllowNobody,
+		types.AccessTypeAnyOfAddresses.With(anyAddr),
+	}
+	for i, codeID := range codeIDs {
+		assert.Equal(t, exp[i], k.GetCodeInfo(ctx, codeID).InstantiateConfig)
+	}
+}
+
+func TestMigrate2to3(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	mock := &wasmtesting.MockWasmer{CreateFn: wasmtesting.NoOpCreateFn, AnalyzeCodeFn: wasmtesting.WithoutIBCAnalyzeFn}
+	example := StoreRandomContract(t, ctx, keepers, mock)
+
+	contractAddr := RandomAccountAddress(t)
+	contractInfo := types.ContractInfoFixture(func(info *types.ContractInfo) { info.CodeID = example.CodeID })
+	k.storeContractInfo(ctx, contractAddr, &contractInfo)
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractStorePrefix(contractAddr))
+	prefixStore.Set([]byte("foo"), []byte("bar"))
+	prefixStore.Set([]byte("a"), []byte("bc"))
+
+	// when
+	require.NoError(t, NewMigrator(*k).Migrate2to3(ctx))
+
+	// then
+	assert.Equal(t, types.ContractStorageUsage{Bytes: 9}, k.GetContractStorageUsage(ctx, contractAddr))
+	assert.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+}
+
+func TestMigrate3to4(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+
+	// when
+	require.NoError(t, NewMigrator(*k).Migrate3to4(ctx))
+
+	// then
+	assert.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+	assert.False(t, k.IsStargateQueryAllowed(ctx, "/lbm.bank.v1.Query/AllBalances"))
+	assert.False(t, k.IsStargateMsgAllowed(ctx, "/lbm.bank.v1.MsgSend"))
+}
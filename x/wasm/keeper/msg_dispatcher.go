@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 	"github.com/line/lbm-sdk/x/wasm/types"
@@ -33,7 +35,11 @@ func NewMessageDispatcher(messenger Messenger, keeper replyer) *MessageDispatche
 // DispatchMessages sends all messages.
 func (d MessageDispatcher) DispatchMessages(ctx sdk.Context, contractAddr sdk.AccAddress, ibcPort string, msgs []wasmvmtypes.CosmosMsg) error {
 	for _, msg := range msgs {
-		events, _, err := d.messenger.DispatchMsg(ctx, contractAddr, ibcPort, msg)
+		msgCtx, profile := startCallProfile(ctx, callKindMsg, contractAddr)
+		profile.setMsg(msg, nil)
+		events, data, err := d.messenger.DispatchMsg(msgCtx, contractAddr, ibcPort, msg)
+		profile.finish(msgCtx)
+		profile.setResult(events, data, err)
 		if err != nil {
 			return err
 		}
@@ -82,6 +88,9 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		}
 		// first, we build a sub-context which we can use inside the submessages
 		subCtx, commit := ctx.CacheContext()
+		subCtx, profile := startCallProfile(subCtx, callKindSubMsg, contractAddr)
+		profile.setDetail(fmt.Sprintf("id=%d", msg.ID))
+		profile.setMsg(msg.Msg, &msg)
 
 		// check how much gas left locally, optionally wrap the gas meter
 		gasRemaining := ctx.GasMeter().Limit() - ctx.GasMeter().GasConsumed()
@@ -95,6 +104,8 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		} else {
 			events, data, err = d.messenger.DispatchMsg(subCtx, contractAddr, ibcPort, msg.Msg)
 		}
+		profile.finish(subCtx)
+		profile.setResult(events, data, err)
 
 		// if it succeeds, commit state changes from submessage, and pass on events to Event Manager
 		if err == nil {
@@ -25,8 +25,8 @@ func (m msgServer) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*t
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "sender")
 	}
-	codeID, err := m.keeper.Create(ctx, sdk.AccAddress(msg.Sender), msg.WASMByteCode, msg.Source, msg.Builder,
-		msg.InstantiatePermission)
+	codeID, err := m.keeper.CreateWithEventSchema(ctx, sdk.AccAddress(msg.Sender), msg.WASMByteCode, msg.Source, msg.Builder,
+		msg.InstantiatePermission, msg.EventSchema)
 	if err != nil {
 		return nil, err
 	}
@@ -83,6 +83,43 @@ func (m msgServer) InstantiateContract(goCtx context.Context, msg *types.MsgInst
 	}, nil
 }
 
+func (m msgServer) InstantiateContract2(goCtx context.Context, msg *types.MsgInstantiateContract2) (*types.MsgInstantiateContract2Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := sdk.ValidateAccAddress(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	var adminAddr sdk.AccAddress
+	if msg.Admin != "" {
+		if err = sdk.ValidateAccAddress(msg.Admin); err != nil {
+			return nil, sdkerrors.Wrap(err, "admin")
+		}
+		adminAddr = sdk.AccAddress(msg.Admin)
+	}
+
+	contractAddr, data, err := m.keeper.Instantiate2(ctx, msg.CodeID, sdk.AccAddress(msg.Sender), adminAddr, msg.InitMsg, msg.Label, msg.Funds, msg.Salt, msg.FixMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeInstantiateContract,
+		sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", msg.CodeID)),
+		sdk.NewAttribute(types.AttributeKeyContract, contractAddr.String()),
+	))
+
+	return &types.MsgInstantiateContract2Response{
+		Address: contractAddr.String(),
+		Data:    data,
+	}, nil
+}
+
 func (m msgServer) StoreCodeAndInstantiateContract(goCtx context.Context,
 	msg *types.MsgStoreCodeAndInstantiateContract) (*types.MsgStoreCodeAndInstantiateContractResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -90,8 +127,8 @@ func (m msgServer) StoreCodeAndInstantiateContract(goCtx context.Context,
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "sender")
 	}
-	codeID, err := m.keeper.Create(ctx, sdk.AccAddress(msg.Sender), msg.WASMByteCode, msg.Source, msg.Builder,
-		msg.InstantiatePermission)
+	codeID, err := m.keeper.CreateWithEventSchema(ctx, sdk.AccAddress(msg.Sender), msg.WASMByteCode, msg.Source, msg.Builder,
+		msg.InstantiatePermission, msg.EventSchema)
 	if err != nil {
 		return nil, err
 	}
@@ -291,3 +328,62 @@ func (m msgServer) UpdateContractStatus(goCtx context.Context, msg *types.MsgUpd
 
 	return &types.MsgUpdateContractStatusResponse{}, nil
 }
+
+// UpdateInstantiateConfig handles MsgUpdateInstantiateConfig
+// CONTRACT: msg.validateBasic() must be called before calling this
+func (m msgServer) UpdateInstantiateConfig(goCtx context.Context, msg *types.MsgUpdateInstantiateConfig) (*types.MsgUpdateInstantiateConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := sdk.ValidateAccAddress(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	if err = m.keeper.SetAccessConfig(ctx, msg.CodeID, sdk.AccAddress(msg.Sender), msg.NewInstantiatePermission); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+		sdk.NewEvent(
+			types.EventTypeUpdateCodeAccessConfig,
+			sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", msg.CodeID)),
+			sdk.NewAttribute(types.AttributeKeyAccessConfig, msg.NewInstantiatePermission.String()),
+		),
+	})
+
+	return &types.MsgUpdateInstantiateConfigResponse{}, nil
+}
+
+// SubmitCodeVerification handles MsgSubmitCodeVerification
+// CONTRACT: msg.validateBasic() must be called before calling this
+func (m msgServer) SubmitCodeVerification(goCtx context.Context, msg *types.MsgSubmitCodeVerification) (*types.MsgSubmitCodeVerificationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := sdk.ValidateAccAddress(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	if err = m.keeper.SubmitCodeVerification(ctx, msg.CodeID, sdk.AccAddress(msg.Sender), msg.SourceHash, msg.BuilderDigest, msg.Attestation); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+		sdk.NewEvent(
+			types.EventTypeSubmitCodeVerification,
+			sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", msg.CodeID)),
+			sdk.NewAttribute(types.AttributeKeySourceHash, msg.SourceHash.String()),
+			sdk.NewAttribute(types.AttributeKeyBuilderDigest, msg.BuilderDigest),
+		),
+	})
+
+	return &types.MsgSubmitCodeVerificationResponse{}, nil
+}
@@ -77,9 +77,12 @@ func WithCoinTransferrer(x CoinTransferrer) Option {
 	})
 }
 
+// WithVMCacheMetrics is an optional constructor parameter to register the wasmvm cache metrics
+// including the cache hits and misses by code id
 func WithVMCacheMetrics(r prometheus.Registerer) Option {
 	return optsFn(func(k *Keeper) {
-		NewWasmVMCacheMetricsCollector(k.wasmVM).Register(r)
+		k.vmCacheMetrics = NewWasmVMCacheMetricsCollector(k.wasmVM)
+		k.vmCacheMetrics.Register(r)
 	})
 }
 
@@ -88,3 +91,11 @@ func WithVMMetrics(provider MetricsProvider) Option {
 		k.metrics = provider()
 	})
 }
+
+// WithCallProfiling is an optional constructor parameter to enable the simulate only `ProfileExecute` query
+// which records the call tree of a contract execution with gas and storage usage.
+func WithCallProfiling() Option {
+	return optsFn(func(k *Keeper) {
+		k.callProfiling = true
+	})
+}
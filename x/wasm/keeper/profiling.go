@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/wasm/types"
+	wasmvmtypes "github.com/line/wasmvm/types"
+)
+
+// call kinds recorded in a call profile
+const (
+	callKindRoot        = "root"
+	callKindInstantiate = "instantiate"
+	callKindExecute     = "execute"
+	callKindMigrate     = "migrate"
+	callKindSudo        = "sudo"
+	callKindReply       = "reply"
+	callKindQuery       = "query"
+	callKindMsg         = "msg"
+	callKindSubMsg      = "submsg"
+	callKindSmartQuery  = "smart_query"
+)
+
+type callProfileKey struct{}
+
+// callProfileNode records gas and storage usage of a single contract call and its nested calls.
+// Dispatched messages and replies also record their message and result, which SimulateExecute
+// returns as the message trace. All methods are no-ops on a nil node so that callers do not need
+// to care whether profiling is active.
+type callProfileNode struct {
+	kind         string
+	detail       string
+	contract     sdk.AccAddress
+	startGas     sdk.Gas
+	gasUsed      sdk.Gas
+	bytesRead    uint64
+	bytesWritten uint64
+	err          string
+	children     []*callProfileNode
+
+	msg         json.RawMessage
+	subMsg      *wasmvmtypes.SubMsg
+	eventsStart int
+	events      []sdk.Event
+	data        []byte
+}
+
+// startCallProfile adds a new node to the call profile stored in the context, if any. The returned context
+// carries the new node so that nested calls are recorded as its children.
+func startCallProfile(ctx sdk.Context, kind string, contract sdk.AccAddress) (sdk.Context, *callProfileNode) {
+	if ctx.Context() == nil {
+		return ctx, nil
+	}
+	parent, ok := ctx.Context().Value(callProfileKey{}).(*callProfileNode)
+	if !ok || parent == nil {
+		return ctx, nil
+	}
+	node := &callProfileNode{
+		kind:        kind,
+		contract:    contract,
+		startGas:    ctx.GasMeter().GasConsumed(),
+		eventsStart: len(ctx.EventManager().Events()),
+	}
+	parent.children = append(parent.children, node)
+	return ctx.WithValue(callProfileKey{}, node), node
+}
+
+// finish records the gas consumed on the given context's gas meter since the node was started.
+func (n *callProfileNode) finish(ctx sdk.Context) {
+	if n == nil {
+		return
+	}
+	n.gasUsed = ctx.GasMeter().GasConsumed() - n.startGas
+}
+
+func (n *callProfileNode) setContract(contract sdk.AccAddress) {
+	if n == nil {
+		return
+	}
+	n.contract = contract
+}
+
+func (n *callProfileNode) setDetail(detail string) {
+	if n == nil {
+		return
+	}
+	n.detail = detail
+}
+
+func (n *callProfileNode) setError(err error) {
+	if n == nil || err == nil {
+		return
+	}
+	n.err = err.Error()
+}
+
+// setMsg records the dispatched message and, for a submessage, how it is replied to.
+func (n *callProfileNode) setMsg(msg wasmvmtypes.CosmosMsg, subMsg *wasmvmtypes.SubMsg) {
+	if n == nil {
+		return
+	}
+	if bz, err := json.Marshal(msg); err == nil {
+		n.msg = bz
+	}
+	n.subMsg = subMsg
+}
+
+// setResult records the error or the events and data of a dispatched message. Events are only
+// kept when the message succeeded as they are discarded otherwise.
+func (n *callProfileNode) setResult(events []sdk.Event, data [][]byte, err error) {
+	if n == nil {
+		return
+	}
+	if err != nil {
+		n.setError(err)
+		return
+	}
+	n.events = events
+	if len(data) > 0 {
+		n.data = data[0]
+	}
+}
+
+// setReplyResult records the error or the data of a reply with the events emitted to the context's
+// event manager since the node was started.
+func (n *callProfileNode) setReplyResult(ctx sdk.Context, res *sdk.Result, err error) {
+	if n == nil {
+		return
+	}
+	if err != nil {
+		n.setError(err)
+		return
+	}
+	if events := ctx.EventManager().Events(); len(events) > n.eventsStart {
+		n.events = events[n.eventsStart:]
+	}
+	if res != nil {
+		n.data = res.Data
+	}
+}
+
+// toProto converts the node and all children into the proto type.
+func (n *callProfileNode) toProto() types.CallProfile {
+	children := make([]types.CallProfile, len(n.children))
+	for i, c := range n.children {
+		children[i] = c.toProto()
+	}
+	return types.CallProfile{
+		Kind:                n.kind,
+		Detail:              n.detail,
+		Contract:            n.contract.String(),
+		GasUsed:             n.gasUsed,
+		StorageBytesRead:    n.bytesRead,
+		StorageBytesWritten: n.bytesWritten,
+		Error:               n.err,
+		Children:            children,
+	}
+}
+
+// newWasmStore wraps the contract store for the wasmvm and counts the bytes read and written
+// when a call profile is active.
+func newWasmStore(n *callProfileNode, store sdk.KVStore) types.WasmStore {
+	if n == nil {
+		return types.NewWasmStore(store)
+	}
+	return types.NewWasmStore(profiledKVStore{KVStore: store, node: n})
+}
+
+// profiledKVStore counts the key and value bytes that pass through the store
+type profiledKVStore struct {
+	sdk.KVStore
+	node *callProfileNode
+}
+
+func (s profiledKVStore) Get(key []byte) []byte {
+	value := s.KVStore.Get(key)
+	s.node.bytesRead += uint64(len(key) + len(value))
+	return value
+}
+
+func (s profiledKVStore) Set(key, value []byte) {
+	s.node.bytesWritten += uint64(len(key) + len(value))
+	s.KVStore.Set(key, value)
+}
+
+func (s profiledKVStore) Delete(key []byte) {
+	s.node.bytesWritten += uint64(len(key))
+	s.KVStore.Delete(key)
+}
+
+func (s profiledKVStore) Iterator(start, end []byte) sdk.Iterator {
+	return profiledIterator{Iterator: s.KVStore.Iterator(start, end), node: s.node}
+}
+
+func (s profiledKVStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	return profiledIterator{Iterator: s.KVStore.ReverseIterator(start, end), node: s.node}
+}
+
+// profiledIterator counts the bytes of all values read through the iterator
+type profiledIterator struct {
+	sdk.Iterator
+	node *callProfileNode
+}
+
+func (i profiledIterator) Value() []byte {
+	value := i.Iterator.Value()
+	i.node.bytesRead += uint64(len(i.Iterator.Key()) + len(value))
+	return value
+}
+
+// ProfileExecute executes the contract in a cached context that is never committed and returns the
+// recorded call tree. It requires call profiling to be enabled with the `WithCallProfiling` option.
+// Running out of gas is returned as an error together with the profile recorded so far.
+func (k Keeper) ProfileExecute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (res *sdk.Result, profile *types.CallProfile, err error) {
+	if !k.callProfiling {
+		return nil, nil, types.ErrProfilingDisabled
+	}
+	root := &callProfileNode{kind: callKindRoot, contract: caller, startGas: ctx.GasMeter().GasConsumed()}
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithValue(callProfileKey{}, root)
+
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			res, err = nil, sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", oog.Descriptor)
+		}
+		root.finish(ctx)
+		root.setError(err)
+		p := root.toProto()
+		profile = &p
+	}()
+	res, err = k.execute(cacheCtx, contractAddress, caller, msg, coins)
+	return res, nil, err
+}
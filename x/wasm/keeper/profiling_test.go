@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/wasm/types"
+	wasmvmtypes "github.com/line/wasmvm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileExecuteContract(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, ReflectFeatures, nil, nil, WithCallProfiling())
+	accKeeper, bankKeeper := keepers.AccountKeeper, keepers.BankKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	contractStart := sdk.NewCoins(sdk.NewInt64Coin("denom", 40000))
+	creator := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, deposit)
+	_, _, fred := keyPubAddr()
+
+	reflectCode, err := ioutil.ReadFile("./testdata/reflect.wasm")
+	require.NoError(t, err)
+	codeID, err := keepers.ContractKeeper.Create(ctx, creator, reflectCode, "", "", nil)
+	require.NoError(t, err)
+	contractAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, codeID, creator, "", []byte("{}"), "reflect contract 1", contractStart)
+	require.NoError(t, err)
+
+	reflectSend := ReflectHandleMsg{
+		ReflectSubCall: &reflectSubPayload{
+			Msgs: []wasmvmtypes.SubMsg{{
+				ID: 7,
+				Msg: wasmvmtypes.CosmosMsg{
+					Bank: &wasmvmtypes.BankMsg{
+						Send: &wasmvmtypes.SendMsg{
+							ToAddress: fred.String(),
+							Amount:    []wasmvmtypes.Coin{{Denom: "denom", Amount: "15000"}},
+						},
+					},
+				},
+				ReplyOn: wasmvmtypes.ReplyAlways,
+			}},
+		},
+	}
+	reflectSendBz, err := json.Marshal(reflectSend)
+	require.NoError(t, err)
+
+	q := Querier(keepers.WasmKeeper)
+	rsp, err := q.ProfileExecuteContract(sdk.WrapSDKContext(ctx), &types.QueryProfileExecuteContractRequest{
+		Sender:   creator.String(),
+		Contract: contractAddr.String(),
+		Msg:      reflectSendBz,
+	})
+	require.NoError(t, err)
+
+	root := rsp.Profile
+	assert.Equal(t, "root", root.Kind)
+	assert.Equal(t, creator.String(), root.Contract)
+	assert.Empty(t, root.Error)
+	assert.Equal(t, rsp.GasUsed, root.GasUsed)
+	require.Len(t, root.Children, 1)
+
+	exec := root.Children[0]
+	assert.Equal(t, "execute", exec.Kind)
+	assert.Equal(t, contractAddr.String(), exec.Contract)
+	assert.NotZero(t, exec.GasUsed)
+	assert.LessOrEqual(t, exec.GasUsed, root.GasUsed)
+	require.Len(t, exec.Children, 2)
+
+	subMsg, reply := exec.Children[0], exec.Children[1]
+	assert.Equal(t, "submsg", subMsg.Kind)
+	assert.Equal(t, "id=7", subMsg.Detail)
+	assert.Empty(t, subMsg.Error)
+	assert.NotZero(t, subMsg.GasUsed)
+	assert.Equal(t, "reply", reply.Kind)
+	assert.Equal(t, "id=7", reply.Detail)
+	assert.NotZero(t, reply.StorageBytesWritten)
+	assert.LessOrEqual(t, subMsg.GasUsed+reply.GasUsed, exec.GasUsed)
+
+	// nothing was committed
+	checkAccount(t, ctx, accKeeper, bankKeeper, fred, nil)
+	checkAccount(t, ctx, accKeeper, bankKeeper, contractAddr, contractStart)
+
+	// failures are reported in the profile
+	rsp, err = q.ProfileExecuteContract(sdk.WrapSDKContext(ctx), &types.QueryProfileExecuteContractRequest{
+		Sender:   creator.String(),
+		Contract: contractAddr.String(),
+		Msg:      []byte(`{"unknown":{}}`),
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, rsp.Profile.Error)
+	require.Len(t, rsp.Profile.Children, 1)
+	assert.Equal(t, "execute", rsp.Profile.Children[0].Kind)
+}
+
+func TestProfileExecuteContractDisabled(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, ReflectFeatures, nil, nil)
+	_, _, sender := keyPubAddr()
+	_, _, contract := keyPubAddr()
+
+	_, err := Querier(keepers.WasmKeeper).ProfileExecuteContract(sdk.WrapSDKContext(ctx), &types.QueryProfileExecuteContractRequest{
+		Sender:   sender.String(),
+		Contract: contract.String(),
+		Msg:      []byte(`{}`),
+	})
+	require.True(t, types.ErrProfilingDisabled.Is(err))
+}
@@ -34,6 +34,8 @@ func NewWasmProposalHandlerX(k types.ContractOpsKeeper, enabledProposalTypes []t
 			return handleStoreCodeProposal(ctx, k, *c)
 		case *types.InstantiateContractProposal:
 			return handleInstantiateProposal(ctx, k, *c)
+		case *types.InstantiateContract2Proposal:
+			return handleInstantiate2Proposal(ctx, k, *c)
 		case *types.MigrateContractProposal:
 			return handleMigrateProposal(ctx, k, *c)
 		case *types.UpdateAdminProposal:
@@ -46,6 +48,10 @@ func NewWasmProposalHandlerX(k types.ContractOpsKeeper, enabledProposalTypes []t
 			return handleUnpinCodesProposal(ctx, k, *c)
 		case *types.UpdateContractStatusProposal:
 			return handleUpdateContractStatusProposal(ctx, k, *c)
+		case *types.RegisterBlockHookProposal:
+			return handleRegisterBlockHookProposal(ctx, k, *c)
+		case *types.DeregisterBlockHookProposal:
+			return handleDeregisterBlockHookProposal(ctx, k, *c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized wasm proposal content type: %T", c)
 		}
@@ -101,6 +107,33 @@ func handleInstantiateProposal(ctx sdk.Context, k types.ContractOpsKeeper, p typ
 	return nil
 }
 
+func handleInstantiate2Proposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.InstantiateContract2Proposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	err := sdk.ValidateAccAddress(p.RunAs)
+	if err != nil {
+		return sdkerrors.Wrap(err, "run as address")
+	}
+	err = sdk.ValidateAccAddress(p.Admin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+
+	contractAddr, _, err := k.Instantiate2(ctx, p.CodeID, sdk.AccAddress(p.RunAs), sdk.AccAddress(p.Admin), p.InitMsg, p.Label, p.Funds, p.Salt, p.FixMsg)
+	if err != nil {
+		return err
+	}
+
+	ourEvent := sdk.NewEvent(
+		types.EventTypeInstantiateContract,
+		sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", p.CodeID)),
+		sdk.NewAttribute(types.AttributeKeyContract, contractAddr.String()),
+	)
+	ctx.EventManager().EmitEvent(ourEvent)
+	return nil
+}
+
 func handleMigrateProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.MigrateContractProposal) error {
 	if err := p.ValidateBasic(); err != nil {
 		return err
@@ -256,3 +289,33 @@ func handleUpdateContractStatusProposal(ctx sdk.Context, k types.ContractOpsKeep
 	))
 	return nil
 }
+
+func handleRegisterBlockHookProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.RegisterBlockHookProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := k.RegisterBlockHook(ctx, sdk.AccAddress(p.Contract), p.BlockHook); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegisterBlockHook,
+		sdk.NewAttribute(types.AttributeKeyContract, p.Contract),
+	))
+	return nil
+}
+
+func handleDeregisterBlockHookProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.DeregisterBlockHookProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := k.DeregisterBlockHook(ctx, sdk.AccAddress(p.Contract)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDeregisterBlockHook,
+		sdk.NewAttribute(types.AttributeKeyContract, p.Contract),
+	))
+	return nil
+}
@@ -157,7 +157,7 @@ func TestMigrateProposal(t *testing.T) {
 	key, err := hex.DecodeString("636F6E666967")
 	require.NoError(t, err)
 	m := types.Model{Key: key, Value: []byte(`{"verifier":"AAAAAAAAAAAAAAAAAAAAAAAAAAA=","beneficiary":"AAAAAAAAAAAAAAAAAAAAAAAAAAA=","funder":"AQEBAQEBAQEBAQEBAQEBAQEBAQE="}`)}
-	require.NoError(t, wasmKeeper.importContract(ctx, contractAddr, &contractInfoFixture, []types.Model{m}))
+	require.NoError(t, wasmKeeper.importContract(ctx, contractAddr, &contractInfoFixture, []types.Model{m}, nil))
 
 	migMsg := struct {
 		Verifier sdk.AccAddress `json:"verifier"`
@@ -276,7 +276,7 @@ func TestAdminProposals(t *testing.T) {
 			codeInfoFixture := types.CodeInfoFixture(types.WithSHA256CodeHash(wasmCode))
 			require.NoError(t, wasmKeeper.importCode(ctx, 1, codeInfoFixture, wasmCode))
 
-			require.NoError(t, wasmKeeper.importContract(ctx, contractAddr, &spec.state, []types.Model{}))
+			require.NoError(t, wasmKeeper.importContract(ctx, contractAddr, &spec.state, []types.Model{}, nil))
 			// when stored
 			storedProposal, err := govKeeper.SubmitProposal(ctx, spec.srcProposal)
 			require.NoError(t, err)
@@ -589,7 +589,7 @@ func TestUpdateContractStatusProposals(t *testing.T) {
 			codeInfoFixture := types.CodeInfoFixture(types.WithSHA256CodeHash(wasmCode))
 			require.NoError(t, wasmKeeper.importCode(ctx, 1, codeInfoFixture, wasmCode))
 
-			require.NoError(t, wasmKeeper.importContract(ctx, contractAddr, &spec.state, []types.Model{}))
+			require.NoError(t, wasmKeeper.importContract(ctx, contractAddr, &spec.state, []types.Model{}, nil))
 			// when stored
 			storedProposal, err := govKeeper.SubmitProposal(ctx, spec.srcProposal)
 			require.NoError(t, err)
@@ -606,3 +606,92 @@ func TestUpdateContractStatusProposals(t *testing.T) {
 		})
 	}
 }
+
+func TestBlockHookProposals(t *testing.T) {
+	var contractAddr = contractAddress(1, 1)
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	hook := types.BlockHook{BeginBlock: true, GasLimit: 100000}
+
+	specs := map[string]struct {
+		registered  bool
+		srcProposal govtypes.Content
+		expHook     *types.BlockHook
+		expErr      bool
+	}{
+		"register": {
+			srcProposal: &types.RegisterBlockHookProposal{
+				Title:       "Foo",
+				Description: "Bar",
+				Contract:    contractAddr.String(),
+				BlockHook:   hook,
+			},
+			expHook: &hook,
+		},
+		"update registration": {
+			registered: true,
+			srcProposal: &types.RegisterBlockHookProposal{
+				Title:       "Foo",
+				Description: "Bar",
+				Contract:    contractAddr.String(),
+				BlockHook:   types.BlockHook{EndBlock: true, GasLimit: 1},
+			},
+			expHook: &types.BlockHook{EndBlock: true, GasLimit: 1},
+		},
+		"register unknown contract": {
+			srcProposal: &types.RegisterBlockHookProposal{
+				Title:       "Foo",
+				Description: "Bar",
+				Contract:    contractAddress(1, 2).String(),
+				BlockHook:   hook,
+			},
+			expErr: true,
+		},
+		"deregister": {
+			registered: true,
+			srcProposal: &types.DeregisterBlockHookProposal{
+				Title:       "Foo",
+				Description: "Bar",
+				Contract:    contractAddr.String(),
+			},
+		},
+		"deregister not registered": {
+			srcProposal: &types.DeregisterBlockHookProposal{
+				Title:       "Foo",
+				Description: "Bar",
+				Contract:    contractAddr.String(),
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, "staking", nil, nil)
+			govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+
+			codeInfoFixture := types.CodeInfoFixture(types.WithSHA256CodeHash(wasmCode))
+			require.NoError(t, wasmKeeper.importCode(ctx, 1, codeInfoFixture, wasmCode))
+			contractInfo := types.ContractInfoFixture()
+			require.NoError(t, wasmKeeper.importContract(ctx, contractAddr, &contractInfo, []types.Model{}, nil))
+			if spec.registered {
+				require.NoError(t, wasmKeeper.setBlockHook(ctx, contractAddr, hook))
+			}
+
+			// when stored
+			storedProposal, err := govKeeper.SubmitProposal(ctx, spec.srcProposal)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// and execute proposal
+			handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+			err = handler(ctx, storedProposal.GetContent())
+			require.NoError(t, err)
+
+			// then
+			assert.Equal(t, spec.expHook, wasmKeeper.GetBlockHook(ctx, contractAddr))
+		})
+	}
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
 	"runtime/debug"
 
 	"github.com/line/lbm-sdk/codec"
@@ -141,6 +143,52 @@ func (q GrpcQuerier) AllContractState(c context.Context, req *types.QueryAllCont
 	}, nil
 }
 
+// ContractStorageUsage returns the state size and the storage deposit of a contract
+func (q GrpcQuerier) ContractStorageUsage(c context.Context, req *types.QueryContractStorageUsageRequest) (*types.QueryContractStorageUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateAccAddress(req.Address); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.keeper.HasContractInfo(ctx, sdk.AccAddress(req.Address)) {
+		return nil, types.ErrNotFound
+	}
+	return &types.QueryContractStorageUsageResponse{
+		StorageUsage: q.keeper.GetContractStorageUsage(ctx, sdk.AccAddress(req.Address)),
+	}, nil
+}
+
+func (q GrpcQuerier) EventSchema(c context.Context, req *types.QueryEventSchemaRequest) (*types.QueryEventSchemaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.CodeId == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "code id")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if q.keeper.GetCodeInfo(ctx, req.CodeId) == nil {
+		return nil, types.ErrNotFound
+	}
+	schema := q.keeper.GetEventSchema(ctx, req.CodeId)
+	if schema == nil {
+		return nil, sdkerrors.Wrap(types.ErrNotFound, "event schema")
+	}
+	return &types.QueryEventSchemaResponse{EventSchema: schema}, nil
+}
+
+func (q GrpcQuerier) StargateAllowlist(c context.Context, req *types.QueryStargateAllowlistRequest) (*types.QueryStargateAllowlistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	params := q.keeper.GetParams(sdk.UnwrapSDKContext(c))
+	return &types.QueryStargateAllowlistResponse{
+		QueryPaths:  params.StargateQueryAllowlist,
+		MsgTypeURLs: params.StargateMsgAllowlist,
+	}, nil
+}
+
 func (q GrpcQuerier) RawContractState(c context.Context, req *types.QueryRawContractStateRequest) (*types.QueryRawContractStateResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -250,6 +298,268 @@ func (q GrpcQuerier) Codes(c context.Context, req *types.QueryCodesRequest) (*ty
 	return &types.QueryCodesResponse{CodeInfos: r, Pagination: pageRes}, nil
 }
 
+func (q GrpcQuerier) BlockHooks(c context.Context, req *types.QueryBlockHooksRequest) (*types.QueryBlockHooksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]types.BlockHookInfo, 0)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.BlockHookKeyPrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var hook types.BlockHook
+			if err := q.cdc.UnmarshalBinaryBare(value, &hook); err != nil {
+				return false, err
+			}
+			r = append(r, types.BlockHookInfo{
+				Address:   string(key),
+				BlockHook: hook,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryBlockHooksResponse{BlockHooks: r, Pagination: pageRes}, nil
+}
+
+func (q GrpcQuerier) CodeVerifications(c context.Context, req *types.QueryCodeVerificationsRequest) (*types.QueryCodeVerificationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.CodeId == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "code id")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]types.CodeVerification, 0)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetCodeVerificationPrefix(req.CodeId))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var verification types.CodeVerification
+			if err := q.cdc.UnmarshalBinaryBare(value, &verification); err != nil {
+				return false, err
+			}
+			r = append(r, verification)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCodeVerificationsResponse{Verifications: r, Pagination: pageRes}, nil
+}
+
+func (q GrpcQuerier) VerifiedCodes(c context.Context, req *types.QueryVerifiedCodesRequest) (*types.QueryVerifiedCodesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]types.CodeVerification, 0)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.CodeVerificationPrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var verification types.CodeVerification
+			if err := q.cdc.UnmarshalBinaryBare(value, &verification); err != nil {
+				return false, err
+			}
+			r = append(r, verification)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryVerifiedCodesResponse{Verifications: r, Pagination: pageRes}, nil
+}
+
+// ExportContract returns a page of the contract state. The first page additionally carries the code,
+// contract info and history so that a client can assemble a full ContractExport at a fixed height.
+func (q GrpcQuerier) ExportContract(c context.Context, req *types.QueryExportContractRequest) (*types.QueryExportContractResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateAccAddress(req.Address); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	contractAddr := sdk.AccAddress(req.Address)
+	contractInfo := q.keeper.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return nil, types.ErrNotFound
+	}
+
+	export := types.ContractExport{
+		Height:   ctx.BlockHeight(),
+		Contract: types.Contract{ContractAddress: req.Address, ContractState: make([]types.Model, 0)},
+	}
+	if req.Pagination == nil || (len(req.Pagination.Key) == 0 && req.Pagination.Offset == 0) {
+		codeInfo := q.keeper.GetCodeInfo(ctx, contractInfo.CodeID)
+		if codeInfo == nil {
+			return nil, sdkerrors.Wrap(types.ErrNotFound, "code info")
+		}
+		bytecode, err := q.keeper.GetByteCode(ctx, contractInfo.CodeID)
+		if err != nil {
+			return nil, err
+		}
+		export.Code = types.Code{
+			CodeID:        contractInfo.CodeID,
+			CodeInfo:      *codeInfo,
+			CodeBytes:     bytecode,
+			Pinned:        q.keeper.IsPinnedCode(ctx, contractInfo.CodeID),
+			EventSchema:   q.keeper.GetEventSchema(ctx, contractInfo.CodeID),
+			Verifications: q.keeper.GetCodeVerifications(ctx, contractInfo.CodeID),
+		}
+		export.Contract.ContractInfo = *contractInfo
+		export.Contract.ContractInfo.Created = nil // redact
+		export.History = q.keeper.GetContractHistory(ctx, contractAddr)
+	}
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetContractStorePrefix(contractAddr))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			export.Contract.ContractState = append(export.Contract.ContractState, types.Model{
+				Key:   key,
+				Value: value,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryExportContractResponse{Export: export, Pagination: pageRes}, nil
+}
+
+func (q GrpcQuerier) BuildAddress(c context.Context, req *types.QueryBuildAddressRequest) (*types.QueryBuildAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	codeHash, err := hex.DecodeString(req.CodeHash)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid code hash: %s", err)
+	}
+	if err := sdk.ValidateAccAddress(req.CreatorAddress); err != nil {
+		return nil, sdkerrors.Wrap(err, "creator address")
+	}
+	salt, err := hex.DecodeString(req.Salt)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid salt: %s", err)
+	}
+	if err := types.ValidateSalt(salt); err != nil {
+		return nil, sdkerrors.Wrap(err, "salt")
+	}
+	if len(req.InitArgs) != 0 && !json.Valid(req.InitArgs) {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "init args json")
+	}
+	contractAddr := BuildContractAddressPredictable(codeHash, sdk.AccAddress(req.CreatorAddress), salt, req.InitArgs)
+	return &types.QueryBuildAddressResponse{Address: contractAddr.String()}, nil
+}
+
+// callProfiler is implemented by keepers that can record the call tree of a contract execution
+type callProfiler interface {
+	ProfileExecute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (*sdk.Result, *types.CallProfile, error)
+}
+
+// ProfileExecuteContract simulates a contract execution and returns the recorded call tree. Failures of the
+// execution itself are not returned as error but reported in the profile so that the costs up to the failure
+// can be inspected.
+func (q GrpcQuerier) ProfileExecuteContract(c context.Context, req *types.QueryProfileExecuteContractRequest) (rsp *types.QueryProfileExecuteContractResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateAccAddress(req.Sender); err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	if err := sdk.ValidateAccAddress(req.Contract); err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+	if !req.Funds.IsValid() {
+		return nil, sdkerrors.ErrInvalidCoins
+	}
+	profiler, ok := q.keeper.(callProfiler)
+	if !ok {
+		return nil, types.ErrProfilingDisabled
+	}
+	ctx := sdk.UnwrapSDKContext(c).WithGasMeter(sdk.NewGasMeter(q.queryGasLimit))
+	// recover from panics other than out-of-gas which is handled by the profiler
+	defer func() {
+		if r := recover(); r != nil {
+			err, rsp = sdkerrors.ErrPanic, nil
+			moduleLogger(ctx).
+				Debug("profile execute contract",
+					"error", "recovering panic",
+					"contract-address", req.Contract,
+					"stacktrace", string(debug.Stack()))
+		}
+	}()
+
+	res, profile, err := profiler.ProfileExecute(ctx, sdk.AccAddress(req.Contract), sdk.AccAddress(req.Sender), req.Msg, req.Funds)
+	if profile == nil {
+		return nil, err
+	}
+	rsp = &types.QueryProfileExecuteContractResponse{
+		GasUsed: ctx.GasMeter().GasConsumed(),
+		Profile: *profile,
+	}
+	if res != nil {
+		rsp.Data = res.Data
+	}
+	return rsp, nil
+}
+
+// executeSimulator is implemented by keepers that can simulate a contract execution with the message trace
+type executeSimulator interface {
+	SimulateExecute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (*sdk.Result, []types.SimulatedMessage, error)
+}
+
+// SimulateExecute simulates a contract execution and returns the dispatched messages, replies and events.
+// Failures of the execution itself are not returned as error but reported in the response together with
+// the messages dispatched up to the failure.
+func (q GrpcQuerier) SimulateExecute(c context.Context, req *types.QuerySimulateExecuteRequest) (rsp *types.QuerySimulateExecuteResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateAccAddress(req.Sender); err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	if err := sdk.ValidateAccAddress(req.Contract); err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+	if !req.Funds.IsValid() {
+		return nil, sdkerrors.ErrInvalidCoins
+	}
+	simulator, ok := q.keeper.(executeSimulator)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "execute simulation not supported")
+	}
+	ctx := sdk.UnwrapSDKContext(c).WithGasMeter(sdk.NewGasMeter(q.queryGasLimit))
+	// recover from panics other than out-of-gas which is handled by the simulator
+	defer func() {
+		if r := recover(); r != nil {
+			err, rsp = sdkerrors.ErrPanic, nil
+			moduleLogger(ctx).
+				Debug("simulate execute contract",
+					"error", "recovering panic",
+					"contract-address", req.Contract,
+					"stacktrace", string(debug.Stack()))
+		}
+	}()
+
+	res, messages, err := simulator.SimulateExecute(ctx, sdk.AccAddress(req.Contract), sdk.AccAddress(req.Sender), req.Msg, req.Funds)
+	rsp = &types.QuerySimulateExecuteResponse{
+		GasUsed:  ctx.GasMeter().GasConsumed(),
+		Messages: messages,
+	}
+	if err != nil {
+		rsp.Error = err.Error()
+		return rsp, nil
+	}
+	rsp.Data = res.Data
+	rsp.Events = res.Events
+	return rsp, nil
+}
+
 func queryContractInfo(ctx sdk.Context, addr sdk.AccAddress, keeper types.ViewKeeper) (*types.QueryContractInfoResponse, error) {
 	info := keeper.GetContractInfo(ctx, addr)
 	if info == nil {
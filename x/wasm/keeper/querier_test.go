@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"google.golang.org/grpc/codes"
@@ -649,6 +650,76 @@ func TestQueryContractInfo(t *testing.T) {
 
 }
 
+func TestQueryExportContract(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contractAddr := exampleContract.Contract
+	require.NoError(t, k.importContractState(ctx, contractAddr, []types.Model{
+		{Key: []byte{0x0, 0x1}, Value: []byte(`{"count":8}`)},
+		{Key: []byte("foo"), Value: []byte(`"bar"`)},
+	}))
+	var expState []types.Model
+	for iter := k.GetContractState(ctx, contractAddr); iter.Valid(); iter.Next() {
+		expState = append(expState, types.Model{Key: iter.Key(), Value: iter.Value()})
+	}
+	q := Querier(k)
+
+	// when paging through the state
+	var export types.ContractExport
+	var nextKey []byte
+	for i := 0; ; i++ {
+		rsp, err := q.ExportContract(sdk.WrapSDKContext(ctx), &types.QueryExportContractRequest{
+			Address:    contractAddr.String(),
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 1},
+		})
+		require.NoError(t, err)
+		require.Len(t, rsp.Export.Contract.ContractState, 1)
+		if i == 0 {
+			export = rsp.Export
+		} else {
+			assert.Empty(t, rsp.Export.Code.CodeBytes)
+			assert.Empty(t, rsp.Export.History)
+			export.Contract.ContractState = append(export.Contract.ContractState, rsp.Export.Contract.ContractState...)
+		}
+		if len(rsp.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = rsp.Pagination.NextKey
+	}
+
+	// then
+	assert.Equal(t, ctx.BlockHeight(), export.Height)
+	assert.Equal(t, exampleContract.CodeID, export.Code.CodeID)
+	assert.Equal(t, exampleContract.CodeID, export.Contract.ContractInfo.CodeID)
+	assert.Equal(t, expState, export.Contract.ContractState)
+	assert.Equal(t, k.GetContractHistory(ctx, contractAddr), export.History)
+	assert.Error(t, export.ValidateBasic())
+	export.Hash, _ = export.ComputeHash()
+	require.NoError(t, export.ValidateBasic())
+
+	// and the export loads into a new chain
+	dstKeeper, dstCtx, _ := setupKeeper(t)
+	_, err := InitGenesis(dstCtx, dstKeeper, types.GenesisState{
+		Params:    types.DefaultParams(),
+		Codes:     []types.Code{export.Code},
+		Contracts: []types.Contract{export.Contract},
+		Sequences: []types.Sequence{
+			{IDKey: types.KeyLastCodeID, Value: export.Code.CodeID + 1},
+			{IDKey: types.KeyLastInstanceID, Value: 2},
+		},
+	}, &StakingKeeperMock{}, nil)
+	require.NoError(t, err)
+	for _, m := range expState {
+		assert.Equal(t, m.Value, dstKeeper.QueryRaw(dstCtx, contractAddr, m.Key))
+	}
+
+	// and unknown contracts are not found
+	_, err = q.ExportContract(sdk.WrapSDKContext(ctx), &types.QueryExportContractRequest{Address: RandomBech32AccountAddress(t)})
+	assert.True(t, types.ErrNotFound.Is(err), "got %+v", err)
+}
+
 func fromBase64(s string) []byte {
 	r, err := base64.StdEncoding.DecodeString(s)
 	if err != nil {
@@ -656,3 +727,72 @@ func fromBase64(s string) []byte {
 	}
 	return r
 }
+
+func TestQueryBuildAddress(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	_, _, creator := keyPubAddr()
+	checksum := []byte("checksum")
+	salt := []byte("salt")
+
+	specs := map[string]struct {
+		src    *types.QueryBuildAddressRequest
+		exp    sdk.AccAddress
+		expErr bool
+	}{
+		"without init args": {
+			src: &types.QueryBuildAddressRequest{
+				CodeHash:       hex.EncodeToString(checksum),
+				CreatorAddress: creator.String(),
+				Salt:           hex.EncodeToString(salt),
+			},
+			exp: BuildContractAddressPredictable(checksum, creator, salt, nil),
+		},
+		"with init args": {
+			src: &types.QueryBuildAddressRequest{
+				CodeHash:       hex.EncodeToString(checksum),
+				CreatorAddress: creator.String(),
+				Salt:           hex.EncodeToString(salt),
+				InitArgs:       []byte(`{"foo":"bar"}`),
+			},
+			exp: BuildContractAddressPredictable(checksum, creator, salt, []byte(`{"foo":"bar"}`)),
+		},
+		"invalid code hash": {
+			src: &types.QueryBuildAddressRequest{
+				CodeHash:       "invalid",
+				CreatorAddress: creator.String(),
+				Salt:           hex.EncodeToString(salt),
+			},
+			expErr: true,
+		},
+		"invalid creator": {
+			src: &types.QueryBuildAddressRequest{
+				CodeHash:       hex.EncodeToString(checksum),
+				CreatorAddress: "invalid",
+				Salt:           hex.EncodeToString(salt),
+			},
+			expErr: true,
+		},
+		"empty salt": {
+			src: &types.QueryBuildAddressRequest{
+				CodeHash:       hex.EncodeToString(checksum),
+				CreatorAddress: creator.String(),
+			},
+			expErr: true,
+		},
+		"nil request": {
+			expErr: true,
+		},
+	}
+	q := Querier(keepers.WasmKeeper)
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.BuildAddress(sdk.WrapSDKContext(ctx), spec.src)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp.String(), got.Address)
+		})
+	}
+}
@@ -49,18 +49,43 @@ func (q QueryHandler) Query(request wasmvmtypes.QueryRequest, gasLimit uint64) (
 	// set a limit for a subctx
 	sdkGas := gasLimit / q.GasMultiplier
 	subctx := q.Ctx.WithGasMeter(sdk.NewGasMeter(sdkGas))
+	subctx, profile := startCallProfile(subctx, callKindQuery, q.Caller)
+	profile.setDetail(queryPluginName(request))
 
 	// make sure we charge the higher level context even on panic
 	defer func() {
+		profile.finish(subctx)
 		q.Ctx.GasMeter().ConsumeGas(subctx.GasMeter().GasConsumed(), "contract sub-query")
 	}()
-	return q.Plugins.HandleQuery(subctx, q.Caller, request)
+	res, err := q.Plugins.HandleQuery(subctx, q.Caller, request)
+	profile.setError(err)
+	return res, err
 }
 
 func (q QueryHandler) GasConsumed() uint64 {
 	return q.Ctx.GasMeter().GasConsumed()
 }
 
+// queryPluginName returns the name of the query plugin responsible for the request
+func queryPluginName(request wasmvmtypes.QueryRequest) string {
+	switch {
+	case request.Bank != nil:
+		return "bank"
+	case request.Custom != nil:
+		return "custom"
+	case request.IBC != nil:
+		return "ibc"
+	case request.Staking != nil:
+		return "staking"
+	case request.Stargate != nil:
+		return "stargate"
+	case request.Wasm != nil:
+		return "wasm"
+	default:
+		return "unknown"
+	}
+}
+
 type CustomQuerier func(ctx sdk.Context, request json.RawMessage) ([]byte, error)
 
 type QueryPlugins struct {
@@ -78,6 +103,7 @@ type contractMetaDataSource interface {
 
 type wasmQueryKeeper interface {
 	contractMetaDataSource
+	stargateQueryAllowlist
 	QueryRaw(ctx sdk.Context, contractAddress sdk.AccAddress, key []byte) []byte
 	QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
 }
@@ -95,7 +121,7 @@ func DefaultQueryPlugins(
 		Custom:   CustomQuerierImpl(queryRouter),
 		IBC:      IBCQuerier(wasm, channelKeeper),
 		Staking:  StakingQuerier(staking, distKeeper),
-		Stargate: StargateQuerier(queryRouter),
+		Stargate: StargateQuerier(queryRouter, wasm),
 		Wasm:     WasmQuerier(wasm),
 	}
 }
@@ -275,8 +301,12 @@ func IBCQuerier(wasm contractMetaDataSource, channelKeeper types.ChannelKeeper)
 	}
 }
 
-func StargateQuerier(queryRouter GRPCQueryRouter) func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
+// StargateQuerier routes the stargate queries of the paths in the allowlist to the gRPC query router
+func StargateQuerier(queryRouter GRPCQueryRouter, allowlist stargateQueryAllowlist) func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
 	return func(ctx sdk.Context, msg *wasmvmtypes.StargateQuery) ([]byte, error) {
+		if !allowlist.IsStargateQueryAllowed(ctx, msg.Path) {
+			return nil, sdkerrors.Wrapf(types.ErrStargateNotAllowed, "query path %q", msg.Path)
+		}
 		route := queryRouter.Route(msg.Path)
 		if route == nil {
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("No route to query '%s'", msg.Path)}
@@ -368,6 +368,15 @@ func TestReflectStargateQuery(t *testing.T) {
 	})
 	require.NoError(t, err)
 
+	// the query path is rejected when it is not in the allowlist
+	_, err = keeper.QuerySmart(ctx, contractAddr, protoQueryBz)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "stargate call not allowed")
+
+	params := keeper.GetParams(ctx)
+	params.StargateQueryAllowlist = []string{"/lbm.bank.v1.Query/AllBalances"}
+	keeper.setParams(ctx, params)
+
 	// make a query on the chain
 	protoRes, err := keeper.QuerySmart(ctx, contractAddr, protoQueryBz)
 	require.NoError(t, err)
@@ -22,7 +22,7 @@ func (k Keeper) OnOpenChannel(
 ) error {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-open-channel")
 
-	_, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
@@ -31,12 +31,19 @@ func (k Keeper) OnOpenChannel(
 	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddr, k.getGasMultiplier(ctx))
 
 	gas := gasForContract(ctx, k.getGasMultiplier(ctx))
-	wasmStore := types.NewWasmStore(prefixStore)
+	store, storageUsage := k.newStorageUsageStore(ctx, contractAddr, prefixStore)
+	wasmStore := types.NewWasmStore(store)
+	k.recordCodeExecution(ctx, contractInfo.CodeID, codeInfo.CodeHash)
+	vmCalled := k.observeVMCache(contractInfo.CodeID)
 	gasUsed, execErr := k.wasmVM.IBCChannelOpen(codeInfo.CodeHash, env, channel, wasmStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas)
+	vmCalled()
 	k.consumeGas(ctx, gasUsed)
 	if execErr != nil {
 		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, storageUsage); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -63,15 +70,25 @@ func (k Keeper) OnConnectChannel(
 	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddr, k.getGasMultiplier(ctx))
 
 	gas := gasForContract(ctx, k.getGasMultiplier(ctx))
-	wasmStore := types.NewWasmStore(prefixStore)
+	store, storageUsage := k.newStorageUsageStore(ctx, contractAddr, prefixStore)
+	wasmStore := types.NewWasmStore(store)
+	k.recordCodeExecution(ctx, contractInfo.CodeID, codeInfo.CodeHash)
+	vmCalled := k.observeVMCache(contractInfo.CodeID)
 	res, gasUsed, execErr := k.wasmVM.IBCChannelConnect(codeInfo.CodeHash, env, channel, wasmStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas)
+	vmCalled()
 	k.consumeGas(ctx, gasUsed)
 	if execErr != nil {
 		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, storageUsage); err != nil {
+		return err
+	}
 
 	// emit all events from this contract itself
 	events := types.ParseEvents(res.Attributes, contractAddr)
+	if err := k.validateContractEvents(ctx, contractInfo.CodeID, events); err != nil {
+		return err
+	}
 	ctx.EventManager().EmitEvents(events)
 
 	if _, err := k.wasmVMResponseHandler.Handle(ctx, contractAddr, contractInfo.IBCPortID, res.Submessages, res.Messages, nil); err != nil {
@@ -102,15 +119,25 @@ func (k Keeper) OnCloseChannel(
 	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddr, k.getGasMultiplier(ctx))
 
 	gas := gasForContract(ctx, k.getGasMultiplier(ctx))
-	wasmStore := types.NewWasmStore(prefixStore)
+	store, storageUsage := k.newStorageUsageStore(ctx, contractAddr, prefixStore)
+	wasmStore := types.NewWasmStore(store)
+	k.recordCodeExecution(ctx, contractInfo.CodeID, codeInfo.CodeHash)
+	vmCalled := k.observeVMCache(contractInfo.CodeID)
 	res, gasUsed, execErr := k.wasmVM.IBCChannelClose(codeInfo.CodeHash, params, channel, wasmStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas)
+	vmCalled()
 	k.consumeGas(ctx, gasUsed)
 	if execErr != nil {
 		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, storageUsage); err != nil {
+		return err
+	}
 
 	// emit all events from this contract itself
 	events := types.ParseEvents(res.Attributes, contractAddr)
+	if err := k.validateContractEvents(ctx, contractInfo.CodeID, events); err != nil {
+		return err
+	}
 	ctx.EventManager().EmitEvents(events)
 
 	if _, err := k.wasmVMResponseHandler.Handle(ctx, contractAddr, contractInfo.IBCPortID, res.Submessages, res.Messages, nil); err != nil {
@@ -140,15 +167,25 @@ func (k Keeper) OnRecvPacket(
 	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddr, k.getGasMultiplier(ctx))
 
 	gas := gasForContract(ctx, k.getGasMultiplier(ctx))
-	wasmStore := types.NewWasmStore(prefixStore)
+	store, storageUsage := k.newStorageUsageStore(ctx, contractAddr, prefixStore)
+	wasmStore := types.NewWasmStore(store)
+	k.recordCodeExecution(ctx, contractInfo.CodeID, codeInfo.CodeHash)
+	vmCalled := k.observeVMCache(contractInfo.CodeID)
 	res, gasUsed, execErr := k.wasmVM.IBCPacketReceive(codeInfo.CodeHash, env, packet, wasmStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas)
+	vmCalled()
 	k.consumeGas(ctx, gasUsed)
 	if execErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, storageUsage); err != nil {
+		return nil, err
+	}
 
 	// emit all events from this contract itself
 	events := types.ParseEvents(res.Attributes, contractAddr)
+	if err := k.validateContractEvents(ctx, contractInfo.CodeID, events); err != nil {
+		return nil, err
+	}
 	ctx.EventManager().EmitEvents(events)
 	return k.wasmVMResponseHandler.Handle(ctx, contractAddr, contractInfo.IBCPortID, res.Submessages, res.Messages, res.Acknowledgement)
 }
@@ -175,15 +212,25 @@ func (k Keeper) OnAckPacket(
 	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddr, k.getGasMultiplier(ctx))
 
 	gas := gasForContract(ctx, k.getGasMultiplier(ctx))
-	wasmStore := types.NewWasmStore(prefixStore)
+	store, storageUsage := k.newStorageUsageStore(ctx, contractAddr, prefixStore)
+	wasmStore := types.NewWasmStore(store)
+	k.recordCodeExecution(ctx, contractInfo.CodeID, codeInfo.CodeHash)
+	vmCalled := k.observeVMCache(contractInfo.CodeID)
 	res, gasUsed, execErr := k.wasmVM.IBCPacketAck(codeInfo.CodeHash, env, acknowledgement, wasmStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas)
+	vmCalled()
 	k.consumeGas(ctx, gasUsed)
 	if execErr != nil {
 		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, storageUsage); err != nil {
+		return err
+	}
 
 	// emit all events from this contract itself
 	events := types.ParseEvents(res.Attributes, contractAddr)
+	if err := k.validateContractEvents(ctx, contractInfo.CodeID, events); err != nil {
+		return err
+	}
 	ctx.EventManager().EmitEvents(events)
 
 	if _, err := k.wasmVMResponseHandler.Handle(ctx, contractAddr, contractInfo.IBCPortID, res.Submessages, res.Messages, nil); err != nil {
@@ -211,15 +258,25 @@ func (k Keeper) OnTimeoutPacket(
 	querier := NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddr, k.getGasMultiplier(ctx))
 
 	gas := gasForContract(ctx, k.getGasMultiplier(ctx))
-	wasmStore := types.NewWasmStore(prefixStore)
+	store, storageUsage := k.newStorageUsageStore(ctx, contractAddr, prefixStore)
+	wasmStore := types.NewWasmStore(store)
+	k.recordCodeExecution(ctx, contractInfo.CodeID, codeInfo.CodeHash)
+	vmCalled := k.observeVMCache(contractInfo.CodeID)
 	res, gasUsed, execErr := k.wasmVM.IBCPacketTimeout(codeInfo.CodeHash, env, packet, wasmStore, k.cosmwasmAPI(ctx), querier, ctx.GasMeter(), gas)
+	vmCalled()
 	k.consumeGas(ctx, gasUsed)
 	if execErr != nil {
 		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, storageUsage); err != nil {
+		return err
+	}
 
 	// emit all events from this contract itself
 	events := types.ParseEvents(res.Attributes, contractAddr)
+	if err := k.validateContractEvents(ctx, contractInfo.CodeID, events); err != nil {
+		return err
+	}
 	ctx.EventManager().EmitEvents(events)
 
 	if _, err := k.wasmVMResponseHandler.Handle(ctx, contractAddr, contractInfo.IBCPortID, res.Submessages, res.Messages, nil); err != nil {
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/wasm/types"
+)
+
+// replyOnNever is recorded for messages that are not dispatched as submessages
+const replyOnNever = "never"
+
+// simulatedMessages returns the messages dispatched within the call profile node in order of execution.
+// Messages dispatched by the contracts a message calls are nested in it, and the reply call following a
+// submessage is attached to it.
+func (n *callProfileNode) simulatedMessages() []types.SimulatedMessage {
+	var res []types.SimulatedMessage
+	for i, c := range n.children {
+		switch c.kind {
+		case callKindMsg, callKindSubMsg:
+			res = append(res, c.toSimulatedMessage())
+		case callKindReply:
+			if prev := i - 1; prev >= 0 && n.children[prev].kind == callKindSubMsg {
+				res[len(res)-1].Reply = c.toSimulatedReply()
+				continue
+			}
+			fallthrough
+		default:
+			res = append(res, c.simulatedMessages()...)
+		}
+	}
+	return res
+}
+
+// toSimulatedMessage converts a node of a dispatched message into the proto type.
+func (n *callProfileNode) toSimulatedMessage() types.SimulatedMessage {
+	msg := types.SimulatedMessage{
+		Contract: n.contract.String(),
+		Msg:      n.msg,
+		ReplyOn:  replyOnNever,
+		GasUsed:  n.gasUsed,
+		Data:     n.data,
+		Events:   sdk.Events(n.events).ToABCIEvents(),
+		Error:    n.err,
+		Messages: n.simulatedMessages(),
+	}
+	if s := n.subMsg; s != nil {
+		msg.SubMsgID = s.ID
+		msg.ReplyOn = string(s.ReplyOn)
+		if s.GasLimit != nil {
+			msg.GasLimit = *s.GasLimit
+		}
+	}
+	return msg
+}
+
+// toSimulatedReply converts a node of a reply call into the proto type.
+func (n *callProfileNode) toSimulatedReply() *types.SimulatedReply {
+	return &types.SimulatedReply{
+		GasUsed:  n.gasUsed,
+		Data:     n.data,
+		Events:   sdk.Events(n.events).ToABCIEvents(),
+		Error:    n.err,
+		Messages: n.simulatedMessages(),
+	}
+}
+
+// SimulateExecute executes the contract in a cached context that is never committed and returns the messages
+// dispatched by the contracts with their replies and events in order of execution, as recorded in the call
+// profile of the execution. The events of the whole execution are returned with the result. Running out of gas
+// is returned as an error together with the messages recorded so far.
+func (k Keeper) SimulateExecute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (res *sdk.Result, messages []types.SimulatedMessage, err error) {
+	root := &callProfileNode{kind: callKindRoot, contract: caller, startGas: ctx.GasMeter().GasConsumed()}
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager()).WithValue(callProfileKey{}, root)
+
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			res, err = nil, sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", oog.Descriptor)
+		}
+		messages = root.simulatedMessages()
+	}()
+	res, err = k.execute(cacheCtx, contractAddress, caller, msg, coins)
+	if err != nil {
+		return nil, nil, err
+	}
+	res.Events = cacheCtx.EventManager().ABCIEvents()
+	return res, nil, nil
+}
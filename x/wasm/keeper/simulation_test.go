@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/wasm/types"
+	wasmvmtypes "github.com/line/wasmvm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateExecute(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, ReflectFeatures, nil, nil)
+	accKeeper, bankKeeper := keepers.AccountKeeper, keepers.BankKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	contractStart := sdk.NewCoins(sdk.NewInt64Coin("denom", 40000))
+	creator := createFakeFundedAccount(t, ctx, accKeeper, bankKeeper, deposit)
+	_, _, fred := keyPubAddr()
+
+	reflectCode, err := ioutil.ReadFile("./testdata/reflect.wasm")
+	require.NoError(t, err)
+	codeID, err := keepers.ContractKeeper.Create(ctx, creator, reflectCode, "", "", nil)
+	require.NoError(t, err)
+	contractAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, codeID, creator, "", []byte("{}"), "reflect contract 1", contractStart)
+	require.NoError(t, err)
+
+	bankSend := wasmvmtypes.CosmosMsg{
+		Bank: &wasmvmtypes.BankMsg{
+			Send: &wasmvmtypes.SendMsg{
+				ToAddress: fred.String(),
+				Amount:    []wasmvmtypes.Coin{{Denom: "denom", Amount: "15000"}},
+			},
+		},
+	}
+	reflectSend := ReflectHandleMsg{
+		ReflectSubCall: &reflectSubPayload{
+			Msgs: []wasmvmtypes.SubMsg{{
+				ID:      7,
+				Msg:     bankSend,
+				ReplyOn: wasmvmtypes.ReplyAlways,
+			}},
+		},
+	}
+	reflectSendBz, err := json.Marshal(reflectSend)
+	require.NoError(t, err)
+
+	q := Querier(keepers.WasmKeeper)
+	rsp, err := q.SimulateExecute(sdk.WrapSDKContext(ctx), &types.QuerySimulateExecuteRequest{
+		Sender:   creator.String(),
+		Contract: contractAddr.String(),
+		Msg:      reflectSendBz,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, rsp.Error)
+	assert.NotZero(t, rsp.GasUsed)
+	assert.NotEmpty(t, rsp.Events)
+	require.Len(t, rsp.Messages, 1)
+
+	subMsg := rsp.Messages[0]
+	expMsg, err := json.Marshal(bankSend)
+	require.NoError(t, err)
+	assert.Equal(t, contractAddr.String(), subMsg.Contract)
+	assert.JSONEq(t, string(expMsg), string(subMsg.Msg))
+	assert.Equal(t, uint64(7), subMsg.SubMsgID)
+	assert.Equal(t, "always", subMsg.ReplyOn)
+	assert.Empty(t, subMsg.Error)
+	assert.NotZero(t, subMsg.GasUsed)
+	assert.NotEmpty(t, subMsg.Events)
+	assert.Empty(t, subMsg.Messages)
+	require.NotNil(t, subMsg.Reply)
+	assert.Empty(t, subMsg.Reply.Error)
+	assert.NotZero(t, subMsg.Reply.GasUsed)
+	assert.LessOrEqual(t, subMsg.GasUsed+subMsg.Reply.GasUsed, rsp.GasUsed)
+
+	// nothing was committed
+	checkAccount(t, ctx, accKeeper, bankKeeper, fred, nil)
+	checkAccount(t, ctx, accKeeper, bankKeeper, contractAddr, contractStart)
+
+	// messages are recorded without reply
+	reflectBz, err := json.Marshal(ReflectHandleMsg{Reflect: &reflectPayload{Msgs: []wasmvmtypes.CosmosMsg{bankSend}}})
+	require.NoError(t, err)
+	rsp, err = q.SimulateExecute(sdk.WrapSDKContext(ctx), &types.QuerySimulateExecuteRequest{
+		Sender:   creator.String(),
+		Contract: contractAddr.String(),
+		Msg:      reflectBz,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, rsp.Error)
+	require.Len(t, rsp.Messages, 1)
+	assert.Equal(t, "never", rsp.Messages[0].ReplyOn)
+	assert.NotEmpty(t, rsp.Messages[0].Events)
+	assert.Nil(t, rsp.Messages[0].Reply)
+
+	// failures of a submessage are passed to the reply
+	reflectSend.ReflectSubCall.Msgs[0].Msg.Bank.Send.Amount[0].Amount = "50000"
+	reflectSendBz, err = json.Marshal(reflectSend)
+	require.NoError(t, err)
+	rsp, err = q.SimulateExecute(sdk.WrapSDKContext(ctx), &types.QuerySimulateExecuteRequest{
+		Sender:   creator.String(),
+		Contract: contractAddr.String(),
+		Msg:      reflectSendBz,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, rsp.Error)
+	require.Len(t, rsp.Messages, 1)
+	assert.NotEmpty(t, rsp.Messages[0].Error)
+	assert.Empty(t, rsp.Messages[0].Events)
+	require.NotNil(t, rsp.Messages[0].Reply)
+	assert.Empty(t, rsp.Messages[0].Reply.Error)
+
+	// failures of the execution are reported in the response
+	rsp, err = q.SimulateExecute(sdk.WrapSDKContext(ctx), &types.QuerySimulateExecuteRequest{
+		Sender:   creator.String(),
+		Contract: contractAddr.String(),
+		Msg:      []byte(`{"unknown":{}}`),
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, rsp.Error)
+	assert.Empty(t, rsp.Events)
+	assert.Empty(t, rsp.Messages)
+}
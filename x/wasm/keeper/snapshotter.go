@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+
+	"github.com/line/ostracon/libs/log"
+	ocproto "github.com/line/ostracon/proto/ostracon/types"
+
+	"github.com/line/lbm-sdk/snapshots"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/wasm/types"
+)
+
+// SnapshotFormat is the format of the wasm snapshot payloads, each payload is the byte code of a code.
+const SnapshotFormat = 1
+
+var _ snapshots.ExtensionSnapshotter = (*WasmSnapshotter)(nil)
+
+// WasmSnapshotter is a snapshot extension shipping the byte code of all codes, which the wasmvm keeps
+// outside of the multistore. On restore the codes are compiled and the pinned codes are pinned again.
+type WasmSnapshotter struct {
+	cms  sdk.MultiStore
+	wasm *Keeper
+}
+
+// NewWasmSnapshotter returns a WasmSnapshotter reading the codes of the keeper from the given multistore.
+func NewWasmSnapshotter(cms sdk.MultiStore, wasm *Keeper) *WasmSnapshotter {
+	return &WasmSnapshotter{cms: cms, wasm: wasm}
+}
+
+// SnapshotName implements snapshots.ExtensionSnapshotter.
+func (ws *WasmSnapshotter) SnapshotName() string {
+	return types.ModuleName
+}
+
+// SnapshotFormat implements snapshots.ExtensionSnapshotter.
+func (ws *WasmSnapshotter) SnapshotFormat() uint32 {
+	return SnapshotFormat
+}
+
+// SupportedFormats implements snapshots.ExtensionSnapshotter.
+func (ws *WasmSnapshotter) SupportedFormats() []uint32 {
+	return []uint32{SnapshotFormat}
+}
+
+// Snapshot implements snapshots.ExtensionSnapshotter. The byte code of codes sharing a code hash is
+// written once, in the order of the code ids.
+func (ws *WasmSnapshotter) Snapshot(height uint64, payloadWriter snapshots.ExtensionPayloadWriter) error {
+	ctx, err := ws.contextAt(height)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	var rerr error
+	ws.wasm.IterateCodeInfos(ctx, func(codeID uint64, info types.CodeInfo) bool {
+		hash := hex.EncodeToString(info.CodeHash)
+		if seen[hash] {
+			return false
+		}
+		seen[hash] = true
+
+		bytecode, err := ws.wasm.wasmVM.GetCode(info.CodeHash)
+		if err != nil {
+			rerr = sdkerrors.Wrapf(err, "code %d", codeID)
+			return true
+		}
+		if err := payloadWriter(bytecode); err != nil {
+			rerr = err
+			return true
+		}
+		return false
+	})
+	return rerr
+}
+
+// Restore implements snapshots.ExtensionSnapshotter. Only the byte code of codes in the restored state
+// is accepted, and the byte code of every code must be restored. A payload is checked against the code
+// hashes of the restored state before it is compiled, so that no unknown code reaches the wasmvm. The
+// payload size is bounded by the snapshot extension reader rather than by the max wasm code size param,
+// which may have been lowered after the code was stored.
+func (ws *WasmSnapshotter) Restore(height uint64, format uint32, payloadReader snapshots.ExtensionPayloadReader) error {
+	if format != SnapshotFormat {
+		return sdkerrors.Wrapf(types.ErrInvalid, "unknown snapshot format %d", format)
+	}
+	ctx, err := ws.contextAt(height)
+	if err != nil {
+		return err
+	}
+
+	missing := make(map[string]bool)
+	ws.wasm.IterateCodeInfos(ctx, func(_ uint64, info types.CodeInfo) bool {
+		missing[hex.EncodeToString(info.CodeHash)] = true
+		return false
+	})
+
+	for {
+		bytecode, err := payloadReader()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		checksum := sha256.Sum256(bytecode)
+		hash := hex.EncodeToString(checksum[:])
+		if !missing[hash] {
+			return sdkerrors.Wrapf(types.ErrInvalid, "unexpected code hash %X", checksum)
+		}
+		created, err := ws.wasm.wasmVM.Create(bytecode)
+		if err != nil {
+			return sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
+		}
+		if !bytes.Equal(created, checksum[:]) {
+			return sdkerrors.Wrapf(types.ErrInvalid, "code hash %X, expected %X", created, checksum)
+		}
+		delete(missing, hash)
+	}
+	if len(missing) > 0 {
+		return sdkerrors.Wrapf(types.ErrNotFound, "byte code of %d codes", len(missing))
+	}
+
+	return ws.wasm.InitializePinnedCodes(ctx)
+}
+
+// contextAt returns a read only context of the state at the given height.
+func (ws *WasmSnapshotter) contextAt(height uint64) (sdk.Context, error) {
+	cacheMS, err := ws.cms.CacheMultiStoreWithVersion(int64(height))
+	if err != nil {
+		return sdk.Context{}, sdkerrors.Wrapf(err, "failed to load state at height %d", height)
+	}
+	return sdk.NewContext(cacheMS, ocproto.Header{Height: int64(height)}, false, log.NewNopLogger()), nil
+}
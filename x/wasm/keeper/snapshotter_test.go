@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	wasmvm "github.com/line/wasmvm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/line/lbm-sdk/snapshots"
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/wasm/keeper/wasmtesting"
+)
+
+// latestMultiStore serves the latest state for every height, as the test stores can't be committed
+type latestMultiStore struct {
+	sdk.MultiStore
+}
+
+func (ms latestMultiStore) CacheMultiStoreWithVersion(_ int64) (sdk.CacheMultiStore, error) {
+	return ms.CacheMultiStore(), nil
+}
+
+func TestWasmSnapshotter(t *testing.T) {
+	wasmCode, err := ioutil.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	otherCode, err := ioutil.ReadFile("./testdata/reflect.wasm")
+	require.NoError(t, err)
+
+	// store the codes, one of them twice, and pin one
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	creator := RandomAccountAddress(t)
+	_, err = keepers.ContractKeeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	_, err = keepers.ContractKeeper.Create(ctx, creator, wasmCode, "", "", nil)
+	require.NoError(t, err)
+	otherID, err := keepers.ContractKeeper.Create(ctx, creator, otherCode, "", "", nil)
+	require.NoError(t, err)
+	require.NoError(t, keepers.ContractKeeper.PinCode(ctx, otherID))
+
+	var payloads [][]byte
+	source := NewWasmSnapshotter(latestMultiStore{ctx.MultiStore()}, keepers.WasmKeeper)
+	err = source.Snapshot(1, func(payload []byte) error {
+		payloads = append(payloads, payload)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{wasmCode, otherCode}, payloads)
+
+	// a node with the restored state has none of the codes in its wasmvm
+	var created [][]byte
+	var pinned []wasmvm.Checksum
+	mock := &wasmtesting.MockWasmer{
+		CreateFn: func(code wasmvm.WasmCode) (wasmvm.Checksum, error) {
+			created = append(created, code)
+			hash := sha256.Sum256(code)
+			return hash[:], nil
+		},
+		PinFn: func(checksum wasmvm.Checksum) error {
+			pinned = append(pinned, checksum)
+			return nil
+		},
+	}
+	targetCtx, targetKeepers := CreateTestInput(t, false, SupportedFeatures, nil, nil, WithWasmEngine(mock))
+	_, err = InitGenesis(targetCtx, targetKeepers.WasmKeeper, *ExportGenesis(ctx, keepers.WasmKeeper), &StakingKeeperMock{}, nil)
+	require.NoError(t, err)
+	target := NewWasmSnapshotter(latestMultiStore{targetCtx.MultiStore()}, targetKeepers.WasmKeeper)
+
+	newPayloadReader := func(payloads [][]byte) snapshots.ExtensionPayloadReader {
+		return func() ([]byte, error) {
+			if len(payloads) == 0 {
+				return nil, io.EOF
+			}
+			payload := payloads[0]
+			payloads = payloads[1:]
+			return payload, nil
+		}
+	}
+
+	// the byte code of every code is required
+	require.Error(t, target.Restore(1, SnapshotFormat, newPayloadReader(payloads[:1])))
+	// and no other byte code is accepted nor compiled
+	created = nil
+	require.Error(t, target.Restore(1, SnapshotFormat, newPayloadReader([][]byte{[]byte("other")})))
+	assert.Empty(t, created)
+
+	// the codes are compiled and the pinned code is pinned again
+	created, pinned = nil, nil
+	require.NoError(t, target.Restore(1, SnapshotFormat, newPayloadReader(payloads)))
+	assert.Equal(t, [][]byte{wasmCode, otherCode}, created)
+	assert.Equal(t, []wasmvm.Checksum{keepers.WasmKeeper.GetCodeInfo(ctx, otherID).CodeHash}, pinned)
+}
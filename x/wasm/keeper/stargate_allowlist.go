@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/wasm/types"
+	wasmvmtypes "github.com/line/wasmvm/types"
+)
+
+// stargateQueryAllowlist provides the query paths contracts can call with stargate queries
+type stargateQueryAllowlist interface {
+	IsStargateQueryAllowed(ctx sdk.Context, path string) bool
+}
+
+func (k Keeper) getStargateQueryAllowlist(ctx sdk.Context) []string {
+	var a []string
+	k.paramSpace.Get(ctx, types.ParamStoreKeyStargateQueryAllowlist, &a)
+	return a
+}
+
+func (k Keeper) getStargateMsgAllowlist(ctx sdk.Context) []string {
+	var a []string
+	k.paramSpace.Get(ctx, types.ParamStoreKeyStargateMsgAllowlist, &a)
+	return a
+}
+
+// IsStargateQueryAllowed returns true when the gRPC query path is in the stargate query allowlist param
+func (k Keeper) IsStargateQueryAllowed(ctx sdk.Context, path string) bool {
+	return contains(k.getStargateQueryAllowlist(ctx), path)
+}
+
+// IsStargateMsgAllowed returns true when the message type URL is in the stargate message allowlist param
+func (k Keeper) IsStargateMsgAllowed(ctx sdk.Context, typeURL string) bool {
+	return contains(k.getStargateMsgAllowlist(ctx), typeURL)
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+var _ Messenger = stargateAllowlistMessenger{}
+
+// stargateAllowlistMessenger rejects stargate messages with a type URL that is not in the allowlist before
+// they are passed to the nested messenger
+type stargateAllowlistMessenger struct {
+	nested Messenger
+	keeper *Keeper
+}
+
+func newStargateAllowlistMessenger(nested Messenger, keeper *Keeper) stargateAllowlistMessenger {
+	return stargateAllowlistMessenger{nested: nested, keeper: keeper}
+}
+
+func (m stargateAllowlistMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+	if msg.Stargate != nil && !m.keeper.IsStargateMsgAllowed(ctx, msg.Stargate.TypeURL) {
+		return nil, nil, sdkerrors.Wrapf(types.ErrStargateNotAllowed, "message type url %q", msg.Stargate.TypeURL)
+	}
+	return m.nested.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/line/lbm-sdk/x/wasm/keeper/wasmtesting"
+	"github.com/line/lbm-sdk/x/wasm/types"
+	wasmvmtypes "github.com/line/wasmvm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStargateAllowlistMessenger(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	params := k.GetParams(ctx)
+	params.StargateMsgAllowlist = []string{"/lbm.bank.v1.MsgSend"}
+	k.setParams(ctx, params)
+
+	specs := map[string]struct {
+		msg         wasmvmtypes.CosmosMsg
+		expErr      bool
+		expDispatch bool
+	}{
+		"allowed stargate msg": {
+			msg:         wasmvmtypes.CosmosMsg{Stargate: &wasmvmtypes.StargateMsg{TypeURL: "/lbm.bank.v1.MsgSend"}},
+			expDispatch: true,
+		},
+		"stargate msg not in allowlist": {
+			msg:    wasmvmtypes.CosmosMsg{Stargate: &wasmvmtypes.StargateMsg{TypeURL: "/lbm.bank.v1.MsgMultiSend"}},
+			expErr: true,
+		},
+		"other msg types are not checked": {
+			msg:         wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{}}},
+			expDispatch: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			nested, dispatched := wasmtesting.NewCapturingMessageHandler()
+			m := newStargateAllowlistMessenger(nested, k)
+			_, _, err := m.DispatchMsg(ctx, RandomAccountAddress(t), "", spec.msg)
+			if spec.expErr {
+				assert.True(t, types.ErrStargateNotAllowed.Is(err), "got %+v", err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, spec.expDispatch, len(*dispatched) == 1)
+		})
+	}
+}
+
+func TestQueryStargateAllowlist(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	params := k.GetParams(ctx)
+	params.StargateQueryAllowlist = []string{"/lbm.bank.v1.Query/AllBalances"}
+	params.StargateMsgAllowlist = []string{"/lbm.bank.v1.MsgSend"}
+	k.setParams(ctx, params)
+	q := NewGrpcQuerier(k.cdc, k.storeKey, k, k.queryGasLimit)
+
+	got, err := q.StargateAllowlist(sdk.WrapSDKContext(ctx), &types.QueryStargateAllowlistRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/lbm.bank.v1.Query/AllBalances"}, got.QueryPaths)
+	assert.Equal(t, []string{"/lbm.bank.v1.MsgSend"}, got.MsgTypeURLs)
+}
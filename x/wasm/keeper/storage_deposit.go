@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/line/lbm-sdk/store/prefix"
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/wasm/types"
+)
+
+// storageUsageDelta is the net change of the key and value bytes stored by a contract during a call
+type storageUsageDelta struct {
+	bytes int64
+}
+
+// storageUsageKVStore counts the net change of the stored bytes. The previous values are read from the
+// unmetered store so that the accounting does not change the gas costs of the contract calls.
+type storageUsageKVStore struct {
+	sdk.KVStore
+	unmetered sdk.KVStore
+	delta     *storageUsageDelta
+}
+
+func (s storageUsageKVStore) Set(key, value []byte) {
+	if old := s.unmetered.Get(key); old != nil {
+		s.delta.bytes -= int64(len(key) + len(old))
+	}
+	s.delta.bytes += int64(len(key) + len(value))
+	s.KVStore.Set(key, value)
+}
+
+func (s storageUsageKVStore) Delete(key []byte) {
+	if old := s.unmetered.Get(key); old != nil {
+		s.delta.bytes -= int64(len(key) + len(old))
+	}
+	s.KVStore.Delete(key)
+}
+
+// newStorageUsageStore wraps the contract store so that the net change of the stored bytes is recorded
+// for settleStorageDeposit.
+func (k Keeper) newStorageUsageStore(ctx sdk.Context, contractAddress sdk.AccAddress, store sdk.KVStore) (sdk.KVStore, *storageUsageDelta) {
+	delta := &storageUsageDelta{}
+	unmetered := prefix.NewStore(ctx.MultiStore().GetKVStore(k.storeKey), types.GetContractStorePrefix(contractAddress))
+	return storageUsageKVStore{KVStore: store, unmetered: unmetered, delta: delta}, delta
+}
+
+// settleStorageDeposit updates the storage usage of the contract with the recorded delta. When the
+// storage deposit param is set, the deposit for new bytes is locked from the contract's balance and
+// the deposit of freed bytes is refunded pro rata.
+func (k Keeper) settleStorageDeposit(ctx sdk.Context, contractAddress sdk.AccAddress, delta *storageUsageDelta) error {
+	if delta.bytes == 0 {
+		return nil
+	}
+	usage := k.GetContractStorageUsage(ctx, contractAddress)
+	var locked, refunded sdk.Coins
+	if delta.bytes > 0 {
+		usage.Bytes += uint64(delta.bytes)
+		for _, c := range k.getStorageDepositPerByte(ctx) {
+			locked = locked.Add(sdk.NewCoin(c.Denom, c.Amount.MulRaw(delta.bytes)))
+		}
+		if !locked.IsZero() {
+			if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, contractAddress, types.ModuleName, locked); err != nil {
+				return sdkerrors.Wrap(types.ErrInsufficientStorageDeposit, err.Error())
+			}
+			usage.Deposit = usage.Deposit.Add(locked...)
+		}
+	} else {
+		freed := uint64(-delta.bytes)
+		if freed >= usage.Bytes {
+			refunded = usage.Deposit
+			usage.Bytes = 0
+		} else {
+			for _, c := range usage.Deposit {
+				amount := c.Amount.Mul(sdk.NewIntFromUint64(freed)).Quo(sdk.NewIntFromUint64(usage.Bytes))
+				refunded = refunded.Add(sdk.NewCoin(c.Denom, amount))
+			}
+			usage.Bytes -= freed
+		}
+		if !refunded.IsZero() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, contractAddress, refunded); err != nil {
+				return err
+			}
+			usage.Deposit = usage.Deposit.Sub(refunded)
+		}
+	}
+	k.setContractStorageUsage(ctx, contractAddress, usage)
+
+	if !locked.IsZero() || !refunded.IsZero() {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeStorageDeposit,
+			sdk.NewAttribute(types.AttributeKeyContract, contractAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyStorageBytes, fmt.Sprintf("%d", usage.Bytes)),
+			sdk.NewAttribute(types.AttributeKeyLocked, locked.String()),
+			sdk.NewAttribute(types.AttributeKeyRefunded, refunded.String()),
+		))
+	}
+	return nil
+}
+
+// GetContractStorageUsage returns the state size and the storage deposit of a contract
+func (k Keeper) GetContractStorageUsage(ctx sdk.Context, contractAddress sdk.AccAddress) types.ContractStorageUsage {
+	var usage types.ContractStorageUsage
+	bz := ctx.MultiStore().GetKVStore(k.storeKey).Get(types.GetContractStorageUsageKey(contractAddress))
+	if bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &usage)
+	}
+	return usage
+}
+
+// setContractStorageUsage persists the storage usage. Like the size lookups it is not metered.
+func (k Keeper) setContractStorageUsage(ctx sdk.Context, contractAddress sdk.AccAddress, usage types.ContractStorageUsage) {
+	store := ctx.MultiStore().GetKVStore(k.storeKey)
+	if usage.Bytes == 0 && usage.Deposit.IsZero() {
+		store.Delete(types.GetContractStorageUsageKey(contractAddress))
+		return
+	}
+	store.Set(types.GetContractStorageUsageKey(contractAddress), k.cdc.MustMarshalBinaryBare(&usage))
+}
+
+func (k Keeper) getStorageDepositPerByte(ctx sdk.Context) sdk.Coins {
+	var a sdk.Coins
+	k.paramSpace.Get(ctx, types.ParamStoreKeyStorageDepositPerByte, &a)
+	return a
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	"github.com/line/lbm-sdk/x/wasm/keeper/wasmtesting"
+	"github.com/line/lbm-sdk/x/wasm/types"
+	wasmvm "github.com/line/wasmvm"
+	wasmvmtypes "github.com/line/wasmvm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageDeposit(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	k := keepers.WasmKeeper
+	params := types.DefaultParams()
+	params.StorageDepositPerByte = sdk.NewCoins(sdk.NewInt64Coin("denom", 2))
+	k.setParams(ctx, params)
+
+	// the msg is interpreted as "set:<key>=<value>" or "del:<key>"
+	applyMsg := func(store wasmvm.KVStore, msg []byte) {
+		switch op, arg := string(msg[:4]), msg[4:]; op {
+		case "set:":
+			kv := bytes.SplitN(arg, []byte("="), 2)
+			store.Set(kv[0], kv[1])
+		case "del:":
+			store.Delete(arg)
+		}
+	}
+	mock := &wasmtesting.MockWasmer{
+		CreateFn:      wasmtesting.NoOpCreateFn,
+		AnalyzeCodeFn: wasmtesting.WithoutIBCAnalyzeFn,
+		InstantiateFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, initMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64) (*wasmvmtypes.Response, uint64, error) {
+			applyMsg(store, initMsg)
+			return &wasmvmtypes.Response{}, 0, nil
+		},
+		ExecuteFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64) (*wasmvmtypes.Response, uint64, error) {
+			applyMsg(store, executeMsg)
+			return &wasmvmtypes.Response{}, 0, nil
+		},
+	}
+	example := StoreRandomContract(t, ctx, keepers, mock)
+	moduleAddr := keepers.AccountKeeper.GetModuleAddress(types.ModuleName)
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("denom", amount))
+	}
+
+	// when instantiated with 10 bytes of state
+	contractAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, "", []byte("set:a=012345678"), "label", coins(100))
+	require.NoError(t, err)
+	// then
+	assert.Equal(t, types.ContractStorageUsage{Bytes: 10, Deposit: coins(20)}, k.GetContractStorageUsage(ctx, contractAddr))
+	assert.Equal(t, coins(80), keepers.BankKeeper.GetAllBalances(ctx, contractAddr))
+	assert.Equal(t, coins(20), keepers.BankKeeper.GetAllBalances(ctx, moduleAddr))
+
+	specs := []struct {
+		name       string
+		msg        string
+		price      sdk.Coins
+		expUsage   types.ContractStorageUsage
+		expBalance sdk.Coins
+		expErr     *sdkerrors.Error
+	}{
+		{
+			name:       "overwrite with a longer value locks the difference",
+			msg:        "set:a=0123456789abcdefghij",
+			price:      coins(2),
+			expUsage:   types.ContractStorageUsage{Bytes: 21, Deposit: coins(42)},
+			expBalance: coins(58),
+		},
+		{
+			name:       "without price the usage is tracked only",
+			msg:        "set:bb=0123456789",
+			price:      nil,
+			expUsage:   types.ContractStorageUsage{Bytes: 33, Deposit: coins(42)},
+			expBalance: coins(58),
+		},
+		{
+			name:       "delete refunds pro rata",
+			msg:        "del:bb",
+			price:      coins(2),
+			expUsage:   types.ContractStorageUsage{Bytes: 21, Deposit: coins(27)},
+			expBalance: coins(73),
+		},
+		{
+			name:       "insufficient contract balance",
+			msg:        "set:c=" + string(make([]byte, 100)),
+			price:      coins(2),
+			expUsage:   types.ContractStorageUsage{Bytes: 21, Deposit: coins(27)},
+			expBalance: coins(73),
+			expErr:     types.ErrInsufficientStorageDeposit,
+		},
+		{
+			name:       "delete all refunds the full deposit",
+			msg:        "del:a",
+			price:      coins(2),
+			expUsage:   types.ContractStorageUsage{},
+			expBalance: coins(100),
+		},
+	}
+	for _, spec := range specs {
+		t.Run(spec.name, func(t *testing.T) {
+			params.StorageDepositPerByte = spec.price
+			k.setParams(ctx, params)
+			xCtx, commit := ctx.CacheContext()
+			_, err := keepers.ContractKeeper.Execute(xCtx, contractAddr, example.CreatorAddr, []byte(spec.msg), nil)
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(err), "got %+v", err)
+			} else {
+				require.NoError(t, err)
+				commit()
+			}
+			assert.Equal(t, spec.expUsage, k.GetContractStorageUsage(ctx, contractAddr))
+			assert.Equal(t, spec.expBalance, keepers.BankKeeper.GetAllBalances(ctx, contractAddr))
+		})
+	}
+}
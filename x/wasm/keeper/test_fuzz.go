@@ -9,7 +9,7 @@ import (
 	tmBytes "github.com/line/ostracon/libs/bytes"
 )
 
-var ModelFuzzers = []interface{}{FuzzAddr, FuzzAddrString, FuzzAbsoluteTxPosition, FuzzContractInfo, FuzzStateModel, FuzzAccessType, FuzzAccessConfig, FuzzContractCodeHistory}
+var ModelFuzzers = []interface{}{FuzzAddr, FuzzAddrString, FuzzAbsoluteTxPosition, FuzzContractInfo, FuzzStateModel, FuzzAccessType, FuzzAccessConfig, FuzzContractCodeHistory, FuzzCoins, FuzzParams}
 
 func FuzzAddr(m *sdk.AccAddress, c fuzz.Continue) {
 	addrBytes := make([]byte, 20)
@@ -73,3 +73,30 @@ func FuzzAccessConfig(m *types.AccessConfig, c fuzz.Continue) {
 	FuzzAddr(&add, c)
 	*m = m.Permission.With(add)
 }
+
+func FuzzCoins(m *sdk.Coins, c fuzz.Continue) {
+	*m = sdk.NewCoins(sdk.NewCoin("stake", sdk.NewIntFromUint64(c.RandUint64()%1000)))
+}
+
+func FuzzParams(m *types.Params, c fuzz.Continue) {
+	FuzzAccessConfig(&m.CodeUploadAccess, c)
+	FuzzAccessType(&m.InstantiateDefaultPermission, c)
+	FuzzAccessConfig(&m.ContractStatusAccess, c)
+	m.MaxWasmCodeSize = c.RandUint64()
+	m.GasMultiplier = c.RandUint64()
+	m.InstanceCost = c.RandUint64()
+	m.CompileCost = c.RandUint64()
+	FuzzCoins(&m.StorageDepositPerByte, c)
+	m.StargateQueryAllowlist = fuzzStargateAllowlist(c)
+	m.StargateMsgAllowlist = fuzzStargateAllowlist(c)
+}
+
+// fuzzStargateAllowlist returns distinct entries starting with "/" as required by the params validation
+func fuzzStargateAllowlist(c fuzz.Continue) []string {
+	list := make([]string, c.Intn(4))
+	for i := range list {
+		FuzzAddrString(&list[i], c)
+		list[i] = "/" + list[i]
+	}
+	return list
+}
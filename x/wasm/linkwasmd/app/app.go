@@ -28,6 +28,7 @@ import (
 	"github.com/line/lbm-sdk/server/config"
 	servertypes "github.com/line/lbm-sdk/server/types"
 	"github.com/line/lbm-sdk/simapp"
+	"github.com/line/lbm-sdk/store/streaming"
 	sdk "github.com/line/lbm-sdk/types"
 	"github.com/line/lbm-sdk/types/module"
 	"github.com/line/lbm-sdk/version"
@@ -39,6 +40,9 @@ import (
 	authtx "github.com/line/lbm-sdk/x/auth/tx"
 	authtypes "github.com/line/lbm-sdk/x/auth/types"
 	"github.com/line/lbm-sdk/x/auth/vesting"
+	"github.com/line/lbm-sdk/x/authz"
+	authzkeeper "github.com/line/lbm-sdk/x/authz/keeper"
+	authztypes "github.com/line/lbm-sdk/x/authz/types"
 	"github.com/line/lbm-sdk/x/bank"
 	bankkeeper "github.com/line/lbm-sdk/x/bank/keeper"
 	banktypes "github.com/line/lbm-sdk/x/bank/types"
@@ -155,6 +159,7 @@ var (
 		evidence.AppModuleBasic{},
 		transfer.AppModuleBasic{},
 		vesting.AppModuleBasic{},
+		authz.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -166,6 +171,7 @@ var (
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		wasm.ModuleName:                {authtypes.Burner},
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -209,6 +215,7 @@ type LinkApp struct { // nolint: golint
 	IBCKeeper        *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	EvidenceKeeper   evidencekeeper.Keeper
 	TransferKeeper   ibctransferkeeper.Keeper
+	AuthzKeeper      authzkeeper.Keeper
 	wasmKeeper       wasm.Keeper
 
 	// make scoped keepers public for test purposes
@@ -252,10 +259,15 @@ func NewLinkApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
-		wasm.StoreKey,
+		authztypes.StoreKey, wasm.StoreKey,
 	)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
+	// configure state streaming as set in the app config
+	if _, err := streaming.LoadStreamingService(bApp, appOpts, keys); err != nil {
+		ostos.Exit(err.Error())
+	}
+
 	app := &LinkApp{
 		BaseApp:           bApp,
 		legacyAmino:       legacyAmino,
@@ -301,6 +313,7 @@ func NewLinkApp(
 		app.GetSubspace(crisistypes.ModuleName), invCheckPeriod, app.BankKeeper, authtypes.FeeCollectorName,
 	)
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath)
+	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authztypes.StoreKey], appCodec, app.BaseApp.MsgServiceRouter())
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -420,6 +433,7 @@ func NewLinkApp(
 		evidence.NewAppModule(app.EvidenceKeeper),
 		ibc.NewAppModule(app.IBCKeeper),
 		params.NewAppModule(app.ParamsKeeper),
+		authz.NewAppModule(appCodec, app.AuthzKeeper, app.interfaceRegistry),
 		transferModule,
 	)
 
@@ -429,9 +443,12 @@ func NewLinkApp(
 	// NOTE: staking module is required if HistoricalEntries param > 0
 	app.mm.SetOrderBeginBlockers(
 		upgradetypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
-		evidencetypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName,
+		evidencetypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName, wasm.ModuleName,
+	)
+	app.mm.SetOrderEndBlockers(
+		banktypes.ModuleName, crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName,
+		authtypes.ModuleName, wasm.ModuleName,
 	)
-	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -442,7 +459,7 @@ func NewLinkApp(
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
 		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, ibctransfertypes.ModuleName,
-		wasm.ModuleName,
+		authztypes.ModuleName, wasm.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -478,14 +495,27 @@ func NewLinkApp(
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
+	// the textual sign docs render coins with the bank metadata
+	signModeHandler := encodingConfig.TxConfig.SignModeHandler()
+	if _, ok := appCodec.(codec.ProtoCodecMarshaler); ok {
+		signModeHandler = authtx.NewContextualSignModeHandler(authtx.DefaultSignModes, app.coinMetadata)
+	}
 	app.SetAnteHandler(
 		ante.NewAnteHandler(
 			app.AccountKeeper, app.BankKeeper, ante.DefaultSigVerificationGasConsumer,
-			encodingConfig.TxConfig.SignModeHandler(),
+			signModeHandler,
 		),
 	)
+	app.SetSigVerifyTxsHandler(ante.NewSigVerifyTxsHandler(signModeHandler))
 	app.SetEndBlocker(app.EndBlocker)
 
+	// ship the wasm byte code kept outside of the multistore with the state sync snapshots
+	if manager := app.SnapshotManager(); manager != nil {
+		if err := manager.RegisterExtensions(wasm.NewWasmSnapshotter(app.CommitMultiStore(), &app.wasmKeeper)); err != nil {
+			panic(err)
+		}
+	}
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			ostos.Exit(err.Error())
@@ -524,6 +554,11 @@ func MakeCodecs() (codec.Marshaler, *codec.LegacyAmino) {
 // Name returns the name of the App
 func (app *LinkApp) Name() string { return app.BaseApp.Name() }
 
+// Close persists the node local data of the app on shutdown
+func (app *LinkApp) Close() error {
+	return app.wasmKeeper.Close()
+}
+
 // BeginBlocker application updates every begin block
 func (app *LinkApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	return app.mm.BeginBlock(ctx, req)
@@ -543,6 +578,18 @@ func (app *LinkApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
+// coinMetadata returns the bank metadata of a denom for the textual sign docs,
+// reading the state the ante handler runs under. The read is not charged: the
+// sign bytes are not built when a signature hits the signature cache, so the gas
+// used by a tx must not depend on them.
+func (app *LinkApp) coinMetadata(ctx sdk.Context, denom string) (*banktypes.Metadata, error) {
+	metadata := app.BankKeeper.GetDenomMetaData(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), denom)
+	if metadata.Base == "" {
+		return nil, nil
+	}
+	return &metadata, nil
+}
+
 // LoadHeight loads a particular height
 func (app *LinkApp) LoadHeight(height int64) error {
 	return app.LoadVersion(height)
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"path/filepath"
 
 	"github.com/line/lbm-sdk/baseapp"
 	"github.com/line/lbm-sdk/client"
@@ -15,7 +14,6 @@ import (
 	"github.com/line/lbm-sdk/client/rpc"
 	"github.com/line/lbm-sdk/server"
 	servertypes "github.com/line/lbm-sdk/server/types"
-	"github.com/line/lbm-sdk/snapshots"
 	"github.com/line/lbm-sdk/store"
 	sdk "github.com/line/lbm-sdk/types"
 	authclient "github.com/line/lbm-sdk/x/auth/client"
@@ -175,15 +173,7 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 		panic(err)
 	}
 
-	snapshotDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots")
-	if err := os.MkdirAll(snapshotDir, 0755); err != nil {
-		panic(err)
-	}
-	snapshotDB, err := sdk.NewLevelDB("metadata", snapshotDir)
-	if err != nil {
-		panic(err)
-	}
-	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+	snapshotStore, err := server.GetSnapshotStore(cast.ToString(appOpts.Get(flags.FlagHome)))
 	if err != nil {
 		panic(err)
 	}
@@ -207,6 +197,8 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 		baseapp.SetIAVLCacheManager(cast.ToInt(appOpts.Get(server.FlagIAVLCacheSize)), iavlCacheMetricsProvider),
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(server.FlagTrace))),
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents))),
+		baseapp.SetParallelDeliverTx(cast.ToBool(appOpts.Get(server.FlagParallelDeliverTx))),
+		baseapp.SetOptimisticDeliverTx(cast.ToBool(appOpts.Get(server.FlagOptimisticDeliverTx))),
 		baseapp.SetSnapshotStore(snapshotStore),
 		baseapp.SetSnapshotInterval(cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval))),
 		baseapp.SetSnapshotKeepRecent(cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent))),
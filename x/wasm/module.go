@@ -3,6 +3,7 @@ package wasm
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"math/rand"
 
 	"github.com/gorilla/mux"
@@ -34,6 +35,7 @@ var (
 const (
 	flagWasmMemoryCacheSize = "wasm.memory_cache_size"
 	flagWasmQueryGasLimit   = "wasm.query_gas_limit"
+	flagWasmCacheWarmupSize = "wasm.cache-warmup-size"
 )
 
 // AppModuleBasic defines the basic application module used by the wasm module.
@@ -114,10 +116,16 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(keeper.NewDefaultPermissionKeeper(am.keeper)))
 	types.RegisterQueryServer(cfg.QueryServer(), NewQuerier(am.keeper))
 
-	// m := keeper.NewMigrator(*am.keeper)
-	// if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
-	// 	panic(fmt.Sprintf("failed to migrate x/distribution from version 1 to 2: %v", err))
-	// }
+	m := keeper.NewMigrator(*am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/wasm from version 1 to 2: %v", err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/wasm from version 2 to 3: %v", err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/wasm from version 3 to 4: %v", err))
+	}
 }
 
 func (am AppModule) LegacyQuerierHandler(amino *codec.LegacyAmino) sdk.Querier {
@@ -157,14 +165,18 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 4 }
 
-// BeginBlock returns the begin blocker for the wasm module.
-func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+// BeginBlock returns the begin blocker for the wasm module. It calls all contracts
+// registered for the `begin_block` sudo callback.
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	am.keeper.BeginBlocker(ctx)
+}
 
-// EndBlock returns the end blocker for the wasm module. It returns no validator
-// updates.
-func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// EndBlock returns the end blocker for the wasm module. It calls all contracts
+// registered for the `end_block` sudo callback and returns no validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.EndBlocker(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -203,6 +215,7 @@ func AddModuleInitFlags(startCmd *cobra.Command) {
 	defaults := DefaultWasmConfig()
 	startCmd.Flags().Uint32(flagWasmMemoryCacheSize, defaults.MemoryCacheSize, "Sets the size in MiB (NOT bytes) of an in-memory cache for Wasm modules. Set to 0 to disable.")
 	startCmd.Flags().Uint64(flagWasmQueryGasLimit, defaults.SmartQueryGasLimit, "Set the max gas that can be spent on executing a query with a Wasm contract")
+	startCmd.Flags().Uint32(flagWasmCacheWarmupSize, defaults.CacheWarmupSize, "Sets the LRU budget in MiB (NOT bytes) of the Wasm codes pinned on execution, filled with the most frequently executed codes on start. Set to 0 to disable.")
 }
 
 // ReadWasmConfig reads the wasm specifig configuration
@@ -219,6 +232,11 @@ func ReadWasmConfig(opts servertypes.AppOptions) (types.WasmConfig, error) {
 			return cfg, err
 		}
 	}
+	if v := opts.Get(flagWasmCacheWarmupSize); v != nil {
+		if cfg.CacheWarmupSize, err = cast.ToUint32E(v); err != nil {
+			return cfg, err
+		}
+	}
 	// attach contract debugging to global "trace" flag
 	if v := opts.Get(server.FlagTrace); v != nil {
 		if cfg.ContractDebugMode, err = cast.ToBoolE(v); err != nil {
@@ -587,6 +587,16 @@ func TestReadWasmConfig(t *testing.T) {
 				SmartQueryGasLimit: defaults.SmartQueryGasLimit,
 			},
 		},
+		"set cache warm-up via opts": {
+			src: AppOptionsMock{
+				"wasm.cache-warmup-size": 3,
+			},
+			exp: types.WasmConfig{
+				SmartQueryGasLimit: defaults.SmartQueryGasLimit,
+				MemoryCacheSize:    defaults.MemoryCacheSize,
+				CacheWarmupSize:    3,
+			},
+		},
 		"set debug via opts": {
 			src: AppOptionsMock{
 				"trace": true,
@@ -0,0 +1,82 @@
+package types
+
+import (
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+	authztypes "github.com/line/lbm-sdk/x/authz/types"
+)
+
+var _ authztypes.Authorization = &ExecuteContractAuthorization{}
+
+// NewExecuteContractAuthorization constructor
+func NewExecuteContractAuthorization(grants ...ContractGrant) *ExecuteContractAuthorization {
+	return &ExecuteContractAuthorization{Grants: grants}
+}
+
+// MethodName implements Authorization.MethodName
+func (a ExecuteContractAuthorization) MethodName() string {
+	return "/lbm.wasm.v1.Msg/ExecuteContract"
+}
+
+// Accept implements Authorization.Accept. The funds sent to a contract are
+// deducted from the spend limit of its grant.
+func (a ExecuteContractAuthorization) Accept(ctx sdk.Context, msg sdk.ServiceMsg) (authztypes.AcceptResponse, error) {
+	exec, ok := msg.Request.(*MsgExecuteContract)
+	if !ok || msg.MethodName != a.MethodName() {
+		return authztypes.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+
+	for i, g := range a.Grants {
+		if g.Contract != exec.Contract {
+			continue
+		}
+
+		limitLeft, isNegative := g.SpendLimit.SafeSub(exec.Funds)
+		if isNegative {
+			return authztypes.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "funds exceed the spend limit of %s", g.Contract)
+		}
+		if exec.Funds.Empty() {
+			return authztypes.AcceptResponse{Accept: true}, nil
+		}
+
+		grants := make([]ContractGrant, len(a.Grants))
+		copy(grants, a.Grants)
+		grants[i] = ContractGrant{Contract: g.Contract, SpendLimit: limitLeft}
+		return authztypes.AcceptResponse{Accept: true, Updated: NewExecuteContractAuthorization(grants...)}, nil
+	}
+	return authztypes.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "no grant for contract %s", exec.Contract)
+}
+
+// ValidateBasic implements Authorization.ValidateBasic
+func (a ExecuteContractAuthorization) ValidateBasic() error {
+	if len(a.Grants) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "grants")
+	}
+	contracts := make(map[string]bool, len(a.Grants))
+	for i, g := range a.Grants {
+		if err := g.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "position %d", i)
+		}
+		if contracts[g.Contract] {
+			return sdkerrors.Wrapf(ErrDuplicate, "contract %s", g.Contract)
+		}
+		contracts[g.Contract] = true
+	}
+	return nil
+}
+
+// NewContractGrant constructor
+func NewContractGrant(contract sdk.AccAddress, spendLimit sdk.Coins) ContractGrant {
+	return ContractGrant{Contract: contract.String(), SpendLimit: spendLimit}
+}
+
+// ValidateBasic validates the grant
+func (g ContractGrant) ValidateBasic() error {
+	if err := sdk.ValidateAccAddress(g.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if !g.SpendLimit.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "spend limit")
+	}
+	return nil
+}
@@ -0,0 +1,559 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lbm/wasm/v1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_line_lbm_sdk_types "github.com/line/lbm-sdk/types"
+	types "github.com/line/lbm-sdk/types"
+	_ "github.com/regen-network/cosmos-proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ExecuteContractAuthorization allows the grantee to execute the given
+// contracts on behalf of the granter's account.
+type ExecuteContractAuthorization struct {
+	// Grants for the contract executions
+	Grants []ContractGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
+}
+
+func (m *ExecuteContractAuthorization) Reset()         { *m = ExecuteContractAuthorization{} }
+func (m *ExecuteContractAuthorization) String() string { return proto.CompactTextString(m) }
+func (*ExecuteContractAuthorization) ProtoMessage()    {}
+func (*ExecuteContractAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afeda93becd0148d, []int{0}
+}
+func (m *ExecuteContractAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecuteContractAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecuteContractAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecuteContractAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteContractAuthorization.Merge(m, src)
+}
+func (m *ExecuteContractAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecuteContractAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteContractAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteContractAuthorization proto.InternalMessageInfo
+
+// ContractGrant grants the execution of a contract, sending it up to
+// spend_limit funds.
+type ContractGrant struct {
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// SpendLimit is the remaining amount of funds that can be sent to the
+	// contract. If it is empty, no funds can be sent.
+	SpendLimit github_com_line_lbm_sdk_types.Coins `protobuf:"bytes,2,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/line/lbm-sdk/types.Coins" json:"spend_limit"`
+}
+
+func (m *ContractGrant) Reset()         { *m = ContractGrant{} }
+func (m *ContractGrant) String() string { return proto.CompactTextString(m) }
+func (*ContractGrant) ProtoMessage()    {}
+func (*ContractGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afeda93becd0148d, []int{1}
+}
+func (m *ContractGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractGrant.Merge(m, src)
+}
+func (m *ContractGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractGrant proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ExecuteContractAuthorization)(nil), "lbm.wasm.v1.ExecuteContractAuthorization")
+	proto.RegisterType((*ContractGrant)(nil), "lbm.wasm.v1.ContractGrant")
+}
+
+func init() { proto.RegisterFile("lbm/wasm/v1/authz.proto", fileDescriptor_afeda93becd0148d) }
+
+var fileDescriptor_afeda93becd0148d = []byte{
+	// 323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x3f, 0x4f, 0x02, 0x31,
+	0x18, 0xc6, 0xaf, 0x6a, 0x88, 0x96, 0x30, 0x70, 0x31, 0x8a, 0x17, 0x53, 0x08, 0x3a, 0x90, 0x18,
+	0xae, 0x41, 0x17, 0xe3, 0xe6, 0x19, 0xe3, 0xe2, 0xc4, 0xe8, 0x42, 0xee, 0x4a, 0x73, 0x34, 0x5c,
+	0x5b, 0x72, 0xed, 0x21, 0xf2, 0x19, 0x1c, 0xfc, 0x1c, 0xce, 0x7e, 0x08, 0x46, 0xe2, 0xe4, 0xe4,
+	0x1f, 0xf8, 0x22, 0xa6, 0x2d, 0x1a, 0x19, 0xdc, 0xee, 0x7d, 0x9e, 0xe7, 0xfd, 0x3d, 0xd7, 0x16,
+	0xee, 0x67, 0x09, 0xc7, 0xf7, 0xb1, 0xe2, 0x78, 0xdc, 0xc1, 0x71, 0xa1, 0x07, 0xd3, 0x70, 0x94,
+	0x4b, 0x2d, 0xfd, 0x72, 0x96, 0xf0, 0xd0, 0x18, 0xe1, 0xb8, 0x13, 0xec, 0xa6, 0x32, 0x95, 0x56,
+	0xc7, 0xe6, 0xcb, 0x45, 0x82, 0x03, 0x22, 0x15, 0x97, 0xaa, 0xe7, 0x0c, 0x37, 0xac, 0xac, 0x3d,
+	0x83, 0x4d, 0x62, 0x45, 0x0d, 0x96, 0x48, 0x26, 0x9c, 0xde, 0x1c, 0xc2, 0xc3, 0xeb, 0x09, 0x25,
+	0x85, 0xa6, 0x57, 0x52, 0xe8, 0x3c, 0x26, 0xfa, 0xb2, 0xd0, 0x03, 0x99, 0xb3, 0x69, 0xac, 0x99,
+	0x14, 0xfe, 0x39, 0x2c, 0xa5, 0x79, 0x2c, 0xb4, 0xaa, 0x81, 0xc6, 0x66, 0xab, 0x7c, 0x1a, 0x84,
+	0x7f, 0x7e, 0x23, 0xfc, 0xd9, 0xb9, 0x31, 0x91, 0x68, 0x6b, 0xf6, 0x5e, 0xf7, 0xba, 0xab, 0xfc,
+	0x45, 0xf5, 0xf5, 0xa5, 0x5d, 0x59, 0x83, 0x35, 0x1f, 0x01, 0xac, 0xac, 0xad, 0xf8, 0x01, 0xdc,
+	0x26, 0x2b, 0xa1, 0x06, 0x1a, 0xa0, 0xb5, 0xd3, 0xfd, 0x9d, 0xfd, 0x1e, 0x2c, 0xab, 0x11, 0x15,
+	0xfd, 0x5e, 0xc6, 0x38, 0xd3, 0xb5, 0x0d, 0xdb, 0x5f, 0xb5, 0xfd, 0xe6, 0x20, 0xae, 0x9f, 0x89,
+	0xe8, 0xc4, 0xd4, 0x3e, 0x7f, 0xd4, 0x8f, 0x52, 0xa6, 0x07, 0x45, 0x12, 0x12, 0xc9, 0x71, 0xc6,
+	0x04, 0xc5, 0x59, 0xc2, 0xdb, 0xaa, 0x3f, 0xc4, 0xfa, 0x61, 0x44, 0x95, 0xcd, 0xaa, 0x2e, 0xb4,
+	0xc8, 0x5b, 0x43, 0x8c, 0xa2, 0xd9, 0x17, 0xf2, 0x66, 0x0b, 0x04, 0xe6, 0x0b, 0x04, 0x3e, 0x17,
+	0x08, 0x3c, 0x2d, 0x91, 0x37, 0x5f, 0x22, 0xef, 0x6d, 0x89, 0xbc, 0xbb, 0xe3, 0xff, 0x70, 0x13,
+	0xf7, 0x3c, 0x96, 0x9a, 0x94, 0xec, 0x35, 0x9e, 0x7d, 0x0f, 0x00, 0xfc, 0x3c, 0x57, 0x83, 0xb7,
+	0x01, 0x00, 0x00,
+}
+
+func (m *ExecuteContractAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteContractAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecuteContractAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExecuteContractAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExecuteContractAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteContractAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteContractAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, ContractGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
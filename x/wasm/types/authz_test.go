@@ -0,0 +1,128 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+func TestExecuteContractAuthorizationValidation(t *testing.T) {
+	contract := sdk.BytesToAccAddress([]byte("contract____________"))
+	otherContract := sdk.BytesToAccAddress([]byte("other_contract______"))
+
+	specs := map[string]struct {
+		src    *ExecuteContractAuthorization
+		expErr bool
+	}{
+		"all good": {
+			src: NewExecuteContractAuthorization(NewContractGrant(contract, sdk.NewCoins(sdk.NewInt64Coin("denom", 1)))),
+		},
+		"no spend limit": {
+			src: NewExecuteContractAuthorization(NewContractGrant(contract, nil)),
+		},
+		"multiple contracts": {
+			src: NewExecuteContractAuthorization(NewContractGrant(contract, nil), NewContractGrant(otherContract, nil)),
+		},
+		"no grants": {
+			src:    NewExecuteContractAuthorization(),
+			expErr: true,
+		},
+		"invalid contract": {
+			src:    NewExecuteContractAuthorization(ContractGrant{Contract: "invalid"}),
+			expErr: true,
+		},
+		"invalid spend limit": {
+			src:    NewExecuteContractAuthorization(ContractGrant{Contract: contract.String(), SpendLimit: sdk.Coins{{Denom: "denom", Amount: sdk.NewInt(-1)}}}),
+			expErr: true,
+		},
+		"duplicate contracts": {
+			src:    NewExecuteContractAuthorization(NewContractGrant(contract, nil), NewContractGrant(contract, nil)),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestExecuteContractAuthorizationAccept(t *testing.T) {
+	contract := sdk.BytesToAccAddress([]byte("contract____________"))
+	otherContract := sdk.BytesToAccAddress([]byte("other_contract______"))
+	sender := sdk.BytesToAccAddress([]byte("sender______________"))
+	execMsg := func(contract sdk.AccAddress, funds sdk.Coins) sdk.ServiceMsg {
+		return sdk.ServiceMsg{
+			MethodName: "/lbm.wasm.v1.Msg/ExecuteContract",
+			Request: &MsgExecuteContract{
+				Sender:   sender.String(),
+				Contract: contract.String(),
+				Msg:      []byte(`{}`),
+				Funds:    funds,
+			},
+		}
+	}
+	authorization := NewExecuteContractAuthorization(
+		NewContractGrant(contract, sdk.NewCoins(sdk.NewInt64Coin("denom", 10))),
+		NewContractGrant(otherContract, nil),
+	)
+
+	specs := map[string]struct {
+		msg        sdk.ServiceMsg
+		expErr     *sdkerrors.Error
+		expUpdated *ExecuteContractAuthorization
+	}{
+		"no funds": {
+			msg: execMsg(contract, nil),
+		},
+		"funds within the spend limit": {
+			msg: execMsg(contract, sdk.NewCoins(sdk.NewInt64Coin("denom", 4))),
+			expUpdated: NewExecuteContractAuthorization(
+				NewContractGrant(contract, sdk.NewCoins(sdk.NewInt64Coin("denom", 6))),
+				NewContractGrant(otherContract, nil),
+			),
+		},
+		"funds exceeding the spend limit": {
+			msg:    execMsg(contract, sdk.NewCoins(sdk.NewInt64Coin("denom", 11))),
+			expErr: sdkerrors.ErrInsufficientFunds,
+		},
+		"funds without spend limit": {
+			msg:    execMsg(otherContract, sdk.NewCoins(sdk.NewInt64Coin("denom", 1))),
+			expErr: sdkerrors.ErrInsufficientFunds,
+		},
+		"contract not granted": {
+			msg:    execMsg(sender, nil),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"other msg": {
+			msg:    sdk.ServiceMsg{MethodName: "/lbm.wasm.v1.Msg/ClearAdmin", Request: &MsgClearAdmin{Sender: sender.String(), Contract: contract.String()}},
+			expErr: sdkerrors.ErrInvalidType,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			resp, err := authorization.Accept(sdk.Context{}, spec.msg)
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(err), err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, resp.Accept)
+			assert.False(t, resp.Delete)
+			if spec.expUpdated == nil {
+				assert.Nil(t, resp.Updated)
+			} else {
+				assert.Equal(t, spec.expUpdated, resp.Updated)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// ValidateBasic does syntax checks on the data
+func (b BlockHook) ValidateBasic() error {
+	if !b.BeginBlock && !b.EndBlock {
+		return sdkerrors.Wrap(ErrEmpty, "begin or end block callback")
+	}
+	if b.GasLimit == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "gas limit must not be zero")
+	}
+	return nil
+}
+
+// BlockHookSudoMsg is the sudo message sent to contracts registered for block callbacks.
+// Exactly one field is set.
+type BlockHookSudoMsg struct {
+	BeginBlock *struct{} `json:"begin_block,omitempty"`
+	EndBlock   *struct{} `json:"end_block,omitempty"`
+}
@@ -66,4 +66,16 @@ var (
 
 	// ErrUnknownMsg error by a message handler to show that it is not responsible for this message type
 	ErrUnknownMsg = sdkErrors.Register(DefaultCodespace, 20, "unknown message from the contract")
+
+	// ErrProfilingDisabled error for profiling requests on a keeper without call profiling enabled
+	ErrProfilingDisabled = sdkErrors.Register(DefaultCodespace, 21, "call profiling disabled")
+
+	// ErrInsufficientStorageDeposit error for contracts that can not pay the deposit for their new state
+	ErrInsufficientStorageDeposit = sdkErrors.Register(DefaultCodespace, 22, "insufficient funds for storage deposit")
+
+	// ErrInvalidEvent error for contract events that do not match the event schema registered with the code
+	ErrInvalidEvent = sdkErrors.Register(DefaultCodespace, 23, "invalid event")
+
+	// ErrStargateNotAllowed error for stargate queries and messages that are not in the allowlist
+	ErrStargateNotAllowed = sdkErrors.Register(DefaultCodespace, 24, "stargate call not allowed")
 )
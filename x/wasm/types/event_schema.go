@@ -0,0 +1,161 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"sort"
+
+	sdk "github.com/line/lbm-sdk/types"
+	sdkerrors "github.com/line/lbm-sdk/types/errors"
+)
+
+// The event schemas support the subset of JSON schema that describes a flat object of string attribute
+// values: the object `properties` with a `type` of `string`, `integer`, `number` or `boolean` and an optional
+// `enum`, the `required` properties and `additionalProperties`. Other keywords are rejected so that a schema
+// can not be mistaken to be enforced.
+const (
+	schemaTypeObject  = "object"
+	schemaTypeString  = "string"
+	schemaTypeInteger = "integer"
+	schemaTypeNumber  = "number"
+	schemaTypeBoolean = "boolean"
+)
+
+var (
+	integerRegexp = regexp.MustCompile(`^-?(0|[1-9][0-9]*)$`)
+	numberRegexp  = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+)
+
+type eventAttributesSchema struct {
+	Schema               string                          `json:"$schema,omitempty"`
+	ID                   string                          `json:"$id,omitempty"`
+	Title                string                          `json:"title,omitempty"`
+	Description          string                          `json:"description,omitempty"`
+	Type                 string                          `json:"type"`
+	Properties           map[string]eventAttributeSchema `json:"properties,omitempty"`
+	Required             []string                        `json:"required,omitempty"`
+	AdditionalProperties *bool                           `json:"additionalProperties,omitempty"`
+}
+
+type eventAttributeSchema struct {
+	Title       string   `json:"title,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Type        string   `json:"type"`
+	Enum        []string `json:"enum,omitempty"`
+}
+
+// ValidateEventSchema checks that the schema of the `wasm` event is supported. The wasm VM does not let
+// contracts emit custom `wasm-*` events, so a code registers the schema of the `wasm` event only.
+func ValidateEventSchema(schema json.RawMessage) error {
+	if len(schema) == 0 {
+		return ErrEmpty
+	}
+	if len(schema) > MaxEventSchemaSize {
+		return sdkerrors.Wrapf(ErrLimit, "cannot be longer than %d bytes", MaxEventSchemaSize)
+	}
+	if _, err := parseEventSchema(schema); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	return nil
+}
+
+func parseEventSchema(bz json.RawMessage) (*eventAttributesSchema, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.DisallowUnknownFields()
+	var schema eventAttributesSchema
+	if err := dec.Decode(&schema); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, sdkerrors.Wrap(ErrInvalid, "trailing data")
+	}
+	if schema.Type != schemaTypeObject {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "type must be %q", schemaTypeObject)
+	}
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names) // deterministic error messages
+	for _, name := range names {
+		p := schema.Properties[name]
+		switch p.Type {
+		case schemaTypeString, schemaTypeInteger, schemaTypeNumber, schemaTypeBoolean:
+		default:
+			return nil, sdkerrors.Wrapf(ErrInvalid, "unsupported type %q of property %q", p.Type, name)
+		}
+		for _, v := range p.Enum {
+			if !p.matchesType(v) {
+				return nil, sdkerrors.Wrapf(ErrInvalid, "enum value %q of property %q is not of type %q", v, name, p.Type)
+			}
+		}
+	}
+	for _, name := range schema.Required {
+		if _, ok := schema.Properties[name]; !ok {
+			return nil, sdkerrors.Wrapf(ErrInvalid, "required property %q is not defined", name)
+		}
+	}
+	return &schema, nil
+}
+
+// ValidateEvent checks the attributes of the event against the event schema. The contract address attribute
+// that is added to all contract events does not need to be described.
+func ValidateEvent(eventSchema json.RawMessage, event sdk.Event) error {
+	schema, err := parseEventSchema(eventSchema)
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	seen := make(map[string]bool, len(event.Attributes))
+	for _, a := range event.Attributes {
+		key := string(a.Key)
+		if key == AttributeKeyContractAddr {
+			continue
+		}
+		seen[key] = true
+		p, ok := schema.Properties[key]
+		if !ok {
+			if schema.AdditionalProperties != nil && !*schema.AdditionalProperties {
+				return sdkerrors.Wrapf(ErrInvalidEvent, "%s: attribute %q is not allowed", event.Type, key)
+			}
+			continue
+		}
+		if err := p.validate(string(a.Value)); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidEvent, "%s: attribute %q %s", event.Type, key, err)
+		}
+	}
+	for _, name := range schema.Required {
+		if !seen[name] {
+			return sdkerrors.Wrapf(ErrInvalidEvent, "%s: attribute %q is required", event.Type, name)
+		}
+	}
+	return nil
+}
+
+func (p eventAttributeSchema) matchesType(v string) bool {
+	switch p.Type {
+	case schemaTypeInteger:
+		return integerRegexp.MatchString(v)
+	case schemaTypeNumber:
+		return numberRegexp.MatchString(v)
+	case schemaTypeBoolean:
+		return v == "true" || v == "false"
+	default:
+		return true
+	}
+}
+
+func (p eventAttributeSchema) validate(v string) error {
+	if !p.matchesType(v) {
+		return sdkerrors.Wrapf(ErrInvalid, "must be of type %q", p.Type)
+	}
+	if len(p.Enum) == 0 {
+		return nil
+	}
+	for _, e := range p.Enum {
+		if e == v {
+			return nil
+		}
+	}
+	return sdkerrors.Wrapf(ErrInvalid, "must be one of %q", p.Enum)
+}
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/line/lbm-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const myEventSchema = `{
+	"$schema": "http://json-schema.org/draft-07/schema#",
+	"title": "transfer",
+	"type": "object",
+	"properties": {
+		"action": {"type": "string", "enum": ["send", "burn"]},
+		"amount": {"type": "integer"},
+		"rate": {"type": "number"},
+		"final": {"type": "boolean"}
+	},
+	"required": ["action", "amount"],
+	"additionalProperties": false
+}`
+
+func TestValidateEventSchema(t *testing.T) {
+	specs := map[string]struct {
+		src    []byte
+		expErr bool
+	}{
+		"all good": {
+			src: []byte(myEventSchema),
+		},
+		"empty schema": {
+			src:    nil,
+			expErr: true,
+		},
+		"invalid json": {
+			src:    []byte(`{"type":`),
+			expErr: true,
+		},
+		"not an object": {
+			src:    []byte(`{"type":"array"}`),
+			expErr: true,
+		},
+		"unsupported keyword": {
+			src:    []byte(`{"type":"object","minProperties":1}`),
+			expErr: true,
+		},
+		"unsupported property type": {
+			src:    []byte(`{"type":"object","properties":{"a":{"type":"array"}}}`),
+			expErr: true,
+		},
+		"enum value of other type": {
+			src:    []byte(`{"type":"object","properties":{"a":{"type":"integer","enum":["x"]}}}`),
+			expErr: true,
+		},
+		"required property not defined": {
+			src:    []byte(`{"type":"object","required":["a"]}`),
+			expErr: true,
+		},
+		"schema too big": {
+			src:    make([]byte, MaxEventSchemaSize+1),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := ValidateEventSchema(spec.src)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateEvent(t *testing.T) {
+	specs := map[string]struct {
+		attrs  []sdk.Attribute
+		expErr bool
+	}{
+		"all good": {
+			attrs: []sdk.Attribute{
+				sdk.NewAttribute(AttributeKeyContractAddr, "any"),
+				sdk.NewAttribute("action", "send"),
+				sdk.NewAttribute("amount", "-10"),
+				sdk.NewAttribute("rate", "1.5e3"),
+				sdk.NewAttribute("final", "true"),
+			},
+		},
+		"required attribute missing": {
+			attrs:  []sdk.Attribute{sdk.NewAttribute("action", "send")},
+			expErr: true,
+		},
+		"contract address only": {
+			attrs:  []sdk.Attribute{sdk.NewAttribute(AttributeKeyContractAddr, "any")},
+			expErr: true,
+		},
+		"value not in enum": {
+			attrs:  []sdk.Attribute{sdk.NewAttribute("action", "mint"), sdk.NewAttribute("amount", "1")},
+			expErr: true,
+		},
+		"not an integer": {
+			attrs:  []sdk.Attribute{sdk.NewAttribute("action", "send"), sdk.NewAttribute("amount", "1.0")},
+			expErr: true,
+		},
+		"not a number": {
+			attrs:  []sdk.Attribute{sdk.NewAttribute("action", "send"), sdk.NewAttribute("amount", "1"), sdk.NewAttribute("rate", "1.")},
+			expErr: true,
+		},
+		"not a boolean": {
+			attrs:  []sdk.Attribute{sdk.NewAttribute("action", "send"), sdk.NewAttribute("amount", "1"), sdk.NewAttribute("final", "1")},
+			expErr: true,
+		},
+		"additional attribute": {
+			attrs:  []sdk.Attribute{sdk.NewAttribute("action", "send"), sdk.NewAttribute("amount", "1"), sdk.NewAttribute("other", "x")},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := ValidateEvent([]byte(myEventSchema), sdk.NewEvent(CustomEventType, spec.attrs...))
+			if spec.expErr {
+				assert.True(t, ErrInvalidEvent.Is(err), "got %+v", err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
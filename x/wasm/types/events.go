@@ -1,15 +1,21 @@
 package types
 
 const (
-	EventTypeStoreCode            = "store_code"
-	EventTypeInstantiateContract  = "instantiate_contract"
-	EventTypeExecuteContract      = "execute_contract"
-	EventTypeMigrateContract      = "migrate_contract"
-	EventTypeUpdateAdmin          = "update_admin"
-	EventTypeClearAdmin           = "clear_admin"
-	EventTypePinCode              = "pin_code"
-	EventTypeUnpinCode            = "unpin_code"
-	EventTypeUpdateContractStatus = "update_contract_status"
+	EventTypeStoreCode              = "store_code"
+	EventTypeInstantiateContract    = "instantiate_contract"
+	EventTypeExecuteContract        = "execute_contract"
+	EventTypeMigrateContract        = "migrate_contract"
+	EventTypeUpdateAdmin            = "update_admin"
+	EventTypeClearAdmin             = "clear_admin"
+	EventTypePinCode                = "pin_code"
+	EventTypeUnpinCode              = "unpin_code"
+	EventTypeUpdateContractStatus   = "update_contract_status"
+	EventTypeUpdateCodeAccessConfig = "update_code_access_config"
+	EventTypeRegisterBlockHook      = "register_block_hook"
+	EventTypeDeregisterBlockHook    = "deregister_block_hook"
+	EventTypeBlockHookFailed        = "block_hook_failed"
+	EventTypeStorageDeposit         = "storage_deposit"
+	EventTypeSubmitCodeVerification = "submit_code_verification"
 )
 const ( // event attributes
 	AttributeKeyContract       = "contract_address"
@@ -17,4 +23,12 @@ const ( // event attributes
 	AttributeKeySigner         = "signer"
 	AttributeKeyCodeIDs        = "code_ids"
 	AttributeKeyContractStatus = "contract_status"
+	AttributeKeyBlockHook      = "block_hook"
+	AttributeKeyAccessConfig   = "access_config"
+	AttributeKeyError          = "error"
+	AttributeKeyStorageBytes   = "storage_bytes"
+	AttributeKeyLocked         = "locked"
+	AttributeKeyRefunded       = "refunded"
+	AttributeKeySourceHash     = "source_hash"
+	AttributeKeyBuilderDigest  = "builder_digest"
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/json"
+
 	sdk "github.com/line/lbm-sdk/types"
 	capabilitytypes "github.com/line/lbm-sdk/x/capability/types"
 	types2 "github.com/line/wasmvm/types"
@@ -20,6 +22,10 @@ type ViewKeeper interface {
 	IterateCodeInfos(ctx sdk.Context, cb func(uint64, CodeInfo) bool)
 	GetByteCode(ctx sdk.Context, codeID uint64) ([]byte, error)
 	IsPinnedCode(ctx sdk.Context, codeID uint64) bool
+	GetContractStorageUsage(ctx sdk.Context, contractAddress sdk.AccAddress) ContractStorageUsage
+	GetEventSchema(ctx sdk.Context, codeID uint64) json.RawMessage
+	GetParams(ctx sdk.Context) Params
+	GetCodeVerifications(ctx sdk.Context, codeID uint64) []CodeVerification
 }
 
 // ContractOpsKeeper contains mutable operations on a contract.
@@ -27,9 +33,17 @@ type ContractOpsKeeper interface {
 	// Create uploads and compiles a WASM contract, returning a short identifier for the contract
 	Create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, source string, builder string, instantiateAccess *AccessConfig) (codeID uint64, err error)
 
+	// CreateWithEventSchema uploads a WASM contract like Create and registers the JSON schema the wasm events
+	// emitted by its contracts are validated against
+	CreateWithEventSchema(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, source string, builder string, instantiateAccess *AccessConfig, eventSchema json.RawMessage) (codeID uint64, err error)
+
 	// Instantiate creates an instance of a WASM contract
 	Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins) (sdk.AccAddress, []byte, error)
 
+	// Instantiate2 creates an instance of a WASM contract with a predictable address built from the code checksum,
+	// creator, salt and optionally the init message
+	Instantiate2(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, salt []byte, fixMsg bool) (sdk.AccAddress, []byte, error)
+
 	// Execute executes the contract instance
 	Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (*sdk.Result, error)
 
@@ -53,6 +67,18 @@ type ContractOpsKeeper interface {
 
 	// UpdateContractStatus sets a new status of the contract on the ContractInfo.
 	UpdateContractStatus(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, status ContractStatus) error
+
+	// SetAccessConfig updates the instantiate config of a stored code
+	SetAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig AccessConfig) error
+
+	// SubmitCodeVerification links a stored code to the reproducible build it was compiled from
+	SubmitCodeVerification(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, sourceHash []byte, builderDigest string, attestation []byte) error
+
+	// RegisterBlockHook registers the contract for begin/end block sudo callbacks or updates the registration
+	RegisterBlockHook(ctx sdk.Context, contractAddress sdk.AccAddress, hook BlockHook) error
+
+	// DeregisterBlockHook removes the contract from the begin/end block sudo callbacks
+	DeregisterBlockHook(ctx sdk.Context, contractAddress sdk.AccAddress) error
 }
 
 // IBCContractKeeper IBC lifecycle event handler
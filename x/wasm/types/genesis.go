@@ -2,6 +2,9 @@ package types
 
 import "C"
 import (
+	"bytes"
+	"crypto/sha256"
+
 	sdk "github.com/line/lbm-sdk/types"
 	sdkerrors "github.com/line/lbm-sdk/types/errors"
 )
@@ -50,6 +53,24 @@ func (c Code) ValidateBasic() error {
 	if err := validateWasmCode(c.CodeBytes); err != nil {
 		return sdkerrors.Wrap(err, "code bytes")
 	}
+	if len(c.EventSchema) != 0 {
+		if err := ValidateEventSchema(c.EventSchema); err != nil {
+			return sdkerrors.Wrap(err, "event schema")
+		}
+	}
+	submitters := make(map[string]struct{}, len(c.Verifications))
+	for i, verification := range c.Verifications {
+		if err := verification.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "verification: %d", i)
+		}
+		if verification.CodeID != c.CodeID {
+			return sdkerrors.Wrapf(ErrInvalid, "verification code id: %d", i)
+		}
+		if _, ok := submitters[verification.Submitter]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "verification submitter: %d", i)
+		}
+		submitters[verification.Submitter] = struct{}{}
+	}
 	return nil
 }
 
@@ -69,9 +90,59 @@ func (c Contract) ValidateBasic() error {
 			return sdkerrors.Wrapf(err, "contract state %d", i)
 		}
 	}
+	if c.BlockHook != nil {
+		if err := c.BlockHook.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "block hook")
+		}
+	}
+	if err := c.StorageDeposit.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "storage deposit")
+	}
+	for i, entry := range c.ContractCodeHistory {
+		if entry.CodeID == 0 {
+			return sdkerrors.Wrapf(ErrEmpty, "code id of history entry %d", i)
+		}
+		if entry.Updated == nil {
+			return sdkerrors.Wrapf(ErrEmpty, "updated of history entry %d", i)
+		}
+	}
+	if n := len(c.ContractCodeHistory); n != 0 && c.ContractCodeHistory[n-1].CodeID != c.ContractInfo.CodeID {
+		return sdkerrors.Wrap(ErrInvalid, "code id of the last history entry does not match contract info")
+	}
+	return nil
+}
+
+func (e ContractExport) ValidateBasic() error {
+	if err := e.Code.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "code")
+	}
+	if err := e.Contract.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if e.Contract.ContractInfo.CodeID != e.Code.CodeID {
+		return sdkerrors.Wrap(ErrInvalid, "contract code id does not match code")
+	}
+	hash, err := e.ComputeHash()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(hash, e.Hash) {
+		return sdkerrors.Wrap(ErrInvalid, "integrity hash does not match")
+	}
 	return nil
 }
 
+// ComputeHash returns the sha256 hash of the proto encoded export with an empty hash field.
+func (e ContractExport) ComputeHash() ([]byte, error) {
+	e.Hash = nil
+	bz, err := e.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
+
 // AsMsg returns the underlying cosmos-sdk message instance. Null when can not be mapped to a known type.
 func (m GenesisState_GenMsgs) AsMsg() sdk.Msg {
 	if msg := m.GetStoreCode(); msg != nil {
@@ -7,6 +7,8 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_line_lbm_sdk_types "github.com/line/lbm-sdk/types"
+	types "github.com/line/lbm-sdk/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -209,6 +211,10 @@ type Code struct {
 	CodeBytes []byte   `protobuf:"bytes,3,opt,name=code_bytes,json=codeBytes,proto3" json:"code_bytes,omitempty"`
 	// Pinned to wasmvm cache
 	Pinned bool `protobuf:"varint,4,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// EventSchema of the `wasm` event registered with the code, optional
+	EventSchema []byte `protobuf:"bytes,5,opt,name=event_schema,json=eventSchema,proto3" json:"event_schema,omitempty"`
+	// Verifications link the code to its reproducible build, one per submitter
+	Verifications []CodeVerification `protobuf:"bytes,6,rep,name=verifications,proto3" json:"verifications"`
 }
 
 func (m *Code) Reset()         { *m = Code{} }
@@ -272,11 +278,32 @@ func (m *Code) GetPinned() bool {
 	return false
 }
 
+func (m *Code) GetEventSchema() []byte {
+	if m != nil {
+		return m.EventSchema
+	}
+	return nil
+}
+
+func (m *Code) GetVerifications() []CodeVerification {
+	if m != nil {
+		return m.Verifications
+	}
+	return nil
+}
+
 // Contract struct encompasses ContractAddress, ContractInfo, and ContractState
 type Contract struct {
 	ContractAddress string       `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	ContractInfo    ContractInfo `protobuf:"bytes,2,opt,name=contract_info,json=contractInfo,proto3" json:"contract_info"`
 	ContractState   []Model      `protobuf:"bytes,3,rep,name=contract_state,json=contractState,proto3" json:"contract_state"`
+	// BlockHook is set when the contract is registered for begin/end block callbacks
+	BlockHook *BlockHook `protobuf:"bytes,4,opt,name=block_hook,json=blockHook,proto3" json:"block_hook,omitempty"`
+	// StorageDeposit is the deposit locked for the contract state
+	StorageDeposit github_com_line_lbm_sdk_types.Coins `protobuf:"bytes,5,rep,name=storage_deposit,json=storageDeposit,proto3,castrepeated=github.com/line/lbm-sdk/types.Coins" json:"storage_deposit,omitempty"`
+	// ContractCodeHistory is the code history of the contract, which is started anew with a genesis
+	// entry when empty
+	ContractCodeHistory []ContractCodeHistoryEntry `protobuf:"bytes,6,rep,name=contract_code_history,json=contractCodeHistory,proto3" json:"contract_code_history,omitempty"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -333,6 +360,110 @@ func (m *Contract) GetContractState() []Model {
 	return nil
 }
 
+func (m *Contract) GetBlockHook() *BlockHook {
+	if m != nil {
+		return m.BlockHook
+	}
+	return nil
+}
+
+func (m *Contract) GetStorageDeposit() github_com_line_lbm_sdk_types.Coins {
+	if m != nil {
+		return m.StorageDeposit
+	}
+	return nil
+}
+
+func (m *Contract) GetContractCodeHistory() []ContractCodeHistoryEntry {
+	if m != nil {
+		return m.ContractCodeHistory
+	}
+	return nil
+}
+
+// ContractExport is a portable dump of a single contract with its code, history and full state
+// taken at a given height
+type ContractExport struct {
+	// height the contract was exported at
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// code is the code of the contract
+	Code Code `protobuf:"bytes,2,opt,name=code,proto3" json:"code"`
+	// contract is the contract info with the full contract state
+	Contract Contract `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract"`
+	// history is the code history of the contract
+	History []ContractCodeHistoryEntry `protobuf:"bytes,4,rep,name=history,proto3" json:"history"`
+	// hash is the sha256 integrity hash of the proto encoded export with an empty hash
+	Hash []byte `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *ContractExport) Reset()         { *m = ContractExport{} }
+func (m *ContractExport) String() string { return proto.CompactTextString(m) }
+func (*ContractExport) ProtoMessage()    {}
+func (*ContractExport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3308f670fed712dc, []int{3}
+}
+func (m *ContractExport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractExport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractExport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractExport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractExport.Merge(m, src)
+}
+func (m *ContractExport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractExport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractExport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractExport proto.InternalMessageInfo
+
+func (m *ContractExport) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ContractExport) GetCode() Code {
+	if m != nil {
+		return m.Code
+	}
+	return Code{}
+}
+
+func (m *ContractExport) GetContract() Contract {
+	if m != nil {
+		return m.Contract
+	}
+	return Contract{}
+}
+
+func (m *ContractExport) GetHistory() []ContractCodeHistoryEntry {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *ContractExport) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 // Sequence key and value of an id generation counter
 type Sequence struct {
 	IDKey []byte `protobuf:"bytes,1,opt,name=id_key,json=idKey,proto3" json:"id_key,omitempty"`
@@ -343,7 +474,7 @@ func (m *Sequence) Reset()         { *m = Sequence{} }
 func (m *Sequence) String() string { return proto.CompactTextString(m) }
 func (*Sequence) ProtoMessage()    {}
 func (*Sequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3308f670fed712dc, []int{3}
+	return fileDescriptor_3308f670fed712dc, []int{4}
 }
 func (m *Sequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -391,54 +522,71 @@ func init() {
 	proto.RegisterType((*GenesisState_GenMsgs)(nil), "lbm.wasm.v1.GenesisState.GenMsgs")
 	proto.RegisterType((*Code)(nil), "lbm.wasm.v1.Code")
 	proto.RegisterType((*Contract)(nil), "lbm.wasm.v1.Contract")
+	proto.RegisterType((*ContractExport)(nil), "lbm.wasm.v1.ContractExport")
 	proto.RegisterType((*Sequence)(nil), "lbm.wasm.v1.Sequence")
 }
 
 func init() { proto.RegisterFile("lbm/wasm/v1/genesis.proto", fileDescriptor_3308f670fed712dc) }
 
 var fileDescriptor_3308f670fed712dc = []byte{
-	// 648 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x94, 0xcf, 0x4e, 0xdb, 0x4e,
-	0x10, 0xc7, 0x63, 0xe2, 0x84, 0x64, 0xc8, 0xef, 0x07, 0x5d, 0x42, 0x31, 0xa9, 0x9a, 0xa4, 0xa1,
-	0x07, 0x2a, 0xb5, 0xb1, 0xa0, 0x97, 0xaa, 0x87, 0xfe, 0x71, 0xa9, 0x4a, 0x44, 0x91, 0x90, 0xb9,
-	0xa0, 0x5e, 0x22, 0xc7, 0x1e, 0x5c, 0x8b, 0xd8, 0x9b, 0x66, 0x37, 0x94, 0xbc, 0x45, 0x1f, 0xa0,
-	0x8f, 0xd2, 0x07, 0xe0, 0xc8, 0xb1, 0xa7, 0xa8, 0x0a, 0xb7, 0x9e, 0x7b, 0xe8, 0xb1, 0xda, 0x3f,
-	0x31, 0x0e, 0x70, 0xcb, 0x7c, 0xe7, 0x3b, 0x9f, 0x9d, 0x9d, 0x8c, 0x17, 0x36, 0xfa, 0xbd, 0xd8,
-	0xfe, 0xea, 0xb1, 0xd8, 0x3e, 0xdb, 0xb6, 0x43, 0x4c, 0x90, 0x45, 0xac, 0x3d, 0x18, 0x52, 0x4e,
-	0xc9, 0x52, 0xbf, 0x17, 0xb7, 0x45, 0xaa, 0x7d, 0xb6, 0x5d, 0xab, 0x86, 0x34, 0xa4, 0x52, 0xb7,
-	0xc5, 0x2f, 0x65, 0xa9, 0xad, 0x67, 0xab, 0xf9, 0x78, 0x80, 0xba, 0xb6, 0x56, 0x9d, 0x4b, 0x9c,
-	0x2b, 0xb5, 0xf5, 0xd7, 0x84, 0xca, 0x07, 0x75, 0xc6, 0x11, 0xf7, 0x38, 0x92, 0x6d, 0x28, 0x0e,
-	0xbc, 0xa1, 0x17, 0x33, 0xcb, 0x68, 0x1a, 0x5b, 0x4b, 0x3b, 0xab, 0xed, 0xcc, 0x99, 0xed, 0x43,
-	0x99, 0x72, 0xcc, 0x8b, 0x49, 0x23, 0xe7, 0x6a, 0x23, 0x79, 0x03, 0x05, 0x9f, 0x06, 0xc8, 0xac,
-	0x85, 0x66, 0x7e, 0x6b, 0x69, 0xe7, 0xde, 0x5c, 0xc5, 0x3b, 0x1a, 0xa0, 0xb3, 0x2e, 0xfc, 0xbf,
-	0x27, 0x8d, 0x65, 0xe9, 0x7b, 0x4a, 0xe3, 0x88, 0x63, 0x3c, 0xe0, 0x63, 0x57, 0x15, 0x92, 0x43,
-	0x28, 0xfb, 0x34, 0xe1, 0x43, 0xcf, 0xe7, 0xcc, 0xca, 0x4b, 0xca, 0xda, 0x0d, 0x8a, 0xca, 0x3a,
-	0x0f, 0x34, 0x69, 0x35, 0xf5, 0x67, 0x68, 0xd7, 0x10, 0x41, 0x64, 0xf8, 0x65, 0x84, 0x89, 0x8f,
-	0xcc, 0x32, 0xef, 0x20, 0x1e, 0xe9, 0xec, 0x35, 0x31, 0xf5, 0x67, 0x89, 0xa9, 0x48, 0x8e, 0xa1,
-	0x14, 0x62, 0xd2, 0x8d, 0x59, 0xc8, 0xac, 0x82, 0x04, 0x3e, 0x9a, 0x03, 0x66, 0xa7, 0x28, 0x82,
-	0x03, 0x16, 0x32, 0xa7, 0xa6, 0xe1, 0x64, 0x56, 0x9a, 0x61, 0x2f, 0x86, 0xca, 0x54, 0xfb, 0x63,
-	0xc0, 0xa2, 0x2e, 0x20, 0x2f, 0x01, 0x18, 0xa7, 0x43, 0xec, 0x8a, 0xc1, 0xe8, 0xbf, 0x60, 0x63,
-	0xee, 0x9c, 0x03, 0x16, 0x1e, 0x09, 0x87, 0x18, 0xec, 0x5e, 0xce, 0x2d, 0xb3, 0x59, 0x40, 0x8e,
-	0xa1, 0x1a, 0x25, 0x8c, 0x7b, 0x09, 0x8f, 0x3c, 0x2e, 0x08, 0x6a, 0x18, 0xd6, 0x82, 0xa4, 0x6c,
-	0xde, 0xa4, 0x74, 0xae, 0xbd, 0xb3, 0xf1, 0xee, 0xe5, 0xdc, 0xd5, 0xe8, 0xb6, 0x4c, 0x3e, 0xc2,
-	0x0a, 0x9e, 0xa3, 0x3f, 0xca, 0x52, 0xf3, 0x92, 0xda, 0xb8, 0x49, 0x7d, 0xaf, 0x7c, 0x19, 0xe2,
-	0x32, 0xce, 0x4b, 0x4e, 0x01, 0xf2, 0x6c, 0x14, 0xb7, 0xbe, 0x1b, 0x60, 0xca, 0xbe, 0x37, 0x61,
-	0x51, 0xdc, 0xb6, 0x1b, 0x05, 0xf2, 0xc2, 0xa6, 0x03, 0xd3, 0x49, 0xa3, 0x28, 0x52, 0x9d, 0x5d,
-	0xb7, 0x28, 0x52, 0x9d, 0x80, 0xbc, 0x10, 0x2b, 0x22, 0x4c, 0xc9, 0x09, 0xd5, 0x37, 0x5a, 0xbb,
-	0xb5, 0x68, 0x9d, 0xe4, 0x84, 0xea, 0xe5, 0x2c, 0xf9, 0x3a, 0x26, 0x0f, 0x01, 0x64, 0x65, 0x6f,
-	0xcc, 0x91, 0xc9, 0xb6, 0x2b, 0xae, 0x64, 0x39, 0x42, 0x20, 0xf7, 0xa1, 0x38, 0x88, 0x92, 0x04,
-	0x03, 0xcb, 0x6c, 0x1a, 0x5b, 0x25, 0x57, 0x47, 0xad, 0x1f, 0x06, 0x94, 0xd2, 0x01, 0x3c, 0x81,
-	0x95, 0xd9, 0xc5, 0xbb, 0x5e, 0x10, 0x0c, 0x91, 0xa9, 0xef, 0xa3, 0xec, 0x2e, 0xcf, 0xf4, 0xb7,
-	0x4a, 0x26, 0xbb, 0xf0, 0x5f, 0x6a, 0xcd, 0x34, 0xbb, 0x71, 0xe7, 0x3e, 0x67, 0x1a, 0xae, 0xf8,
-	0x19, 0x8d, 0xbc, 0x86, 0xff, 0x53, 0x0a, 0x13, 0x2b, 0xa5, 0x3f, 0x0b, 0x32, 0x3f, 0x6f, 0x1a,
-	0x60, 0x5f, 0xd7, 0xa7, 0xa7, 0xca, 0x0d, 0x6c, 0x39, 0x50, 0x9a, 0xad, 0x38, 0x69, 0x42, 0x31,
-	0x0a, 0xba, 0xa7, 0x38, 0x96, 0x3d, 0x57, 0x9c, 0xf2, 0x74, 0xd2, 0x28, 0x74, 0x76, 0xf7, 0x71,
-	0xec, 0x16, 0xa2, 0x60, 0x1f, 0xc7, 0xa4, 0x0a, 0x85, 0x33, 0xaf, 0x3f, 0x42, 0xd9, 0xac, 0xe9,
-	0xaa, 0xc0, 0x79, 0x75, 0x31, 0xad, 0x1b, 0x97, 0xd3, 0xba, 0xf1, 0x6b, 0x5a, 0x37, 0xbe, 0x5d,
-	0xd5, 0x73, 0x97, 0x57, 0xf5, 0xdc, 0xcf, 0xab, 0x7a, 0xee, 0xd3, 0xe3, 0x30, 0xe2, 0x9f, 0x47,
-	0xbd, 0xb6, 0x4f, 0x63, 0xbb, 0x1f, 0x25, 0x68, 0xf7, 0x7b, 0xf1, 0x33, 0x16, 0x9c, 0xda, 0xe7,
-	0xea, 0x89, 0x91, 0x0f, 0x4f, 0xaf, 0x28, 0xdf, 0x98, 0xe7, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x88, 0xd2, 0x11, 0xe2, 0xd2, 0x04, 0x00, 0x00,
+	// 898 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x4b, 0x6f, 0x23, 0x45,
+	0x10, 0xc7, 0x3d, 0xf1, 0xd8, 0xb1, 0xcb, 0xde, 0x64, 0xe9, 0x3c, 0x76, 0x62, 0xb4, 0x76, 0xd6,
+	0x01, 0x11, 0x04, 0xd8, 0x4a, 0x10, 0x02, 0x71, 0xe0, 0x31, 0x24, 0x22, 0xd6, 0xb2, 0xd2, 0x6a,
+	0x22, 0xa1, 0x15, 0x97, 0xd1, 0x3c, 0x3a, 0xe3, 0x96, 0x3d, 0xdd, 0xc6, 0xdd, 0x36, 0xf1, 0x15,
+	0x71, 0xe2, 0xc4, 0xe7, 0xe0, 0x93, 0xec, 0x71, 0x8f, 0x9c, 0x02, 0x72, 0x4e, 0x70, 0xe6, 0xc0,
+	0x0d, 0xd4, 0x8f, 0x71, 0xc6, 0x8e, 0xf7, 0xc0, 0x6d, 0xba, 0xea, 0x5f, 0x3f, 0x77, 0x55, 0x57,
+	0x95, 0xe1, 0x60, 0x18, 0xa6, 0xdd, 0x1f, 0x02, 0x9e, 0x76, 0xa7, 0x27, 0xdd, 0x04, 0x53, 0xcc,
+	0x09, 0xef, 0x8c, 0xc6, 0x4c, 0x30, 0x54, 0x1b, 0x86, 0x69, 0x47, 0xba, 0x3a, 0xd3, 0x93, 0xc6,
+	0x6e, 0xc2, 0x12, 0xa6, 0xec, 0x5d, 0xf9, 0xa5, 0x25, 0x8d, 0x7d, 0x19, 0x1d, 0x06, 0x1c, 0xcb,
+	0xe8, 0x88, 0x11, 0x6a, 0xec, 0x8f, 0xf2, 0x54, 0x31, 0x1b, 0x61, 0xc3, 0x6c, 0xec, 0x2e, 0x39,
+	0xae, 0xb5, 0xb5, 0xfd, 0x8f, 0x0d, 0xf5, 0xaf, 0xf5, 0x6f, 0x5f, 0x8a, 0x40, 0x60, 0x74, 0x02,
+	0xe5, 0x51, 0x30, 0x0e, 0x52, 0xee, 0x58, 0x87, 0xd6, 0x71, 0xed, 0x74, 0xa7, 0x93, 0xbb, 0x4b,
+	0xe7, 0xb9, 0x72, 0xb9, 0xf6, 0xcb, 0x9b, 0x56, 0xc1, 0x33, 0x42, 0xf4, 0x05, 0x94, 0x22, 0x16,
+	0x63, 0xee, 0x6c, 0x1c, 0x16, 0x8f, 0x6b, 0xa7, 0x6f, 0x2c, 0x45, 0x7c, 0xc5, 0x62, 0xec, 0x3e,
+	0x92, 0xfa, 0xbf, 0x6e, 0x5a, 0xdb, 0x4a, 0xf7, 0x3e, 0x4b, 0x89, 0xc0, 0xe9, 0x48, 0xcc, 0x3c,
+	0x1d, 0x88, 0x9e, 0x43, 0x35, 0x62, 0x54, 0x8c, 0x83, 0x48, 0x70, 0xa7, 0xa8, 0x28, 0x7b, 0x2b,
+	0x14, 0xed, 0x75, 0xdf, 0x34, 0xa4, 0x9d, 0x85, 0x3e, 0x47, 0xbb, 0x83, 0x48, 0x22, 0xc7, 0xdf,
+	0x4f, 0x30, 0x8d, 0x30, 0x77, 0xec, 0x35, 0xc4, 0x4b, 0xe3, 0xbd, 0x23, 0x2e, 0xf4, 0x79, 0xe2,
+	0xc2, 0x88, 0x5e, 0x40, 0x25, 0xc1, 0xd4, 0x4f, 0x79, 0xc2, 0x9d, 0x92, 0x02, 0x3e, 0x59, 0x02,
+	0xe6, 0xab, 0x28, 0x0f, 0xcf, 0x78, 0xc2, 0xdd, 0x86, 0x81, 0xa3, 0x2c, 0x34, 0xc7, 0xde, 0x4c,
+	0xb4, 0xa8, 0xf1, 0xb7, 0x05, 0x9b, 0x26, 0x00, 0x7d, 0x0a, 0xc0, 0x05, 0x1b, 0x63, 0x5f, 0x16,
+	0xc6, 0x3c, 0xc1, 0xc1, 0xd2, 0xef, 0x3c, 0xe3, 0xc9, 0xa5, 0x54, 0xc8, 0xc2, 0x5e, 0x14, 0xbc,
+	0x2a, 0xcf, 0x0e, 0xe8, 0x05, 0xec, 0x12, 0xca, 0x45, 0x40, 0x05, 0x09, 0x04, 0xf6, 0xb3, 0x62,
+	0x38, 0x1b, 0x8a, 0x72, 0xb4, 0x4a, 0xe9, 0xdd, 0x69, 0xb3, 0xf2, 0x5e, 0x14, 0xbc, 0x1d, 0x72,
+	0xdf, 0x8c, 0xbe, 0x81, 0x87, 0xf8, 0x1a, 0x47, 0x93, 0x3c, 0xb5, 0xa8, 0xa8, 0xad, 0x55, 0xea,
+	0xb9, 0xd6, 0xe5, 0x88, 0xdb, 0x78, 0xd9, 0xe4, 0x96, 0xa0, 0xc8, 0x27, 0x69, 0xfb, 0xe7, 0x0d,
+	0xb0, 0xd5, 0xbd, 0x8f, 0x60, 0x53, 0x66, 0xeb, 0x93, 0x58, 0x25, 0x6c, 0xbb, 0x30, 0xbf, 0x69,
+	0x95, 0xa5, 0xab, 0x77, 0xe6, 0x95, 0xa5, 0xab, 0x17, 0xa3, 0x4f, 0xa0, 0xaa, 0x45, 0xf4, 0x8a,
+	0x99, 0x8c, 0xf6, 0xee, 0x35, 0x5a, 0x8f, 0x5e, 0x31, 0xd3, 0x9c, 0x95, 0xc8, 0x9c, 0xd1, 0x63,
+	0x00, 0x15, 0x19, 0xce, 0x04, 0xe6, 0xea, 0xda, 0x75, 0x4f, 0xb1, 0x5c, 0x69, 0x40, 0xfb, 0x50,
+	0x1e, 0x11, 0x4a, 0x71, 0xec, 0xd8, 0x87, 0xd6, 0x71, 0xc5, 0x33, 0x27, 0xf4, 0x04, 0xea, 0x78,
+	0x8a, 0xa9, 0xf0, 0x79, 0xd4, 0xc7, 0x69, 0xe0, 0x94, 0x54, 0x60, 0x4d, 0xd9, 0x2e, 0x95, 0x09,
+	0xf5, 0xe0, 0xc1, 0x14, 0x8f, 0xc9, 0x15, 0x89, 0x02, 0x41, 0x18, 0xe5, 0x4e, 0x59, 0xf5, 0xc5,
+	0xe3, 0x7b, 0xf7, 0xfa, 0x36, 0xa7, 0x32, 0xf7, 0x5b, 0x8e, 0x6c, 0xff, 0x5b, 0x84, 0xca, 0xa2,
+	0xdc, 0xef, 0xc2, 0xc3, 0xac, 0xcc, 0x7e, 0x10, 0xc7, 0x63, 0xcc, 0xf5, 0x34, 0x56, 0xbd, 0xed,
+	0xcc, 0xfe, 0xa5, 0x36, 0xa3, 0x33, 0x78, 0xb0, 0x90, 0xe6, 0x4a, 0x73, 0xb0, 0x76, 0x7a, 0x72,
+	0xe5, 0xa9, 0x47, 0x39, 0x1b, 0xfa, 0x1c, 0xb6, 0x16, 0x14, 0x2e, 0x1b, 0xd8, 0x0c, 0x21, 0x5a,
+	0x7e, 0x5d, 0x16, 0xe3, 0x61, 0x76, 0xfd, 0x4c, 0xaf, 0xb7, 0xc6, 0x47, 0x00, 0xe1, 0x90, 0x45,
+	0x03, 0xbf, 0xcf, 0xd8, 0x40, 0x15, 0xb2, 0x76, 0xba, 0xbf, 0x14, 0xec, 0x4a, 0xf7, 0x05, 0x63,
+	0x03, 0xaf, 0x1a, 0x66, 0x9f, 0xe8, 0x27, 0x0b, 0xb6, 0x65, 0xff, 0x06, 0x09, 0xf6, 0x63, 0x3c,
+	0x62, 0x9c, 0x08, 0xa7, 0x94, 0x5b, 0x22, 0x72, 0xbf, 0xe9, 0x04, 0x08, 0x75, 0x2f, 0xcc, 0x2c,
+	0x1d, 0xac, 0x44, 0xdc, 0x8d, 0xd4, 0xaf, 0xbf, 0xb7, 0x8e, 0x12, 0x22, 0xfa, 0x93, 0xb0, 0x13,
+	0xb1, 0xb4, 0x3b, 0x24, 0x14, 0x77, 0x87, 0x61, 0xfa, 0x01, 0x8f, 0x07, 0x66, 0x15, 0x4a, 0x10,
+	0xf7, 0xb6, 0x0c, 0xe1, 0x4c, 0x03, 0xd0, 0x8f, 0x16, 0xec, 0x2d, 0xf2, 0x57, 0xbd, 0xd2, 0x27,
+	0x52, 0x32, 0x33, 0x0f, 0xfa, 0xf6, 0xda, 0x6a, 0xaa, 0x01, 0xd4, 0xba, 0x73, 0x2a, 0xc6, 0x33,
+	0xf7, 0x1d, 0x73, 0xc1, 0xd6, 0x5a, 0x56, 0x6e, 0xf2, 0x77, 0xa2, 0xfb, 0x88, 0xf6, 0x9f, 0x16,
+	0x6c, 0x65, 0xe8, 0xf3, 0xeb, 0x11, 0x1b, 0x0b, 0xd9, 0x9a, 0x7d, 0x4c, 0x92, 0xbe, 0x50, 0xaf,
+	0x5f, 0xf4, 0xcc, 0x09, 0xbd, 0x07, 0xb6, 0x5a, 0x0f, 0xfa, 0xad, 0xd7, 0xec, 0x5b, 0xfd, 0x46,
+	0x4a, 0x84, 0x3e, 0x86, 0xca, 0xca, 0xcc, 0xbe, 0x66, 0xb5, 0x2e, 0xe6, 0xc6, 0x74, 0xe1, 0x39,
+	0x6c, 0x66, 0x65, 0xb0, 0xff, 0x4f, 0x19, 0x34, 0x27, 0x8b, 0x45, 0x08, 0xec, 0x7e, 0xc0, 0xfb,
+	0x66, 0x7e, 0xd4, 0x77, 0xdb, 0x85, 0x4a, 0xb6, 0x7f, 0xd1, 0x21, 0x94, 0x49, 0xec, 0x0f, 0xf0,
+	0x4c, 0x25, 0x59, 0x77, 0xab, 0xf3, 0x9b, 0x56, 0xa9, 0x77, 0xf6, 0x14, 0xcf, 0xbc, 0x12, 0x89,
+	0x9f, 0xe2, 0x19, 0xda, 0x85, 0xd2, 0x34, 0x18, 0x4e, 0x74, 0xbe, 0xb6, 0xa7, 0x0f, 0xee, 0x67,
+	0x2f, 0xe7, 0x4d, 0xeb, 0xd5, 0xbc, 0x69, 0xfd, 0x31, 0x6f, 0x5a, 0xbf, 0xdc, 0x36, 0x0b, 0xaf,
+	0x6e, 0x9b, 0x85, 0xdf, 0x6e, 0x9b, 0x85, 0xef, 0xde, 0x7a, 0x5d, 0x0f, 0x5c, 0xeb, 0xff, 0x3f,
+	0xd5, 0x0a, 0x61, 0x59, 0xfd, 0x01, 0x7e, 0xf8, 0xdf, 0x00, 0xa5, 0x0e, 0x99, 0x94, 0x87, 0x07,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -645,6 +793,27 @@ func (m *Code) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Verifications) > 0 {
+		for iNdEx := len(m.Verifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Verifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.EventSchema) > 0 {
+		i -= len(m.EventSchema)
+		copy(dAtA[i:], m.EventSchema)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EventSchema)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Pinned {
 		i--
 		if m.Pinned {
@@ -700,6 +869,46 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContractCodeHistory) > 0 {
+		for iNdEx := len(m.ContractCodeHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractCodeHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.StorageDeposit) > 0 {
+		for iNdEx := len(m.StorageDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.BlockHook != nil {
+		{
+			size, err := m.BlockHook.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.ContractState) > 0 {
 		for iNdEx := len(m.ContractState) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -734,6 +943,75 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContractExport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractExport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractExport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Code.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Sequence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -881,6 +1159,16 @@ func (m *Code) Size() (n int) {
 	if m.Pinned {
 		n += 2
 	}
+	l = len(m.EventSchema)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Verifications) > 0 {
+		for _, e := range m.Verifications {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -902,6 +1190,48 @@ func (m *Contract) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.BlockHook != nil {
+		l = m.BlockHook.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.StorageDeposit) > 0 {
+		for _, e := range m.StorageDeposit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractCodeHistory) > 0 {
+		for _, e := range m.ContractCodeHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractExport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = m.Code.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Contract.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -1436,61 +1766,11 @@ func (m *Code) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Pinned = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Contract) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Contract: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Contract: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventSchema", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1500,7 +1780,125 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventSchema = append(m.EventSchema[:0], dAtA[iNdEx:postIndex]...)
+			if m.EventSchema == nil {
+				m.EventSchema = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifications = append(m.Verifications, CodeVerification{})
+			if err := m.Verifications[len(m.Verifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Contract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Contract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Contract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1585,6 +1983,313 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockHook == nil {
+				m.BlockHook = &BlockHook{}
+			}
+			if err := m.BlockHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageDeposit = append(m.StorageDeposit, types.Coin{})
+			if err := m.StorageDeposit[len(m.StorageDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCodeHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractCodeHistory = append(m.ContractCodeHistory, ContractCodeHistoryEntry{})
+			if err := m.ContractCodeHistory[len(m.ContractCodeHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractExport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractExport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractExport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Code.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, ContractCodeHistoryEntry{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
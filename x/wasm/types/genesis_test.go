@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -113,6 +114,29 @@ func TestCodeValidateBasic(t *testing.T) {
 			},
 			expError: true,
 		},
+		"verifications of different submitters": {
+			srcMutator: func(c *Code) {
+				c.Verifications = []CodeVerification{
+					codeVerificationFixture(c, "link1qyqszqgpqyqszqgpqyqszqgpqyqszqgp8apuk5"),
+					codeVerificationFixture(c, "link1ghekyjucln7y67ntx7cf27m9dpuxxemnqk82wt"),
+				}
+			},
+		},
+		"verification of other code": {
+			srcMutator: func(c *Code) {
+				v := codeVerificationFixture(c, "link1qyqszqgpqyqszqgpqyqszqgpqyqszqgp8apuk5")
+				v.CodeID++
+				c.Verifications = []CodeVerification{v}
+			},
+			expError: true,
+		},
+		"duplicate verification submitter": {
+			srcMutator: func(c *Code) {
+				v := codeVerificationFixture(c, "link1qyqszqgpqyqszqgpqyqszqgpqyqszqgp8apuk5")
+				c.Verifications = []CodeVerification{v, v}
+			},
+			expError: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -170,3 +194,13 @@ func TestContractValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func codeVerificationFixture(c *Code, submitter string) CodeVerification {
+	return CodeVerification{
+		CodeID:        c.CodeID,
+		CodeHash:      c.CodeInfo.CodeHash,
+		Submitter:     submitter,
+		SourceHash:    bytes.Repeat([]byte{0x1}, 32),
+		BuilderDigest: "sha256:" + strings.Repeat("ab", 32),
+	}
+}
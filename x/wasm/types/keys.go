@@ -30,6 +30,10 @@ var (
 	ContractCodeHistoryElementPrefix               = []byte{0x05}
 	ContractByCodeIDAndCreatedSecondaryIndexPrefix = []byte{0x06}
 	PinnedCodeIndexPrefix                          = []byte{0x07}
+	BlockHookKeyPrefix                             = []byte{0x08}
+	ContractStorageUsagePrefix                     = []byte{0x09}
+	EventSchemaPrefix                              = []byte{0x0a}
+	CodeVerificationPrefix                         = []byte{0x0b}
 
 	KeyLastCodeID     = append(SequenceKeyPrefix, []byte("lastCodeId")...)
 	KeyLastInstanceID = append(SequenceKeyPrefix, []byte("lastContractId")...)
@@ -103,3 +107,36 @@ func GetPinnedCodeIndexPrefix(codeID uint64) []byte {
 func ParsePinnedCodeIndex(s []byte) uint64 {
 	return sdk.BigEndianToUint64(s)
 }
+
+// GetBlockHookKey returns the key for the begin/end block callback registration of a contract
+func GetBlockHookKey(contractAddr sdk.AccAddress) []byte {
+	return append(BlockHookKeyPrefix, contractAddr...)
+}
+
+// GetContractStorageUsageKey returns the key for the storage usage and deposit of a contract
+func GetContractStorageUsageKey(contractAddr sdk.AccAddress) []byte {
+	return append(ContractStorageUsagePrefix, contractAddr...)
+}
+
+// GetEventSchemaKey returns the key for the event schema registered with a code id
+func GetEventSchemaKey(codeID uint64) []byte {
+	prefixLen := len(EventSchemaPrefix)
+	r := make([]byte, prefixLen+8)
+	copy(r[0:], EventSchemaPrefix)
+	copy(r[prefixLen:], sdk.Uint64ToBigEndian(codeID))
+	return r
+}
+
+// GetCodeVerificationPrefix returns the key prefix for the verification records of a code
+func GetCodeVerificationPrefix(codeID uint64) []byte {
+	prefixLen := len(CodeVerificationPrefix)
+	r := make([]byte, prefixLen+8)
+	copy(r[0:], CodeVerificationPrefix)
+	copy(r[prefixLen:], sdk.Uint64ToBigEndian(codeID))
+	return r
+}
+
+// GetCodeVerificationKey returns the key of the verification record of a code by a submitter
+func GetCodeVerificationKey(codeID uint64, submitter sdk.AccAddress) []byte {
+	return append(GetCodeVerificationPrefix(codeID), submitter...)
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/gogo/protobuf/jsonpb"
 	sdk "github.com/line/lbm-sdk/types"
@@ -39,25 +40,47 @@ var ParamStoreKeyMaxWasmCodeSize = []byte("maxWasmCodeSize")
 var ParamStoreKeyGasMultiplier = []byte("gasMultiplier")
 var ParamStoreKeyInstanceCost = []byte("instanceCost")
 var ParamStoreKeyCompileCost = []byte("compileCost")
+var ParamStoreKeyStorageDepositPerByte = []byte("storageDepositPerByte")
+var ParamStoreKeyStargateQueryAllowlist = []byte("stargateQueryAllowlist")
+var ParamStoreKeyStargateMsgAllowlist = []byte("stargateMsgAllowlist")
 
 var AllAccessTypes = []AccessType{
 	AccessTypeNobody,
 	AccessTypeOnlyAddress,
 	AccessTypeEverybody,
+	AccessTypeAnyOfAddresses,
 }
 
-func (a AccessType) With(addr sdk.AccAddress) AccessConfig {
+// With returns an AccessConfig of this type for the given addresses. AccessTypeOnlyAddress requires exactly one
+// address, AccessTypeAnyOfAddresses at least one.
+func (a AccessType) With(addrs ...sdk.AccAddress) AccessConfig {
 	switch a {
 	case AccessTypeNobody:
 		return AllowNobody
 	case AccessTypeOnlyAddress:
-		addrBytes, _ := sdk.AccAddressToBytes(addr.String())
+		if len(addrs) != 1 {
+			panic("only one address allowed")
+		}
+		addrBytes, _ := sdk.AccAddressToBytes(addrs[0].String())
 		if err := sdk.VerifyAddressFormat(addrBytes); err != nil {
 			panic(err)
 		}
-		return AccessConfig{Permission: AccessTypeOnlyAddress, Address: addr.String()}
+		return AccessConfig{Permission: AccessTypeOnlyAddress, Address: addrs[0].String()}
 	case AccessTypeEverybody:
 		return AllowEverybody
+	case AccessTypeAnyOfAddresses:
+		if len(addrs) == 0 {
+			panic("addresses must not be empty")
+		}
+		bech32Addrs := make([]string, len(addrs))
+		for i, addr := range addrs {
+			addrBytes, _ := sdk.AccAddressToBytes(addr.String())
+			if err := sdk.VerifyAddressFormat(addrBytes); err != nil {
+				panic(err)
+			}
+			bech32Addrs[i] = addr.String()
+		}
+		return AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: bech32Addrs}
 	}
 	panic("unsupported access type")
 }
@@ -70,6 +93,8 @@ func (a AccessType) String() string {
 		return "OnlyAddress"
 	case AccessTypeEverybody:
 		return "Everybody"
+	case AccessTypeAnyOfAddresses:
+		return "AnyOfAddresses"
 	}
 	return "Unspecified"
 }
@@ -97,7 +122,15 @@ func (a *AccessType) UnmarshalJSONPB(_ *jsonpb.Unmarshaler, data []byte) error {
 }
 
 func (a AccessConfig) Equals(o AccessConfig) bool {
-	return a.Permission == o.Permission && a.Address == o.Address
+	if a.Permission != o.Permission || a.Address != o.Address || len(a.Addresses) != len(o.Addresses) {
+		return false
+	}
+	for i := range a.Addresses {
+		if a.Addresses[i] != o.Addresses[i] {
+			return false
+		}
+	}
+	return true
 }
 
 var (
@@ -140,6 +173,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyGasMultiplier, &p.GasMultiplier, validateGasMultiplier),
 		paramtypes.NewParamSetPair(ParamStoreKeyInstanceCost, &p.InstanceCost, validateInstanceCost),
 		paramtypes.NewParamSetPair(ParamStoreKeyCompileCost, &p.CompileCost, validateCompileCost),
+		paramtypes.NewParamSetPair(ParamStoreKeyStorageDepositPerByte, &p.StorageDepositPerByte, validateStorageDepositPerByte),
+		paramtypes.NewParamSetPair(ParamStoreKeyStargateQueryAllowlist, &p.StargateQueryAllowlist, validateStargateAllowlist),
+		paramtypes.NewParamSetPair(ParamStoreKeyStargateMsgAllowlist, &p.StargateMsgAllowlist, validateStargateAllowlist),
 	}
 }
 
@@ -166,6 +202,15 @@ func (p Params) ValidateBasic() error {
 	if err := validateCompileCost(p.CompileCost); err != nil {
 		return errors.Wrap(err, "compile cost")
 	}
+	if err := validateStorageDepositPerByte(p.StorageDepositPerByte); err != nil {
+		return errors.Wrap(err, "storage deposit per byte")
+	}
+	if err := validateStargateAllowlist(p.StargateQueryAllowlist); err != nil {
+		return errors.Wrap(err, "stargate query allowlist")
+	}
+	if err := validateStargateAllowlist(p.StargateMsgAllowlist); err != nil {
+		return errors.Wrap(err, "stargate msg allowlist")
+	}
 	return nil
 }
 
@@ -237,18 +282,52 @@ func validateCompileCost(i interface{}) error {
 	return nil
 }
 
+func validateStorageDepositPerByte(i interface{}) error {
+	a, ok := i.(sdk.Coins)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalid, "type: %T", i)
+	}
+	return a.Validate()
+}
+
+func validateStargateAllowlist(i interface{}) error {
+	a, ok := i.([]string)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalid, "type: %T", i)
+	}
+	index := make(map[string]struct{}, len(a))
+	for _, v := range a {
+		if !strings.HasPrefix(v, "/") || strings.ContainsAny(v, " \t\n") {
+			return sdkerrors.Wrapf(ErrInvalid, "%q must start with / and contain no whitespace", v)
+		}
+		if _, exists := index[v]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "%q", v)
+		}
+		index[v] = struct{}{}
+	}
+	return nil
+}
+
 func (a AccessConfig) ValidateBasic() error {
 	switch a.Permission {
 	case AccessTypeUnspecified:
 		return sdkerrors.Wrap(ErrEmpty, "type")
 	case AccessTypeNobody, AccessTypeEverybody:
-		if len(a.Address) != 0 {
+		if len(a.Address) != 0 || len(a.Addresses) != 0 {
 			return sdkerrors.Wrap(ErrInvalid, "address not allowed for this type")
 		}
 		return nil
 	case AccessTypeOnlyAddress:
+		if len(a.Addresses) != 0 {
+			return sdkerrors.Wrap(ErrInvalid, "addresses not allowed for this type")
+		}
 		err := sdk.ValidateAccAddress(a.Address)
 		return err
+	case AccessTypeAnyOfAddresses:
+		if len(a.Address) != 0 {
+			return sdkerrors.Wrap(ErrInvalid, "address not allowed for this type")
+		}
+		return validateAddresses(a.Addresses)
 	}
 	return sdkerrors.Wrapf(ErrInvalid, "unknown type: %q", a.Permission)
 }
@@ -261,7 +340,31 @@ func (a AccessConfig) Allowed(actor sdk.AccAddress) bool {
 		return true
 	case AccessTypeOnlyAddress:
 		return a.Address == actor.String()
+	case AccessTypeAnyOfAddresses:
+		for _, addr := range a.Addresses {
+			if addr == actor.String() {
+				return true
+			}
+		}
+		return false
 	default:
 		panic("unknown type")
 	}
 }
+
+func validateAddresses(addrs []string) error {
+	if len(addrs) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "addresses")
+	}
+	idx := make(map[string]struct{}, len(addrs))
+	for _, addr := range addrs {
+		if err := sdk.ValidateAccAddress(addr); err != nil {
+			return sdkerrors.Wrapf(err, "address: %s", addr)
+		}
+		if _, found := idx[addr]; found {
+			return sdkerrors.Wrapf(ErrDuplicate, "address: %s", addr)
+		}
+		idx[addr] = struct{}{}
+	}
+	return nil
+}
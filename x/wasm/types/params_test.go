@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
 	"testing"
 
@@ -14,6 +15,7 @@ import (
 func TestValidateParams(t *testing.T) {
 	var (
 		anyAddress     = sdk.BytesToAccAddress(make([]byte, sdk.BytesAddrLen))
+		otherAddress   = sdk.BytesToAccAddress(bytes.Repeat([]byte{1}, sdk.BytesAddrLen))
 		invalidAddress = "invalid address"
 	)
 
@@ -57,6 +59,77 @@ func TestValidateParams(t *testing.T) {
 				CompileCost:                  DefaultCompileCost,
 			},
 		},
+		"all good with any of addresses": {
+			src: Params{
+				CodeUploadAccess:             AccessTypeAnyOfAddresses.With(anyAddress, otherAddress),
+				InstantiateDefaultPermission: AccessTypeAnyOfAddresses,
+				ContractStatusAccess:         AccessTypeAnyOfAddresses.With(anyAddress),
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+			},
+		},
+		"reject CodeUploadAccess empty addresses in any of addresses": {
+			src: Params{
+				CodeUploadAccess:             AccessConfig{Permission: AccessTypeAnyOfAddresses},
+				InstantiateDefaultPermission: AccessTypeAnyOfAddresses,
+				ContractStatusAccess:         DefaultContractStatusAccess,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+			},
+			expErr: true,
+		},
+		"reject CodeUploadAccess invalid address in any of addresses": {
+			src: Params{
+				CodeUploadAccess:             AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{anyAddress.String(), invalidAddress}},
+				InstantiateDefaultPermission: AccessTypeAnyOfAddresses,
+				ContractStatusAccess:         DefaultContractStatusAccess,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+			},
+			expErr: true,
+		},
+		"reject CodeUploadAccess duplicate address in any of addresses": {
+			src: Params{
+				CodeUploadAccess:             AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []string{anyAddress.String(), anyAddress.String()}},
+				InstantiateDefaultPermission: AccessTypeAnyOfAddresses,
+				ContractStatusAccess:         DefaultContractStatusAccess,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+			},
+			expErr: true,
+		},
+		"reject CodeUploadAccess any of addresses with obsolete address": {
+			src: Params{
+				CodeUploadAccess:             AccessConfig{Permission: AccessTypeAnyOfAddresses, Address: anyAddress.String(), Addresses: []string{anyAddress.String()}},
+				InstantiateDefaultPermission: AccessTypeAnyOfAddresses,
+				ContractStatusAccess:         DefaultContractStatusAccess,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+			},
+			expErr: true,
+		},
+		"reject CodeUploadAccess only address with obsolete addresses": {
+			src: Params{
+				CodeUploadAccess:             AccessConfig{Permission: AccessTypeOnlyAddress, Address: anyAddress.String(), Addresses: []string{anyAddress.String()}},
+				InstantiateDefaultPermission: AccessTypeOnlyAddress,
+				ContractStatusAccess:         DefaultContractStatusAccess,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+			},
+			expErr: true,
+		},
 		"reject empty type in instantiate permission": {
 			src: Params{
 				CodeUploadAccess:     AllowNobody,
@@ -139,6 +212,83 @@ func TestValidateParams(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"all good with storage deposit": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				ContractStatusAccess:         DefaultContractStatusAccess,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				StorageDepositPerByte:        sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+			},
+		},
+		"reject invalid storage deposit": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				ContractStatusAccess:         DefaultContractStatusAccess,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				StorageDepositPerByte:        sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.ZeroInt()}},
+			},
+			expErr: true,
+		},
+		"all good with stargate allowlists": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				ContractStatusAccess:         DefaultContractStatusAccess,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				StargateQueryAllowlist:       []string{"/lbm.bank.v1.Query/AllBalances"},
+				StargateMsgAllowlist:         []string{"/lbm.bank.v1.MsgSend"},
+			},
+		},
+		"reject stargate query path without leading slash": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				ContractStatusAccess:         DefaultContractStatusAccess,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				StargateQueryAllowlist:       []string{"lbm.bank.v1.Query/AllBalances"},
+			},
+			expErr: true,
+		},
+		"reject stargate msg type url with whitespace": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				ContractStatusAccess:         DefaultContractStatusAccess,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				StargateMsgAllowlist:         []string{"/lbm.bank.v1.MsgSend "},
+			},
+			expErr: true,
+		},
+		"reject duplicate stargate msg type url": {
+			src: Params{
+				CodeUploadAccess:             AllowNobody,
+				InstantiateDefaultPermission: AccessTypeNobody,
+				ContractStatusAccess:         DefaultContractStatusAccess,
+				MaxWasmCodeSize:              DefaultMaxWasmCodeSize,
+				GasMultiplier:                DefaultGasMultiplier,
+				InstanceCost:                 DefaultInstanceCost,
+				CompileCost:                  DefaultCompileCost,
+				StargateMsgAllowlist:         []string{"/lbm.bank.v1.MsgSend", "/lbm.bank.v1.MsgSend"},
+			},
+			expErr: true,
+		},
 		"reject empty max wasm code size": {
 			src: Params{
 				CodeUploadAccess:             AllowNobody,
@@ -257,11 +407,12 @@ func TestAccessTypeMarshalJson(t *testing.T) {
 		src AccessType
 		exp string
 	}{
-		"Unspecified": {src: AccessTypeUnspecified, exp: `"Unspecified"`},
-		"Nobody":      {src: AccessTypeNobody, exp: `"Nobody"`},
-		"OnlyAddress": {src: AccessTypeOnlyAddress, exp: `"OnlyAddress"`},
-		"Everybody":   {src: AccessTypeEverybody, exp: `"Everybody"`},
-		"unknown":     {src: 999, exp: `"Unspecified"`},
+		"Unspecified":    {src: AccessTypeUnspecified, exp: `"Unspecified"`},
+		"Nobody":         {src: AccessTypeNobody, exp: `"Nobody"`},
+		"OnlyAddress":    {src: AccessTypeOnlyAddress, exp: `"OnlyAddress"`},
+		"Everybody":      {src: AccessTypeEverybody, exp: `"Everybody"`},
+		"AnyOfAddresses": {src: AccessTypeAnyOfAddresses, exp: `"AnyOfAddresses"`},
+		"unknown":        {src: 999, exp: `"Unspecified"`},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -277,11 +428,12 @@ func TestAccessTypeUnmarshalJson(t *testing.T) {
 		src string
 		exp AccessType
 	}{
-		"Unspecified": {src: `"Unspecified"`, exp: AccessTypeUnspecified},
-		"Nobody":      {src: `"Nobody"`, exp: AccessTypeNobody},
-		"OnlyAddress": {src: `"OnlyAddress"`, exp: AccessTypeOnlyAddress},
-		"Everybody":   {src: `"Everybody"`, exp: AccessTypeEverybody},
-		"unknown":     {src: `""`, exp: AccessTypeUnspecified},
+		"Unspecified":    {src: `"Unspecified"`, exp: AccessTypeUnspecified},
+		"Nobody":         {src: `"Nobody"`, exp: AccessTypeNobody},
+		"OnlyAddress":    {src: `"OnlyAddress"`, exp: AccessTypeOnlyAddress},
+		"Everybody":      {src: `"Everybody"`, exp: AccessTypeEverybody},
+		"AnyOfAddresses": {src: `"AnyOfAddresses"`, exp: AccessTypeAnyOfAddresses},
+		"unknown":        {src: `""`, exp: AccessTypeUnspecified},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -321,3 +473,35 @@ func TestParamsUnmarshalJson(t *testing.T) {
 		})
 	}
 }
+
+func TestAccessConfigAllowed(t *testing.T) {
+	var (
+		myAddr    = sdk.BytesToAccAddress(bytes.Repeat([]byte{1}, sdk.BytesAddrLen))
+		otherAddr = sdk.BytesToAccAddress(bytes.Repeat([]byte{2}, sdk.BytesAddrLen))
+	)
+	specs := map[string]struct {
+		config AccessConfig
+		exp    bool
+	}{
+		"nobody":                 {config: AllowNobody},
+		"everybody":              {config: AllowEverybody, exp: true},
+		"only address - matches": {config: AccessTypeOnlyAddress.With(myAddr), exp: true},
+		"only address - other":   {config: AccessTypeOnlyAddress.With(otherAddr)},
+		"any of addresses - single match": {
+			config: AccessTypeAnyOfAddresses.With(myAddr),
+			exp:    true,
+		},
+		"any of addresses - one of many matches": {
+			config: AccessTypeAnyOfAddresses.With(otherAddr, myAddr),
+			exp:    true,
+		},
+		"any of addresses - not included": {
+			config: AccessTypeAnyOfAddresses.With(otherAddr),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			assert.Equal(t, spec.exp, spec.config.Allowed(myAddr))
+		})
+	}
+}
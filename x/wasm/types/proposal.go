@@ -16,12 +16,15 @@ type ProposalType string
 const (
 	ProposalTypeStoreCode            ProposalType = "StoreCode"
 	ProposalTypeInstantiateContract  ProposalType = "InstantiateContract"
+	ProposalTypeInstantiateContract2 ProposalType = "InstantiateContract2"
 	ProposalTypeMigrateContract      ProposalType = "MigrateContract"
 	ProposalTypeUpdateAdmin          ProposalType = "UpdateAdmin"
 	ProposalTypeClearAdmin           ProposalType = "ClearAdmin"
 	ProposalTypePinCodes             ProposalType = "PinCodes"
 	ProposalTypeUnpinCodes           ProposalType = "UnpinCodes"
 	ProposalTypeUpdateContractStatus ProposalType = "UpdateContractStatus"
+	ProposalTypeRegisterBlockHook    ProposalType = "RegisterBlockHook"
+	ProposalTypeDeregisterBlockHook  ProposalType = "DeregisterBlockHook"
 )
 
 // DisableAllProposals contains no wasm gov types.
@@ -31,12 +34,15 @@ var DisableAllProposals []ProposalType
 var EnableAllProposals = []ProposalType{
 	ProposalTypeStoreCode,
 	ProposalTypeInstantiateContract,
+	ProposalTypeInstantiateContract2,
 	ProposalTypeMigrateContract,
 	ProposalTypeUpdateAdmin,
 	ProposalTypeClearAdmin,
 	ProposalTypePinCodes,
 	ProposalTypeUnpinCodes,
 	ProposalTypeUpdateContractStatus,
+	ProposalTypeRegisterBlockHook,
+	ProposalTypeDeregisterBlockHook,
 }
 
 // ConvertToProposals maps each key to a ProposalType and returns a typed list.
@@ -60,19 +66,25 @@ func ConvertToProposals(keys []string) ([]ProposalType, error) {
 func init() { // register new content types with the sdk
 	govtypes.RegisterProposalType(string(ProposalTypeStoreCode))
 	govtypes.RegisterProposalType(string(ProposalTypeInstantiateContract))
+	govtypes.RegisterProposalType(string(ProposalTypeInstantiateContract2))
 	govtypes.RegisterProposalType(string(ProposalTypeMigrateContract))
 	govtypes.RegisterProposalType(string(ProposalTypeUpdateAdmin))
 	govtypes.RegisterProposalType(string(ProposalTypeClearAdmin))
 	govtypes.RegisterProposalType(string(ProposalTypePinCodes))
 	govtypes.RegisterProposalType(string(ProposalTypeUnpinCodes))
+	govtypes.RegisterProposalType(string(ProposalTypeRegisterBlockHook))
+	govtypes.RegisterProposalType(string(ProposalTypeDeregisterBlockHook))
 	govtypes.RegisterProposalTypeCodec(&StoreCodeProposal{}, "wasm/StoreCodeProposal")
 	govtypes.RegisterProposalTypeCodec(&InstantiateContractProposal{}, "wasm/InstantiateContractProposal")
+	govtypes.RegisterProposalTypeCodec(&InstantiateContract2Proposal{}, "wasm/InstantiateContract2Proposal")
 	govtypes.RegisterProposalTypeCodec(&MigrateContractProposal{}, "wasm/MigrateContractProposal")
 	govtypes.RegisterProposalTypeCodec(&UpdateAdminProposal{}, "wasm/UpdateAdminProposal")
 	govtypes.RegisterProposalTypeCodec(&ClearAdminProposal{}, "wasm/ClearAdminProposal")
 	govtypes.RegisterProposalTypeCodec(&PinCodesProposal{}, "wasm/PinCodesProposal")
 	govtypes.RegisterProposalTypeCodec(&UnpinCodesProposal{}, "wasm/UnpinCodesProposal")
 	govtypes.RegisterProposalTypeCodec(UpdateContractStatusProposal{}, "wasm/UpdateContractStatusProposal")
+	govtypes.RegisterProposalTypeCodec(&RegisterBlockHookProposal{}, "wasm/RegisterBlockHookProposal")
+	govtypes.RegisterProposalTypeCodec(&DeregisterBlockHookProposal{}, "wasm/DeregisterBlockHookProposal")
 }
 
 // ProposalRoute returns the routing key of a parameter change proposal.
@@ -232,6 +244,99 @@ func (p InstantiateContractProposal) MarshalYAML() (interface{}, error) {
 	}, nil
 }
 
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p InstantiateContract2Proposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *InstantiateContract2Proposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p InstantiateContract2Proposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p InstantiateContract2Proposal) ProposalType() string {
+	return string(ProposalTypeInstantiateContract2)
+}
+
+// ValidateBasic validates the proposal
+func (p InstantiateContract2Proposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if err := sdk.ValidateAccAddress(p.RunAs); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "run as")
+	}
+
+	if p.CodeID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "code id is required")
+	}
+
+	if err := validateLabel(p.Label); err != nil {
+		return err
+	}
+
+	if !p.Funds.IsValid() {
+		return sdkerrors.ErrInvalidCoins
+	}
+
+	if len(p.Admin) != 0 {
+		if err := sdk.ValidateAccAddress(p.Admin); err != nil {
+			return err
+		}
+	}
+	if !json.Valid(p.InitMsg) {
+		return sdkerrors.Wrap(ErrInvalid, "init msg json")
+	}
+	if err := ValidateSalt(p.Salt); err != nil {
+		return sdkerrors.Wrap(err, "salt")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p InstantiateContract2Proposal) String() string {
+	return fmt.Sprintf(`Instantiate Code 2 Proposal:
+  Title:       %s
+  Description: %s
+  Run as:      %s
+  Admin:       %s
+  Code id:     %d
+  Label:       %s
+  InitMsg:     %q
+  Funds:       %s
+  Salt:        %X
+  FixMsg:      %t
+`, p.Title, p.Description, p.RunAs, p.Admin, p.CodeID, p.Label, p.InitMsg, p.Funds, p.Salt, p.FixMsg)
+}
+
+// MarshalYAML pretty prints the init message
+func (p InstantiateContract2Proposal) MarshalYAML() (interface{}, error) {
+	return struct {
+		Title       string    `yaml:"title"`
+		Description string    `yaml:"description"`
+		RunAs       string    `yaml:"run_as"`
+		Admin       string    `yaml:"admin"`
+		CodeID      uint64    `yaml:"code_id"`
+		Label       string    `yaml:"label"`
+		InitMsg     string    `yaml:"init_msg"`
+		Funds       sdk.Coins `yaml:"funds"`
+		Salt        string    `yaml:"salt"`
+		FixMsg      bool      `yaml:"fix_msg"`
+	}{
+		Title:       p.Title,
+		Description: p.Description,
+		RunAs:       p.RunAs,
+		Admin:       p.Admin,
+		CodeID:      p.CodeID,
+		Label:       p.Label,
+		InitMsg:     string(p.InitMsg),
+		Funds:       p.Funds,
+		Salt:        base64.StdEncoding.EncodeToString(p.Salt),
+		FixMsg:      p.FixMsg,
+	}, nil
+}
+
 // ProposalRoute returns the routing key of a parameter change proposal.
 func (p MigrateContractProposal) ProposalRoute() string { return RouterKey }
 
@@ -470,6 +575,80 @@ func (p UpdateContractStatusProposal) String() string {
 `, p.Title, p.Description, p.Contract, p.Status.String())
 }
 
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p RegisterBlockHookProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *RegisterBlockHookProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p RegisterBlockHookProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p RegisterBlockHookProposal) ProposalType() string {
+	return string(ProposalTypeRegisterBlockHook)
+}
+
+// ValidateBasic validates the proposal
+func (p RegisterBlockHookProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if err := sdk.ValidateAccAddress(p.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if err := p.BlockHook.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "block hook")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p RegisterBlockHookProposal) String() string {
+	return fmt.Sprintf(`Register Block Hook Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  BeginBlock:  %t
+  EndBlock:    %t
+  GasLimit:    %d
+`, p.Title, p.Description, p.Contract, p.BlockHook.BeginBlock, p.BlockHook.EndBlock, p.BlockHook.GasLimit)
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p DeregisterBlockHookProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *DeregisterBlockHookProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p DeregisterBlockHookProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p DeregisterBlockHookProposal) ProposalType() string {
+	return string(ProposalTypeDeregisterBlockHook)
+}
+
+// ValidateBasic validates the proposal
+func (p DeregisterBlockHookProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if err := sdk.ValidateAccAddress(p.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p DeregisterBlockHookProposal) String() string {
+	return fmt.Sprintf(`Deregister Block Hook Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+`, p.Title, p.Description, p.Contract)
+}
+
 func validateProposalCommons(title, description string) error {
 	if strings.TrimSpace(title) != title {
 		return sdkerrors.Wrap(govtypes.ErrInvalidProposalContent, "proposal title must not start/end with white spaces")
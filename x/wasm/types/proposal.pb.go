@@ -128,6 +128,64 @@ func (m *InstantiateContractProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InstantiateContractProposal proto.InternalMessageInfo
 
+// InstantiateContract2Proposal gov proposal content type to instantiate a
+// contract with a predictable address.
+type InstantiateContract2Proposal struct {
+	// Title is a short summary
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Description is a human readable text
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// RunAs is the address that is passed to the contract's environment as sender
+	RunAs string `protobuf:"bytes,3,opt,name=run_as,json=runAs,proto3" json:"run_as,omitempty"`
+	// Admin is an optional address that can execute migrations
+	Admin string `protobuf:"bytes,4,opt,name=admin,proto3" json:"admin,omitempty"`
+	// CodeID is the reference to the stored WASM code
+	CodeID uint64 `protobuf:"varint,5,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// Label is optional metadata to be stored with a constract instance.
+	Label string `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
+	// InitMsg json encoded message to be passed to the contract on instantiation
+	InitMsg []byte `protobuf:"bytes,7,opt,name=init_msg,json=initMsg,proto3" json:"init_msg,omitempty"`
+	// Funds coins that are transferred to the contract on instantiation
+	Funds github_com_line_lbm_sdk_types.Coins `protobuf:"bytes,8,rep,name=funds,proto3,castrepeated=github.com/line/lbm-sdk/types.Coins" json:"funds"`
+	// Salt is an arbitrary value provided by the sender. Size can be 1 to 64.
+	Salt []byte `protobuf:"bytes,9,opt,name=salt,proto3" json:"salt,omitempty"`
+	// FixMsg include the msg value into the hash for the predictable address.
+	// Default is false
+	FixMsg bool `protobuf:"varint,10,opt,name=fix_msg,json=fixMsg,proto3" json:"fix_msg,omitempty"`
+}
+
+func (m *InstantiateContract2Proposal) Reset()      { *m = InstantiateContract2Proposal{} }
+func (*InstantiateContract2Proposal) ProtoMessage() {}
+func (*InstantiateContract2Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b6af62537450c9, []int{2}
+}
+func (m *InstantiateContract2Proposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InstantiateContract2Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InstantiateContract2Proposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InstantiateContract2Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstantiateContract2Proposal.Merge(m, src)
+}
+func (m *InstantiateContract2Proposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *InstantiateContract2Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstantiateContract2Proposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InstantiateContract2Proposal proto.InternalMessageInfo
+
 // MigrateContractProposal gov proposal content type to migrate a contract.
 type MigrateContractProposal struct {
 	// Title is a short summary
@@ -147,7 +205,7 @@ type MigrateContractProposal struct {
 func (m *MigrateContractProposal) Reset()      { *m = MigrateContractProposal{} }
 func (*MigrateContractProposal) ProtoMessage() {}
 func (*MigrateContractProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_38b6af62537450c9, []int{2}
+	return fileDescriptor_38b6af62537450c9, []int{3}
 }
 func (m *MigrateContractProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,7 +249,7 @@ type UpdateAdminProposal struct {
 func (m *UpdateAdminProposal) Reset()      { *m = UpdateAdminProposal{} }
 func (*UpdateAdminProposal) ProtoMessage() {}
 func (*UpdateAdminProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_38b6af62537450c9, []int{3}
+	return fileDescriptor_38b6af62537450c9, []int{4}
 }
 func (m *UpdateAdminProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -233,7 +291,7 @@ type ClearAdminProposal struct {
 func (m *ClearAdminProposal) Reset()      { *m = ClearAdminProposal{} }
 func (*ClearAdminProposal) ProtoMessage() {}
 func (*ClearAdminProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_38b6af62537450c9, []int{4}
+	return fileDescriptor_38b6af62537450c9, []int{5}
 }
 func (m *ClearAdminProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,7 +333,7 @@ type PinCodesProposal struct {
 func (m *PinCodesProposal) Reset()      { *m = PinCodesProposal{} }
 func (*PinCodesProposal) ProtoMessage() {}
 func (*PinCodesProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_38b6af62537450c9, []int{5}
+	return fileDescriptor_38b6af62537450c9, []int{6}
 }
 func (m *PinCodesProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,7 +375,7 @@ type UnpinCodesProposal struct {
 func (m *UnpinCodesProposal) Reset()      { *m = UnpinCodesProposal{} }
 func (*UnpinCodesProposal) ProtoMessage() {}
 func (*UnpinCodesProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_38b6af62537450c9, []int{6}
+	return fileDescriptor_38b6af62537450c9, []int{7}
 }
 func (m *UnpinCodesProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,7 +419,7 @@ type UpdateContractStatusProposal struct {
 func (m *UpdateContractStatusProposal) Reset()      { *m = UpdateContractStatusProposal{} }
 func (*UpdateContractStatusProposal) ProtoMessage() {}
 func (*UpdateContractStatusProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_38b6af62537450c9, []int{7}
+	return fileDescriptor_38b6af62537450c9, []int{8}
 }
 func (m *UpdateContractStatusProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -390,67 +448,165 @@ func (m *UpdateContractStatusProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateContractStatusProposal proto.InternalMessageInfo
 
+// RegisterBlockHookProposal gov proposal content type to register a contract
+// for begin/end block sudo callbacks.
+type RegisterBlockHookProposal struct {
+	// Title is a short summary
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	// Description is a human readable text
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+	// BlockHook configures the callbacks
+	BlockHook BlockHook `protobuf:"bytes,4,opt,name=block_hook,json=blockHook,proto3" json:"block_hook" yaml:"block_hook"`
+}
+
+func (m *RegisterBlockHookProposal) Reset()      { *m = RegisterBlockHookProposal{} }
+func (*RegisterBlockHookProposal) ProtoMessage() {}
+func (*RegisterBlockHookProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b6af62537450c9, []int{9}
+}
+func (m *RegisterBlockHookProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterBlockHookProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterBlockHookProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisterBlockHookProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterBlockHookProposal.Merge(m, src)
+}
+func (m *RegisterBlockHookProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterBlockHookProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterBlockHookProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterBlockHookProposal proto.InternalMessageInfo
+
+// DeregisterBlockHookProposal gov proposal content type to remove a contract
+// from the begin/end block callbacks.
+type DeregisterBlockHookProposal struct {
+	// Title is a short summary
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	// Description is a human readable text
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty" yaml:"contract"`
+}
+
+func (m *DeregisterBlockHookProposal) Reset()      { *m = DeregisterBlockHookProposal{} }
+func (*DeregisterBlockHookProposal) ProtoMessage() {}
+func (*DeregisterBlockHookProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b6af62537450c9, []int{10}
+}
+func (m *DeregisterBlockHookProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeregisterBlockHookProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeregisterBlockHookProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeregisterBlockHookProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeregisterBlockHookProposal.Merge(m, src)
+}
+func (m *DeregisterBlockHookProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeregisterBlockHookProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeregisterBlockHookProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeregisterBlockHookProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*StoreCodeProposal)(nil), "lbm.wasm.v1.StoreCodeProposal")
 	proto.RegisterType((*InstantiateContractProposal)(nil), "lbm.wasm.v1.InstantiateContractProposal")
+	proto.RegisterType((*InstantiateContract2Proposal)(nil), "lbm.wasm.v1.InstantiateContract2Proposal")
 	proto.RegisterType((*MigrateContractProposal)(nil), "lbm.wasm.v1.MigrateContractProposal")
 	proto.RegisterType((*UpdateAdminProposal)(nil), "lbm.wasm.v1.UpdateAdminProposal")
 	proto.RegisterType((*ClearAdminProposal)(nil), "lbm.wasm.v1.ClearAdminProposal")
 	proto.RegisterType((*PinCodesProposal)(nil), "lbm.wasm.v1.PinCodesProposal")
 	proto.RegisterType((*UnpinCodesProposal)(nil), "lbm.wasm.v1.UnpinCodesProposal")
 	proto.RegisterType((*UpdateContractStatusProposal)(nil), "lbm.wasm.v1.UpdateContractStatusProposal")
+	proto.RegisterType((*RegisterBlockHookProposal)(nil), "lbm.wasm.v1.RegisterBlockHookProposal")
+	proto.RegisterType((*DeregisterBlockHookProposal)(nil), "lbm.wasm.v1.DeregisterBlockHookProposal")
 }
 
 func init() { proto.RegisterFile("lbm/wasm/v1/proposal.proto", fileDescriptor_38b6af62537450c9) }
 
 var fileDescriptor_38b6af62537450c9 = []byte{
-	// 728 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0x4d, 0x6b, 0xe3, 0x46,
-	0x18, 0xc7, 0xad, 0x38, 0x96, 0x9d, 0xb1, 0x49, 0x1d, 0xd5, 0x71, 0x14, 0xa7, 0x48, 0x46, 0x29,
-	0xc5, 0x50, 0x6a, 0x93, 0x04, 0x4a, 0xdb, 0x9b, 0xe5, 0x5e, 0x52, 0x30, 0x18, 0xa5, 0xa1, 0xd0,
-	0x8b, 0xd1, 0xcb, 0x44, 0x19, 0x2a, 0xcd, 0x08, 0xcd, 0x38, 0xa9, 0xbf, 0x45, 0x3f, 0x40, 0xe9,
-	0xb5, 0xa5, 0x87, 0x96, 0xfd, 0x16, 0x61, 0x4f, 0x39, 0xe6, 0xa4, 0xdd, 0x38, 0xdf, 0xc0, 0x9f,
-	0x60, 0x99, 0x19, 0xd9, 0x6b, 0x2f, 0xbb, 0xcb, 0xc2, 0x6e, 0x16, 0xf6, 0xe6, 0xe7, 0x6d, 0xfe,
-	0xcf, 0xfc, 0x9e, 0xc7, 0x23, 0xd0, 0x8a, 0xbc, 0xb8, 0x77, 0xed, 0xd2, 0xb8, 0x77, 0x75, 0xd4,
-	0x4b, 0x52, 0x92, 0x10, 0xea, 0x46, 0xdd, 0x24, 0x25, 0x8c, 0x68, 0xd5, 0xc8, 0x8b, 0xbb, 0x3c,
-	0xd6, 0xbd, 0x3a, 0x6a, 0x35, 0x42, 0x12, 0x12, 0xe1, 0xef, 0xf1, 0x5f, 0x32, 0xa5, 0xd5, 0xe4,
-	0xe5, 0x9e, 0x4b, 0x21, 0x2f, 0xf7, 0x09, 0xc2, 0xb9, 0x7f, 0x6f, 0xf5, 0x58, 0x36, 0x4d, 0x20,
-	0x95, 0x01, 0xeb, 0xaf, 0x0d, 0xb0, 0x73, 0xc6, 0x48, 0x0a, 0x07, 0x24, 0x80, 0xa3, 0x5c, 0x4f,
-	0x6b, 0x80, 0x12, 0x43, 0x2c, 0x82, 0xba, 0xd2, 0x56, 0x3a, 0x5b, 0x8e, 0x34, 0xb4, 0x36, 0xa8,
-	0x06, 0x90, 0xfa, 0x29, 0x4a, 0x18, 0x22, 0x58, 0xdf, 0x10, 0xb1, 0x55, 0x97, 0xb6, 0x0b, 0xd4,
-	0x74, 0x82, 0xc7, 0x2e, 0xd5, 0x8b, 0xb2, 0x30, 0x9d, 0xe0, 0x3e, 0xd5, 0xbe, 0x05, 0xdb, 0x5c,
-	0x7b, 0xec, 0x4d, 0x19, 0x1c, 0xfb, 0x24, 0x80, 0xfa, 0x66, 0x5b, 0xe9, 0xd4, 0xec, 0xfa, 0x2c,
-	0x33, 0x6b, 0xbf, 0xf4, 0xcf, 0x86, 0xf6, 0x94, 0x89, 0x06, 0x9c, 0x1a, 0xcf, 0x5b, 0x58, 0x5a,
-	0x13, 0xa8, 0x94, 0x4c, 0x52, 0x1f, 0xea, 0x25, 0x71, 0x5c, 0x6e, 0x69, 0x3a, 0x28, 0x7b, 0x13,
-	0x14, 0x05, 0x30, 0xd5, 0x55, 0x11, 0x58, 0x98, 0xda, 0x08, 0x34, 0x11, 0xa6, 0xcc, 0xc5, 0x0c,
-	0xb9, 0x0c, 0x8e, 0x13, 0x98, 0xc6, 0x88, 0x52, 0xde, 0x6d, 0xb9, 0xad, 0x74, 0xaa, 0xc7, 0xfb,
-	0xdd, 0x15, 0x86, 0xdd, 0xbe, 0xef, 0x43, 0x4a, 0x07, 0x04, 0x5f, 0xa0, 0xd0, 0xd9, 0x5d, 0x29,
-	0x1c, 0x2d, 0xeb, 0xac, 0xff, 0x36, 0xc0, 0xc1, 0xe9, 0xcb, 0xc8, 0x80, 0x60, 0x96, 0xba, 0x3e,
-	0x7b, 0x2c, 0x54, 0x0d, 0x50, 0x72, 0x83, 0x18, 0x61, 0x41, 0x68, 0xcb, 0x91, 0x86, 0x76, 0x08,
-	0xca, 0x1c, 0xdb, 0x18, 0x05, 0x82, 0xc4, 0xa6, 0x0d, 0x66, 0x99, 0xa9, 0x72, 0x46, 0xa7, 0x3f,
-	0x3a, 0x2a, 0x0f, 0x9d, 0x06, 0xbc, 0x34, 0x72, 0x3d, 0x18, 0xe5, 0x4c, 0xa4, 0xa1, 0xed, 0x83,
-	0x0a, 0xc2, 0x88, 0x8d, 0x63, 0x1a, 0x0a, 0x06, 0x35, 0xa7, 0xcc, 0xed, 0x21, 0x0d, 0xb5, 0x9f,
-	0x41, 0xe9, 0x62, 0x82, 0x03, 0xaa, 0x57, 0xda, 0xc5, 0x4e, 0xf5, 0x78, 0x47, 0xb0, 0xe1, 0xcb,
-	0xc3, 0xd9, 0x0c, 0x08, 0xc2, 0xf6, 0xd7, 0x37, 0x99, 0x59, 0xf8, 0xf7, 0x99, 0x79, 0x18, 0x22,
-	0x76, 0x39, 0xf1, 0xba, 0x3e, 0x89, 0x7b, 0x11, 0xc2, 0xb0, 0x17, 0x79, 0xf1, 0x37, 0x34, 0xf8,
-	0x2d, 0x5f, 0x25, 0x9e, 0x4b, 0x1d, 0x79, 0x98, 0xf5, 0x54, 0x01, 0x7b, 0x43, 0x14, 0xa6, 0x1f,
-	0x01, 0x56, 0x0b, 0x54, 0xfc, 0x5c, 0x22, 0xe7, 0xb5, 0xb4, 0xdf, 0x0d, 0x99, 0x09, 0xaa, 0xb1,
-	0x6c, 0x55, 0xf0, 0x51, 0x05, 0x1f, 0x90, 0xbb, 0x86, 0x34, 0xb4, 0xfe, 0x54, 0xc0, 0xe7, 0xe7,
-	0x49, 0xe0, 0x32, 0xd8, 0xe7, 0x83, 0x78, 0xef, 0x8b, 0x1c, 0x81, 0x2d, 0x0c, 0xaf, 0xc7, 0x72,
-	0xc4, 0xe2, 0x2e, 0x76, 0x63, 0x9e, 0x99, 0xf5, 0xa9, 0x1b, 0x47, 0x3f, 0x58, 0xcb, 0x90, 0xe5,
-	0x54, 0x30, 0xbc, 0x16, 0x92, 0x6f, 0xbb, 0xa4, 0x75, 0x09, 0xb4, 0x41, 0x04, 0xdd, 0xf4, 0xc3,
-	0x34, 0xb7, 0xaa, 0x54, 0x7c, 0x45, 0xe9, 0x7f, 0x05, 0xd4, 0x47, 0x08, 0x73, 0x7e, 0x74, 0x29,
-	0xf4, 0xd5, 0x9a, 0x90, 0x5d, 0x9f, 0x67, 0x66, 0x4d, 0xde, 0x44, 0xb8, 0xad, 0x85, 0xf4, 0x77,
-	0xaf, 0x91, 0xb6, 0x9b, 0xf3, 0xcc, 0xd4, 0x64, 0xf6, 0x4a, 0xd0, 0x5a, 0x6f, 0xe9, 0x7b, 0xde,
-	0x92, 0x98, 0x22, 0x1f, 0x7d, 0xb1, 0xb3, 0x69, 0x1b, 0xb3, 0xcc, 0x2c, 0xcb, 0x31, 0xd2, 0x79,
-	0x66, 0x7e, 0x26, 0x4f, 0x58, 0x24, 0x59, 0x4e, 0x59, 0x8e, 0x96, 0x5a, 0x4f, 0x14, 0xa0, 0x9d,
-	0xe3, 0xe4, 0x93, 0xea, 0xf9, 0x6f, 0x05, 0x7c, 0x21, 0xd7, 0x6d, 0xf1, 0xd7, 0x39, 0x63, 0x2e,
-	0x9b, 0xd0, 0xc7, 0x1c, 0xad, 0x76, 0x02, 0x54, 0x2a, 0x54, 0xc4, 0x7a, 0x6d, 0x1f, 0x1f, 0xac,
-	0xbd, 0x91, 0xeb, 0x8d, 0x38, 0x79, 0xaa, 0xfd, 0xd3, 0xcd, 0xbd, 0x51, 0xb8, 0xbb, 0x37, 0x0a,
-	0xff, 0xcc, 0x0c, 0xe5, 0x66, 0x66, 0x28, 0xb7, 0x33, 0x43, 0x79, 0x3e, 0x33, 0x94, 0x3f, 0x1e,
-	0x8c, 0xc2, 0xed, 0x83, 0x51, 0xb8, 0x7b, 0x30, 0x0a, 0xbf, 0x7e, 0xf9, 0xa6, 0x77, 0xe3, 0x77,
-	0xf9, 0x31, 0x12, 0xcf, 0x87, 0xa7, 0x8a, 0x4f, 0xd1, 0xc9, 0x8b, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xe7, 0x86, 0xc8, 0x59, 0xfc, 0x06, 0x00, 0x00,
+	// 845 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4d, 0x6b, 0x1b, 0x47,
+	0x18, 0xd6, 0xca, 0xb6, 0x3e, 0x5e, 0x89, 0xd4, 0x9e, 0x38, 0xf2, 0xda, 0x0e, 0xbb, 0x62, 0x53,
+	0x8a, 0xa0, 0x54, 0xc2, 0x0a, 0x94, 0xb6, 0x37, 0xaf, 0x72, 0xa8, 0x0b, 0x06, 0xb1, 0x6e, 0x28,
+	0xf4, 0x22, 0xf6, 0x63, 0xbc, 0x1e, 0xbc, 0x3b, 0x23, 0x76, 0x46, 0xb1, 0x7d, 0xeb, 0x4f, 0xe8,
+	0x0f, 0x28, 0xbd, 0xb6, 0xf4, 0xd0, 0x8f, 0x7f, 0x61, 0x7a, 0xca, 0x31, 0x87, 0xb2, 0x6d, 0xe4,
+	0x7f, 0xa0, 0x5f, 0x50, 0x66, 0x66, 0xa5, 0x48, 0x21, 0x29, 0x85, 0x36, 0x01, 0x43, 0x6e, 0xfb,
+	0x7e, 0x3e, 0xef, 0xf3, 0xbc, 0xaf, 0xb4, 0x0b, 0x7b, 0x49, 0x90, 0xf6, 0x2e, 0x7c, 0x9e, 0xf6,
+	0x9e, 0x1c, 0xf4, 0xc6, 0x19, 0x1b, 0x33, 0xee, 0x27, 0xdd, 0x71, 0xc6, 0x04, 0x43, 0x8d, 0x24,
+	0x48, 0xbb, 0x32, 0xd6, 0x7d, 0x72, 0xb0, 0xb7, 0x1d, 0xb3, 0x98, 0x29, 0x7f, 0x4f, 0x3e, 0xe9,
+	0x94, 0xbd, 0x96, 0x2c, 0x0f, 0x7c, 0x8e, 0x65, 0x79, 0xc8, 0x08, 0x2d, 0xfc, 0x3b, 0xcb, 0x6d,
+	0xc5, 0xd5, 0x18, 0x73, 0x1d, 0x70, 0xbe, 0x2f, 0xc3, 0xd6, 0x89, 0x60, 0x19, 0x1e, 0xb0, 0x08,
+	0x0f, 0x0b, 0x3c, 0xb4, 0x0d, 0x1b, 0x82, 0x88, 0x04, 0x9b, 0x46, 0xdb, 0xe8, 0xd4, 0x3d, 0x6d,
+	0xa0, 0x36, 0x34, 0x22, 0xcc, 0xc3, 0x8c, 0x8c, 0x05, 0x61, 0xd4, 0x2c, 0xab, 0xd8, 0xb2, 0x0b,
+	0xdd, 0x83, 0x4a, 0x36, 0xa1, 0x23, 0x9f, 0x9b, 0x6b, 0xba, 0x30, 0x9b, 0xd0, 0x43, 0x8e, 0x3e,
+	0x86, 0x3b, 0x12, 0x7b, 0x14, 0x5c, 0x09, 0x3c, 0x0a, 0x59, 0x84, 0xcd, 0xf5, 0xb6, 0xd1, 0x69,
+	0xba, 0x9b, 0xd3, 0xdc, 0x6e, 0x7e, 0x75, 0x78, 0x72, 0xec, 0x5e, 0x09, 0x35, 0x80, 0xd7, 0x94,
+	0x79, 0x73, 0x0b, 0xb5, 0xa0, 0xc2, 0xd9, 0x24, 0x0b, 0xb1, 0xb9, 0xa1, 0xda, 0x15, 0x16, 0x32,
+	0xa1, 0x1a, 0x4c, 0x48, 0x12, 0xe1, 0xcc, 0xac, 0xa8, 0xc0, 0xdc, 0x44, 0x43, 0x68, 0x11, 0xca,
+	0x85, 0x4f, 0x05, 0xf1, 0x05, 0x1e, 0x8d, 0x71, 0x96, 0x12, 0xce, 0xe5, 0xb4, 0xd5, 0xb6, 0xd1,
+	0x69, 0xf4, 0x77, 0xbb, 0x4b, 0x1a, 0x76, 0x0f, 0xc3, 0x10, 0x73, 0x3e, 0x60, 0xf4, 0x94, 0xc4,
+	0xde, 0xbd, 0xa5, 0xc2, 0xe1, 0xa2, 0xce, 0xf9, 0xb9, 0x0c, 0xfb, 0x47, 0x2f, 0x22, 0x03, 0x46,
+	0x45, 0xe6, 0x87, 0xe2, 0x4d, 0x49, 0xb5, 0x0d, 0x1b, 0x7e, 0x94, 0x12, 0xaa, 0x14, 0xaa, 0x7b,
+	0xda, 0x40, 0x0f, 0xa0, 0x2a, 0x65, 0x1b, 0x91, 0x48, 0x29, 0xb1, 0xee, 0xc2, 0x34, 0xb7, 0x2b,
+	0x52, 0xa3, 0xa3, 0x47, 0x5e, 0x45, 0x86, 0x8e, 0x22, 0x59, 0x9a, 0xf8, 0x01, 0x4e, 0x0a, 0x4d,
+	0xb4, 0x81, 0x76, 0xa1, 0x46, 0x28, 0x11, 0xa3, 0x94, 0xc7, 0x4a, 0x83, 0xa6, 0x57, 0x95, 0xf6,
+	0x31, 0x8f, 0xd1, 0x97, 0xb0, 0x71, 0x3a, 0xa1, 0x11, 0x37, 0x6b, 0xed, 0xb5, 0x4e, 0xa3, 0xbf,
+	0xa5, 0xb4, 0x91, 0xc7, 0x23, 0xb5, 0x19, 0x30, 0x42, 0xdd, 0x0f, 0xaf, 0x73, 0xbb, 0xf4, 0xd3,
+	0x9f, 0xf6, 0x83, 0x98, 0x88, 0xb3, 0x49, 0xd0, 0x0d, 0x59, 0xda, 0x4b, 0x08, 0xc5, 0xbd, 0x24,
+	0x48, 0x3f, 0xe2, 0xd1, 0x79, 0x71, 0x4a, 0x32, 0x97, 0x7b, 0xba, 0x99, 0xf3, 0x47, 0x19, 0xee,
+	0xbf, 0x42, 0xb0, 0xfe, 0x3b, 0xc5, 0x5e, 0xa3, 0x18, 0x42, 0xb0, 0xce, 0xfd, 0x44, 0x98, 0x75,
+	0x05, 0xa6, 0x9e, 0xd1, 0x0e, 0x54, 0x4f, 0xc9, 0xa5, 0x9a, 0x01, 0xda, 0x46, 0xa7, 0xe6, 0x55,
+	0x4e, 0xc9, 0xe5, 0x31, 0x8f, 0x9d, 0xdf, 0x0d, 0xd8, 0x39, 0x26, 0x71, 0xf6, 0x16, 0x6e, 0x71,
+	0x0f, 0x6a, 0x61, 0x01, 0x51, 0x88, 0xbb, 0xb0, 0xff, 0x9d, 0xbe, 0x36, 0x34, 0x52, 0x3d, 0xaa,
+	0x22, 0x52, 0x51, 0xfc, 0xa0, 0x70, 0x49, 0x32, 0xdf, 0x19, 0x70, 0xf7, 0xf1, 0x38, 0xf2, 0x05,
+	0x3e, 0x94, 0x5b, 0xfb, 0xcf, 0x44, 0x0e, 0xa0, 0x4e, 0xf1, 0xc5, 0x48, 0xdf, 0x83, 0xe2, 0xe2,
+	0x6e, 0xcf, 0x72, 0x7b, 0xf3, 0xca, 0x4f, 0x93, 0xcf, 0x9c, 0x45, 0xc8, 0xf1, 0x6a, 0x14, 0x5f,
+	0x28, 0xc8, 0x7f, 0x22, 0xe9, 0x9c, 0x01, 0x1a, 0x24, 0xd8, 0xcf, 0xfe, 0x9f, 0xe1, 0x96, 0x91,
+	0xd6, 0x5e, 0x42, 0xfa, 0xc5, 0x80, 0xcd, 0x21, 0xa1, 0x52, 0x3f, 0xbe, 0x00, 0xfa, 0x60, 0x05,
+	0xc8, 0xdd, 0x9c, 0xe5, 0x76, 0x53, 0x33, 0x51, 0x6e, 0x67, 0x0e, 0xfd, 0xc9, 0x2b, 0xa0, 0xdd,
+	0xd6, 0x2c, 0xb7, 0x91, 0xce, 0x5e, 0x0a, 0x3a, 0xab, 0x23, 0x7d, 0x0a, 0xb5, 0x62, 0x8b, 0x72,
+	0xf5, 0x6b, 0x9d, 0x75, 0xd7, 0x9a, 0xe6, 0x76, 0x55, 0xaf, 0x91, 0xcf, 0x72, 0xfb, 0x3d, 0xdd,
+	0x61, 0x9e, 0xe4, 0x78, 0x55, 0xbd, 0x5a, 0xee, 0xfc, 0x66, 0x00, 0x7a, 0x4c, 0xc7, 0xb7, 0x6a,
+	0xe6, 0x1f, 0x0c, 0xb8, 0xaf, 0xcf, 0x6d, 0xfe, 0xd3, 0x39, 0x11, 0xbe, 0x98, 0xf0, 0x37, 0xb9,
+	0x5a, 0xf4, 0x10, 0x2a, 0x5c, 0xa1, 0xa8, 0xf3, 0xba, 0xd3, 0xdf, 0x5f, 0x79, 0x05, 0xad, 0x0e,
+	0xe2, 0x15, 0xa9, 0xce, 0x37, 0x65, 0xd8, 0xf5, 0x70, 0x4c, 0xb8, 0xc0, 0x99, 0x9b, 0xb0, 0xf0,
+	0xfc, 0x73, 0xc6, 0xce, 0xdf, 0xa2, 0xc8, 0xbd, 0x97, 0x09, 0xb9, 0x77, 0x97, 0x95, 0x2d, 0xae,
+	0x76, 0x89, 0xe5, 0x10, 0x20, 0x90, 0x73, 0x8e, 0xce, 0x18, 0x3b, 0x57, 0x4c, 0x1b, 0xfd, 0xd6,
+	0x0a, 0xd3, 0x05, 0x0d, 0x77, 0x57, 0xfe, 0x47, 0xce, 0x72, 0x7b, 0x4b, 0xb7, 0x7b, 0x51, 0xe7,
+	0x78, 0xf5, 0x60, 0x9e, 0xe5, 0xfc, 0x6a, 0xc0, 0xfe, 0x23, 0x9c, 0xdd, 0x22, 0x11, 0xdc, 0x2f,
+	0xae, 0x9f, 0x5b, 0xa5, 0x67, 0xcf, 0xad, 0xd2, 0x8f, 0x53, 0xcb, 0xb8, 0x9e, 0x5a, 0xc6, 0xd3,
+	0xa9, 0x65, 0xfc, 0x35, 0xb5, 0x8c, 0x6f, 0x6f, 0xac, 0xd2, 0xd3, 0x1b, 0xab, 0xf4, 0xec, 0xc6,
+	0x2a, 0x7d, 0xfd, 0xfe, 0xeb, 0x5e, 0x0d, 0x97, 0xfa, 0x0b, 0x4d, 0xbd, 0x21, 0x82, 0x8a, 0xfa,
+	0x3e, 0x7b, 0xf8, 0xf7, 0x00, 0x7b, 0xee, 0x23, 0x57, 0x11, 0x0a, 0x00, 0x00,
 }
 
 func (this *StoreCodeProposal) Equal(that interface{}) bool {
@@ -545,6 +701,62 @@ func (this *InstantiateContractProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *InstantiateContract2Proposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InstantiateContract2Proposal)
+	if !ok {
+		that2, ok := that.(InstantiateContract2Proposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.RunAs != that1.RunAs {
+		return false
+	}
+	if this.Admin != that1.Admin {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if this.Label != that1.Label {
+		return false
+	}
+	if !bytes.Equal(this.InitMsg, that1.InitMsg) {
+		return false
+	}
+	if len(this.Funds) != len(that1.Funds) {
+		return false
+	}
+	for i := range this.Funds {
+		if !this.Funds[i].Equal(&that1.Funds[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Salt, that1.Salt) {
+		return false
+	}
+	if this.FixMsg != that1.FixMsg {
+		return false
+	}
+	return true
+}
 func (this *MigrateContractProposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -750,6 +962,69 @@ func (this *UpdateContractStatusProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RegisterBlockHookProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RegisterBlockHookProposal)
+	if !ok {
+		that2, ok := that.(RegisterBlockHookProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if !this.BlockHook.Equal(&that1.BlockHook) {
+		return false
+	}
+	return true
+}
+func (this *DeregisterBlockHookProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeregisterBlockHookProposal)
+	if !ok {
+		that2, ok := that.(DeregisterBlockHookProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	return true
+}
 func (m *StoreCodeProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -911,7 +1186,7 @@ func (m *InstantiateContractProposal) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MigrateContractProposal) Marshal() (dAtA []byte, err error) {
+func (m *InstantiateContract2Proposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -921,20 +1196,58 @@ func (m *MigrateContractProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MigrateContractProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *InstantiateContract2Proposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MigrateContractProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InstantiateContract2Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MigrateMsg) > 0 {
-		i -= len(m.MigrateMsg)
-		copy(dAtA[i:], m.MigrateMsg)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.MigrateMsg)))
+	if m.FixMsg {
+		i--
+		if m.FixMsg {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Funds) > 0 {
+		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.InitMsg) > 0 {
+		i -= len(m.InitMsg)
+		copy(dAtA[i:], m.InitMsg)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.InitMsg)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Label)))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -943,10 +1256,10 @@ func (m *MigrateContractProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x28
 	}
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintProposal(dAtA, i, uint64(len(m.Contract)))
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Admin)))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -974,7 +1287,70 @@ func (m *MigrateContractProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdateAdminProposal) Marshal() (dAtA []byte, err error) {
+func (m *MigrateContractProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrateContractProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrateContractProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MigrateMsg) > 0 {
+		i -= len(m.MigrateMsg)
+		copy(dAtA[i:], m.MigrateMsg)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.MigrateMsg)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RunAs) > 0 {
+		i -= len(m.RunAs)
+		copy(dAtA[i:], m.RunAs)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.RunAs)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateAdminProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1228,6 +1604,104 @@ func (m *UpdateContractStatusProposal) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *RegisterBlockHookProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterBlockHookProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterBlockHookProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BlockHook.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeregisterBlockHookProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeregisterBlockHookProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeregisterBlockHookProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProposal(v)
 	base := offset
@@ -1318,6 +1792,55 @@ func (m *InstantiateContractProposal) Size() (n int) {
 	return n
 }
 
+func (m *InstantiateContract2Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.RunAs)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovProposal(uint64(m.CodeID))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.InitMsg)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.FixMsg {
+		n += 2
+	}
+	return n
+}
+
 func (m *MigrateContractProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1468,6 +1991,50 @@ func (m *UpdateContractStatusProposal) Size() (n int) {
 	return n
 }
 
+func (m *RegisterBlockHookProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = m.BlockHook.Size()
+	n += 1 + l + sovProposal(uint64(l))
+	return n
+}
+
+func (m *DeregisterBlockHookProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
 func sovProposal(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2051,7 +2618,7 @@ func (m *InstantiateContractProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MigrateContractProposal) Unmarshal(dAtA []byte) error {
+func (m *InstantiateContract2Proposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2074,10 +2641,10 @@ func (m *MigrateContractProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MigrateContractProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: InstantiateContract2Proposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MigrateContractProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InstantiateContract2Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2178,7 +2745,7 @@ func (m *MigrateContractProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2206,7 +2773,7 @@ func (m *MigrateContractProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
@@ -2229,9 +2796,9 @@ func (m *MigrateContractProposal) Unmarshal(dAtA []byte) error {
 			}
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MigrateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -2241,26 +2808,146 @@ func (m *MigrateContractProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MigrateMsg = append(m.MigrateMsg[:0], dAtA[iNdEx:postIndex]...)
-			if m.MigrateMsg == nil {
-				m.MigrateMsg = []byte{}
-			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitMsg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitMsg = append(m.InitMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.InitMsg == nil {
+				m.InitMsg = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funds = append(m.Funds, types.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixMsg", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FixMsg = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -2282,7 +2969,7 @@ func (m *MigrateContractProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateAdminProposal) Unmarshal(dAtA []byte) error {
+func (m *MigrateContractProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2305,10 +2992,10 @@ func (m *UpdateAdminProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateAdminProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MigrateContractProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateAdminProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MigrateContractProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2377,7 +3064,7 @@ func (m *UpdateAdminProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2405,7 +3092,7 @@ func (m *UpdateAdminProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewAdmin = string(dAtA[iNdEx:postIndex])
+			m.RunAs = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -2439,6 +3126,59 @@ func (m *UpdateAdminProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrateMsg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigrateMsg = append(m.MigrateMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.MigrateMsg == nil {
+				m.MigrateMsg = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -2460,7 +3200,7 @@ func (m *UpdateAdminProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClearAdminProposal) Unmarshal(dAtA []byte) error {
+func (m *UpdateAdminProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2483,10 +3223,10 @@ func (m *ClearAdminProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClearAdminProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateAdminProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClearAdminProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateAdminProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2554,6 +3294,38 @@ func (m *ClearAdminProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
@@ -2606,7 +3378,7 @@ func (m *ClearAdminProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PinCodesProposal) Unmarshal(dAtA []byte) error {
+func (m *ClearAdminProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2629,10 +3401,10 @@ func (m *PinCodesProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PinCodesProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClearAdminProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PinCodesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClearAdminProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2700,36 +3472,182 @@ func (m *PinCodesProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProposal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
 				}
-				m.CodeIDs = append(m.CodeIDs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProposal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PinCodesProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PinCodesProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PinCodesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProposal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CodeIDs = append(m.CodeIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProposal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
 						break
 					}
 				}
@@ -3151,6 +4069,331 @@ func (m *UpdateContractStatusProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RegisterBlockHookProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterBlockHookProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterBlockHookProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeregisterBlockHookProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeregisterBlockHookProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeregisterBlockHookProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProposal(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
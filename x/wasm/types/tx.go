@@ -37,6 +37,11 @@ func (msg MsgStoreCode) ValidateBasic() error {
 			return sdkerrors.Wrap(err, "instantiate permission")
 		}
 	}
+	if len(msg.EventSchema) != 0 {
+		if err := ValidateEventSchema(msg.EventSchema); err != nil {
+			return sdkerrors.Wrap(err, "event schema")
+		}
+	}
 	return nil
 }
 
@@ -98,6 +103,57 @@ func (msg MsgInstantiateContract) GetSigners() []sdk.AccAddress {
 
 }
 
+func (msg MsgInstantiateContract2) Route() string {
+	return RouterKey
+}
+
+func (msg MsgInstantiateContract2) Type() string {
+	return "instantiate2"
+}
+
+func (msg MsgInstantiateContract2) ValidateBasic() error {
+	if err := sdk.ValidateAccAddress(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+
+	if msg.CodeID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "code id is required")
+	}
+
+	if err := validateLabel(msg.Label); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "label is required")
+
+	}
+
+	if !msg.Funds.IsValid() {
+		return sdkerrors.ErrInvalidCoins
+	}
+
+	if len(msg.Admin) != 0 {
+		if err := sdk.ValidateAccAddress(msg.Admin); err != nil {
+			return sdkerrors.Wrap(err, "admin")
+		}
+	}
+	if !json.Valid(msg.InitMsg) {
+		return sdkerrors.Wrap(ErrInvalid, "init msg json")
+	}
+	if err := ValidateSalt(msg.Salt); err != nil {
+		return sdkerrors.Wrap(err, "salt")
+	}
+	return nil
+}
+
+func (msg MsgInstantiateContract2) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+
+}
+
+func (msg MsgInstantiateContract2) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.AccAddress(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+
+}
+
 func (msg MsgStoreCodeAndInstantiateContract) Route() string {
 	return RouterKey
 }
@@ -129,6 +185,12 @@ func (msg MsgStoreCodeAndInstantiateContract) ValidateBasic() error {
 		}
 	}
 
+	if len(msg.EventSchema) != 0 {
+		if err := ValidateEventSchema(msg.EventSchema); err != nil {
+			return sdkerrors.Wrap(err, "event schema")
+		}
+	}
+
 	if err := sdk.ValidateAccAddress(msg.Sender); err != nil {
 		return sdkerrors.Wrap(err, "sender")
 	}
@@ -332,6 +394,72 @@ func (msg MsgUpdateContractStatus) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{senderAddr}
 }
 
+func (msg MsgUpdateInstantiateConfig) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUpdateInstantiateConfig) Type() string {
+	return "update-instantiate-config"
+}
+
+func (msg MsgUpdateInstantiateConfig) ValidateBasic() error {
+	if err := sdk.ValidateAccAddress(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.CodeID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "code id is required")
+	}
+	if err := msg.NewInstantiatePermission.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "instantiate permission")
+	}
+	return nil
+}
+
+func (msg MsgUpdateInstantiateConfig) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateInstantiateConfig) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.AccAddress(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgSubmitCodeVerification) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSubmitCodeVerification) Type() string {
+	return "submit-code-verification"
+}
+
+func (msg MsgSubmitCodeVerification) ValidateBasic() error {
+	if err := sdk.ValidateAccAddress(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.CodeID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "code id is required")
+	}
+	if err := validateSourceHash(msg.SourceHash); err != nil {
+		return sdkerrors.Wrap(err, "source hash")
+	}
+	if err := validateBuilderDigest(msg.BuilderDigest); err != nil {
+		return sdkerrors.Wrap(err, "builder digest")
+	}
+	if err := validateAttestation(msg.Attestation); err != nil {
+		return sdkerrors.Wrap(err, "attestation")
+	}
+	return nil
+}
+
+func (msg MsgSubmitCodeVerification) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSubmitCodeVerification) GetSigners() []sdk.AccAddress {
+	senderAddr := sdk.AccAddress(msg.Sender)
+	return []sdk.AccAddress{senderAddr}
+}
+
 func (msg MsgIBCSend) Route() string {
 	return RouterKey
 }
@@ -12,6 +12,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_line_lbm_sdk_types "github.com/line/lbm-sdk/types"
 	types "github.com/line/lbm-sdk/types"
+	github_com_line_ostracon_libs_bytes "github.com/line/ostracon/libs/bytes"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -45,6 +46,12 @@ type MsgStoreCode struct {
 	// InstantiatePermission access control to apply on contract creation,
 	// optional
 	InstantiatePermission *AccessConfig `protobuf:"bytes,5,opt,name=instantiate_permission,json=instantiatePermission,proto3" json:"instantiate_permission,omitempty"`
+	// EventSchema is a JSON schema of an object with the attributes of the
+	// `wasm` event as properties, which the `wasm` events emitted by the
+	// contracts are validated against, optional. The VM lets the contracts emit
+	// the `wasm` event only, not custom `wasm-{type}` events, so a code has a
+	// single event schema.
+	EventSchema []byte `protobuf:"bytes,6,opt,name=event_schema,json=eventSchema,proto3" json:"event_schema,omitempty"`
 }
 
 func (m *MsgStoreCode) Reset()         { *m = MsgStoreCode{} }
@@ -210,6 +217,102 @@ func (m *MsgInstantiateContractResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgInstantiateContractResponse proto.InternalMessageInfo
 
+// MsgInstantiateContract2 create a new smart contract instance for the given
+// code id with a predictable address.
+type MsgInstantiateContract2 struct {
+	// Sender is the that actor that signed the messages
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Admin is an optional address that can execute migrations
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// CodeID is the reference to the stored WASM code
+	CodeID uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// Label is optional metadata to be stored with a contract instance.
+	Label string `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	// InitMsg json encoded message to be passed to the contract on instantiation
+	InitMsg []byte `protobuf:"bytes,5,opt,name=init_msg,json=initMsg,proto3" json:"init_msg,omitempty"`
+	// Funds coins that are transferred to the contract on instantiation
+	Funds github_com_line_lbm_sdk_types.Coins `protobuf:"bytes,6,rep,name=funds,proto3,castrepeated=github.com/line/lbm-sdk/types.Coins" json:"funds"`
+	// Salt is an arbitrary value provided by the sender. Size can be 1 to 64.
+	Salt []byte `protobuf:"bytes,7,opt,name=salt,proto3" json:"salt,omitempty"`
+	// FixMsg include the msg value into the hash for the predictable address.
+	// Default is false
+	FixMsg bool `protobuf:"varint,8,opt,name=fix_msg,json=fixMsg,proto3" json:"fix_msg,omitempty"`
+}
+
+func (m *MsgInstantiateContract2) Reset()         { *m = MsgInstantiateContract2{} }
+func (m *MsgInstantiateContract2) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantiateContract2) ProtoMessage()    {}
+func (*MsgInstantiateContract2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{4}
+}
+func (m *MsgInstantiateContract2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantiateContract2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantiateContract2.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstantiateContract2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantiateContract2.Merge(m, src)
+}
+func (m *MsgInstantiateContract2) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantiateContract2) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantiateContract2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstantiateContract2 proto.InternalMessageInfo
+
+// MsgInstantiateContract2Response return instantiation result data
+type MsgInstantiateContract2Response struct {
+	// Address is the bech32 address of the new contract instance.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Data contains base64-encoded bytes to returned from the contract
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *MsgInstantiateContract2Response) Reset()         { *m = MsgInstantiateContract2Response{} }
+func (m *MsgInstantiateContract2Response) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantiateContract2Response) ProtoMessage()    {}
+func (*MsgInstantiateContract2Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{5}
+}
+func (m *MsgInstantiateContract2Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantiateContract2Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantiateContract2Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstantiateContract2Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantiateContract2Response.Merge(m, src)
+}
+func (m *MsgInstantiateContract2Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantiateContract2Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantiateContract2Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstantiateContract2Response proto.InternalMessageInfo
+
 // MsgStoreCodeAndInstantiateContract submit Wasm code to the system and instantiate a contract using it.
 type MsgStoreCodeAndInstantiateContract struct {
 	// Sender is the that actor that signed the messages
@@ -230,13 +333,15 @@ type MsgStoreCodeAndInstantiateContract struct {
 	InitMsg encoding_json.RawMessage `protobuf:"bytes,8,opt,name=init_msg,json=initMsg,proto3,casttype=encoding/json.RawMessage" json:"init_msg,omitempty"`
 	// Funds coins that are transferred to the contract on instantiation
 	Funds github_com_line_lbm_sdk_types.Coins `protobuf:"bytes,9,rep,name=funds,proto3,castrepeated=github.com/line/lbm-sdk/types.Coins" json:"funds"`
+	// EventSchema of the `wasm` event as in MsgStoreCode, optional
+	EventSchema []byte `protobuf:"bytes,10,opt,name=event_schema,json=eventSchema,proto3" json:"event_schema,omitempty"`
 }
 
 func (m *MsgStoreCodeAndInstantiateContract) Reset()         { *m = MsgStoreCodeAndInstantiateContract{} }
 func (m *MsgStoreCodeAndInstantiateContract) String() string { return proto.CompactTextString(m) }
 func (*MsgStoreCodeAndInstantiateContract) ProtoMessage()    {}
 func (*MsgStoreCodeAndInstantiateContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751e1d2b9f9bf9e8, []int{4}
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{6}
 }
 func (m *MsgStoreCodeAndInstantiateContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -283,7 +388,7 @@ func (m *MsgStoreCodeAndInstantiateContractResponse) String() string {
 }
 func (*MsgStoreCodeAndInstantiateContractResponse) ProtoMessage() {}
 func (*MsgStoreCodeAndInstantiateContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751e1d2b9f9bf9e8, []int{5}
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{7}
 }
 func (m *MsgStoreCodeAndInstantiateContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,7 +433,7 @@ func (m *MsgExecuteContract) Reset()         { *m = MsgExecuteContract{} }
 func (m *MsgExecuteContract) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteContract) ProtoMessage()    {}
 func (*MsgExecuteContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751e1d2b9f9bf9e8, []int{6}
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{8}
 }
 func (m *MsgExecuteContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -367,7 +472,7 @@ func (m *MsgExecuteContractResponse) Reset()         { *m = MsgExecuteContractRe
 func (m *MsgExecuteContractResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteContractResponse) ProtoMessage()    {}
 func (*MsgExecuteContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751e1d2b9f9bf9e8, []int{7}
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{9}
 }
 func (m *MsgExecuteContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -412,7 +517,7 @@ func (m *MsgMigrateContract) Reset()         { *m = MsgMigrateContract{} }
 func (m *MsgMigrateContract) String() string { return proto.CompactTextString(m) }
 func (*MsgMigrateContract) ProtoMessage()    {}
 func (*MsgMigrateContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751e1d2b9f9bf9e8, []int{8}
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{10}
 }
 func (m *MsgMigrateContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -452,7 +557,7 @@ func (m *MsgMigrateContractResponse) Reset()         { *m = MsgMigrateContractRe
 func (m *MsgMigrateContractResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMigrateContractResponse) ProtoMessage()    {}
 func (*MsgMigrateContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751e1d2b9f9bf9e8, []int{9}
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{11}
 }
 func (m *MsgMigrateContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -495,7 +600,7 @@ func (m *MsgUpdateAdmin) Reset()         { *m = MsgUpdateAdmin{} }
 func (m *MsgUpdateAdmin) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateAdmin) ProtoMessage()    {}
 func (*MsgUpdateAdmin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751e1d2b9f9bf9e8, []int{10}
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{12}
 }
 func (m *MsgUpdateAdmin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -532,7 +637,7 @@ func (m *MsgUpdateAdminResponse) Reset()         { *m = MsgUpdateAdminResponse{}
 func (m *MsgUpdateAdminResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateAdminResponse) ProtoMessage()    {}
 func (*MsgUpdateAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751e1d2b9f9bf9e8, []int{11}
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{13}
 }
 func (m *MsgUpdateAdminResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -573,7 +678,7 @@ func (m *MsgClearAdmin) Reset()         { *m = MsgClearAdmin{} }
 func (m *MsgClearAdmin) String() string { return proto.CompactTextString(m) }
 func (*MsgClearAdmin) ProtoMessage()    {}
 func (*MsgClearAdmin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751e1d2b9f9bf9e8, []int{12}
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{14}
 }
 func (m *MsgClearAdmin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -610,7 +715,7 @@ func (m *MsgClearAdminResponse) Reset()         { *m = MsgClearAdminResponse{} }
 func (m *MsgClearAdminResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClearAdminResponse) ProtoMessage()    {}
 func (*MsgClearAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751e1d2b9f9bf9e8, []int{13}
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{15}
 }
 func (m *MsgClearAdminResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -653,7 +758,7 @@ func (m *MsgUpdateContractStatus) Reset()         { *m = MsgUpdateContractStatus
 func (m *MsgUpdateContractStatus) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateContractStatus) ProtoMessage()    {}
 func (*MsgUpdateContractStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751e1d2b9f9bf9e8, []int{14}
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{16}
 }
 func (m *MsgUpdateContractStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -690,7 +795,7 @@ func (m *MsgUpdateContractStatusResponse) Reset()         { *m = MsgUpdateContra
 func (m *MsgUpdateContractStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateContractStatusResponse) ProtoMessage()    {}
 func (*MsgUpdateContractStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751e1d2b9f9bf9e8, []int{15}
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{17}
 }
 func (m *MsgUpdateContractStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -719,11 +824,180 @@ func (m *MsgUpdateContractStatusResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateContractStatusResponse proto.InternalMessageInfo
 
+// MsgUpdateInstantiateConfig updates the instantiate config of a stored code
+type MsgUpdateInstantiateConfig struct {
+	// Sender is the that actor that signed the messages
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// CodeID references the stored WASM code
+	CodeID uint64 `protobuf:"varint,2,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// NewInstantiatePermission is the new access control
+	NewInstantiatePermission AccessConfig `protobuf:"bytes,3,opt,name=new_instantiate_permission,json=newInstantiatePermission,proto3" json:"new_instantiate_permission"`
+}
+
+func (m *MsgUpdateInstantiateConfig) Reset()         { *m = MsgUpdateInstantiateConfig{} }
+func (m *MsgUpdateInstantiateConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateInstantiateConfig) ProtoMessage()    {}
+func (*MsgUpdateInstantiateConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{18}
+}
+func (m *MsgUpdateInstantiateConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateInstantiateConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateInstantiateConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateInstantiateConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateInstantiateConfig.Merge(m, src)
+}
+func (m *MsgUpdateInstantiateConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateInstantiateConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateInstantiateConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateInstantiateConfig proto.InternalMessageInfo
+
+// MsgUpdateInstantiateConfigResponse returns empty data
+type MsgUpdateInstantiateConfigResponse struct {
+}
+
+func (m *MsgUpdateInstantiateConfigResponse) Reset()         { *m = MsgUpdateInstantiateConfigResponse{} }
+func (m *MsgUpdateInstantiateConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateInstantiateConfigResponse) ProtoMessage()    {}
+func (*MsgUpdateInstantiateConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{19}
+}
+func (m *MsgUpdateInstantiateConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateInstantiateConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateInstantiateConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateInstantiateConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateInstantiateConfigResponse.Merge(m, src)
+}
+func (m *MsgUpdateInstantiateConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateInstantiateConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateInstantiateConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateInstantiateConfigResponse proto.InternalMessageInfo
+
+// MsgSubmitCodeVerification links a stored code to the reproducible build it
+// was compiled from. It replaces the verification the sender submitted for
+// the code before.
+type MsgSubmitCodeVerification struct {
+	// Sender is the that actor that signed the messages
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// CodeID references the stored WASM code
+	CodeID uint64 `protobuf:"varint,2,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// SourceHash is the sha256 hash of the source archive
+	SourceHash github_com_line_ostracon_libs_bytes.HexBytes `protobuf:"bytes,3,opt,name=source_hash,json=sourceHash,proto3,casttype=github.com/line/ostracon/libs/bytes.HexBytes" json:"source_hash,omitempty"`
+	// BuilderDigest is the digest of the optimizer image used for the build,
+	// optionally prefixed with the image name
+	BuilderDigest string `protobuf:"bytes,4,opt,name=builder_digest,json=builderDigest,proto3" json:"builder_digest,omitempty"`
+	// Attestation is an off-chain verifiable statement about the build
+	Attestation []byte `protobuf:"bytes,5,opt,name=attestation,proto3" json:"attestation,omitempty"`
+}
+
+func (m *MsgSubmitCodeVerification) Reset()         { *m = MsgSubmitCodeVerification{} }
+func (m *MsgSubmitCodeVerification) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitCodeVerification) ProtoMessage()    {}
+func (*MsgSubmitCodeVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{20}
+}
+func (m *MsgSubmitCodeVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitCodeVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitCodeVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitCodeVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitCodeVerification.Merge(m, src)
+}
+func (m *MsgSubmitCodeVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitCodeVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitCodeVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitCodeVerification proto.InternalMessageInfo
+
+// MsgSubmitCodeVerificationResponse returns empty data
+type MsgSubmitCodeVerificationResponse struct {
+}
+
+func (m *MsgSubmitCodeVerificationResponse) Reset()         { *m = MsgSubmitCodeVerificationResponse{} }
+func (m *MsgSubmitCodeVerificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitCodeVerificationResponse) ProtoMessage()    {}
+func (*MsgSubmitCodeVerificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751e1d2b9f9bf9e8, []int{21}
+}
+func (m *MsgSubmitCodeVerificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitCodeVerificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitCodeVerificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitCodeVerificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitCodeVerificationResponse.Merge(m, src)
+}
+func (m *MsgSubmitCodeVerificationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitCodeVerificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitCodeVerificationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitCodeVerificationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgStoreCode)(nil), "lbm.wasm.v1.MsgStoreCode")
 	proto.RegisterType((*MsgStoreCodeResponse)(nil), "lbm.wasm.v1.MsgStoreCodeResponse")
 	proto.RegisterType((*MsgInstantiateContract)(nil), "lbm.wasm.v1.MsgInstantiateContract")
 	proto.RegisterType((*MsgInstantiateContractResponse)(nil), "lbm.wasm.v1.MsgInstantiateContractResponse")
+	proto.RegisterType((*MsgInstantiateContract2)(nil), "lbm.wasm.v1.MsgInstantiateContract2")
+	proto.RegisterType((*MsgInstantiateContract2Response)(nil), "lbm.wasm.v1.MsgInstantiateContract2Response")
 	proto.RegisterType((*MsgStoreCodeAndInstantiateContract)(nil), "lbm.wasm.v1.MsgStoreCodeAndInstantiateContract")
 	proto.RegisterType((*MsgStoreCodeAndInstantiateContractResponse)(nil), "lbm.wasm.v1.MsgStoreCodeAndInstantiateContractResponse")
 	proto.RegisterType((*MsgExecuteContract)(nil), "lbm.wasm.v1.MsgExecuteContract")
@@ -736,70 +1010,92 @@ func init() {
 	proto.RegisterType((*MsgClearAdminResponse)(nil), "lbm.wasm.v1.MsgClearAdminResponse")
 	proto.RegisterType((*MsgUpdateContractStatus)(nil), "lbm.wasm.v1.MsgUpdateContractStatus")
 	proto.RegisterType((*MsgUpdateContractStatusResponse)(nil), "lbm.wasm.v1.MsgUpdateContractStatusResponse")
+	proto.RegisterType((*MsgUpdateInstantiateConfig)(nil), "lbm.wasm.v1.MsgUpdateInstantiateConfig")
+	proto.RegisterType((*MsgUpdateInstantiateConfigResponse)(nil), "lbm.wasm.v1.MsgUpdateInstantiateConfigResponse")
+	proto.RegisterType((*MsgSubmitCodeVerification)(nil), "lbm.wasm.v1.MsgSubmitCodeVerification")
+	proto.RegisterType((*MsgSubmitCodeVerificationResponse)(nil), "lbm.wasm.v1.MsgSubmitCodeVerificationResponse")
 }
 
 func init() { proto.RegisterFile("lbm/wasm/v1/tx.proto", fileDescriptor_751e1d2b9f9bf9e8) }
 
 var fileDescriptor_751e1d2b9f9bf9e8 = []byte{
-	// 918 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x37, 0x69, 0xd2, 0xbc, 0x84, 0xb2, 0x98, 0x6c, 0xeb, 0x75, 0x51, 0xdc, 0x75, 0x57,
-	0xa2, 0x62, 0x21, 0x61, 0xbb, 0x12, 0x7b, 0xe0, 0xd4, 0x04, 0x0e, 0x95, 0x30, 0xac, 0x5c, 0x10,
-	0x12, 0x1c, 0xa2, 0xb1, 0x3d, 0x6b, 0xbc, 0xc4, 0x33, 0x91, 0xc7, 0xd9, 0xb6, 0x27, 0x24, 0xae,
-	0x20, 0xc4, 0xef, 0xe0, 0x84, 0xc4, 0x9f, 0xe8, 0x71, 0x8f, 0x9c, 0x0a, 0xa4, 0xff, 0x80, 0x23,
-	0x27, 0x34, 0x63, 0xc7, 0x99, 0xb8, 0x76, 0x9b, 0xc2, 0x5e, 0xf6, 0xe6, 0x37, 0xef, 0xbd, 0xef,
-	0xbd, 0xf7, 0xcd, 0x37, 0x2f, 0x81, 0xce, 0xd8, 0x09, 0xfb, 0xc7, 0x88, 0x85, 0xfd, 0xe7, 0x0f,
-	0xfb, 0xf1, 0x49, 0x6f, 0x12, 0xd1, 0x98, 0xaa, 0xad, 0xb1, 0x13, 0xf6, 0xf8, 0x69, 0xef, 0xf9,
-	0x43, 0xbd, 0xe3, 0x53, 0x9f, 0x8a, 0xf3, 0x3e, 0xff, 0x4a, 0x42, 0xf4, 0x4d, 0x9e, 0xe8, 0x20,
-	0x86, 0x79, 0xa2, 0x4b, 0x03, 0x92, 0x9e, 0x6f, 0x2d, 0x01, 0x9e, 0x4e, 0x30, 0x4b, 0x1c, 0xe6,
-	0x4c, 0x81, 0xb6, 0xc5, 0xfc, 0xa3, 0x98, 0x46, 0x78, 0x48, 0x3d, 0xac, 0x6e, 0x42, 0x9d, 0x61,
-	0xe2, 0xe1, 0x48, 0x53, 0x76, 0x94, 0xbd, 0xa6, 0x9d, 0x5a, 0xea, 0x07, 0xb0, 0xc1, 0xf3, 0x47,
-	0xce, 0x69, 0x8c, 0x47, 0x2e, 0xf5, 0xb0, 0x76, 0x6b, 0x47, 0xd9, 0x6b, 0x0f, 0x6e, 0xcf, 0xce,
-	0x8d, 0xf6, 0x97, 0x07, 0x47, 0xd6, 0xe0, 0x34, 0x16, 0x08, 0x76, 0x9b, 0xc7, 0xcd, 0x2d, 0x81,
-	0x47, 0xa7, 0x91, 0x8b, 0xb5, 0x6a, 0x8a, 0x27, 0x2c, 0x55, 0x83, 0x86, 0x33, 0x0d, 0xc6, 0xbc,
-	0x50, 0x4d, 0x38, 0xe6, 0xa6, 0xfa, 0x04, 0x36, 0x03, 0xc2, 0x62, 0x44, 0xe2, 0x00, 0xc5, 0x78,
-	0x34, 0xc1, 0x51, 0x18, 0x30, 0x16, 0x50, 0xa2, 0xad, 0xed, 0x28, 0x7b, 0xad, 0xfd, 0xbb, 0x3d,
-	0x89, 0x87, 0xde, 0x81, 0xeb, 0x62, 0xc6, 0x86, 0x94, 0x3c, 0x0d, 0x7c, 0xfb, 0x8e, 0x94, 0xf8,
-	0x24, 0xcb, 0x33, 0x3f, 0x84, 0x8e, 0x3c, 0xa3, 0x8d, 0xd9, 0x84, 0x12, 0x86, 0xd5, 0x5d, 0x68,
-	0xf0, 0x49, 0x46, 0x81, 0x27, 0x86, 0xad, 0x0d, 0x60, 0x76, 0x6e, 0xd4, 0x79, 0xc8, 0xe1, 0x47,
-	0x76, 0x9d, 0xbb, 0x0e, 0x3d, 0xf3, 0x6f, 0x05, 0x36, 0x2d, 0xe6, 0x1f, 0x2e, 0x90, 0x87, 0x94,
-	0xc4, 0x11, 0x72, 0xe3, 0x52, 0xae, 0x3a, 0xb0, 0x86, 0xbc, 0x30, 0x20, 0x82, 0xa2, 0xa6, 0x9d,
-	0x18, 0x72, 0xb5, 0x6a, 0x59, 0x35, 0x9e, 0x3a, 0x46, 0x0e, 0x1e, 0xa7, 0xa4, 0x24, 0x86, 0x7a,
-	0x17, 0xd6, 0x03, 0x12, 0xc4, 0xa3, 0x90, 0xf9, 0x82, 0x84, 0xb6, 0xdd, 0xe0, 0xb6, 0xc5, 0x7c,
-	0xf5, 0x73, 0x58, 0x7b, 0x3a, 0x25, 0x1e, 0xd3, 0xea, 0x3b, 0xd5, 0xbd, 0xd6, 0xfe, 0x1b, 0x82,
-	0x1c, 0xae, 0x00, 0x4e, 0xce, 0x90, 0x06, 0x64, 0xf0, 0xe0, 0xec, 0xdc, 0xa8, 0xfc, 0xf2, 0x87,
-	0xb1, 0xeb, 0x07, 0xf1, 0x37, 0x53, 0xa7, 0xe7, 0xd2, 0xb0, 0x3f, 0x0e, 0x08, 0xee, 0x8f, 0x9d,
-	0xf0, 0x3d, 0xe6, 0x7d, 0x9b, 0xea, 0x81, 0xc7, 0x32, 0x3b, 0x01, 0x33, 0x3f, 0x85, 0x6e, 0xf1,
-	0xcc, 0x19, 0x77, 0x1a, 0x34, 0x90, 0xe7, 0x45, 0x98, 0xb1, 0x74, 0xf8, 0xb9, 0xa9, 0xaa, 0x50,
-	0xf3, 0x50, 0x8c, 0x12, 0x7d, 0xd8, 0xe2, 0xdb, 0xfc, 0xad, 0x0a, 0xa6, 0x7c, 0x05, 0x07, 0xc4,
-	0xbb, 0x09, 0xa1, 0xaf, 0xb0, 0xf8, 0x16, 0x62, 0xa8, 0xcb, 0x62, 0xc8, 0xee, 0xb9, 0x21, 0xdf,
-	0xf3, 0x63, 0xe9, 0x9e, 0xd7, 0xc5, 0x84, 0x6f, 0xfd, 0x73, 0x6e, 0x68, 0x98, 0xb8, 0xd4, 0x0b,
-	0x88, 0xdf, 0x7f, 0xc6, 0x28, 0xe9, 0xd9, 0xe8, 0xd8, 0xc2, 0x8c, 0x21, 0x1f, 0x17, 0xa8, 0xa0,
-	0xf9, 0x32, 0x55, 0xf0, 0x1d, 0xbc, 0x73, 0xfd, 0xa5, 0xdd, 0xe8, 0x35, 0xc9, 0xb2, 0xb9, 0x55,
-	0x2c, 0x9b, 0xaa, 0x24, 0x9b, 0x5f, 0x15, 0x50, 0x2d, 0xe6, 0x7f, 0x7c, 0x82, 0xdd, 0xe9, 0x0a,
-	0x32, 0xd1, 0x61, 0xdd, 0x4d, 0x63, 0x52, 0xf4, 0xcc, 0x56, 0x6f, 0x43, 0x95, 0xb3, 0x9a, 0xa0,
-	0xf3, 0xcf, 0x05, 0x67, 0xb5, 0x97, 0xc9, 0xd9, 0xfb, 0xa0, 0x5f, 0xee, 0x38, 0xe3, 0x68, 0x3e,
-	0xa4, 0x22, 0x0d, 0xf9, 0x53, 0x32, 0xa4, 0x15, 0xf8, 0x11, 0xfa, 0x9f, 0x43, 0xae, 0xb4, 0x62,
-	0x0c, 0x68, 0x85, 0x49, 0x2d, 0xa1, 0xb3, 0x9a, 0x68, 0x05, 0xd2, 0x23, 0x8b, 0xf9, 0xe9, 0x08,
-	0xb9, 0x7e, 0xae, 0x1c, 0x01, 0xc1, 0x86, 0xc5, 0xfc, 0x2f, 0x26, 0x1e, 0x8a, 0xf1, 0x81, 0xd0,
-	0x77, 0x59, 0xf7, 0xdb, 0xd0, 0x24, 0xf8, 0x78, 0x24, 0xaf, 0xc7, 0x75, 0x82, 0x8f, 0x93, 0x24,
-	0x79, 0xb4, 0xea, 0xf2, 0x68, 0xa6, 0x26, 0xb6, 0xb0, 0x54, 0x62, 0xde, 0x90, 0x39, 0x84, 0xd7,
-	0x2c, 0xe6, 0x0f, 0xc7, 0x18, 0x45, 0x57, 0xd7, 0xbe, 0x0a, 0x7e, 0x0b, 0xee, 0x2c, 0x81, 0x64,
-	0xe8, 0xdf, 0x2b, 0xb0, 0x95, 0x15, 0x9e, 0x93, 0x71, 0x14, 0xa3, 0x78, 0xca, 0xfe, 0xd3, 0x15,
-	0x3d, 0x82, 0x3a, 0x13, 0xd9, 0xa2, 0x85, 0x8d, 0xfd, 0xed, 0xa5, 0x85, 0xb2, 0x5c, 0xc0, 0x4e,
-	0x43, 0xcd, 0x7b, 0x60, 0x94, 0xf4, 0x30, 0xef, 0x73, 0xff, 0xc7, 0x3a, 0x54, 0xf9, 0x26, 0x38,
-	0x84, 0xe6, 0xe2, 0xc7, 0x7c, 0x79, 0x5b, 0xc9, 0x6f, 0x59, 0xbf, 0x57, 0xea, 0xca, 0x6e, 0xda,
-	0x87, 0x37, 0x8b, 0x96, 0xf4, 0x6e, 0x3e, 0xb3, 0x20, 0x48, 0x7f, 0xb0, 0x42, 0x50, 0x56, 0xe8,
-	0x07, 0x05, 0x8c, 0xeb, 0x7e, 0x1a, 0xfa, 0xa5, 0xfd, 0x16, 0x27, 0xe8, 0x8f, 0x6f, 0x98, 0x90,
-	0x75, 0xf3, 0x35, 0xbc, 0x9e, 0x5f, 0x38, 0x46, 0x1e, 0x2b, 0x17, 0xa0, 0xbf, 0x7d, 0x4d, 0x80,
-	0x0c, 0x9e, 0x7f, 0xe8, 0x97, 0xc0, 0x73, 0x01, 0x97, 0xc1, 0xcb, 0x9e, 0xe6, 0x67, 0xd0, 0x92,
-	0xdf, 0xe0, 0x76, 0x3e, 0x4f, 0x72, 0xea, 0xbb, 0x57, 0x38, 0x33, 0xc0, 0x4f, 0x00, 0xa4, 0x77,
-	0xa5, 0xe7, 0x53, 0x16, 0x3e, 0xdd, 0x2c, 0xf7, 0x65, 0x68, 0xcf, 0xa0, 0x53, 0xf8, 0x8c, 0xee,
-	0x17, 0xb7, 0xb2, 0x1c, 0xa5, 0xbf, 0xbb, 0x4a, 0xd4, 0xbc, 0xd6, 0x60, 0x70, 0xf6, 0x57, 0xb7,
-	0x72, 0x36, 0xeb, 0x2a, 0x2f, 0x66, 0x5d, 0xe5, 0xcf, 0x59, 0x57, 0xf9, 0xf9, 0xa2, 0x5b, 0x79,
-	0x71, 0xd1, 0xad, 0xfc, 0x7e, 0xd1, 0xad, 0x7c, 0x75, 0xbf, 0x6c, 0xa1, 0x9f, 0x24, 0x7f, 0x92,
-	0xc5, 0x5e, 0x77, 0xea, 0xe2, 0x2f, 0xf2, 0xa3, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x83, 0xe8,
-	0xd3, 0x0c, 0x8e, 0x0b, 0x00, 0x00,
+	// 1202 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4f, 0x6f, 0xe3, 0x44,
+	0x1c, 0xad, 0x9b, 0x34, 0x49, 0x7f, 0xc9, 0x96, 0xc5, 0x74, 0x13, 0xd7, 0x45, 0x49, 0xea, 0x16,
+	0x5a, 0xb1, 0x25, 0xd9, 0xcd, 0x4a, 0xec, 0x81, 0x53, 0x93, 0x45, 0xda, 0x4a, 0x84, 0x5d, 0x5c,
+	0xfe, 0x48, 0x20, 0x14, 0x4d, 0xec, 0x89, 0x3b, 0x4b, 0x6c, 0x47, 0x99, 0x49, 0x9b, 0x9e, 0x90,
+	0x90, 0x38, 0x21, 0x21, 0x3e, 0x02, 0x67, 0x4e, 0x1c, 0xf9, 0x08, 0x3d, 0xee, 0x09, 0x71, 0xea,
+	0x42, 0xfb, 0x0d, 0x10, 0xa7, 0x3d, 0xa1, 0xb1, 0x1d, 0x77, 0xe2, 0xda, 0x6d, 0xba, 0xbb, 0x17,
+	0xf6, 0x96, 0x99, 0x79, 0xbf, 0x7f, 0xcf, 0xcf, 0x6f, 0xac, 0xc0, 0x72, 0xbf, 0x6b, 0xd7, 0x0f,
+	0x11, 0xb5, 0xeb, 0x07, 0x77, 0xeb, 0x6c, 0x5c, 0x1b, 0x0c, 0x5d, 0xe6, 0xca, 0xf9, 0x7e, 0xd7,
+	0xae, 0xf1, 0xdd, 0xda, 0xc1, 0x5d, 0x75, 0xd9, 0x72, 0x2d, 0xd7, 0xdb, 0xaf, 0xf3, 0x5f, 0x3e,
+	0x44, 0x2d, 0xf2, 0xc0, 0x2e, 0xa2, 0x98, 0x07, 0x1a, 0x2e, 0x71, 0x82, 0xfd, 0xd2, 0x54, 0xc2,
+	0xa3, 0x01, 0xa6, 0xfe, 0x81, 0xf6, 0xc3, 0x3c, 0x14, 0xda, 0xd4, 0xda, 0x63, 0xee, 0x10, 0xb7,
+	0x5c, 0x13, 0xcb, 0x45, 0xc8, 0x50, 0xec, 0x98, 0x78, 0xa8, 0x48, 0x55, 0x69, 0x6b, 0x51, 0x0f,
+	0x56, 0xf2, 0x07, 0xb0, 0xc4, 0xe3, 0x3b, 0xdd, 0x23, 0x86, 0x3b, 0x86, 0x6b, 0x62, 0x65, 0xbe,
+	0x2a, 0x6d, 0x15, 0x9a, 0x37, 0x4f, 0x4f, 0x2a, 0x85, 0x2f, 0x77, 0xf6, 0xda, 0xcd, 0x23, 0xe6,
+	0x65, 0xd0, 0x0b, 0x1c, 0x37, 0x59, 0x79, 0xf9, 0xdc, 0xd1, 0xd0, 0xc0, 0x4a, 0x2a, 0xc8, 0xe7,
+	0xad, 0x64, 0x05, 0xb2, 0xdd, 0x11, 0xe9, 0xf3, 0x42, 0x69, 0xef, 0x60, 0xb2, 0x94, 0x1f, 0x43,
+	0x91, 0x38, 0x94, 0x21, 0x87, 0x11, 0xc4, 0x70, 0x67, 0x80, 0x87, 0x36, 0xa1, 0x94, 0xb8, 0x8e,
+	0xb2, 0x50, 0x95, 0xb6, 0xf2, 0x8d, 0x95, 0x9a, 0xc0, 0x43, 0x6d, 0xc7, 0x30, 0x30, 0xa5, 0x2d,
+	0xd7, 0xe9, 0x11, 0x4b, 0xbf, 0x25, 0x04, 0x3e, 0x0e, 0xe3, 0xe4, 0x35, 0x28, 0xe0, 0x03, 0xec,
+	0xb0, 0x0e, 0x35, 0xf6, 0xb1, 0x8d, 0x94, 0x0c, 0xef, 0x5c, 0xcf, 0x7b, 0x7b, 0x7b, 0xde, 0x96,
+	0xf6, 0x21, 0x2c, 0x8b, 0x34, 0xe8, 0x98, 0x0e, 0x5c, 0x87, 0x62, 0x79, 0x1d, 0xb2, 0x7c, 0xd8,
+	0x0e, 0x31, 0x3d, 0x3e, 0xd2, 0x4d, 0x38, 0x3d, 0xa9, 0x64, 0x38, 0x64, 0xf7, 0x81, 0x9e, 0xe1,
+	0x47, 0xbb, 0xa6, 0xf6, 0x8f, 0x04, 0xc5, 0x36, 0xb5, 0x76, 0xcf, 0x8b, 0xb7, 0x5c, 0x87, 0x0d,
+	0x91, 0xc1, 0x12, 0xe9, 0x5c, 0x86, 0x05, 0x64, 0xda, 0xc4, 0xf1, 0x58, 0x5c, 0xd4, 0xfd, 0x85,
+	0x58, 0x2d, 0x95, 0x54, 0x8d, 0x87, 0xf6, 0x51, 0x17, 0xf7, 0x03, 0xde, 0xfc, 0x85, 0xbc, 0x02,
+	0x39, 0xe2, 0x10, 0xd6, 0xb1, 0xa9, 0xe5, 0xf1, 0x54, 0xd0, 0xb3, 0x7c, 0xdd, 0xa6, 0x96, 0xfc,
+	0x19, 0x2c, 0xf4, 0x46, 0x8e, 0x49, 0x95, 0x4c, 0x35, 0xb5, 0x95, 0x6f, 0xbc, 0xe9, 0xf1, 0xc7,
+	0x45, 0xc2, 0xf9, 0x6b, 0xb9, 0xc4, 0x69, 0xde, 0x3e, 0x3e, 0xa9, 0xcc, 0xfd, 0xfa, 0xac, 0xb2,
+	0x6e, 0x11, 0xb6, 0x3f, 0xea, 0xd6, 0x0c, 0xd7, 0xae, 0xf7, 0x89, 0x83, 0xeb, 0xfd, 0xae, 0xfd,
+	0x3e, 0x35, 0xbf, 0x0d, 0x24, 0xc3, 0xb1, 0x54, 0xf7, 0x93, 0x69, 0x9f, 0x40, 0x39, 0x7e, 0xe6,
+	0x90, 0x3b, 0x05, 0xb2, 0xc8, 0x34, 0x87, 0x98, 0xd2, 0x60, 0xf8, 0xc9, 0x52, 0x96, 0x21, 0x6d,
+	0x22, 0x86, 0x7c, 0x09, 0xe9, 0xde, 0x6f, 0xed, 0x97, 0x79, 0x28, 0xc5, 0x27, 0x6c, 0xbc, 0xbe,
+	0x2c, 0x72, 0x26, 0x28, 0xea, 0x33, 0x25, 0xeb, 0x33, 0xc1, 0x7f, 0xcb, 0x25, 0xc8, 0xf6, 0xc8,
+	0xd8, 0xeb, 0x21, 0x57, 0x95, 0xb6, 0x72, 0x7a, 0xa6, 0x47, 0xc6, 0x6d, 0x6a, 0x69, 0x8f, 0xa0,
+	0x92, 0xc0, 0xd0, 0x0b, 0x72, 0xfe, 0x47, 0x0a, 0x34, 0x51, 0xf6, 0x3b, 0x8e, 0x79, 0x1d, 0x11,
+	0xff, 0x9f, 0x3d, 0x21, 0x94, 0x4e, 0x46, 0x94, 0x4e, 0xa8, 0x8a, 0xac, 0xa8, 0x8a, 0xfb, 0x82,
+	0x2a, 0x72, 0xde, 0x84, 0x6f, 0x3f, 0x3f, 0xa9, 0x28, 0xd8, 0x31, 0x5c, 0x93, 0x38, 0x56, 0xfd,
+	0x09, 0x75, 0x9d, 0x9a, 0x8e, 0x0e, 0xdb, 0x98, 0x52, 0x64, 0xe1, 0x18, 0xcd, 0x2c, 0xbe, 0x4a,
+	0xcd, 0x44, 0xed, 0x0c, 0x2e, 0xda, 0xd9, 0x77, 0xf0, 0xde, 0xd5, 0xcf, 0xf5, 0x5a, 0x26, 0x27,
+	0x2a, 0x6b, 0x3e, 0x5e, 0x59, 0x29, 0x41, 0x59, 0xbf, 0x49, 0x20, 0xb7, 0xa9, 0xf5, 0xd1, 0x18,
+	0x1b, 0xa3, 0x19, 0x94, 0xa4, 0x42, 0xce, 0x08, 0x30, 0x41, 0xf6, 0x70, 0x2d, 0xdf, 0x84, 0x14,
+	0x27, 0xde, 0xcf, 0x9e, 0xb2, 0x45, 0x5a, 0xd3, 0xaf, 0xd2, 0xd0, 0xee, 0x80, 0x7a, 0xb1, 0xe3,
+	0x90, 0xa3, 0xc9, 0x90, 0x92, 0x30, 0xe4, 0x4f, 0xfe, 0x90, 0x6d, 0x62, 0x0d, 0xd1, 0x4b, 0x0e,
+	0x39, 0x93, 0x67, 0x55, 0x20, 0x6f, 0xfb, 0xb5, 0x3c, 0x29, 0xa6, 0xbd, 0x56, 0x20, 0xd8, 0xe2,
+	0x06, 0xe1, 0x8f, 0x10, 0xe9, 0xe7, 0xd2, 0x11, 0x10, 0x2c, 0xb5, 0xa9, 0xf5, 0xf9, 0xc0, 0x44,
+	0x0c, 0xef, 0x78, 0xaf, 0x40, 0x52, 0xf7, 0xab, 0xb0, 0xe8, 0xe0, 0xc3, 0x8e, 0xe8, 0xb7, 0x39,
+	0x07, 0x1f, 0xfa, 0x41, 0xe2, 0x68, 0xa9, 0xe9, 0xd1, 0x34, 0x05, 0x8a, 0xd3, 0x25, 0x26, 0x0d,
+	0x69, 0x2d, 0xb8, 0xd1, 0xa6, 0x56, 0xab, 0x8f, 0xd1, 0xf0, 0xf2, 0xda, 0x97, 0xa5, 0x2f, 0xc1,
+	0xad, 0xa9, 0x24, 0x61, 0xf6, 0xef, 0x25, 0x28, 0x85, 0x85, 0x27, 0x64, 0xec, 0x31, 0xc4, 0x46,
+	0xf4, 0x85, 0x1e, 0xd1, 0x3d, 0xc8, 0x50, 0x2f, 0xda, 0x6b, 0x61, 0xa9, 0xb1, 0x3a, 0xe5, 0x39,
+	0xd3, 0x05, 0xf4, 0x00, 0xaa, 0xad, 0x41, 0x25, 0xa1, 0x87, 0xb0, 0xcf, 0xdf, 0x25, 0x50, 0x43,
+	0xcc, 0xf4, 0x6b, 0xda, 0x23, 0x56, 0x62, 0xab, 0x82, 0x62, 0xe6, 0x13, 0x15, 0xf3, 0x0d, 0xa8,
+	0xfc, 0xa1, 0x25, 0x78, 0x67, 0xea, 0x0a, 0xef, 0x6c, 0xa6, 0xf9, 0x6b, 0xa4, 0x2b, 0x0e, 0x3e,
+	0xdc, 0x8d, 0x33, 0x51, 0x6d, 0x03, 0xb4, 0xe4, 0xce, 0xc3, 0x01, 0xff, 0x95, 0x60, 0x85, 0xbb,
+	0xd1, 0xa8, 0x6b, 0x13, 0xc6, 0x1b, 0xfc, 0x02, 0x0f, 0x49, 0x8f, 0x18, 0x88, 0x71, 0x23, 0x7e,
+	0xa9, 0xf9, 0x3e, 0x85, 0xbc, 0x7f, 0x77, 0x74, 0xf6, 0x11, 0xdd, 0xf7, 0x3d, 0xa2, 0x79, 0xe7,
+	0xf9, 0x49, 0x65, 0x3b, 0xfa, 0xe2, 0xbb, 0x94, 0x73, 0xef, 0x3a, 0xf5, 0x3e, 0xe9, 0xd2, 0x3a,
+	0xbf, 0xb1, 0x68, 0xed, 0x21, 0x1e, 0xf3, 0x3b, 0x89, 0xea, 0xe0, 0x27, 0x79, 0x88, 0xe8, 0xbe,
+	0xfc, 0x0e, 0x2c, 0x05, 0xb7, 0x4e, 0xc7, 0x24, 0x16, 0xa6, 0x2c, 0xb8, 0x8b, 0x6e, 0x04, 0xbb,
+	0x0f, 0xbc, 0x4d, 0xb9, 0x0a, 0x79, 0xc4, 0x18, 0xe6, 0x8f, 0x79, 0x72, 0x0d, 0x15, 0x74, 0x71,
+	0x4b, 0x5b, 0x87, 0xb5, 0xc4, 0xa9, 0x27, 0xdc, 0x34, 0x9e, 0xe5, 0x20, 0xc5, 0x6f, 0x8a, 0x5d,
+	0x58, 0x3c, 0xff, 0x06, 0x9f, 0x7e, 0x22, 0xa2, 0x91, 0xab, 0x6b, 0x89, 0x47, 0xe1, 0x6b, 0x6e,
+	0xc1, 0x5b, 0x71, 0x97, 0xf8, 0x7a, 0x34, 0x32, 0x06, 0xa4, 0xde, 0x9e, 0x01, 0x14, 0x16, 0x7a,
+	0x02, 0xcb, 0xb1, 0x5f, 0x6b, 0x1b, 0x33, 0x24, 0x69, 0xa8, 0xdb, 0xb3, 0xa0, 0xc2, 0x5a, 0x3f,
+	0x4a, 0x50, 0xb9, 0xea, 0x33, 0xa5, 0x9e, 0xc8, 0x4d, 0x7c, 0x80, 0x7a, 0xff, 0x9a, 0x01, 0x61,
+	0x37, 0x5f, 0xc3, 0x1b, 0xd1, 0x9b, 0xad, 0x12, 0xcd, 0x15, 0x01, 0xa8, 0x9b, 0x57, 0x00, 0xc4,
+	0xe4, 0xd1, 0x1b, 0xe5, 0x42, 0xf2, 0x08, 0x40, 0xdd, 0xbc, 0x02, 0x10, 0x26, 0x7f, 0x04, 0x79,
+	0xd1, 0xec, 0x57, 0xa3, 0x71, 0xc2, 0xa1, 0xba, 0x7e, 0xc9, 0x61, 0x98, 0xf0, 0x63, 0x00, 0xc1,
+	0xc0, 0xd5, 0x68, 0xc8, 0xf9, 0x99, 0xaa, 0x25, 0x9f, 0x89, 0x92, 0x8a, 0xf5, 0xeb, 0x8d, 0xf8,
+	0x56, 0xa6, 0x51, 0xea, 0xf6, 0x2c, 0xa8, 0xb0, 0x16, 0x85, 0x52, 0x92, 0xe7, 0x6e, 0xc6, 0x27,
+	0xba, 0x00, 0x54, 0xeb, 0x33, 0x02, 0xc3, 0xa2, 0x03, 0x28, 0x26, 0xf8, 0xe0, 0xbb, 0x17, 0xc4,
+	0x18, 0x8b, 0x53, 0x6b, 0xb3, 0xe1, 0x26, 0x15, 0x9b, 0xcd, 0xe3, 0xbf, 0xcb, 0x73, 0xc7, 0xa7,
+	0x65, 0xe9, 0xe9, 0x69, 0x59, 0xfa, 0xeb, 0xb4, 0x2c, 0xfd, 0x7c, 0x56, 0x9e, 0x7b, 0x7a, 0x56,
+	0x9e, 0xfb, 0xf3, 0xac, 0x3c, 0xf7, 0xd5, 0x46, 0xd2, 0x07, 0xd2, 0xd8, 0xff, 0xbb, 0xc0, 0xfb,
+	0x4e, 0xea, 0x66, 0xbc, 0x3f, 0x0b, 0xee, 0xfd, 0x37, 0x00, 0xea, 0xc5, 0x74, 0x76, 0x98, 0x10,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -818,6 +1114,8 @@ type MsgClient interface {
 	StoreCode(ctx context.Context, in *MsgStoreCode, opts ...grpc.CallOption) (*MsgStoreCodeResponse, error)
 	// Instantiate creates a new smart contract instance for the given code id.
 	InstantiateContract(ctx context.Context, in *MsgInstantiateContract, opts ...grpc.CallOption) (*MsgInstantiateContractResponse, error)
+	// Instantiate2 creates a new smart contract instance for the given code id with a predictable address.
+	InstantiateContract2(ctx context.Context, in *MsgInstantiateContract2, opts ...grpc.CallOption) (*MsgInstantiateContract2Response, error)
 	// StoreCodeAndInstantiatecontract upload code and instantiate a contract using it.
 	StoreCodeAndInstantiateContract(ctx context.Context, in *MsgStoreCodeAndInstantiateContract, opts ...grpc.CallOption) (*MsgStoreCodeAndInstantiateContractResponse, error)
 	// Execute submits the given message data to a smart contract
@@ -830,6 +1128,10 @@ type MsgClient interface {
 	ClearAdmin(ctx context.Context, in *MsgClearAdmin, opts ...grpc.CallOption) (*MsgClearAdminResponse, error)
 	// UpdateContractStatus sets a new status for a smart contract
 	UpdateContractStatus(ctx context.Context, in *MsgUpdateContractStatus, opts ...grpc.CallOption) (*MsgUpdateContractStatusResponse, error)
+	// UpdateInstantiateConfig updates the instantiate config of a stored code
+	UpdateInstantiateConfig(ctx context.Context, in *MsgUpdateInstantiateConfig, opts ...grpc.CallOption) (*MsgUpdateInstantiateConfigResponse, error)
+	// SubmitCodeVerification links a stored code to the reproducible build it was compiled from
+	SubmitCodeVerification(ctx context.Context, in *MsgSubmitCodeVerification, opts ...grpc.CallOption) (*MsgSubmitCodeVerificationResponse, error)
 }
 
 type msgClient struct {
@@ -858,6 +1160,15 @@ func (c *msgClient) InstantiateContract(ctx context.Context, in *MsgInstantiateC
 	return out, nil
 }
 
+func (c *msgClient) InstantiateContract2(ctx context.Context, in *MsgInstantiateContract2, opts ...grpc.CallOption) (*MsgInstantiateContract2Response, error) {
+	out := new(MsgInstantiateContract2Response)
+	err := c.cc.Invoke(ctx, "/lbm.wasm.v1.Msg/InstantiateContract2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) StoreCodeAndInstantiateContract(ctx context.Context, in *MsgStoreCodeAndInstantiateContract, opts ...grpc.CallOption) (*MsgStoreCodeAndInstantiateContractResponse, error) {
 	out := new(MsgStoreCodeAndInstantiateContractResponse)
 	err := c.cc.Invoke(ctx, "/lbm.wasm.v1.Msg/StoreCodeAndInstantiateContract", in, out, opts...)
@@ -912,12 +1223,32 @@ func (c *msgClient) UpdateContractStatus(ctx context.Context, in *MsgUpdateContr
 	return out, nil
 }
 
+func (c *msgClient) UpdateInstantiateConfig(ctx context.Context, in *MsgUpdateInstantiateConfig, opts ...grpc.CallOption) (*MsgUpdateInstantiateConfigResponse, error) {
+	out := new(MsgUpdateInstantiateConfigResponse)
+	err := c.cc.Invoke(ctx, "/lbm.wasm.v1.Msg/UpdateInstantiateConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitCodeVerification(ctx context.Context, in *MsgSubmitCodeVerification, opts ...grpc.CallOption) (*MsgSubmitCodeVerificationResponse, error) {
+	out := new(MsgSubmitCodeVerificationResponse)
+	err := c.cc.Invoke(ctx, "/lbm.wasm.v1.Msg/SubmitCodeVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// StoreCode to submit Wasm code to the system
 	StoreCode(context.Context, *MsgStoreCode) (*MsgStoreCodeResponse, error)
 	// Instantiate creates a new smart contract instance for the given code id.
 	InstantiateContract(context.Context, *MsgInstantiateContract) (*MsgInstantiateContractResponse, error)
+	// Instantiate2 creates a new smart contract instance for the given code id with a predictable address.
+	InstantiateContract2(context.Context, *MsgInstantiateContract2) (*MsgInstantiateContract2Response, error)
 	// StoreCodeAndInstantiatecontract upload code and instantiate a contract using it.
 	StoreCodeAndInstantiateContract(context.Context, *MsgStoreCodeAndInstantiateContract) (*MsgStoreCodeAndInstantiateContractResponse, error)
 	// Execute submits the given message data to a smart contract
@@ -930,6 +1261,10 @@ type MsgServer interface {
 	ClearAdmin(context.Context, *MsgClearAdmin) (*MsgClearAdminResponse, error)
 	// UpdateContractStatus sets a new status for a smart contract
 	UpdateContractStatus(context.Context, *MsgUpdateContractStatus) (*MsgUpdateContractStatusResponse, error)
+	// UpdateInstantiateConfig updates the instantiate config of a stored code
+	UpdateInstantiateConfig(context.Context, *MsgUpdateInstantiateConfig) (*MsgUpdateInstantiateConfigResponse, error)
+	// SubmitCodeVerification links a stored code to the reproducible build it was compiled from
+	SubmitCodeVerification(context.Context, *MsgSubmitCodeVerification) (*MsgSubmitCodeVerificationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -942,6 +1277,9 @@ func (*UnimplementedMsgServer) StoreCode(ctx context.Context, req *MsgStoreCode)
 func (*UnimplementedMsgServer) InstantiateContract(ctx context.Context, req *MsgInstantiateContract) (*MsgInstantiateContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InstantiateContract not implemented")
 }
+func (*UnimplementedMsgServer) InstantiateContract2(ctx context.Context, req *MsgInstantiateContract2) (*MsgInstantiateContract2Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantiateContract2 not implemented")
+}
 func (*UnimplementedMsgServer) StoreCodeAndInstantiateContract(ctx context.Context, req *MsgStoreCodeAndInstantiateContract) (*MsgStoreCodeAndInstantiateContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StoreCodeAndInstantiateContract not implemented")
 }
@@ -960,6 +1298,12 @@ func (*UnimplementedMsgServer) ClearAdmin(ctx context.Context, req *MsgClearAdmi
 func (*UnimplementedMsgServer) UpdateContractStatus(ctx context.Context, req *MsgUpdateContractStatus) (*MsgUpdateContractStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateContractStatus not implemented")
 }
+func (*UnimplementedMsgServer) UpdateInstantiateConfig(ctx context.Context, req *MsgUpdateInstantiateConfig) (*MsgUpdateInstantiateConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInstantiateConfig not implemented")
+}
+func (*UnimplementedMsgServer) SubmitCodeVerification(ctx context.Context, req *MsgSubmitCodeVerification) (*MsgSubmitCodeVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitCodeVerification not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1001,6 +1345,24 @@ func _Msg_InstantiateContract_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_InstantiateContract2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstantiateContract2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InstantiateContract2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.wasm.v1.Msg/InstantiateContract2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstantiateContract2(ctx, req.(*MsgInstantiateContract2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_StoreCodeAndInstantiateContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgStoreCodeAndInstantiateContract)
 	if err := dec(in); err != nil {
@@ -1109,6 +1471,42 @@ func _Msg_UpdateContractStatus_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateInstantiateConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateInstantiateConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateInstantiateConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.wasm.v1.Msg/UpdateInstantiateConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateInstantiateConfig(ctx, req.(*MsgUpdateInstantiateConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitCodeVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitCodeVerification)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitCodeVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lbm.wasm.v1.Msg/SubmitCodeVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitCodeVerification(ctx, req.(*MsgSubmitCodeVerification))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lbm.wasm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1121,6 +1519,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InstantiateContract",
 			Handler:    _Msg_InstantiateContract_Handler,
 		},
+		{
+			MethodName: "InstantiateContract2",
+			Handler:    _Msg_InstantiateContract2_Handler,
+		},
 		{
 			MethodName: "StoreCodeAndInstantiateContract",
 			Handler:    _Msg_StoreCodeAndInstantiateContract_Handler,
@@ -1145,6 +1547,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateContractStatus",
 			Handler:    _Msg_UpdateContractStatus_Handler,
 		},
+		{
+			MethodName: "UpdateInstantiateConfig",
+			Handler:    _Msg_UpdateInstantiateConfig_Handler,
+		},
+		{
+			MethodName: "SubmitCodeVerification",
+			Handler:    _Msg_SubmitCodeVerification_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lbm/wasm/v1/tx.proto",
@@ -1170,6 +1580,13 @@ func (m *MsgStoreCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EventSchema) > 0 {
+		i -= len(m.EventSchema)
+		copy(dAtA[i:], m.EventSchema)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EventSchema)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.InstantiatePermission != nil {
 		{
 			size, err := m.InstantiatePermission.MarshalToSizedBuffer(dAtA[:i])
@@ -1348,7 +1765,7 @@ func (m *MsgInstantiateContractResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgStoreCodeAndInstantiateContract) Marshal() (dAtA []byte, err error) {
+func (m *MsgInstantiateContract2) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1358,16 +1775,33 @@ func (m *MsgStoreCodeAndInstantiateContract) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgStoreCodeAndInstantiateContract) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgInstantiateContract2) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgStoreCodeAndInstantiateContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgInstantiateContract2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.FixMsg {
+		i--
+		if m.FixMsg {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Funds) > 0 {
 		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1379,7 +1813,7 @@ func (m *MsgStoreCodeAndInstantiateContract) MarshalToSizedBuffer(dAtA []byte) (
 				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x32
 		}
 	}
 	if len(m.InitMsg) > 0 {
@@ -1387,19 +1821,133 @@ func (m *MsgStoreCodeAndInstantiateContract) MarshalToSizedBuffer(dAtA []byte) (
 		copy(dAtA[i:], m.InitMsg)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.InitMsg)))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x2a
 	}
 	if len(m.Label) > 0 {
 		i -= len(m.Label)
 		copy(dAtA[i:], m.Label)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Label)))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x22
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+	if m.CodeID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantiateContract2Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantiateContract2Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantiateContract2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStoreCodeAndInstantiateContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStoreCodeAndInstantiateContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStoreCodeAndInstantiateContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EventSchema) > 0 {
+		i -= len(m.EventSchema)
+		copy(dAtA[i:], m.EventSchema)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EventSchema)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Funds) > 0 {
+		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.InitMsg) > 0 {
+		i -= len(m.InitMsg)
+		copy(dAtA[i:], m.InitMsg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InitMsg)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -1847,6 +2395,153 @@ func (m *MsgUpdateContractStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateInstantiateConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateInstantiateConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateInstantiateConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NewInstantiatePermission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.CodeID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateInstantiateConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateInstantiateConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateInstantiateConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitCodeVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitCodeVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitCodeVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestation) > 0 {
+		i -= len(m.Attestation)
+		copy(dAtA[i:], m.Attestation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Attestation)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BuilderDigest) > 0 {
+		i -= len(m.BuilderDigest)
+		copy(dAtA[i:], m.BuilderDigest)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BuilderDigest)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceHash) > 0 {
+		i -= len(m.SourceHash)
+		copy(dAtA[i:], m.SourceHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitCodeVerificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitCodeVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitCodeVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1884,6 +2579,10 @@ func (m *MsgStoreCode) Size() (n int) {
 		l = m.InstantiatePermission.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.EventSchema)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1950,7 +2649,7 @@ func (m *MsgInstantiateContractResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgStoreCodeAndInstantiateContract) Size() (n int) {
+func (m *MsgInstantiateContract2) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1960,26 +2659,13 @@ func (m *MsgStoreCodeAndInstantiateContract) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.WASMByteCode)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Builder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.InstantiatePermission != nil {
-		l = m.InstantiatePermission.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
 	l = len(m.Admin)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.CodeID != 0 {
+		n += 1 + sovTx(uint64(m.CodeID))
+	}
 	l = len(m.Label)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -1994,18 +2680,22 @@ func (m *MsgStoreCodeAndInstantiateContract) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FixMsg {
+		n += 2
+	}
 	return n
 }
 
-func (m *MsgStoreCodeAndInstantiateContractResponse) Size() (n int) {
+func (m *MsgInstantiateContract2Response) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.CodeID != 0 {
-		n += 1 + sovTx(uint64(m.CodeID))
-	}
 	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -2017,7 +2707,78 @@ func (m *MsgStoreCodeAndInstantiateContractResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgExecuteContract) Size() (n int) {
+func (m *MsgStoreCodeAndInstantiateContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WASMByteCode)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Builder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.InstantiatePermission != nil {
+		l = m.InstantiatePermission.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InitMsg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.EventSchema)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStoreCodeAndInstantiateContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTx(uint64(m.CodeID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExecuteContract) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2179,6 +2940,70 @@ func (m *MsgUpdateContractStatusResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateInstantiateConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovTx(uint64(m.CodeID))
+	}
+	l = m.NewInstantiatePermission.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateInstantiateConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitCodeVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovTx(uint64(m.CodeID))
+	}
+	l = len(m.SourceHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BuilderDigest)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Attestation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitCodeVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2380,6 +3205,40 @@ func (m *MsgStoreCode) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventSchema", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventSchema = append(m.EventSchema[:0], dAtA[iNdEx:postIndex]...)
+			if m.EventSchema == nil {
+				m.EventSchema = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2819,7 +3678,7 @@ func (m *MsgInstantiateContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStoreCodeAndInstantiateContract) Unmarshal(dAtA []byte) error {
+func (m *MsgInstantiateContract2) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2842,10 +3701,10 @@ func (m *MsgStoreCodeAndInstantiateContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStoreCodeAndInstantiateContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgInstantiateContract2: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStoreCodeAndInstantiateContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgInstantiateContract2: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2882,9 +3741,9 @@ func (m *MsgStoreCodeAndInstantiateContract) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WASMByteCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2894,31 +3753,29 @@ func (m *MsgStoreCodeAndInstantiateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WASMByteCode = append(m.WASMByteCode[:0], dAtA[iNdEx:postIndex]...)
-			if m.WASMByteCode == nil {
-				m.WASMByteCode = []byte{}
-			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
 			}
-			var stringLen uint64
+			m.CodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2928,27 +3785,14 @@ func (m *MsgStoreCodeAndInstantiateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CodeID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Source = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Builder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2976,13 +3820,13 @@ func (m *MsgStoreCodeAndInstantiateContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Builder = string(dAtA[iNdEx:postIndex])
+			m.Label = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiatePermission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitMsg", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2992,33 +3836,31 @@ func (m *MsgStoreCodeAndInstantiateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InstantiatePermission == nil {
-				m.InstantiatePermission = &AccessConfig{}
-			}
-			if err := m.InstantiatePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.InitMsg = append(m.InitMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.InitMsg == nil {
+				m.InitMsg = []byte{}
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3028,29 +3870,31 @@ func (m *MsgStoreCodeAndInstantiateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Funds = append(m.Funds, types.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3060,29 +3904,31 @@ func (m *MsgStoreCodeAndInstantiateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
 			iNdEx = postIndex
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitMsg", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixMsg", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3092,31 +3938,882 @@ func (m *MsgStoreCodeAndInstantiateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			m.FixMsg = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantiateContract2Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantiateContract2Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantiateContract2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStoreCodeAndInstantiateContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreCodeAndInstantiateContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreCodeAndInstantiateContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WASMByteCode", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WASMByteCode = append(m.WASMByteCode[:0], dAtA[iNdEx:postIndex]...)
+			if m.WASMByteCode == nil {
+				m.WASMByteCode = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Builder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Builder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiatePermission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InstantiatePermission == nil {
+				m.InstantiatePermission = &AccessConfig{}
+			}
+			if err := m.InstantiatePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitMsg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitMsg = append(m.InitMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.InitMsg == nil {
+				m.InitMsg = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funds = append(m.Funds, types.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventSchema", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventSchema = append(m.EventSchema[:0], dAtA[iNdEx:postIndex]...)
+			if m.EventSchema == nil {
+				m.EventSchema = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStoreCodeAndInstantiateContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreCodeAndInstantiateContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreCodeAndInstantiateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funds = append(m.Funds, types.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitMsg = append(m.InitMsg[:0], dAtA[iNdEx:postIndex]...)
-			if m.InitMsg == nil {
-				m.InitMsg = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 9:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3126,24 +4823,24 @@ func (m *MsgStoreCodeAndInstantiateContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Funds = append(m.Funds, types.Coin{})
-			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -3167,7 +4864,7 @@ func (m *MsgStoreCodeAndInstantiateContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStoreCodeAndInstantiateContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3190,17 +4887,17 @@ func (m *MsgStoreCodeAndInstantiateContractResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStoreCodeAndInstantiateContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStoreCodeAndInstantiateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			m.CodeID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3210,14 +4907,27 @@ func (m *MsgStoreCodeAndInstantiateContractResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3245,11 +4955,30 @@ func (m *MsgStoreCodeAndInstantiateContractResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrateMsg", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3276,9 +5005,9 @@ func (m *MsgStoreCodeAndInstantiateContractResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.MigrateMsg = append(m.MigrateMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.MigrateMsg == nil {
+				m.MigrateMsg = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -3302,7 +5031,7 @@ func (m *MsgStoreCodeAndInstantiateContractResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3325,17 +5054,17 @@ func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3345,27 +5074,79 @@ func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3393,13 +5174,13 @@ func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3409,31 +5190,29 @@ func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
-			if m.Msg == nil {
-				m.Msg = []byte{}
-			}
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3443,25 +5222,23 @@ func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Funds = append(m.Funds, types.Coin{})
-			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3484,7 +5261,7 @@ func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3507,46 +5284,12 @@ func (m *MsgExecuteContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
+			return fmt.Errorf("proto: MsgUpdateAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3568,7 +5311,7 @@ func (m *MsgExecuteContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
+func (m *MsgClearAdmin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3591,10 +5334,10 @@ func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClearAdmin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClearAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3629,7 +5372,7 @@ func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
@@ -3661,59 +5404,6 @@ func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
 			}
 			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
-			}
-			m.CodeID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MigrateMsg", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MigrateMsg = append(m.MigrateMsg[:0], dAtA[iNdEx:postIndex]...)
-			if m.MigrateMsg == nil {
-				m.MigrateMsg = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3735,7 +5425,7 @@ func (m *MsgMigrateContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClearAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3758,46 +5448,12 @@ func (m *MsgMigrateContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClearAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClearAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3819,7 +5475,7 @@ func (m *MsgMigrateContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAdmin) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateContractStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3842,10 +5498,10 @@ func (m *MsgUpdateAdmin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAdmin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateContractStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateContractStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3882,7 +5538,7 @@ func (m *MsgUpdateAdmin) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3910,13 +5566,13 @@ func (m *MsgUpdateAdmin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewAdmin = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3926,24 +5582,11 @@ func (m *MsgUpdateAdmin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= ContractStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3965,7 +5608,7 @@ func (m *MsgUpdateAdmin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateContractStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3988,10 +5631,10 @@ func (m *MsgUpdateAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateContractStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateContractStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4015,7 +5658,7 @@ func (m *MsgUpdateAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClearAdmin) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateInstantiateConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4038,10 +5681,10 @@ func (m *MsgClearAdmin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClearAdmin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateInstantiateConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClearAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateInstantiateConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4076,11 +5719,30 @@ func (m *MsgClearAdmin) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewInstantiatePermission", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4090,23 +5752,24 @@ func (m *MsgClearAdmin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			if err := m.NewInstantiatePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4129,7 +5792,7 @@ func (m *MsgClearAdmin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClearAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateInstantiateConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4152,10 +5815,10 @@ func (m *MsgClearAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClearAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateInstantiateConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClearAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateInstantiateConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4179,7 +5842,7 @@ func (m *MsgClearAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateContractStatus) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitCodeVerification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4202,10 +5865,10 @@ func (m *MsgUpdateContractStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateContractStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitCodeVerification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateContractStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitCodeVerification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4241,8 +5904,61 @@ func (m *MsgUpdateContractStatus) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceHash = append(m.SourceHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.SourceHash == nil {
+				m.SourceHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuilderDigest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4270,13 +5986,13 @@ func (m *MsgUpdateContractStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.BuilderDigest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
 			}
-			m.Status = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4286,11 +6002,26 @@ func (m *MsgUpdateContractStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ContractStatus(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestation = append(m.Attestation[:0], dAtA[iNdEx:postIndex]...)
+			if m.Attestation == nil {
+				m.Attestation = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4312,7 +6043,7 @@ func (m *MsgUpdateContractStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateContractStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitCodeVerificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4335,10 +6066,10 @@ func (m *MsgUpdateContractStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateContractStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitCodeVerificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateContractStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitCodeVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
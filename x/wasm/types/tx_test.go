@@ -239,6 +239,97 @@ func TestInstantiateContractValidation(t *testing.T) {
 	}
 }
 
+func TestInstantiateContract2Validation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.BytesToAccAddress(make([]byte, 20)).String()
+
+	cases := map[string]struct {
+		msg   MsgInstantiateContract2
+		valid bool
+	}{
+		"empty": {
+			msg:   MsgInstantiateContract2{},
+			valid: false,
+		},
+		"correct minimal": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+				Salt:    []byte{0},
+			},
+			valid: true,
+		},
+		"correct maximal": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				Admin:   goodAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte(`{"some": "data"}`),
+				Funds:   sdk.Coins{sdk.Coin{Denom: "foobar", Amount: sdk.NewInt(200)}},
+				Salt:    bytes.Repeat([]byte{0}, MaxSaltSize),
+				FixMsg:  true,
+			},
+			valid: true,
+		},
+		"bad sender": {
+			msg: MsgInstantiateContract2{
+				Sender:  badAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+				Salt:    []byte{0},
+			},
+			valid: false,
+		},
+		"missing salt": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+			},
+			valid: false,
+		},
+		"salt too long": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+				Salt:    bytes.Repeat([]byte{0}, MaxSaltSize+1),
+			},
+			valid: false,
+		},
+		"non json init msg": {
+			msg: MsgInstantiateContract2{
+				Sender:  goodAddress,
+				CodeID:  firstCodeID,
+				Label:   "foo",
+				InitMsg: []byte("invalid-json"),
+				Salt:    []byte{0},
+			},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestStoreCodeAndInstantiateContractValidation(t *testing.T) {
 	bad, err := sdk.AccAddressFromHex("012345")
 	require.NoError(t, err)
@@ -770,3 +861,99 @@ func TestMsgUpdateContractStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSubmitCodeVerification(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.BytesToAccAddress(make([]byte, 20)).String()
+	sourceHash := bytes.Repeat([]byte{0x1}, 32)
+	digest := "sha256:" + strings.Repeat("ab", 32)
+
+	specs := map[string]struct {
+		src    MsgSubmitCodeVerification
+		expErr bool
+	}{
+		"all good": {
+			src: MsgSubmitCodeVerification{
+				Sender:        goodAddress,
+				CodeID:        1,
+				SourceHash:    sourceHash,
+				BuilderDigest: digest,
+				Attestation:   []byte("signed build report"),
+			},
+		},
+		"builder digest with image name": {
+			src: MsgSubmitCodeVerification{
+				Sender:        goodAddress,
+				CodeID:        1,
+				SourceHash:    sourceHash,
+				BuilderDigest: "cosmwasm/rust-optimizer@" + digest,
+			},
+		},
+		"bad sender": {
+			src: MsgSubmitCodeVerification{
+				Sender:        badAddress,
+				CodeID:        1,
+				SourceHash:    sourceHash,
+				BuilderDigest: digest,
+			},
+			expErr: true,
+		},
+		"code id required": {
+			src: MsgSubmitCodeVerification{
+				Sender:        goodAddress,
+				SourceHash:    sourceHash,
+				BuilderDigest: digest,
+			},
+			expErr: true,
+		},
+		"source hash not sha256": {
+			src: MsgSubmitCodeVerification{
+				Sender:        goodAddress,
+				CodeID:        1,
+				SourceHash:    sourceHash[1:],
+				BuilderDigest: digest,
+			},
+			expErr: true,
+		},
+		"builder digest required": {
+			src: MsgSubmitCodeVerification{
+				Sender:     goodAddress,
+				CodeID:     1,
+				SourceHash: sourceHash,
+			},
+			expErr: true,
+		},
+		"builder tag instead of digest": {
+			src: MsgSubmitCodeVerification{
+				Sender:        goodAddress,
+				CodeID:        1,
+				SourceHash:    sourceHash,
+				BuilderDigest: "cosmwasm/rust-optimizer:0.11.5",
+			},
+			expErr: true,
+		},
+		"attestation too large": {
+			src: MsgSubmitCodeVerification{
+				Sender:        goodAddress,
+				CodeID:        1,
+				SourceHash:    sourceHash,
+				BuilderDigest: digest,
+				Attestation:   make([]byte, MaxAttestationSize+1),
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -17,6 +17,7 @@ const (
 	defaultMemoryCacheSize   uint32 = 100 // in MiB
 	defaultQueryGasLimit     uint64 = 3000000
 	defaultContractDebugMode        = false
+	defaultCacheWarmupSize   uint32 = 0 // in MiB, disabled
 )
 
 var AllContractStatus = []ContractStatus{
@@ -83,6 +84,29 @@ func (c CodeInfo) ValidateBasic() error {
 	return nil
 }
 
+// ValidateBasic performs stateless checks of the verification record
+func (c CodeVerification) ValidateBasic() error {
+	if c.CodeID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "code id")
+	}
+	if len(c.CodeHash) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "code hash")
+	}
+	if err := sdk.ValidateAccAddress(c.Submitter); err != nil {
+		return sdkerrors.Wrap(err, "submitter")
+	}
+	if err := validateSourceHash(c.SourceHash); err != nil {
+		return sdkerrors.Wrap(err, "source hash")
+	}
+	if err := validateBuilderDigest(c.BuilderDigest); err != nil {
+		return sdkerrors.Wrap(err, "builder digest")
+	}
+	if err := validateAttestation(c.Attestation); err != nil {
+		return sdkerrors.Wrap(err, "attestation")
+	}
+	return nil
+}
+
 // NewCodeInfo fills a new Contract struct
 func NewCodeInfo(codeHash []byte, creator sdk.AccAddress, source string, builder string, instantiatePermission AccessConfig) CodeInfo {
 	return CodeInfo{
@@ -374,6 +398,9 @@ type WasmConfig struct {
 	MemoryCacheSize uint32
 	// ContractDebugMode log what contract print
 	ContractDebugMode bool
+	// CacheWarmupSize in MiB not bytes. The LRU budget of the codes pinned in addition to the codes
+	// pinned by governance, filled with the most frequently executed codes on start.
+	CacheWarmupSize uint32
 }
 
 // DefaultWasmConfig returns the default settings for WasmConfig
@@ -382,5 +409,6 @@ func DefaultWasmConfig() WasmConfig {
 		SmartQueryGasLimit: defaultQueryGasLimit,
 		MemoryCacheSize:    defaultMemoryCacheSize,
 		ContractDebugMode:  defaultContractDebugMode,
+		CacheWarmupSize:    defaultCacheWarmupSize,
 	}
 }
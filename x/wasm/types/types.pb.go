@@ -9,7 +9,9 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
-	types "github.com/line/lbm-sdk/codec/types"
+	types1 "github.com/line/lbm-sdk/codec/types"
+	github_com_line_lbm_sdk_types "github.com/line/lbm-sdk/types"
+	types "github.com/line/lbm-sdk/types"
 	github_com_line_ostracon_libs_bytes "github.com/line/ostracon/libs/bytes"
 	_ "github.com/regen-network/cosmos-proto"
 	io "io"
@@ -40,6 +42,8 @@ const (
 	AccessTypeOnlyAddress AccessType = 2
 	// AccessTypeEverybody unrestricted
 	AccessTypeEverybody AccessType = 3
+	// AccessTypeAnyOfAddresses allow any of the addresses
+	AccessTypeAnyOfAddresses AccessType = 4
 )
 
 var AccessType_name = map[int32]string{
@@ -47,13 +51,15 @@ var AccessType_name = map[int32]string{
 	1: "ACCESS_TYPE_NOBODY",
 	2: "ACCESS_TYPE_ONLY_ADDRESS",
 	3: "ACCESS_TYPE_EVERYBODY",
+	4: "ACCESS_TYPE_ANY_OF_ADDRESSES",
 }
 
 var AccessType_value = map[string]int32{
-	"ACCESS_TYPE_UNSPECIFIED":  0,
-	"ACCESS_TYPE_NOBODY":       1,
-	"ACCESS_TYPE_ONLY_ADDRESS": 2,
-	"ACCESS_TYPE_EVERYBODY":    3,
+	"ACCESS_TYPE_UNSPECIFIED":      0,
+	"ACCESS_TYPE_NOBODY":           1,
+	"ACCESS_TYPE_ONLY_ADDRESS":     2,
+	"ACCESS_TYPE_EVERYBODY":        3,
+	"ACCESS_TYPE_ANY_OF_ADDRESSES": 4,
 }
 
 func (AccessType) EnumDescriptor() ([]byte, []int) {
@@ -166,6 +172,8 @@ var xxx_messageInfo_AccessTypeParam proto.InternalMessageInfo
 type AccessConfig struct {
 	Permission AccessType `protobuf:"varint,1,opt,name=permission,proto3,enum=lbm.wasm.v1.AccessType" json:"permission,omitempty" yaml:"permission"`
 	Address    string     `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// Addresses are the allowed addresses of the AccessTypeAnyOfAddresses type
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
 }
 
 func (m *AccessConfig) Reset()         { *m = AccessConfig{} }
@@ -210,6 +218,15 @@ type Params struct {
 	GasMultiplier                uint64       `protobuf:"varint,5,opt,name=gas_multiplier,json=gasMultiplier,proto3" json:"gas_multiplier,omitempty" yaml:"max_gas"`
 	InstanceCost                 uint64       `protobuf:"varint,6,opt,name=instance_cost,json=instanceCost,proto3" json:"instance_cost,omitempty" yaml:"instance_cost"`
 	CompileCost                  uint64       `protobuf:"varint,7,opt,name=compile_cost,json=compileCost,proto3" json:"compile_cost,omitempty" yaml:"compile_cost"`
+	// StorageDepositPerByte is locked from a contract's balance for every byte of
+	// state it stores and refunded when the state is deleted. Empty disables deposits.
+	StorageDepositPerByte github_com_line_lbm_sdk_types.Coins `protobuf:"bytes,8,rep,name=storage_deposit_per_byte,json=storageDepositPerByte,proto3,castrepeated=github.com/line/lbm-sdk/types.Coins" json:"storage_deposit_per_byte" yaml:"storage_deposit_per_byte"`
+	// StargateQueryAllowlist are the gRPC query paths contracts can call with
+	// stargate queries. Empty disables stargate queries.
+	StargateQueryAllowlist []string `protobuf:"bytes,9,rep,name=stargate_query_allowlist,json=stargateQueryAllowlist,proto3" json:"stargate_query_allowlist,omitempty" yaml:"stargate_query_allowlist"`
+	// StargateMsgAllowlist are the message type URLs contracts can dispatch with
+	// stargate messages. Empty disables stargate messages.
+	StargateMsgAllowlist []string `protobuf:"bytes,10,rep,name=stargate_msg_allowlist,json=stargateMsgAllowlist,proto3" json:"stargate_msg_allowlist,omitempty" yaml:"stargate_msg_allowlist"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -311,7 +328,7 @@ type ContractInfo struct {
 	Status ContractStatus `protobuf:"varint,7,opt,name=status,proto3,enum=lbm.wasm.v1.ContractStatus" json:"status,omitempty"`
 	// Extension is an extension point to store custom metadata within the
 	// persistence model.
-	Extension *types.Any `protobuf:"bytes,8,opt,name=extension,proto3" json:"extension,omitempty"`
+	Extension *types1.Any `protobuf:"bytes,8,opt,name=extension,proto3" json:"extension,omitempty"`
 }
 
 func (m *ContractInfo) Reset()         { *m = ContractInfo{} }
@@ -474,6 +491,147 @@ func (m *Model) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Model proto.InternalMessageInfo
 
+// BlockHook configures the sudo callbacks a contract receives from the wasm
+// module's begin and end blockers
+type BlockHook struct {
+	// BeginBlock enables the `begin_block` sudo callback
+	BeginBlock bool `protobuf:"varint,1,opt,name=begin_block,json=beginBlock,proto3" json:"begin_block,omitempty" yaml:"begin_block"`
+	// EndBlock enables the `end_block` sudo callback
+	EndBlock bool `protobuf:"varint,2,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty" yaml:"end_block"`
+	// GasLimit is the max gas a single callback can consume
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty" yaml:"gas_limit"`
+}
+
+func (m *BlockHook) Reset()         { *m = BlockHook{} }
+func (m *BlockHook) String() string { return proto.CompactTextString(m) }
+func (*BlockHook) ProtoMessage()    {}
+func (*BlockHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a7988258faf20f7, []int{8}
+}
+func (m *BlockHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockHook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHook.Merge(m, src)
+}
+func (m *BlockHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockHook proto.InternalMessageInfo
+
+// ContractStorageUsage is the state size of a contract with the storage deposit
+// locked for it
+type ContractStorageUsage struct {
+	// Bytes is the total size of the keys and values stored by the contract
+	Bytes uint64 `protobuf:"varint,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	// Deposit is the amount locked from the contract's balance for its state
+	Deposit github_com_line_lbm_sdk_types.Coins `protobuf:"bytes,2,rep,name=deposit,proto3,castrepeated=github.com/line/lbm-sdk/types.Coins" json:"deposit"`
+}
+
+func (m *ContractStorageUsage) Reset()         { *m = ContractStorageUsage{} }
+func (m *ContractStorageUsage) String() string { return proto.CompactTextString(m) }
+func (*ContractStorageUsage) ProtoMessage()    {}
+func (*ContractStorageUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a7988258faf20f7, []int{9}
+}
+func (m *ContractStorageUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractStorageUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractStorageUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractStorageUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractStorageUsage.Merge(m, src)
+}
+func (m *ContractStorageUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractStorageUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractStorageUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractStorageUsage proto.InternalMessageInfo
+
+// CodeVerification links a stored code to the reproducible build it was
+// compiled from. Any address can submit one record per code, like the code
+// creator or an auditor.
+type CodeVerification struct {
+	// CodeID references the stored WASM code
+	CodeID uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// CodeHash is the hash of the stored WASM code that a rebuild must reproduce
+	CodeHash github_com_line_ostracon_libs_bytes.HexBytes `protobuf:"bytes,2,opt,name=code_hash,json=codeHash,proto3,casttype=github.com/line/ostracon/libs/bytes.HexBytes" json:"code_hash,omitempty"`
+	// Submitter is the address that submitted the verification
+	Submitter string `protobuf:"bytes,3,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// SourceHash is the sha256 hash of the source archive
+	SourceHash github_com_line_ostracon_libs_bytes.HexBytes `protobuf:"bytes,4,opt,name=source_hash,json=sourceHash,proto3,casttype=github.com/line/ostracon/libs/bytes.HexBytes" json:"source_hash,omitempty"`
+	// BuilderDigest is the digest of the optimizer image used for the build,
+	// optionally prefixed with the image name
+	BuilderDigest string `protobuf:"bytes,5,opt,name=builder_digest,json=builderDigest,proto3" json:"builder_digest,omitempty"`
+	// Attestation is an off-chain verifiable statement about the build, like a
+	// signed build report
+	Attestation []byte `protobuf:"bytes,6,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	// Submitted is the position when the verification was submitted
+	Submitted *AbsoluteTxPosition `protobuf:"bytes,7,opt,name=submitted,proto3" json:"submitted,omitempty"`
+}
+
+func (m *CodeVerification) Reset()         { *m = CodeVerification{} }
+func (m *CodeVerification) String() string { return proto.CompactTextString(m) }
+func (*CodeVerification) ProtoMessage()    {}
+func (*CodeVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a7988258faf20f7, []int{10}
+}
+func (m *CodeVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CodeVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CodeVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeVerification.Merge(m, src)
+}
+func (m *CodeVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *CodeVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeVerification proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("lbm.wasm.v1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterEnum("lbm.wasm.v1.ContractStatus", ContractStatus_name, ContractStatus_value)
@@ -486,101 +644,130 @@ func init() {
 	proto.RegisterType((*ContractCodeHistoryEntry)(nil), "lbm.wasm.v1.ContractCodeHistoryEntry")
 	proto.RegisterType((*AbsoluteTxPosition)(nil), "lbm.wasm.v1.AbsoluteTxPosition")
 	proto.RegisterType((*Model)(nil), "lbm.wasm.v1.Model")
+	proto.RegisterType((*BlockHook)(nil), "lbm.wasm.v1.BlockHook")
+	proto.RegisterType((*ContractStorageUsage)(nil), "lbm.wasm.v1.ContractStorageUsage")
+	proto.RegisterType((*CodeVerification)(nil), "lbm.wasm.v1.CodeVerification")
 }
 
 func init() { proto.RegisterFile("lbm/wasm/v1/types.proto", fileDescriptor_5a7988258faf20f7) }
 
 var fileDescriptor_5a7988258faf20f7 = []byte{
-	// 1409 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
-	0x1a, 0x16, 0x6d, 0x59, 0xb6, 0xc6, 0x8a, 0xa3, 0x4c, 0xfc, 0x21, 0x29, 0x89, 0xa4, 0x70, 0x13,
-	0xac, 0x93, 0xd8, 0x52, 0xe2, 0x2c, 0x76, 0x37, 0x06, 0x76, 0x17, 0xfa, 0x4a, 0xcc, 0xdd, 0xb5,
-	0x64, 0x8c, 0xe4, 0xa4, 0x2e, 0x50, 0x10, 0x23, 0x72, 0x2c, 0x33, 0x21, 0x39, 0x02, 0x67, 0xe4,
-	0x48, 0xf9, 0x05, 0x81, 0x4e, 0x45, 0x4f, 0xbd, 0x08, 0x28, 0xd0, 0xa2, 0xc8, 0x0f, 0xe8, 0x3f,
-	0xe8, 0x25, 0xc8, 0x29, 0xc7, 0x9e, 0x84, 0xd6, 0xb9, 0xb4, 0x87, 0x5e, 0x7c, 0x29, 0x90, 0x4b,
-	0x0b, 0x0e, 0xa9, 0x88, 0x76, 0x5c, 0xdb, 0x37, 0xbd, 0x1f, 0xcf, 0xf3, 0xce, 0xf3, 0xbe, 0xef,
-	0x0c, 0x05, 0x96, 0xcc, 0xa6, 0x95, 0x7f, 0x8e, 0x99, 0x95, 0xdf, 0xbf, 0x97, 0xe7, 0xbd, 0x36,
-	0x61, 0xb9, 0xb6, 0x43, 0x39, 0x85, 0xb3, 0x66, 0xd3, 0xca, 0xb9, 0x81, 0xdc, 0xfe, 0xbd, 0x54,
-	0x52, 0xa3, 0xcc, 0xa2, 0x4c, 0x15, 0xa1, 0xbc, 0x67, 0x78, 0x79, 0xa9, 0xf9, 0x16, 0x6d, 0x51,
-	0xcf, 0xef, 0xfe, 0xf2, 0xbd, 0xc9, 0x16, 0xa5, 0x2d, 0x93, 0xe4, 0x85, 0xd5, 0xec, 0xec, 0xe6,
-	0xb1, 0xdd, 0xf3, 0x42, 0xf2, 0x27, 0xe0, 0x62, 0x41, 0xd3, 0x08, 0x63, 0x8d, 0x5e, 0x9b, 0x6c,
-	0x61, 0x07, 0x5b, 0xf0, 0x3f, 0x60, 0x6a, 0x1f, 0x9b, 0x1d, 0x92, 0x90, 0xb2, 0xd2, 0xf2, 0xdc,
-	0xda, 0x52, 0x2e, 0x50, 0x3b, 0x37, 0x4e, 0x2e, 0xc6, 0x0f, 0x87, 0x99, 0x58, 0x0f, 0x5b, 0xe6,
-	0xba, 0x2c, 0xf2, 0x65, 0xe4, 0xe1, 0xd6, 0xc3, 0x5f, 0x7e, 0x95, 0x91, 0xe4, 0x2f, 0x24, 0x10,
-	0xf3, 0xb2, 0x4b, 0xd4, 0xde, 0x35, 0x5a, 0xb0, 0x0a, 0x40, 0x9b, 0x38, 0x96, 0xc1, 0x98, 0x41,
-	0xed, 0xb3, 0xc8, 0x17, 0x0e, 0x87, 0x99, 0x4b, 0x1e, 0xf9, 0x18, 0x24, 0xa3, 0x00, 0x03, 0x5c,
-	0x01, 0xd3, 0x58, 0xd7, 0x1d, 0xc2, 0x58, 0x62, 0x22, 0x2b, 0x2d, 0x47, 0x8b, 0xf0, 0x70, 0x98,
-	0x99, 0xf3, 0x30, 0x7e, 0x40, 0x46, 0xa3, 0x14, 0xff, 0x50, 0xbf, 0x87, 0x41, 0x44, 0xa8, 0x64,
-	0xf0, 0x29, 0x80, 0x1a, 0xd5, 0x89, 0xda, 0x69, 0x9b, 0x14, 0xeb, 0x2a, 0x16, 0xb5, 0xc5, 0xb1,
-	0x66, 0xd7, 0x92, 0x27, 0x1c, 0xcb, 0x53, 0x51, 0xbc, 0xfe, 0x7a, 0x98, 0x09, 0x1d, 0x0e, 0x33,
-	0x49, 0xaf, 0xd0, 0xc7, 0x14, 0x32, 0x8a, 0xbb, 0xce, 0x6d, 0xe1, 0xf3, 0xa0, 0xf0, 0xa5, 0x04,
-	0xd2, 0x86, 0xcd, 0x38, 0xb6, 0xb9, 0x81, 0x39, 0x51, 0x75, 0xb2, 0x8b, 0x3b, 0x26, 0x57, 0x03,
-	0xfd, 0x98, 0x38, 0xbd, 0x1f, 0xb7, 0x0e, 0x87, 0x99, 0x9b, 0x5e, 0xc9, 0xd3, 0x89, 0x64, 0x74,
-	0x35, 0x90, 0x50, 0xf6, 0xe2, 0x5b, 0xe3, 0xae, 0xed, 0x83, 0x45, 0x8d, 0xda, 0xdc, 0xc1, 0x1a,
-	0x57, 0x19, 0xc7, 0xbc, 0xc3, 0x46, 0xd2, 0x27, 0xcf, 0x92, 0x7e, 0xd3, 0x97, 0x7e, 0x6d, 0x24,
-	0xfd, 0x24, 0x1a, 0x19, 0xcd, 0x8f, 0x02, 0x75, 0xe1, 0xf7, 0x5b, 0xf0, 0x5f, 0x00, 0x2d, 0xdc,
-	0x55, 0x5d, 0x62, 0x55, 0x34, 0x8d, 0x19, 0x2f, 0x48, 0x22, 0x9c, 0x95, 0x96, 0xc3, 0xc5, 0x6b,
-	0xe3, 0x7e, 0x7e, 0x9c, 0x23, 0xa3, 0x8b, 0x16, 0xee, 0x3e, 0xc1, 0xcc, 0x2a, 0x51, 0x9d, 0xd4,
-	0x8d, 0x17, 0x04, 0x3e, 0x00, 0x73, 0x2d, 0xcc, 0x54, 0xab, 0x63, 0x72, 0xa3, 0x6d, 0x1a, 0xc4,
-	0x49, 0x4c, 0x09, 0x9e, 0xc0, 0x02, 0xb8, 0x3c, 0x2d, 0xcc, 0x64, 0x74, 0xa1, 0x85, 0xd9, 0xe6,
-	0x87, 0x44, 0xf8, 0x2f, 0x70, 0xc1, 0x6b, 0x8f, 0x46, 0x54, 0x8d, 0x32, 0x9e, 0x88, 0x08, 0x64,
-	0xe2, 0x70, 0x98, 0x99, 0x0f, 0xb6, 0xd7, 0x0f, 0xcb, 0x28, 0x36, 0xb2, 0x4b, 0x94, 0x71, 0xb8,
-	0x0e, 0x62, 0x1a, 0xb5, 0xda, 0x86, 0xe9, 0xa3, 0xa7, 0x05, 0x7a, 0xe9, 0x70, 0x98, 0xb9, 0x3c,
-	0x6a, 0xca, 0x38, 0x2a, 0xa3, 0x59, 0xdf, 0x74, 0xb1, 0x62, 0x03, 0x43, 0xf2, 0xf7, 0x12, 0x98,
-	0x71, 0x85, 0x28, 0xf6, 0x2e, 0x85, 0x57, 0x40, 0x54, 0xe8, 0xdc, 0xc3, 0x6c, 0x4f, 0xac, 0x5e,
-	0x0c, 0xcd, 0xb8, 0x8e, 0x0d, 0xcc, 0xf6, 0x60, 0x02, 0x4c, 0x6b, 0x0e, 0xc1, 0x9c, 0x3a, 0xde,
-	0x7e, 0xa3, 0x91, 0x09, 0x17, 0x41, 0x84, 0xd1, 0x8e, 0xa3, 0x11, 0x31, 0xb3, 0x28, 0xf2, 0x2d,
-	0x17, 0xd1, 0xec, 0x18, 0xa6, 0x4e, 0x1c, 0xd1, 0xd8, 0x28, 0x1a, 0x99, 0xb0, 0x0a, 0x60, 0x70,
-	0x6d, 0x34, 0x31, 0x50, 0xd1, 0xb5, 0x53, 0x27, 0x1e, 0x76, 0x27, 0x8e, 0x2e, 0x05, 0xa0, 0x5e,
-	0x40, 0xfe, 0x75, 0x02, 0xc4, 0x4a, 0xfe, 0x98, 0x85, 0x92, 0xbf, 0x80, 0x69, 0xa1, 0xc4, 0xd0,
-	0x85, 0x8e, 0x70, 0x11, 0x1c, 0x0c, 0x33, 0x11, 0x21, 0xb4, 0x8c, 0x22, 0x6e, 0x48, 0xd1, 0x4f,
-	0x51, 0x34, 0x0f, 0xa6, 0xb0, 0x6e, 0x19, 0xb6, 0x2f, 0xc8, 0x33, 0x5c, 0xaf, 0x89, 0x9b, 0xc4,
-	0xf4, 0xd5, 0x78, 0x06, 0x7c, 0xe0, 0xb3, 0x10, 0xdd, 0x17, 0x90, 0x39, 0x2a, 0xa0, 0xc9, 0xa8,
-	0xd9, 0xe1, 0xa4, 0xd1, 0xdd, 0xa2, 0xcc, 0xe0, 0x06, 0xb5, 0xd1, 0x28, 0x1f, 0xae, 0x82, 0x59,
-	0xa3, 0xa9, 0xa9, 0x6d, 0xea, 0x70, 0xf7, 0xa4, 0x11, 0xf1, 0x6c, 0x5c, 0x38, 0x18, 0x66, 0xa2,
-	0x4a, 0xb1, 0xb4, 0x45, 0x1d, 0xae, 0x94, 0x51, 0xd4, 0x68, 0x6a, 0xe2, 0xa7, 0x0e, 0xef, 0x83,
-	0x88, 0xb7, 0xdb, 0x62, 0xce, 0x73, 0x6b, 0x57, 0x8e, 0x14, 0x2a, 0x1d, 0x59, 0x73, 0xe4, 0xa7,
-	0xc2, 0x4d, 0x10, 0x25, 0x5d, 0x4e, 0x6c, 0x71, 0xab, 0x67, 0xc4, 0x01, 0xe7, 0x73, 0xde, 0x03,
-	0x9c, 0x1b, 0x3d, 0xc0, 0xb9, 0x82, 0xdd, 0x2b, 0x26, 0xdf, 0x7c, 0xb7, 0xba, 0x10, 0x6c, 0x62,
-	0x65, 0x04, 0x43, 0x63, 0x86, 0xf5, 0xf0, 0xcf, 0xee, 0xbb, 0xf5, 0x9b, 0x04, 0x12, 0xa3, 0x54,
-	0xb7, 0xa9, 0x1b, 0x06, 0xe3, 0xd4, 0xe9, 0x55, 0x6c, 0xee, 0xf4, 0xe0, 0xff, 0x40, 0x94, 0xb6,
-	0x89, 0x83, 0xf9, 0xf8, 0x5d, 0x5d, 0x3d, 0xf1, 0xa4, 0x01, 0x64, 0x6d, 0x04, 0x70, 0x5f, 0x17,
-	0x34, 0xc6, 0x07, 0x07, 0x39, 0xf1, 0xa7, 0x83, 0x7c, 0x00, 0xa6, 0x3b, 0x6d, 0x5d, 0x8c, 0x60,
-	0xf2, 0x9c, 0x23, 0xf0, 0xf3, 0x61, 0x0e, 0x4c, 0x5a, 0xac, 0x25, 0x26, 0x1a, 0x2b, 0x5e, 0x7d,
-	0x3f, 0xcc, 0x24, 0x88, 0xad, 0x51, 0xdd, 0xb0, 0x5b, 0xf9, 0xa7, 0x8c, 0xda, 0x39, 0x84, 0x9f,
-	0x6f, 0x12, 0xc6, 0x70, 0x8b, 0x20, 0x37, 0x51, 0x46, 0x00, 0x7e, 0x4c, 0x07, 0xaf, 0x83, 0x58,
-	0xd3, 0xa4, 0xda, 0x33, 0x75, 0x8f, 0x18, 0xad, 0x3d, 0xee, 0xed, 0x1c, 0x9a, 0x15, 0xbe, 0x0d,
-	0xe1, 0x82, 0x49, 0x30, 0xc3, 0xbb, 0xaa, 0x61, 0xeb, 0xa4, 0xeb, 0x29, 0x41, 0xd3, 0xbc, 0xab,
-	0xb8, 0xa6, 0x8c, 0xc1, 0xd4, 0x26, 0xd5, 0x89, 0x09, 0x8b, 0x60, 0xf2, 0x19, 0xe9, 0x79, 0x37,
-	0xaf, 0x78, 0xf7, 0xfd, 0x30, 0xb3, 0xd2, 0x32, 0xf8, 0x5e, 0xa7, 0x99, 0xd3, 0xa8, 0x95, 0x37,
-	0x0d, 0x9b, 0xe4, 0x29, 0x73, 0x3b, 0x47, 0xed, 0xbc, 0x69, 0x34, 0x59, 0xbe, 0xd9, 0xe3, 0x84,
-	0xe5, 0x36, 0x48, 0xb7, 0xe8, 0xfe, 0x40, 0x2e, 0xd8, 0x5d, 0x52, 0xef, 0x73, 0x39, 0x21, 0xee,
-	0xaf, 0x67, 0xdc, 0xfe, 0x45, 0x02, 0x60, 0xfc, 0x7c, 0xc3, 0xbf, 0x83, 0xa5, 0x42, 0xa9, 0x54,
-	0xa9, 0xd7, 0xd5, 0xc6, 0xce, 0x56, 0x45, 0xdd, 0xae, 0xd6, 0xb7, 0x2a, 0x25, 0xe5, 0xa1, 0x52,
-	0x29, 0xc7, 0x43, 0xa9, 0x64, 0x7f, 0x90, 0x5d, 0x18, 0x27, 0x6f, 0xdb, 0xac, 0x4d, 0x34, 0x63,
-	0xd7, 0x20, 0x3a, 0x5c, 0x01, 0x30, 0x88, 0xab, 0xd6, 0x8a, 0xb5, 0xf2, 0x4e, 0x5c, 0x4a, 0xcd,
-	0xf7, 0x07, 0xd9, 0xf8, 0x18, 0x52, 0xa5, 0x4d, 0xaa, 0xf7, 0xe0, 0x3f, 0x40, 0x22, 0x98, 0x5d,
-	0xab, 0xfe, 0x7f, 0x47, 0x2d, 0x94, 0xcb, 0xa8, 0x52, 0xaf, 0xc7, 0x27, 0x8e, 0x97, 0xa9, 0xd9,
-	0x66, 0xaf, 0xe0, 0x7d, 0x1c, 0xe1, 0x1a, 0x58, 0x08, 0x02, 0x2b, 0x8f, 0x2b, 0x68, 0x47, 0x54,
-	0x9a, 0x4c, 0x2d, 0xf5, 0x07, 0xd9, 0xcb, 0x63, 0x54, 0x65, 0x9f, 0x38, 0x3d, 0xb7, 0x58, 0x6a,
-	0xe6, 0xe5, 0xd7, 0xe9, 0xd0, 0xab, 0x6f, 0xd2, 0xa1, 0xdb, 0x6f, 0x24, 0x30, 0x77, 0xf4, 0x32,
-	0xc0, 0x7f, 0x83, 0x2b, 0xa5, 0x5a, 0xb5, 0x81, 0x0a, 0xa5, 0x86, 0x5a, 0x6f, 0x14, 0x1a, 0xdb,
-	0xf5, 0x63, 0x9a, 0xaf, 0xf5, 0x07, 0xd9, 0xe4, 0x51, 0x50, 0x50, 0xf7, 0xdf, 0xc0, 0xe2, 0x71,
-	0x7c, 0xa1, 0xd4, 0x50, 0x1e, 0x57, 0xe2, 0x52, 0x2a, 0xd1, 0x1f, 0x64, 0xe7, 0x4b, 0xc7, 0xbe,
-	0x31, 0xdc, 0xd8, 0x27, 0xf0, 0x9f, 0x20, 0x71, 0x1c, 0xa5, 0x54, 0x7d, 0xdc, 0x44, 0x2a, 0xd5,
-	0x1f, 0x64, 0x17, 0x8f, 0xe2, 0x14, 0x1b, 0x0b, 0x64, 0x40, 0xcc, 0xb7, 0x93, 0x20, 0x7b, 0xd6,
-	0x7d, 0x81, 0x04, 0xdc, 0xfd, 0x50, 0xa8, 0x54, 0x2b, 0x57, 0xd4, 0x0d, 0xa5, 0xde, 0xa8, 0xa1,
-	0x1d, 0xb5, 0xb6, 0x55, 0x41, 0x85, 0x86, 0x52, 0xab, 0x9e, 0x34, 0xe7, 0x7c, 0x7f, 0x90, 0xbd,
-	0x73, 0x16, 0x77, 0xb0, 0x0b, 0x4f, 0xc0, 0xad, 0x73, 0x95, 0x51, 0xaa, 0x4a, 0x23, 0x2e, 0xa5,
-	0x96, 0xfb, 0x83, 0xec, 0x8d, 0xb3, 0xf8, 0x15, 0xdb, 0xe0, 0xf0, 0x33, 0xb0, 0x72, 0x2e, 0xe2,
-	0x4d, 0xe5, 0x11, 0x2a, 0x34, 0xdc, 0xe6, 0xdd, 0xe9, 0x0f, 0xb2, 0x7f, 0x3d, 0x8b, 0x7b, 0xd3,
-	0x68, 0x39, 0x98, 0x93, 0x73, 0xd3, 0x3f, 0xaa, 0x54, 0x2b, 0x75, 0xa5, 0x1e, 0x9f, 0x3c, 0x1f,
-	0xfd, 0x23, 0x62, 0x13, 0x66, 0xb0, 0x54, 0xd8, 0x1d, 0x56, 0xf1, 0xe1, 0xeb, 0x9f, 0xd2, 0xa1,
-	0x57, 0x07, 0x69, 0xe9, 0xf5, 0x41, 0x5a, 0x7a, 0x7b, 0x90, 0x96, 0x7e, 0x3c, 0x48, 0x4b, 0x9f,
-	0xbf, 0x4b, 0x87, 0xde, 0xbe, 0x4b, 0x87, 0x7e, 0x78, 0x97, 0x0e, 0x7d, 0x7a, 0xe3, 0xf8, 0x65,
-	0x36, 0x9b, 0xd6, 0x2a, 0xd3, 0x9f, 0xe5, 0xbb, 0xde, 0x7f, 0x6c, 0xf1, 0x07, 0xbb, 0x19, 0x11,
-	0x8f, 0xf2, 0xfd, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa3, 0xad, 0x2c, 0x63, 0x7c, 0x0b, 0x00,
-	0x00,
+	// 1835 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x92, 0xd4, 0x0f, 0x8e, 0x64, 0x85, 0x9e, 0x50, 0xd2, 0x8a, 0x96, 0x49, 0x7a, 0x1d,
+	0xe3, 0xab, 0xc4, 0x36, 0x19, 0x2b, 0x5f, 0x34, 0xb5, 0x81, 0xa4, 0xe0, 0x2f, 0x5b, 0x6c, 0x23,
+	0x52, 0x19, 0x52, 0x76, 0x54, 0x20, 0x58, 0x2c, 0x77, 0x47, 0xab, 0x89, 0x97, 0x3b, 0xec, 0xce,
+	0x50, 0x16, 0xf3, 0x07, 0x14, 0x01, 0x4f, 0x3d, 0x16, 0x05, 0x08, 0x14, 0x68, 0x51, 0xa4, 0x3d,
+	0xf5, 0xd0, 0xff, 0xa0, 0x40, 0x61, 0xe4, 0x94, 0x43, 0x0f, 0x3d, 0xb1, 0xad, 0x7c, 0xe9, 0xa5,
+	0x17, 0x5d, 0x0a, 0xe4, 0xd2, 0x62, 0x66, 0x97, 0xda, 0x95, 0x2c, 0x59, 0x42, 0x6e, 0x7c, 0x3f,
+	0x3e, 0x9f, 0x37, 0xef, 0xcd, 0x7b, 0x6f, 0x16, 0x04, 0x2b, 0x4e, 0xa7, 0x5b, 0x7c, 0x61, 0xb0,
+	0x6e, 0xf1, 0xe0, 0x41, 0x91, 0x0f, 0x7a, 0x98, 0x15, 0x7a, 0x1e, 0xe5, 0x14, 0xce, 0x3b, 0x9d,
+	0x6e, 0x41, 0x18, 0x0a, 0x07, 0x0f, 0x32, 0xab, 0x26, 0x65, 0x5d, 0xca, 0x74, 0x69, 0x2a, 0xfa,
+	0x82, 0xef, 0x97, 0x49, 0xdb, 0xd4, 0xa6, 0xbe, 0x5e, 0xfc, 0x0a, 0xb4, 0xab, 0x36, 0xa5, 0xb6,
+	0x83, 0x8b, 0x52, 0xea, 0xf4, 0xf7, 0x8a, 0x86, 0x3b, 0x08, 0x4c, 0xcb, 0x22, 0x62, 0xc7, 0x60,
+	0x58, 0x44, 0x34, 0x29, 0x71, 0x7d, 0xbd, 0xf6, 0x19, 0x78, 0xab, 0x64, 0x9a, 0x98, 0xb1, 0xf6,
+	0xa0, 0x87, 0xb7, 0x0d, 0xcf, 0xe8, 0xc2, 0x1f, 0x81, 0xe9, 0x03, 0xc3, 0xe9, 0x63, 0x55, 0xc9,
+	0x2b, 0xeb, 0x8b, 0x1b, 0x2b, 0x85, 0xc8, 0x99, 0x0a, 0xa1, 0x73, 0x39, 0x75, 0x3c, 0xce, 0x2d,
+	0x0c, 0x8c, 0xae, 0xf3, 0x48, 0x93, 0xfe, 0x1a, 0xf2, 0x71, 0x8f, 0x12, 0xbf, 0xfc, 0x75, 0x4e,
+	0xd1, 0xfe, 0xa2, 0x80, 0x05, 0xdf, 0xbb, 0x42, 0xdd, 0x3d, 0x62, 0xc3, 0x06, 0x00, 0x3d, 0xec,
+	0x75, 0x09, 0x63, 0x84, 0xba, 0x97, 0x91, 0x2f, 0x1d, 0x8f, 0x73, 0xd7, 0x7d, 0xf2, 0x10, 0xa4,
+	0xa1, 0x08, 0x03, 0xbc, 0x07, 0x66, 0x0d, 0xcb, 0xf2, 0x30, 0x63, 0x6a, 0x2c, 0xaf, 0xac, 0x27,
+	0xcb, 0xf0, 0x78, 0x9c, 0x5b, 0xf4, 0x31, 0x81, 0x41, 0x43, 0x13, 0x17, 0xb8, 0x01, 0x92, 0xc1,
+	0x4f, 0xcc, 0xd4, 0x78, 0x3e, 0xbe, 0x9e, 0x2c, 0xa7, 0x8f, 0xc7, 0xb9, 0xd4, 0x29, 0x7f, 0xcc,
+	0x34, 0x14, 0xba, 0x05, 0x89, 0xfc, 0x75, 0x16, 0xcc, 0xc8, 0xca, 0x30, 0xf8, 0x05, 0x80, 0x26,
+	0xb5, 0xb0, 0xde, 0xef, 0x39, 0xd4, 0xb0, 0x74, 0x43, 0x9e, 0x57, 0xa6, 0x32, 0xbf, 0xb1, 0x7a,
+	0x4e, 0x2a, 0x7e, 0xe6, 0xe5, 0x5b, 0x2f, 0xc7, 0xb9, 0xa9, 0xe3, 0x71, 0x6e, 0xd5, 0x0f, 0xf6,
+	0x3a, 0x85, 0x86, 0x52, 0x42, 0xb9, 0x23, 0x75, 0x3e, 0x14, 0x7e, 0xa5, 0x80, 0x2c, 0x71, 0x19,
+	0x37, 0x5c, 0x4e, 0x0c, 0x8e, 0x75, 0x0b, 0xef, 0x19, 0x7d, 0x87, 0xeb, 0x91, 0x1a, 0xc6, 0xde,
+	0x5c, 0xc3, 0x77, 0x8f, 0xc7, 0xb9, 0x3b, 0x7e, 0xc8, 0x37, 0x13, 0x69, 0x68, 0x2d, 0xe2, 0x50,
+	0xf5, 0xed, 0xdb, 0x61, 0xa5, 0x0f, 0xc0, 0xb2, 0x49, 0x5d, 0xee, 0x19, 0x26, 0xd7, 0x19, 0x37,
+	0x78, 0x9f, 0x4d, 0x52, 0x8f, 0x5f, 0x96, 0xfa, 0x9d, 0x20, 0xf5, 0x9b, 0x93, 0xd4, 0xcf, 0xa3,
+	0xd1, 0x50, 0x7a, 0x62, 0x68, 0x49, 0x7d, 0x50, 0x82, 0x1f, 0x03, 0xd8, 0x35, 0x0e, 0x75, 0x41,
+	0xac, 0xcb, 0xa2, 0x31, 0xf2, 0x25, 0x56, 0x13, 0x79, 0x65, 0x3d, 0x51, 0xbe, 0x19, 0xd6, 0xf3,
+	0x75, 0x1f, 0x0d, 0xbd, 0xd5, 0x35, 0x0e, 0x9f, 0x19, 0xac, 0x5b, 0xa1, 0x16, 0x6e, 0x91, 0x2f,
+	0x31, 0x7c, 0x08, 0x16, 0x6d, 0x83, 0xe9, 0xdd, 0xbe, 0xc3, 0x49, 0xcf, 0x21, 0xd8, 0x53, 0xa7,
+	0x25, 0x4f, 0xa4, 0x69, 0x04, 0x8f, 0x6d, 0x30, 0x0d, 0x5d, 0xb3, 0x0d, 0xb6, 0x75, 0xe2, 0x08,
+	0x3f, 0x02, 0xd7, 0xfc, 0xf2, 0x98, 0x58, 0x37, 0x29, 0xe3, 0xea, 0x8c, 0x44, 0xaa, 0xc7, 0xe3,
+	0x5c, 0x3a, 0x5a, 0xde, 0xc0, 0xac, 0xa1, 0x85, 0x89, 0x5c, 0xa1, 0x8c, 0xc3, 0x47, 0x60, 0xc1,
+	0xa4, 0xdd, 0x1e, 0x71, 0x02, 0xf4, 0xac, 0x44, 0xaf, 0x1c, 0x8f, 0x73, 0x6f, 0x4f, 0x8a, 0x12,
+	0x5a, 0x35, 0x34, 0x1f, 0x88, 0x12, 0xfb, 0x2b, 0x05, 0xa8, 0x8c, 0x53, 0xcf, 0xb0, 0xc5, 0xbd,
+	0xf5, 0x28, 0x23, 0xf2, 0xde, 0xf4, 0xce, 0x80, 0x63, 0x75, 0x2e, 0x1f, 0x5f, 0x9f, 0xdf, 0xb8,
+	0x2e, 0x8b, 0x2f, 0x46, 0x5b, 0x14, 0xbf, 0x42, 0x89, 0x5b, 0x6e, 0x04, 0x45, 0xcf, 0xf9, 0xfc,
+	0x17, 0x11, 0x68, 0x7f, 0xf8, 0x7b, 0xee, 0xb6, 0x4d, 0xf8, 0x7e, 0xbf, 0x53, 0x30, 0x69, 0xb7,
+	0xe8, 0x10, 0x17, 0x17, 0x9d, 0x4e, 0xf7, 0x3e, 0xb3, 0x9e, 0x07, 0x5b, 0x49, 0xd0, 0x31, 0xb4,
+	0x14, 0x30, 0x54, 0x7d, 0x82, 0x6d, 0xec, 0x95, 0x07, 0x1c, 0xc3, 0xcf, 0xc5, 0xd9, 0x0c, 0xcf,
+	0x16, 0x4d, 0xf5, 0xb3, 0x3e, 0xf6, 0x06, 0xba, 0xe1, 0x38, 0xf4, 0x85, 0x43, 0x18, 0x57, 0x93,
+	0x72, 0xc2, 0x6e, 0x47, 0x0f, 0x71, 0xbe, 0xa7, 0x86, 0x96, 0x27, 0xa6, 0x4f, 0x85, 0xa5, 0x34,
+	0x31, 0xc0, 0x67, 0xe0, 0xc4, 0xa2, 0x77, 0x99, 0x1d, 0x21, 0x07, 0x92, 0xfc, 0x56, 0xd8, 0x56,
+	0xe7, 0xfb, 0x69, 0x28, 0x3d, 0x31, 0x6c, 0x31, 0xfb, 0x84, 0x58, 0x8e, 0xf5, 0x94, 0xf6, 0x67,
+	0x05, 0xcc, 0x89, 0xee, 0xa8, 0xbb, 0x7b, 0x14, 0xde, 0x00, 0x49, 0xd9, 0x3c, 0xfb, 0x06, 0xdb,
+	0x97, 0xf3, 0xbc, 0x80, 0xe6, 0x84, 0x62, 0xd3, 0x60, 0xfb, 0x50, 0x05, 0xb3, 0xa6, 0x87, 0x0d,
+	0x4e, 0x3d, 0x7f, 0xd1, 0xa0, 0x89, 0x08, 0x97, 0xc1, 0x0c, 0xa3, 0x7d, 0xcf, 0xc4, 0x72, 0x10,
+	0x92, 0x28, 0x90, 0x04, 0xa2, 0xd3, 0x27, 0x8e, 0x85, 0x3d, 0xd9, 0xad, 0x49, 0x34, 0x11, 0x61,
+	0x03, 0xc0, 0xe8, 0x2c, 0x9a, 0x72, 0x4a, 0xd4, 0xe9, 0xcb, 0xc6, 0x28, 0x21, 0x6e, 0x14, 0x5d,
+	0x8f, 0x40, 0x7d, 0x83, 0xf6, 0xef, 0x18, 0x58, 0xa8, 0x04, 0xb3, 0x23, 0x33, 0xb9, 0x0d, 0x66,
+	0x65, 0x26, 0xc4, 0x92, 0x79, 0x24, 0xca, 0xe0, 0x68, 0x9c, 0x9b, 0x91, 0x89, 0x56, 0xd1, 0x8c,
+	0x30, 0xd5, 0xad, 0x37, 0x64, 0x94, 0x06, 0xd3, 0x86, 0xd5, 0x25, 0x6e, 0x90, 0x90, 0x2f, 0x08,
+	0xad, 0x63, 0x74, 0xb0, 0x13, 0x64, 0xe3, 0x0b, 0xf0, 0x61, 0xc0, 0x82, 0xad, 0x20, 0x81, 0xdc,
+	0xe9, 0x04, 0x3a, 0x8c, 0x3a, 0x7d, 0x8e, 0xdb, 0x87, 0xdb, 0xa2, 0x6b, 0x08, 0x75, 0xd1, 0xc4,
+	0x1f, 0xde, 0x07, 0xf3, 0xa4, 0x63, 0xea, 0x3d, 0xea, 0x71, 0x71, 0xd2, 0x19, 0xb9, 0xbf, 0xaf,
+	0x1d, 0x8d, 0x73, 0xc9, 0x7a, 0xb9, 0xb2, 0x4d, 0x3d, 0x5e, 0xaf, 0xa2, 0x24, 0xe9, 0x98, 0xf2,
+	0xa7, 0x05, 0x3f, 0x00, 0x33, 0xfe, 0xc2, 0x90, 0xc3, 0xb3, 0xb8, 0x71, 0xe3, 0x54, 0xa0, 0xca,
+	0xa9, 0xdd, 0x81, 0x02, 0x57, 0xb8, 0x05, 0x92, 0xf8, 0x90, 0x63, 0x57, 0xae, 0xca, 0x39, 0x79,
+	0xc0, 0x74, 0xc1, 0x7f, 0x21, 0x0b, 0x93, 0x17, 0xb2, 0x50, 0x72, 0x07, 0xe5, 0xd5, 0x6f, 0xfe,
+	0x74, 0x7f, 0x29, 0x5a, 0xc4, 0xda, 0x04, 0x86, 0x42, 0x86, 0x47, 0x89, 0x7f, 0x89, 0xc7, 0xe0,
+	0x3f, 0x0a, 0x50, 0x27, 0xae, 0xa2, 0xa8, 0x9b, 0x44, 0xcc, 0xc6, 0xa0, 0xe6, 0x72, 0x6f, 0x00,
+	0x7f, 0x02, 0x92, 0xb4, 0x87, 0x3d, 0x83, 0x87, 0x0f, 0xdc, 0xfd, 0x73, 0x4f, 0x1a, 0x41, 0x36,
+	0x27, 0x00, 0xb1, 0xb2, 0x51, 0x88, 0x8f, 0x5e, 0x64, 0xec, 0xc2, 0x8b, 0x7c, 0x08, 0x66, 0xfb,
+	0x3d, 0x4b, 0x5e, 0x41, 0xfc, 0x8a, 0x57, 0x10, 0xf8, 0xc3, 0x02, 0x88, 0x77, 0x99, 0x2d, 0x6f,
+	0x74, 0xa1, 0xbc, 0xf6, 0xdd, 0x38, 0xa7, 0x62, 0xd7, 0xa4, 0x16, 0x71, 0xed, 0xe2, 0x17, 0x8c,
+	0xba, 0x05, 0x64, 0xbc, 0xd8, 0xc2, 0x8c, 0x19, 0x36, 0x46, 0xc2, 0x51, 0x43, 0x00, 0xbe, 0x4e,
+	0x07, 0x6f, 0x81, 0x85, 0x8e, 0x43, 0xcd, 0xe7, 0xfa, 0x3e, 0x26, 0xf6, 0x3e, 0xf7, 0x7b, 0x0e,
+	0xcd, 0x4b, 0xdd, 0xa6, 0x54, 0xc1, 0x55, 0x30, 0xc7, 0x0f, 0x75, 0xe2, 0x5a, 0xf8, 0xd0, 0xcf,
+	0x04, 0xcd, 0xf2, 0xc3, 0xba, 0x10, 0x35, 0x03, 0x4c, 0x6f, 0x51, 0x0b, 0x3b, 0xb0, 0x0c, 0xe2,
+	0xcf, 0xf1, 0xc0, 0x9f, 0xbc, 0xf2, 0xfb, 0xdf, 0x8d, 0x73, 0xf7, 0xce, 0xee, 0x25, 0xca, 0x44,
+	0xe5, 0xa8, 0x5b, 0x74, 0x48, 0x87, 0x15, 0xc5, 0xfa, 0x62, 0x85, 0x4d, 0x7c, 0x28, 0x16, 0x11,
+	0x43, 0x02, 0x2c, 0x9a, 0xd4, 0xff, 0x6e, 0x89, 0xc9, 0xf9, 0xf5, 0x05, 0xed, 0x8f, 0x0a, 0x48,
+	0x96, 0xe5, 0x69, 0x28, 0x7d, 0x0e, 0x3f, 0x04, 0xf3, 0x1d, 0x6c, 0x13, 0x57, 0x97, 0x07, 0x94,
+	0xf1, 0xe6, 0xca, 0xcb, 0xc7, 0xe3, 0x1c, 0xf4, 0x17, 0x49, 0xc4, 0xa8, 0x21, 0x20, 0x25, 0x09,
+	0x86, 0x0f, 0x40, 0x12, 0xbb, 0x56, 0x00, 0x8b, 0x49, 0x58, 0xe4, 0xf3, 0xe1, 0xc4, 0xa4, 0xa1,
+	0x39, 0xec, 0x5a, 0x27, 0x10, 0xf1, 0xe2, 0x38, 0xa4, 0x4b, 0xb8, 0xbc, 0x9d, 0x44, 0x14, 0x72,
+	0x62, 0xd2, 0xd0, 0x9c, 0x6d, 0xb0, 0x4f, 0xc4, 0xcf, 0xa0, 0xc7, 0x7e, 0xae, 0x80, 0x74, 0xd8,
+	0xd3, 0x72, 0xf3, 0xee, 0x88, 0x7b, 0x10, 0x19, 0xca, 0xc4, 0x83, 0x2a, 0xfb, 0x02, 0xfc, 0x0c,
+	0xcc, 0x06, 0x9b, 0x5d, 0x8d, 0x5d, 0xf4, 0x22, 0xdc, 0x15, 0xfb, 0xe3, 0xaa, 0xeb, 0x7e, 0x42,
+	0xa7, 0xfd, 0x37, 0x06, 0x52, 0xa2, 0xe1, 0x9e, 0x62, 0x8f, 0xec, 0x11, 0xf3, 0xb5, 0xbe, 0xbc,
+	0x78, 0xc1, 0x6c, 0x45, 0xf7, 0x69, 0xec, 0x7b, 0xde, 0x6a, 0xb8, 0x81, 0xd7, 0x40, 0x92, 0xf5,
+	0x3b, 0x5d, 0xc2, 0x39, 0xf6, 0x82, 0xcd, 0x14, 0x2a, 0xe0, 0xa7, 0x60, 0xde, 0xdf, 0xbb, 0x7e,
+	0xb8, 0xc4, 0xf7, 0x0c, 0x07, 0x7c, 0x12, 0x19, 0xf0, 0x0e, 0x58, 0x0c, 0x36, 0xb6, 0x6e, 0x11,
+	0x1b, 0x33, 0x2e, 0x37, 0x5c, 0x12, 0x5d, 0x0b, 0xb4, 0x55, 0xa9, 0x84, 0x79, 0x30, 0x6f, 0x70,
+	0x8e, 0x19, 0xf7, 0x47, 0x7e, 0x46, 0x36, 0x5e, 0x54, 0x05, 0x3f, 0x0a, 0x4f, 0x6e, 0xa9, 0xb3,
+	0x57, 0x1b, 0xd1, 0x10, 0xf1, 0xde, 0xef, 0x63, 0x00, 0x84, 0x5f, 0x74, 0xf0, 0x07, 0x60, 0xa5,
+	0x54, 0xa9, 0xd4, 0x5a, 0x2d, 0xbd, 0xbd, 0xbb, 0x5d, 0xd3, 0x77, 0x1a, 0xad, 0xed, 0x5a, 0xa5,
+	0xfe, 0xb8, 0x5e, 0xab, 0xa6, 0xa6, 0x32, 0xab, 0xc3, 0x51, 0x7e, 0x29, 0x74, 0xde, 0x71, 0x59,
+	0x0f, 0x9b, 0x64, 0x8f, 0x60, 0x0b, 0xde, 0x03, 0x30, 0x8a, 0x6b, 0x34, 0xcb, 0xcd, 0xea, 0x6e,
+	0x4a, 0xc9, 0xa4, 0x87, 0xa3, 0x7c, 0x2a, 0x84, 0x34, 0x68, 0x87, 0x5a, 0x03, 0xf8, 0x21, 0x50,
+	0xa3, 0xde, 0xcd, 0xc6, 0x27, 0xbb, 0x7a, 0xa9, 0x5a, 0x45, 0xb5, 0x56, 0x2b, 0x15, 0x3b, 0x1b,
+	0xa6, 0xe9, 0x3a, 0x83, 0xd2, 0xc9, 0x37, 0xf6, 0x52, 0x14, 0x58, 0x7b, 0x5a, 0x43, 0xbb, 0x32,
+	0x52, 0x3c, 0xb3, 0x32, 0x1c, 0xe5, 0xdf, 0x0e, 0x51, 0xb5, 0x03, 0xec, 0x0d, 0x64, 0xb0, 0x8f,
+	0xc1, 0x5a, 0x14, 0x53, 0x6a, 0xec, 0xea, 0xcd, 0xc7, 0x93, 0x70, 0xb5, 0x56, 0x2a, 0x91, 0x59,
+	0x1b, 0x8e, 0xf2, 0x6a, 0x08, 0x2d, 0xb9, 0x83, 0xe6, 0x5e, 0x69, 0xf2, 0x8d, 0x9e, 0x99, 0xfb,
+	0xea, 0x37, 0xd9, 0xa9, 0xaf, 0x7f, 0x9b, 0x9d, 0x7a, 0xef, 0x1b, 0x05, 0x2c, 0x9e, 0x7e, 0x0a,
+	0xe0, 0xc7, 0xe0, 0x46, 0xa5, 0xd9, 0x68, 0xa3, 0x52, 0xa5, 0xad, 0xb7, 0xda, 0xa5, 0xf6, 0x4e,
+	0xeb, 0x4c, 0xcd, 0x6e, 0x0e, 0x47, 0xf9, 0xd5, 0xd3, 0xa0, 0x68, 0xdd, 0xfe, 0x1f, 0x2c, 0x9f,
+	0xc5, 0x97, 0x2a, 0xed, 0xfa, 0xd3, 0x5a, 0x4a, 0xc9, 0xa8, 0xc3, 0x51, 0x3e, 0x5d, 0x39, 0xf3,
+	0xd9, 0xca, 0xc9, 0x01, 0x86, 0x3f, 0x04, 0xea, 0x59, 0x54, 0xbd, 0x11, 0xe0, 0x62, 0x99, 0xcc,
+	0x70, 0x94, 0x5f, 0x3e, 0x8d, 0xab, 0xbb, 0x86, 0x44, 0x46, 0x92, 0xf9, 0x5d, 0x1c, 0xe4, 0x2f,
+	0x7b, 0x2d, 0x20, 0x06, 0xef, 0x9f, 0x04, 0xaa, 0x34, 0xab, 0x35, 0x7d, 0xb3, 0xde, 0x6a, 0x37,
+	0xd1, 0xae, 0xde, 0xdc, 0xae, 0xa1, 0x52, 0xbb, 0xde, 0x6c, 0x9c, 0xd7, 0x27, 0xc5, 0xe1, 0x28,
+	0x7f, 0xf7, 0x32, 0xee, 0x68, 0x15, 0x9e, 0x81, 0x77, 0xaf, 0x14, 0xa6, 0xde, 0xa8, 0xb7, 0x53,
+	0x4a, 0x66, 0x7d, 0x38, 0xca, 0xbf, 0x73, 0x19, 0x7f, 0xdd, 0x25, 0x1c, 0x7e, 0x0e, 0xee, 0x5d,
+	0x89, 0x78, 0xab, 0xfe, 0x04, 0x95, 0xda, 0xa2, 0x78, 0x77, 0x87, 0xa3, 0xfc, 0xff, 0x5d, 0xc6,
+	0xbd, 0x45, 0x6c, 0xcf, 0xe0, 0xf8, 0xca, 0xf4, 0x4f, 0x6a, 0x8d, 0x5a, 0xab, 0xde, 0x4a, 0xc5,
+	0xaf, 0x46, 0xff, 0x04, 0xbb, 0x98, 0x11, 0x96, 0x49, 0x88, 0xcb, 0x2a, 0x3f, 0x7e, 0xf9, 0xcf,
+	0xec, 0xd4, 0xd7, 0x47, 0x59, 0xe5, 0xe5, 0x51, 0x56, 0xf9, 0xf6, 0x28, 0xab, 0xfc, 0xe3, 0x28,
+	0xab, 0xfc, 0xe2, 0x55, 0x76, 0xea, 0xdb, 0x57, 0xd9, 0xa9, 0xbf, 0xbd, 0xca, 0x4e, 0xfd, 0xf4,
+	0x9d, 0x8b, 0x76, 0xee, 0xa1, 0xff, 0x17, 0x80, 0x5c, 0xbd, 0x9d, 0x19, 0xf9, 0x49, 0xf2, 0xc1,
+	0xff, 0x06, 0x00, 0xdb, 0xba, 0x40, 0xb9, 0x1b, 0x10, 0x00, 0x00,
 }
 
 func (this *AccessTypeParam) Equal(that interface{}) bool {
@@ -632,6 +819,14 @@ func (this *AccessConfig) Equal(that interface{}) bool {
 	if this.Address != that1.Address {
 		return false
 	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -674,6 +869,30 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.CompileCost != that1.CompileCost {
 		return false
 	}
+	if len(this.StorageDepositPerByte) != len(that1.StorageDepositPerByte) {
+		return false
+	}
+	for i := range this.StorageDepositPerByte {
+		if !this.StorageDepositPerByte[i].Equal(&that1.StorageDepositPerByte[i]) {
+			return false
+		}
+	}
+	if len(this.StargateQueryAllowlist) != len(that1.StargateQueryAllowlist) {
+		return false
+	}
+	for i := range this.StargateQueryAllowlist {
+		if this.StargateQueryAllowlist[i] != that1.StargateQueryAllowlist[i] {
+			return false
+		}
+	}
+	if len(this.StargateMsgAllowlist) != len(that1.StargateMsgAllowlist) {
+		return false
+	}
+	for i := range this.StargateMsgAllowlist {
+		if this.StargateMsgAllowlist[i] != that1.StargateMsgAllowlist[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *CodeInfo) Equal(that interface{}) bool {
@@ -844,6 +1063,110 @@ func (this *Model) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *BlockHook) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockHook)
+	if !ok {
+		that2, ok := that.(BlockHook)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BeginBlock != that1.BeginBlock {
+		return false
+	}
+	if this.EndBlock != that1.EndBlock {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	return true
+}
+func (this *ContractStorageUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractStorageUsage)
+	if !ok {
+		that2, ok := that.(ContractStorageUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Bytes != that1.Bytes {
+		return false
+	}
+	if len(this.Deposit) != len(that1.Deposit) {
+		return false
+	}
+	for i := range this.Deposit {
+		if !this.Deposit[i].Equal(&that1.Deposit[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *CodeVerification) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CodeVerification)
+	if !ok {
+		that2, ok := that.(CodeVerification)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if !bytes.Equal(this.CodeHash, that1.CodeHash) {
+		return false
+	}
+	if this.Submitter != that1.Submitter {
+		return false
+	}
+	if !bytes.Equal(this.SourceHash, that1.SourceHash) {
+		return false
+	}
+	if this.BuilderDigest != that1.BuilderDigest {
+		return false
+	}
+	if !bytes.Equal(this.Attestation, that1.Attestation) {
+		return false
+	}
+	if !this.Submitted.Equal(that1.Submitted) {
+		return false
+	}
+	return true
+}
 func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -892,6 +1215,15 @@ func (m *AccessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -927,6 +1259,38 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StargateMsgAllowlist) > 0 {
+		for iNdEx := len(m.StargateMsgAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StargateMsgAllowlist[iNdEx])
+			copy(dAtA[i:], m.StargateMsgAllowlist[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.StargateMsgAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.StargateQueryAllowlist) > 0 {
+		for iNdEx := len(m.StargateQueryAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StargateQueryAllowlist[iNdEx])
+			copy(dAtA[i:], m.StargateQueryAllowlist[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.StargateQueryAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.StorageDepositPerByte) > 0 {
+		for iNdEx := len(m.StorageDepositPerByte) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageDepositPerByte[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.CompileCost != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.CompileCost))
 		i--
@@ -1243,62 +1607,233 @@ func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BlockHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AccessTypeParam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovTypes(uint64(m.Value))
-	}
-	return n
+
+func (m *BlockHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccessConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BlockHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Permission != 0 {
-		n += 1 + sovTypes(uint64(m.Permission))
+	if m.GasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.EndBlock {
+		i--
+		if m.EndBlock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.BeginBlock {
+		i--
+		if m.BeginBlock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractStorageUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractStorageUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractStorageUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.CodeUploadAccess.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.InstantiateDefaultPermission != 0 {
-		n += 1 + sovTypes(uint64(m.InstantiateDefaultPermission))
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = m.ContractStatusAccess.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.MaxWasmCodeSize != 0 {
-		n += 1 + sovTypes(uint64(m.MaxWasmCodeSize))
+	if m.Bytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Bytes))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.GasMultiplier != 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Submitted != nil {
+		{
+			size, err := m.Submitted.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Attestation) > 0 {
+		i -= len(m.Attestation)
+		copy(dAtA[i:], m.Attestation)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Attestation)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BuilderDigest) > 0 {
+		i -= len(m.BuilderDigest)
+		copy(dAtA[i:], m.BuilderDigest)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BuilderDigest)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SourceHash) > 0 {
+		i -= len(m.SourceHash)
+		copy(dAtA[i:], m.SourceHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SourceHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccessTypeParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovTypes(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *AccessConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Permission != 0 {
+		n += 1 + sovTypes(uint64(m.Permission))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CodeUploadAccess.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.InstantiateDefaultPermission != 0 {
+		n += 1 + sovTypes(uint64(m.InstantiateDefaultPermission))
+	}
+	l = m.ContractStatusAccess.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.MaxWasmCodeSize != 0 {
+		n += 1 + sovTypes(uint64(m.MaxWasmCodeSize))
+	}
+	if m.GasMultiplier != 0 {
 		n += 1 + sovTypes(uint64(m.GasMultiplier))
 	}
 	if m.InstanceCost != 0 {
@@ -1307,6 +1842,24 @@ func (m *Params) Size() (n int) {
 	if m.CompileCost != 0 {
 		n += 1 + sovTypes(uint64(m.CompileCost))
 	}
+	if len(m.StorageDepositPerByte) > 0 {
+		for _, e := range m.StorageDepositPerByte {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.StargateQueryAllowlist) > 0 {
+		for _, s := range m.StargateQueryAllowlist {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.StargateMsgAllowlist) > 0 {
+		for _, s := range m.StargateMsgAllowlist {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1431,6 +1984,78 @@ func (m *Model) Size() (n int) {
 	return n
 }
 
+func (m *BlockHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BeginBlock {
+		n += 2
+	}
+	if m.EndBlock {
+		n += 2
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *ContractStorageUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bytes != 0 {
+		n += 1 + sovTypes(uint64(m.Bytes))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CodeVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.SourceHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.BuilderDigest)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Attestation)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Submitted != nil {
+		l = m.Submitted.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1586,6 +2211,38 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1797,61 +2454,159 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CodeInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDepositPerByte", wireType)
 			}
-			var byteLen int
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageDepositPerByte = append(m.StorageDepositPerByte, types.Coin{})
+			if err := m.StorageDepositPerByte[len(m.StorageDepositPerByte)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StargateQueryAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StargateQueryAllowlist = append(m.StargateQueryAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StargateMsgAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StargateMsgAllowlist = append(m.StargateMsgAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2292,7 +3047,7 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Extension == nil {
-				m.Extension = &types.Any{}
+				m.Extension = &types1.Any{}
 			}
 			if err := m.Extension.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2683,6 +3438,489 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BlockHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BeginBlock = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EndBlock = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractStorageUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractStorageUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractStorageUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CodeVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceHash = append(m.SourceHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.SourceHash == nil {
+				m.SourceHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuilderDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuilderDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestation = append(m.Attestation[:0], dAtA[iNdEx:postIndex]...)
+			if m.Attestation == nil {
+				m.Attestation = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Submitted == nil {
+				m.Submitted = &AbsoluteTxPosition{}
+			}
+			if err := m.Submitted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"net/url"
 	"regexp"
 
@@ -13,6 +14,12 @@ const (
 	// MaxLabelSize is the longest label that can be used when Instantiating a contract
 	MaxLabelSize = 128
 
+	// MaxSaltSize is the longest salt that can be used when instantiating a contract with a predictable address
+	MaxSaltSize = 64
+
+	// MaxEventSchemaSize is the largest JSON schema of the wasm event that can be registered with a code
+	MaxEventSchemaSize = 16 * 1024
+
 	// BuildTagRegexp is a docker image regexp.
 	// We only support max 128 characters, with at least one organization name (subset of all legal names).
 	//
@@ -27,6 +34,12 @@ const (
 	BuildTagRegexp = "^[a-z0-9][a-z0-9._-]*[a-z0-9](/[a-z0-9][a-z0-9._-]*[a-z0-9])+:[a-zA-Z0-9_][a-zA-Z0-9_.-]*$"
 
 	MaxBuildTagSize = 128
+
+	// BuilderDigestRegexp is a sha256 docker image digest, optionally prefixed with the image name
+	BuilderDigestRegexp = "^([a-z0-9][a-z0-9._-]*[a-z0-9](/[a-z0-9][a-z0-9._-]*[a-z0-9])+@)?sha256:[a-f0-9]{64}$"
+
+	// MaxAttestationSize is the largest attestation that can be submitted with a code verification
+	MaxAttestationSize = 4 * 1024
 )
 
 func validateSourceURL(source string) error {
@@ -59,6 +72,34 @@ func validateBuilder(buildTag string) error {
 	return nil
 }
 
+func validateBuilderDigest(digest string) error {
+	if digest == "" {
+		return sdkerrors.Wrap(ErrEmpty, "is required")
+	}
+	if len(digest) > MaxBuildTagSize+len("@sha256:")+64 {
+		return sdkerrors.Wrap(ErrLimit, "too long")
+	}
+	ok, err := regexp.MatchString(BuilderDigestRegexp, digest)
+	if err != nil || !ok {
+		return sdkerrors.Wrap(ErrInvalid, "must be a sha256 image digest")
+	}
+	return nil
+}
+
+func validateSourceHash(hash []byte) error {
+	if len(hash) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalid, "must be a sha256 hash of %d bytes", sha256.Size)
+	}
+	return nil
+}
+
+func validateAttestation(attestation []byte) error {
+	if len(attestation) > MaxAttestationSize {
+		return sdkerrors.Wrapf(ErrLimit, "cannot be longer than %d bytes", MaxAttestationSize)
+	}
+	return nil
+}
+
 func validateWasmCode(s []byte) error {
 	if len(s) == 0 {
 		return sdkerrors.Wrap(ErrEmpty, "is required")
@@ -78,3 +119,14 @@ func validateLabel(label string) error {
 	}
 	return nil
 }
+
+// ValidateSalt ensure salt constraints
+func ValidateSalt(binarySalt []byte) error {
+	switch n := len(binarySalt); {
+	case n == 0:
+		return sdkerrors.Wrap(ErrEmpty, "is required")
+	case n > MaxSaltSize:
+		return sdkerrors.Wrapf(ErrLimit, "cannot be longer than %d bytes", MaxSaltSize)
+	}
+	return nil
+}